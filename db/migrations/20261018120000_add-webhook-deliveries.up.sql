@@ -0,0 +1,20 @@
+CREATE TABLE webhook_deliveries (
+  id uuid NOT NULL DEFAULT uuid_generate_v4(),
+  webhook_id uuid NOT NULL,
+  replay_of uuid,
+  method character varying(16) NOT NULL,
+  remote_ip character varying(64),
+  query text,
+  headers bytea NOT NULL,
+  body bytea NOT NULL,
+  body_truncated boolean NOT NULL DEFAULT false,
+  status_code integer NOT NULL,
+  error text,
+  nodes jsonb NOT NULL DEFAULT '[]'::jsonb,
+  event_ids jsonb NOT NULL DEFAULT '[]'::jsonb,
+  created_at timestamp without time zone NOT NULL,
+  PRIMARY KEY (id),
+  FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_webhook_deliveries_webhook_id_created_at ON webhook_deliveries(webhook_id, created_at DESC);
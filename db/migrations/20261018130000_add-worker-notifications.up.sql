@@ -0,0 +1,33 @@
+CREATE FUNCTION notify_workers() RETURNS trigger
+  LANGUAGE plpgsql
+  AS $$
+BEGIN
+  PERFORM pg_notify(TG_ARGV[0], '');
+  RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER workflow_events_notify_pending
+  AFTER INSERT OR UPDATE OF state ON workflow_events
+  FOR EACH ROW WHEN (NEW.state = 'pending')
+  EXECUTE FUNCTION notify_workers('superplane_pending_events');
+
+CREATE TRIGGER workflow_node_queue_items_notify_created
+  AFTER INSERT ON workflow_node_queue_items
+  FOR EACH ROW
+  EXECUTE FUNCTION notify_workers('superplane_ready_nodes');
+
+CREATE TRIGGER workflow_nodes_notify_ready
+  AFTER UPDATE OF state ON workflow_nodes
+  FOR EACH ROW WHEN (NEW.state = 'ready' AND OLD.state <> 'ready')
+  EXECUTE FUNCTION notify_workers('superplane_ready_nodes');
+
+CREATE TRIGGER workflow_node_executions_notify_pending
+  AFTER INSERT OR UPDATE OF state ON workflow_node_executions
+  FOR EACH ROW WHEN (NEW.state = 'pending')
+  EXECUTE FUNCTION notify_workers('superplane_pending_executions');
+
+CREATE TRIGGER workflow_node_requests_notify_pending
+  AFTER INSERT OR UPDATE OF state, run_at ON workflow_node_requests
+  FOR EACH ROW WHEN (NEW.state = 'pending')
+  EXECUTE FUNCTION notify_workers('superplane_pending_requests');
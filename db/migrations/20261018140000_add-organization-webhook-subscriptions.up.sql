@@ -0,0 +1,37 @@
+CREATE TABLE organization_webhook_subscriptions (
+  id uuid NOT NULL DEFAULT uuid_generate_v4(),
+  organization_id uuid NOT NULL,
+  url text NOT NULL,
+  secret bytea NOT NULL,
+  event_types jsonb NOT NULL DEFAULT '[]'::jsonb,
+  enabled boolean NOT NULL DEFAULT true,
+  created_by uuid,
+  created_at timestamp without time zone NOT NULL,
+  updated_at timestamp without time zone NOT NULL,
+  PRIMARY KEY (id),
+  FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_organization_webhook_subscriptions_organization_id ON organization_webhook_subscriptions(organization_id);
+
+CREATE TABLE organization_webhook_deliveries (
+  id uuid NOT NULL DEFAULT uuid_generate_v4(),
+  subscription_id uuid NOT NULL,
+  event_type character varying(64) NOT NULL,
+  dedupe_key character varying(256) NOT NULL,
+  payload jsonb NOT NULL,
+  state character varying(32) NOT NULL,
+  attempts integer NOT NULL DEFAULT 0,
+  next_attempt_at timestamp without time zone,
+  last_status_code integer,
+  last_error text,
+  delivered_at timestamp without time zone,
+  created_at timestamp without time zone NOT NULL,
+  updated_at timestamp without time zone NOT NULL,
+  PRIMARY KEY (id),
+  UNIQUE (subscription_id, dedupe_key),
+  FOREIGN KEY (subscription_id) REFERENCES organization_webhook_subscriptions(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_organization_webhook_deliveries_subscription_id_created_at ON organization_webhook_deliveries(subscription_id, created_at DESC);
+CREATE INDEX idx_organization_webhook_deliveries_pending ON organization_webhook_deliveries(next_attempt_at) WHERE state = 'pending';
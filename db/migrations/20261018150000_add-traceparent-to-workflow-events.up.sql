@@ -0,0 +1 @@
+ALTER TABLE workflow_events ADD COLUMN traceparent character varying(64);
@@ -0,0 +1,6 @@
+ALTER TABLE workflow_node_executions
+  ADD COLUMN queued_at timestamp without time zone,
+  ADD COLUMN started_at timestamp without time zone,
+  ADD COLUMN finished_at timestamp without time zone;
+
+CREATE INDEX idx_workflow_node_executions_workflow_finished_at ON workflow_node_executions(workflow_id, finished_at);
@@ -0,0 +1,14 @@
+CREATE TABLE workflow_node_execution_logs (
+  id bigserial NOT NULL,
+  workflow_id uuid NOT NULL,
+  node_id character varying(128) NOT NULL,
+  execution_id uuid NOT NULL,
+  level character varying(16) NOT NULL,
+  message text NOT NULL,
+  fields jsonb NOT NULL DEFAULT '{}'::jsonb,
+  created_at timestamp without time zone NOT NULL,
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_workflow_node_execution_logs_execution_id ON workflow_node_execution_logs(execution_id, id);
+CREATE INDEX idx_workflow_node_execution_logs_workflow_node_id ON workflow_node_execution_logs(workflow_id, node_id);
@@ -0,0 +1,19 @@
+CREATE TABLE cli_tokens (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  user_id uuid NOT NULL,
+  name character varying(255) NOT NULL,
+  code_hash character varying(64),
+  code_challenge character varying(128),
+  code_expires_at timestamp without time zone,
+  token_hash character varying(64),
+  expires_at timestamp without time zone NOT NULL,
+  revoked_at timestamp without time zone,
+  created_at timestamp without time zone NOT NULL,
+  updated_at timestamp without time zone NOT NULL,
+  PRIMARY KEY (id),
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_cli_tokens_code_hash ON cli_tokens(code_hash);
+CREATE UNIQUE INDEX idx_cli_tokens_token_hash ON cli_tokens(token_hash);
+CREATE INDEX idx_cli_tokens_user_id ON cli_tokens(user_id);
@@ -33,6 +33,20 @@ CREATE EXTENSION IF NOT EXISTS "uuid-ossp" WITH SCHEMA public;
 COMMENT ON EXTENSION "uuid-ossp" IS 'generate universally unique identifiers (UUIDs)';
 
 
+--
+-- Name: notify_workers(); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.notify_workers() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+  PERFORM pg_notify(TG_ARGV[0], '');
+  RETURN NULL;
+END;
+$$;
+
+
 SET default_tablespace = '';
 
 SET default_table_access_method = heap;
@@ -207,6 +221,25 @@ CREATE SEQUENCE public.casbin_rule_id_seq
 ALTER SEQUENCE public.casbin_rule_id_seq OWNED BY public.casbin_rule.id;
 
 
+--
+-- Name: cli_tokens; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.cli_tokens (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    user_id uuid NOT NULL,
+    name character varying(255) NOT NULL,
+    code_hash character varying(64),
+    code_challenge character varying(128),
+    code_expires_at timestamp without time zone,
+    token_hash character varying(64),
+    expires_at timestamp without time zone NOT NULL,
+    revoked_at timestamp without time zone,
+    created_at timestamp without time zone NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
 --
 -- Name: data_migrations; Type: TABLE; Schema: public; Owner: -
 --
@@ -295,6 +328,44 @@ CREATE TABLE public.organization_invite_links (
 );
 
 
+--
+-- Name: organization_webhook_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.organization_webhook_deliveries (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    subscription_id uuid NOT NULL,
+    event_type character varying(64) NOT NULL,
+    dedupe_key character varying(256) NOT NULL,
+    payload jsonb NOT NULL,
+    state character varying(32) NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp without time zone,
+    last_status_code integer,
+    last_error text,
+    delivered_at timestamp without time zone,
+    created_at timestamp without time zone NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: organization_webhook_subscriptions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.organization_webhook_subscriptions (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    url text NOT NULL,
+    secret bytea NOT NULL,
+    event_types jsonb DEFAULT '[]'::jsonb NOT NULL,
+    enabled boolean DEFAULT true NOT NULL,
+    created_by uuid,
+    created_at timestamp without time zone NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
 --
 -- Name: organizations; Type: TABLE; Schema: public; Owner: -
 --
@@ -370,6 +441,28 @@ CREATE TABLE public.users (
 );
 
 
+--
+-- Name: webhook_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.webhook_deliveries (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    webhook_id uuid NOT NULL,
+    replay_of uuid,
+    method character varying(16) NOT NULL,
+    remote_ip character varying(64),
+    query text,
+    headers bytea NOT NULL,
+    body bytea NOT NULL,
+    body_truncated boolean DEFAULT false NOT NULL,
+    status_code integer NOT NULL,
+    error text,
+    nodes jsonb DEFAULT '[]'::jsonb NOT NULL,
+    event_ids jsonb DEFAULT '[]'::jsonb NOT NULL,
+    created_at timestamp without time zone NOT NULL
+);
+
+
 --
 -- Name: webhooks; Type: TABLE; Schema: public; Owner: -
 --
@@ -402,7 +495,8 @@ CREATE TABLE public.workflow_events (
     state character varying(32) NOT NULL,
     execution_id uuid,
     created_at timestamp without time zone NOT NULL,
-    custom_name text
+    custom_name text,
+    traceparent character varying(64)
 );
 
 
@@ -421,6 +515,41 @@ CREATE TABLE public.workflow_node_execution_kvs (
 );
 
 
+--
+-- Name: workflow_node_execution_logs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workflow_node_execution_logs (
+    id bigint NOT NULL,
+    workflow_id uuid NOT NULL,
+    node_id character varying(128) NOT NULL,
+    execution_id uuid NOT NULL,
+    level character varying(16) NOT NULL,
+    message text NOT NULL,
+    fields jsonb DEFAULT '{}'::jsonb NOT NULL,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: workflow_node_execution_logs_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.workflow_node_execution_logs_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: workflow_node_execution_logs_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.workflow_node_execution_logs_id_seq OWNED BY public.workflow_node_execution_logs.id;
+
+
 --
 -- Name: workflow_node_executions; Type: TABLE; Schema: public; Owner: -
 --
@@ -441,7 +570,10 @@ CREATE TABLE public.workflow_node_executions (
     configuration jsonb DEFAULT '{}'::jsonb NOT NULL,
     created_at timestamp without time zone NOT NULL,
     updated_at timestamp without time zone NOT NULL,
-    cancelled_by uuid
+    cancelled_by uuid,
+    queued_at timestamp without time zone,
+    started_at timestamp without time zone,
+    finished_at timestamp without time zone
 );
 
 
@@ -528,6 +660,13 @@ CREATE TABLE public.workflows (
 ALTER TABLE ONLY public.casbin_rule ALTER COLUMN id SET DEFAULT nextval('public.casbin_rule_id_seq'::regclass);
 
 
+--
+-- Name: workflow_node_execution_logs id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_logs ALTER COLUMN id SET DEFAULT nextval('public.workflow_node_execution_logs_id_seq'::regclass);
+
+
 --
 -- Name: account_password_auth account_password_auth_account_id_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -648,6 +787,14 @@ ALTER TABLE ONLY public.casbin_rule
     ADD CONSTRAINT casbin_rule_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: cli_tokens cli_tokens_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.cli_tokens
+    ADD CONSTRAINT cli_tokens_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: data_migrations data_migrations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -720,6 +867,30 @@ ALTER TABLE ONLY public.organization_invite_links
     ADD CONSTRAINT organization_invite_links_token_key UNIQUE (token);
 
 
+--
+-- Name: organization_webhook_deliveries organization_webhook_deliveries_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_webhook_deliveries
+    ADD CONSTRAINT organization_webhook_deliveries_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: organization_webhook_deliveries organization_webhook_deliveries_subscription_id_dedupe_key_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_webhook_deliveries
+    ADD CONSTRAINT organization_webhook_deliveries_subscription_id_dedupe_key_key UNIQUE (subscription_id, dedupe_key);
+
+
+--
+-- Name: organization_webhook_subscriptions organization_webhook_subscriptions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_webhook_subscriptions
+    ADD CONSTRAINT organization_webhook_subscriptions_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: organizations organizations_name_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -800,6 +971,14 @@ ALTER TABLE ONLY public.users
     ADD CONSTRAINT users_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: webhook_deliveries webhook_deliveries_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.webhook_deliveries
+    ADD CONSTRAINT webhook_deliveries_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: webhooks webhooks_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -832,6 +1011,14 @@ ALTER TABLE ONLY public.workflow_node_requests
     ADD CONSTRAINT workflow_node_execution_requests_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: workflow_node_execution_logs workflow_node_execution_logs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_logs
+    ADD CONSTRAINT workflow_node_execution_logs_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: workflow_node_executions workflow_node_executions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -998,6 +1185,27 @@ CREATE INDEX idx_casbin_rule_v1 ON public.casbin_rule USING btree (v1);
 CREATE INDEX idx_casbin_rule_v2 ON public.casbin_rule USING btree (v2);
 
 
+--
+-- Name: idx_cli_tokens_code_hash; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_cli_tokens_code_hash ON public.cli_tokens USING btree (code_hash);
+
+
+--
+-- Name: idx_cli_tokens_token_hash; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_cli_tokens_token_hash ON public.cli_tokens USING btree (token_hash);
+
+
+--
+-- Name: idx_cli_tokens_user_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_cli_tokens_user_id ON public.cli_tokens USING btree (user_id);
+
+
 --
 -- Name: idx_group_metadata_lookup; Type: INDEX; Schema: public; Owner: -
 --
@@ -1012,6 +1220,27 @@ CREATE INDEX idx_group_metadata_lookup ON public.group_metadata USING btree (gro
 CREATE INDEX idx_node_requests_state_run_at ON public.workflow_node_requests USING btree (state, run_at) WHERE ((state)::text = 'pending'::text);
 
 
+--
+-- Name: idx_organization_webhook_deliveries_pending; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_organization_webhook_deliveries_pending ON public.organization_webhook_deliveries USING btree (next_attempt_at) WHERE ((state)::text = 'pending'::text);
+
+
+--
+-- Name: idx_organization_webhook_deliveries_subscription_id_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_organization_webhook_deliveries_subscription_id_created_at ON public.organization_webhook_deliveries USING btree (subscription_id, created_at DESC);
+
+
+--
+-- Name: idx_organization_webhook_subscriptions_organization_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_organization_webhook_subscriptions_organization_id ON public.organization_webhook_subscriptions USING btree (organization_id);
+
+
 --
 -- Name: idx_organizations_deleted_at; Type: INDEX; Schema: public; Owner: -
 --
@@ -1026,6 +1255,13 @@ CREATE INDEX idx_organizations_deleted_at ON public.organizations USING btree (d
 CREATE INDEX idx_role_metadata_lookup ON public.role_metadata USING btree (role_name, domain_type, domain_id);
 
 
+--
+-- Name: idx_webhook_deliveries_webhook_id_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_webhook_deliveries_webhook_id_created_at ON public.webhook_deliveries USING btree (webhook_id, created_at DESC);
+
+
 --
 -- Name: idx_webhooks_app_installation_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1075,6 +1311,20 @@ CREATE INDEX idx_workflow_node_execution_kvs_ekv ON public.workflow_node_executi
 CREATE INDEX idx_workflow_node_execution_kvs_workflow_node_key_value ON public.workflow_node_execution_kvs USING btree (workflow_id, node_id, key, value);
 
 
+--
+-- Name: idx_workflow_node_execution_logs_execution_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_execution_logs_execution_id ON public.workflow_node_execution_logs USING btree (execution_id, id);
+
+
+--
+-- Name: idx_workflow_node_execution_logs_workflow_node_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_execution_logs_workflow_node_id ON public.workflow_node_execution_logs USING btree (workflow_id, node_id);
+
+
 --
 -- Name: idx_workflow_node_executions_event_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1117,6 +1367,13 @@ CREATE INDEX idx_workflow_node_executions_root_event_id ON public.workflow_node_
 CREATE INDEX idx_workflow_node_executions_state_created_at ON public.workflow_node_executions USING btree (state, created_at DESC);
 
 
+--
+-- Name: idx_workflow_node_executions_workflow_finished_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_executions_workflow_finished_at ON public.workflow_node_executions USING btree (workflow_id, finished_at);
+
+
 --
 -- Name: idx_workflow_node_executions_workflow_node_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1187,6 +1444,41 @@ CREATE INDEX idx_workflows_is_template ON public.workflows USING btree (is_templ
 CREATE INDEX idx_workflows_organization_id ON public.workflows USING btree (organization_id);
 
 
+--
+-- Name: workflow_events workflow_events_notify_pending; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER workflow_events_notify_pending AFTER INSERT OR UPDATE OF state ON public.workflow_events FOR EACH ROW WHEN (((new.state)::text = 'pending'::text)) EXECUTE FUNCTION public.notify_workers('superplane_pending_events');
+
+
+--
+-- Name: workflow_node_executions workflow_node_executions_notify_pending; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER workflow_node_executions_notify_pending AFTER INSERT OR UPDATE OF state ON public.workflow_node_executions FOR EACH ROW WHEN (((new.state)::text = 'pending'::text)) EXECUTE FUNCTION public.notify_workers('superplane_pending_executions');
+
+
+--
+-- Name: workflow_node_queue_items workflow_node_queue_items_notify_created; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER workflow_node_queue_items_notify_created AFTER INSERT ON public.workflow_node_queue_items FOR EACH ROW EXECUTE FUNCTION public.notify_workers('superplane_ready_nodes');
+
+
+--
+-- Name: workflow_node_requests workflow_node_requests_notify_pending; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER workflow_node_requests_notify_pending AFTER INSERT OR UPDATE OF state, run_at ON public.workflow_node_requests FOR EACH ROW WHEN (((new.state)::text = 'pending'::text)) EXECUTE FUNCTION public.notify_workers('superplane_pending_requests');
+
+
+--
+-- Name: workflow_nodes workflow_nodes_notify_ready; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER workflow_nodes_notify_ready AFTER UPDATE OF state ON public.workflow_nodes FOR EACH ROW WHEN ((((new.state)::text = 'ready'::text) AND ((old.state)::text <> 'ready'::text))) EXECUTE FUNCTION public.notify_workers('superplane_ready_nodes');
+
+
 --
 -- Name: account_password_auth account_password_auth_account_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1259,6 +1551,14 @@ ALTER TABLE ONLY public.app_installations
     ADD CONSTRAINT app_installations_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
 
 
+--
+-- Name: cli_tokens cli_tokens_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.cli_tokens
+    ADD CONSTRAINT cli_tokens_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
 --
 -- Name: workflow_node_execution_kvs fk_wnek_workflow; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1331,6 +1631,22 @@ ALTER TABLE ONLY public.organization_invite_links
     ADD CONSTRAINT organization_invite_links_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
 
 
+--
+-- Name: organization_webhook_deliveries organization_webhook_deliveries_subscription_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_webhook_deliveries
+    ADD CONSTRAINT organization_webhook_deliveries_subscription_id_fkey FOREIGN KEY (subscription_id) REFERENCES public.organization_webhook_subscriptions(id) ON DELETE CASCADE;
+
+
+--
+-- Name: organization_webhook_subscriptions organization_webhook_subscriptions_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_webhook_subscriptions
+    ADD CONSTRAINT organization_webhook_subscriptions_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
 --
 -- Name: users users_account_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1347,6 +1663,14 @@ ALTER TABLE ONLY public.users
     ADD CONSTRAINT users_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id);
 
 
+--
+-- Name: webhook_deliveries webhook_deliveries_webhook_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.webhook_deliveries
+    ADD CONSTRAINT webhook_deliveries_webhook_id_fkey FOREIGN KEY (webhook_id) REFERENCES public.webhooks(id) ON DELETE CASCADE;
+
+
 --
 -- Name: webhooks webhooks_app_installation_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1515,7 +1839,7 @@ SET row_security = off;
 --
 
 COPY public.schema_migrations (version, dirty) FROM stdin;
-20260131134819	f
+20261018180000	f
 \.
 
 
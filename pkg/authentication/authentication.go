@@ -93,6 +93,11 @@ func (a *Handler) RegisterRoutes(router *mux.Router) {
 		router.HandleFunc("/signup", a.handlePasswordSignup).Methods("POST")
 	}
 
+	router.HandleFunc("/cli/login", a.handleCLILogin).Methods("GET")
+	router.HandleFunc("/cli/login", a.handleCLIAuthorize).Methods("POST")
+	router.HandleFunc("/cli/token", a.handleCLIToken).Methods("POST")
+	router.HandleFunc("/cli/logout", a.handleCLILogout).Methods("POST")
+
 	//
 	// If we are running the application locally,
 	// we provide handlers that auto-autenticate to
@@ -0,0 +1,320 @@
+package authentication
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/gorm"
+)
+
+/*
+ * The CLI logs in with a loopback redirect:
+ *
+ * 1. The CLI listens on a local port, and opens /cli/login in the browser,
+ *    with the port, a random state and the challenge of a code verifier.
+ * 2. The user logs in with any of the usual providers, if not logged in yet,
+ *    and authorizes the CLI for one of their organizations.
+ * 3. The browser is redirected to the local port, with a login code
+ *    valid for a few minutes, and the state given by the CLI.
+ * 4. The CLI exchanges the code and its verifier for the token in /cli/token.
+ *
+ * The token never goes through the browser, and the code is useless
+ * without the verifier, which only the CLI knows.
+ */
+
+type cliLoginRequest struct {
+	Port          int
+	State         string
+	CodeChallenge string
+	Name          string
+}
+
+func parseCLILoginRequest(values url.Values) (*cliLoginRequest, error) {
+	port, err := strconv.Atoi(values.Get("port"))
+	if err != nil || port < 1024 || port > 65535 {
+		return nil, fmt.Errorf("invalid port")
+	}
+
+	request := &cliLoginRequest{
+		Port:          port,
+		State:         values.Get("state"),
+		CodeChallenge: values.Get("code_challenge"),
+		Name:          strings.TrimSpace(values.Get("name")),
+	}
+
+	if request.State == "" || len(request.State) > 128 {
+		return nil, fmt.Errorf("invalid state")
+	}
+
+	if request.CodeChallenge == "" || len(request.CodeChallenge) > 128 {
+		return nil, fmt.Errorf("invalid code challenge")
+	}
+
+	if request.Name == "" {
+		request.Name = "CLI"
+	}
+
+	if len(request.Name) > 255 {
+		return nil, fmt.Errorf("invalid name")
+	}
+
+	return request, nil
+}
+
+func (r *cliLoginRequest) callbackURL(code string) string {
+	query := url.Values{}
+	query.Set("code", code)
+	query.Set("state", r.State)
+	return fmt.Sprintf("http://127.0.0.1:%d/callback?%s", r.Port, query.Encode())
+}
+
+var cliLoginTemplate = template.Must(template.New("cli-login").Parse(`<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <title>Authorize SuperPlane CLI</title>
+  <style>
+    body { font-family: sans-serif; max-width: 480px; margin: 80px auto; color: #1f2937; }
+    form { margin: 8px 0; }
+    button { width: 100%; padding: 10px; font-size: 15px; cursor: pointer; }
+  </style>
+</head>
+<body>
+  <h1>Authorize SuperPlane CLI</h1>
+  <p>The CLI on <strong>{{.Request.Name}}</strong> is requesting access to SuperPlane as {{.Email}}.</p>
+  {{if .Organizations}}
+  <p>Choose the organization the CLI will have access to:</p>
+  {{range .Organizations}}
+  <form method="POST" action="/cli/login">
+    <input type="hidden" name="port" value="{{$.Request.Port}}">
+    <input type="hidden" name="state" value="{{$.Request.State}}">
+    <input type="hidden" name="code_challenge" value="{{$.Request.CodeChallenge}}">
+    <input type="hidden" name="name" value="{{$.Request.Name}}">
+    <input type="hidden" name="organization_id" value="{{.ID}}">
+    <button type="submit">{{.Name}}</button>
+  </form>
+  {{end}}
+  {{else}}
+  <p>You are not a member of any organization.</p>
+  {{end}}
+</body>
+</html>
+`))
+
+// handleCLILogin asks the user to authorize the CLI
+// for one of the organizations of their account.
+func (a *Handler) handleCLILogin(w http.ResponseWriter, r *http.Request) {
+	request, err := parseCLILoginRequest(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	account, err := a.accountFromCookie(r)
+	if err != nil {
+		loginURL := fmt.Sprintf("/login?redirect=%s", url.QueryEscape(r.URL.RequestURI()))
+		http.Redirect(w, r, loginURL, http.StatusTemporaryRedirect)
+		return
+	}
+
+	organizations, err := models.FindOrganizationsForAccount(account.Email)
+	if err != nil {
+		log.Errorf("Error finding organizations for account %s: %v", account.ID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	err = cliLoginTemplate.Execute(w, map[string]any{
+		"Request":       request,
+		"Email":         account.Email,
+		"Organizations": organizations,
+	})
+
+	if err != nil {
+		log.Errorf("Error rendering CLI login page: %v", err)
+	}
+}
+
+// handleCLIAuthorize creates the login code for the organization chosen,
+// and redirects the browser back to the CLI with it.
+func (a *Handler) handleCLIAuthorize(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	request, err := parseCLILoginRequest(r.PostForm)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	account, err := a.accountFromCookie(r)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	user, err := models.FindActiveUserByEmail(r.PostForm.Get("organization_id"), account.Email)
+	if err != nil {
+		http.Error(w, "Organization not found", http.StatusForbidden)
+		return
+	}
+
+	code, err := crypto.Base64String(32)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	_, err = models.CreateCLILoginCode(user.ID, request.Name, crypto.HashToken(code), request.CodeChallenge)
+	if err != nil {
+		log.Errorf("Error creating CLI login code for user %s: %v", user.ID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, request.callbackURL(code), http.StatusSeeOther)
+}
+
+type cliTokenRequest struct {
+	Code         string `json:"code"`
+	CodeVerifier string `json:"codeVerifier"`
+}
+
+type cliTokenResponse struct {
+	Token          string    `json:"token"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+	OrganizationID string    `json:"organizationId"`
+}
+
+// handleCLIToken exchanges a login code for a token.
+func (a *Handler) handleCLIToken(w http.ResponseWriter, r *http.Request) {
+	var request cliTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if request.Code == "" || request.CodeVerifier == "" {
+		http.Error(w, "Code and code verifier are required", http.StatusBadRequest)
+		return
+	}
+
+	var response cliTokenResponse
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		cliToken, err := models.FindCLILoginCodeInTransaction(tx, crypto.HashToken(request.Code))
+		if err != nil {
+			return err
+		}
+
+		if !verifyCodeChallenge(*cliToken.CodeChallenge, request.CodeVerifier) {
+			return gorm.ErrRecordNotFound
+		}
+
+		token, err := crypto.Base64String(64)
+		if err != nil {
+			return err
+		}
+
+		err = cliToken.ExchangeInTransaction(tx, crypto.HashToken(token))
+		if err != nil {
+			return err
+		}
+
+		var user models.User
+		err = tx.Where("id = ?", cliToken.UserID).First(&user).Error
+		if err != nil {
+			return err
+		}
+
+		response = cliTokenResponse{
+			Token:          token,
+			ExpiresAt:      cliToken.ExpiresAt,
+			OrganizationID: user.OrganizationID.String(),
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			http.Error(w, "Invalid or expired code", http.StatusBadRequest)
+			return
+		}
+
+		log.Errorf("Error exchanging CLI login code: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Errorf("Error encoding CLI token: %v", err)
+	}
+}
+
+// handleCLILogout revokes the CLI token used in the request.
+func (a *Handler) handleCLILogout(w http.ResponseWriter, r *http.Request) {
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !found || token == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	cliToken, err := models.FindActiveCLIToken(crypto.HashToken(token))
+	if err != nil {
+		http.Error(w, "Token not found", http.StatusNotFound)
+		return
+	}
+
+	if err := cliToken.Revoke(); err != nil {
+		log.Errorf("Error revoking CLI token %s: %v", cliToken.ID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (a *Handler) accountFromCookie(r *http.Request) (*models.Account, error) {
+	cookie, err := r.Cookie("account_token")
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := a.jwtSigner.ValidateAndGetClaims(cookie.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, ok := claims["sub"].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid account ID in token")
+	}
+
+	return models.FindAccountByID(accountID)
+}
+
+// verifyCodeChallenge checks the verifier against
+// the S256 challenge the login was started with.
+func verifyCodeChallenge(challenge, verifier string) bool {
+	hash := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(hash[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
@@ -0,0 +1,150 @@
+package authentication
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+func testCodeChallenge(verifier string) string {
+	hash := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+func TestParseCLILoginRequest(t *testing.T) {
+	values := url.Values{"port": {"4321"}, "state": {"abc"}, "code_challenge": {"xyz"}}
+	request, err := parseCLILoginRequest(values)
+	require.NoError(t, err)
+	assert.Equal(t, "CLI", request.Name)
+	assert.Equal(t, "http://127.0.0.1:4321/callback?code=c1&state=abc", request.callbackURL("c1"))
+
+	_, err = parseCLILoginRequest(url.Values{"port": {"80"}, "state": {"abc"}, "code_challenge": {"xyz"}})
+	require.EqualError(t, err, "invalid port")
+
+	_, err = parseCLILoginRequest(url.Values{"port": {"4321"}, "code_challenge": {"xyz"}})
+	require.EqualError(t, err, "invalid state")
+
+	_, err = parseCLILoginRequest(url.Values{"port": {"4321"}, "state": {"abc"}})
+	require.EqualError(t, err, "invalid code challenge")
+}
+
+func TestVerifyCodeChallenge(t *testing.T) {
+	assert.True(t, verifyCodeChallenge(testCodeChallenge("verifier"), "verifier"))
+	assert.False(t, verifyCodeChallenge(testCodeChallenge("verifier"), "other"))
+}
+
+func TestHandler_CLILogin(t *testing.T) {
+	handler, r := setupAuthHandler(t, false)
+
+	cookie, err := handler.jwtSigner.Generate(r.Account.ID.String(), time.Hour)
+	require.NoError(t, err)
+
+	authorize := func(t *testing.T, challenge string) string {
+		form := url.Values{
+			"port":            {"4321"},
+			"state":           {"abc"},
+			"code_challenge":  {challenge},
+			"name":            {"my-laptop"},
+			"organization_id": {r.Organization.ID.String()},
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/cli/login", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "account_token", Value: cookie})
+		w := httptest.NewRecorder()
+		handler.handleCLIAuthorize(w, req)
+		require.Equal(t, http.StatusSeeOther, w.Code)
+
+		location, err := url.Parse(w.Header().Get("Location"))
+		require.NoError(t, err)
+		assert.Equal(t, "127.0.0.1:4321", location.Host)
+		assert.Equal(t, "abc", location.Query().Get("state"))
+		return location.Query().Get("code")
+	}
+
+	exchange := func(code, verifier string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(cliTokenRequest{Code: code, CodeVerifier: verifier})
+		req := httptest.NewRequest(http.MethodPost, "/cli/token", strings.NewReader(string(body)))
+		w := httptest.NewRecorder()
+		handler.handleCLIToken(w, req)
+		return w
+	}
+
+	t.Run("not logged in -> redirect to login", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/cli/login?port=4321&state=abc&code_challenge=xyz", nil)
+		w := httptest.NewRecorder()
+		handler.handleCLILogin(w, req)
+
+		require.Equal(t, http.StatusTemporaryRedirect, w.Code)
+		assert.Equal(t, "/login?redirect=%2Fcli%2Flogin%3Fport%3D4321%26state%3Dabc%26code_challenge%3Dxyz", w.Header().Get("Location"))
+	})
+
+	t.Run("logged in -> organizations are listed", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/cli/login?port=4321&state=abc&code_challenge=xyz&name=my-laptop", nil)
+		req.AddCookie(&http.Cookie{Name: "account_token", Value: cookie})
+		w := httptest.NewRecorder()
+		handler.handleCLILogin(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "my-laptop")
+		assert.Contains(t, w.Body.String(), r.Organization.ID.String())
+	})
+
+	t.Run("code is exchanged once for a token of the user", func(t *testing.T) {
+		code := authorize(t, testCodeChallenge("verifier"))
+
+		w := exchange(code, "verifier")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response cliTokenResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, r.Organization.ID.String(), response.OrganizationID)
+		assert.WithinDuration(t, time.Now().Add(models.CLITokenTTL), response.ExpiresAt, time.Minute)
+
+		user, err := models.FindActiveUserByCLITokenHash(crypto.HashToken(response.Token))
+		require.NoError(t, err)
+		assert.Equal(t, r.User, user.ID)
+
+		w = exchange(code, "verifier")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("wrong verifier -> error", func(t *testing.T) {
+		code := authorize(t, testCodeChallenge("verifier"))
+
+		w := exchange(code, "other")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("logout revokes the token", func(t *testing.T) {
+		code := authorize(t, testCodeChallenge("verifier"))
+
+		var response cliTokenResponse
+		require.NoError(t, json.Unmarshal(exchange(code, "verifier").Body.Bytes(), &response))
+
+		logout := func() int {
+			req := httptest.NewRequest(http.MethodPost, "/cli/logout", nil)
+			req.Header.Set("Authorization", "Bearer "+response.Token)
+			w := httptest.NewRecorder()
+			handler.handleCLILogout(w, req)
+			return w.Code
+		}
+
+		assert.Equal(t, http.StatusNoContent, logout())
+		assert.Equal(t, http.StatusNotFound, logout())
+
+		_, err := models.FindActiveUserByCLITokenHash(crypto.HashToken(response.Token))
+		require.Error(t, err)
+	})
+}
@@ -71,22 +71,30 @@ func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterc
 		pbRoles.Roles_DeleteRole_FullMethodName:   {Resource: "roles", Action: "delete", DomainType: models.DomainTypeOrganization},
 
 		// Organization Rules
-		pbOrganization.Organizations_DescribeOrganization_FullMethodName:     {Resource: "org", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbOrganization.Organizations_ListInvitations_FullMethodName:          {Resource: "members", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbOrganization.Organizations_RemoveInvitation_FullMethodName:         {Resource: "members", Action: "delete", DomainType: models.DomainTypeOrganization},
-		pbOrganization.Organizations_UpdateOrganization_FullMethodName:       {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbOrganization.Organizations_CreateInvitation_FullMethodName:         {Resource: "members", Action: "create", DomainType: models.DomainTypeOrganization},
-		pbOrganization.Organizations_GetInviteLink_FullMethodName:            {Resource: "members", Action: "create", DomainType: models.DomainTypeOrganization},
-		pbOrganization.Organizations_UpdateInviteLink_FullMethodName:         {Resource: "members", Action: "create", DomainType: models.DomainTypeOrganization},
-		pbOrganization.Organizations_ResetInviteLink_FullMethodName:          {Resource: "members", Action: "create", DomainType: models.DomainTypeOrganization},
-		pbOrganization.Organizations_RemoveUser_FullMethodName:               {Resource: "members", Action: "delete", DomainType: models.DomainTypeOrganization},
-		pbOrganization.Organizations_DeleteOrganization_FullMethodName:       {Resource: "org", Action: "delete", DomainType: models.DomainTypeOrganization},
-		pbOrganization.Organizations_CreateIntegration_FullMethodName:        {Resource: "integrations", Action: "create", DomainType: models.DomainTypeOrganization},
-		pbOrganization.Organizations_UpdateIntegration_FullMethodName:        {Resource: "integrations", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbOrganization.Organizations_DeleteIntegration_FullMethodName:        {Resource: "integrations", Action: "delete", DomainType: models.DomainTypeOrganization},
-		pbOrganization.Organizations_ListIntegrations_FullMethodName:         {Resource: "integrations", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbOrganization.Organizations_DescribeIntegration_FullMethodName:      {Resource: "integrations", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbOrganization.Organizations_ListIntegrationResources_FullMethodName: {Resource: "integrations", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_DescribeOrganization_FullMethodName:              {Resource: "org", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_ListInvitations_FullMethodName:                   {Resource: "members", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_RemoveInvitation_FullMethodName:                  {Resource: "members", Action: "delete", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_UpdateOrganization_FullMethodName:                {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_CreateInvitation_FullMethodName:                  {Resource: "members", Action: "create", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_GetInviteLink_FullMethodName:                     {Resource: "members", Action: "create", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_UpdateInviteLink_FullMethodName:                  {Resource: "members", Action: "create", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_ResetInviteLink_FullMethodName:                   {Resource: "members", Action: "create", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_RemoveUser_FullMethodName:                        {Resource: "members", Action: "delete", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_DeleteOrganization_FullMethodName:                {Resource: "org", Action: "delete", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_CreateIntegration_FullMethodName:                 {Resource: "integrations", Action: "create", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_UpdateIntegration_FullMethodName:                 {Resource: "integrations", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_DeleteIntegration_FullMethodName:                 {Resource: "integrations", Action: "delete", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_ListIntegrations_FullMethodName:                  {Resource: "integrations", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_DescribeIntegration_FullMethodName:               {Resource: "integrations", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_ListIntegrationResources_FullMethodName:          {Resource: "integrations", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_ListWebhookSubscriptions_FullMethodName:          {Resource: "org", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_CreateWebhookSubscription_FullMethodName:         {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_UpdateWebhookSubscription_FullMethodName:         {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_DeleteWebhookSubscription_FullMethodName:         {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_ListWebhookSubscriptionDeliveries_FullMethodName: {Resource: "org", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_ListStuckWork_FullMethodName:                     {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_ExportOrganization_FullMethodName:                {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_ImportOrganization_FullMethodName:                {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
 
 		// Blueprints rules
 		pbBlueprints.Blueprints_ListBlueprints_FullMethodName:    {Resource: "blueprints", Action: "read", DomainType: models.DomainTypeOrganization},
@@ -107,6 +115,7 @@ func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterc
 		pbCanvases.Canvases_UpdateNodePause_FullMethodName:           {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
 		pbCanvases.Canvases_ListCanvasEvents_FullMethodName:          {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
 		pbCanvases.Canvases_ListEventExecutions_FullMethodName:       {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_DescribeExecution_FullMethodName:         {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
 		pbCanvases.Canvases_ListChildExecutions_FullMethodName:       {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
 		pbCanvases.Canvases_CancelExecution_FullMethodName:           {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
 		pbCanvases.Canvases_ResolveExecutionErrors_FullMethodName:    {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
@@ -114,6 +123,11 @@ func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterc
 		pbCanvases.Canvases_InvokeNodeTriggerAction_FullMethodName:   {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
 		pbCanvases.Canvases_ListNodeEvents_FullMethodName:            {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
 		pbCanvases.Canvases_EmitNodeEvent_FullMethodName:             {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_ListWebhookDeliveries_FullMethodName:     {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_ReplayWebhookDelivery_FullMethodName:     {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_GetCanvasMetrics_FullMethodName:          {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_WatchCanvas_FullMethodName:               {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_GetExecutionLogs_FullMethodName:          {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
 	}
 
 	return &AuthorizationInterceptor{
@@ -124,49 +138,78 @@ func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterc
 
 func (a *AuthorizationInterceptor) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		rule, requiresAuth := a.rules[info.FullMethod]
-		if !requiresAuth {
-			return handler(ctx, req)
+		newContext, err := a.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
 		}
 
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			log.Errorf("Metadata not found in context")
-			return nil, status.Error(codes.NotFound, "Not found")
-		}
+		return handler(newContext, req)
+	}
+}
 
-		userMeta, ok := md["x-user-id"]
-		if !ok || len(userMeta) == 0 {
-			log.Errorf("User not found in metadata, metadata %v", md)
-			return nil, status.Error(codes.NotFound, "Not found")
+func (a *AuthorizationInterceptor) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		newContext, err := a.authorize(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
 		}
 
-		orgMeta, ok := md["x-organization-id"]
-		if !ok || len(orgMeta) == 0 {
-			log.Errorf("Organization not found in metadata, metadata %v", md)
-			return nil, status.Error(codes.NotFound, "Not found")
-		}
+		return handler(srv, &authorizedStream{ServerStream: stream, ctx: newContext})
+	}
+}
 
-		userID := userMeta[0]
-		organizationID := orgMeta[0]
-		org, err := models.FindOrganizationByID(organizationID)
-		if err != nil {
-			return nil, status.Error(codes.NotFound, "organization not found")
-		}
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
 
-		allowed, err := a.authService.CheckOrganizationPermission(userID, org.ID.String(), rule.Resource, rule.Action)
-		if err != nil {
-			return nil, err
-		}
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
 
-		if !allowed {
-			log.Warnf("User %s tried to %s %s in organization %s", userID, rule.Action, rule.Resource, org.ID.String())
-			return nil, status.Error(codes.NotFound, "Not found")
-		}
+func (a *AuthorizationInterceptor) authorize(ctx context.Context, fullMethod string) (context.Context, error) {
+	rule, requiresAuth := a.rules[fullMethod]
+	if !requiresAuth {
+		return ctx, nil
+	}
 
-		newContext := context.WithValue(ctx, OrganizationContextKey, organizationID)
-		newContext = context.WithValue(newContext, DomainTypeContextKey, models.DomainTypeOrganization)
-		newContext = context.WithValue(newContext, DomainIdContextKey, organizationID)
-		return handler(newContext, req)
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		log.Errorf("Metadata not found in context")
+		return nil, status.Error(codes.NotFound, "Not found")
+	}
+
+	userMeta, ok := md["x-user-id"]
+	if !ok || len(userMeta) == 0 {
+		log.Errorf("User not found in metadata, metadata %v", md)
+		return nil, status.Error(codes.NotFound, "Not found")
+	}
+
+	orgMeta, ok := md["x-organization-id"]
+	if !ok || len(orgMeta) == 0 {
+		log.Errorf("Organization not found in metadata, metadata %v", md)
+		return nil, status.Error(codes.NotFound, "Not found")
 	}
+
+	userID := userMeta[0]
+	organizationID := orgMeta[0]
+	org, err := models.FindOrganizationByID(organizationID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "organization not found")
+	}
+
+	allowed, err := a.authService.CheckOrganizationPermission(userID, org.ID.String(), rule.Resource, rule.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	if !allowed {
+		log.Warnf("User %s tried to %s %s in organization %s", userID, rule.Action, rule.Resource, org.ID.String())
+		return nil, status.Error(codes.NotFound, "Not found")
+	}
+
+	newContext := context.WithValue(ctx, OrganizationContextKey, organizationID)
+	newContext = context.WithValue(newContext, DomainTypeContextKey, models.DomainTypeOrganization)
+	newContext = context.WithValue(newContext, DomainIdContextKey, organizationID)
+	return newContext, nil
 }
@@ -0,0 +1,376 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+
+	"github.com/spf13/cobra"
+)
+
+var applyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Apply resources from files.",
+	Long: `Create and update SuperPlane resources so the organization matches the given YAML files.
+
+Files can have multiple documents, and directories are read recursively.
+Supported kinds are Canvas, Blueprint, Secret, Group, Role and Integration.
+
+With --prune, resources of the kinds present in the files that are not
+in any of the files are deleted. Default roles are never deleted.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		paths, err := cmd.Flags().GetStringSlice("file")
+		CheckWithMessage(err, "Path not provided")
+		if len(paths) == 0 {
+			Fail("at least one file or directory must be given with --file")
+		}
+
+		prune, _ := cmd.Flags().GetBool("prune")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		documents, err := ReadYamlDocuments(paths)
+		Check(err)
+
+		client := DefaultClient()
+		domainType, domainID := getDomainOrExit(client, cmd)
+		appliers := newResourceAppliers(client, domainType, domainID)
+
+		for _, document := range documents {
+			Check(addDocument(appliers, document))
+		}
+
+		ctx := context.Background()
+		plan, err := planApply(ctx, appliers, prune)
+		Check(err)
+
+		printPlan(plan)
+		if dryRun || plan.Empty() {
+			return
+		}
+
+		for _, step := range plan.Steps() {
+			err := step.run(ctx)
+			if err != nil {
+				Fail(fmt.Sprintf("failed to %s %s/%s: %v", step.Action, step.Kind, step.Name, err))
+			}
+
+			fmt.Fprintf(os.Stdout, "%s/%s %sd\n", step.Kind, step.Name, step.Action)
+		}
+	},
+}
+
+const (
+	ApplyActionCreate = "create"
+	ApplyActionUpdate = "update"
+	ApplyActionDelete = "delete"
+)
+
+type applyStep struct {
+	Action string
+	Kind   string
+	Name   string
+
+	// Updates of resources that look the same as the live ones,
+	// but have parts the API does not return to compare them.
+	MayChange bool
+
+	run func(ctx context.Context) error
+}
+
+type applyPlan struct {
+	Changes   []applyStep
+	Deletions []applyStep
+}
+
+func (p *applyPlan) Empty() bool {
+	return len(p.Changes) == 0 && len(p.Deletions) == 0
+}
+
+// Steps returns the steps in the order they are run:
+// changes in dependency order, then deletions in reverse dependency order.
+func (p *applyPlan) Steps() []applyStep {
+	return append(append([]applyStep{}, p.Changes...), p.Deletions...)
+}
+
+/*
+ * resourceApplier plans the changes for one kind of resource.
+ * Appliers are ordered by their dependencies, so resources
+ * are created before the ones that reference them.
+ */
+type resourceApplier interface {
+	Kind() string
+	Add(document YamlDocument) error
+	Plan(ctx context.Context, prune bool) ([]applyStep, []applyStep, error)
+}
+
+func addDocument(appliers []resourceApplier, document YamlDocument) error {
+	_, kind, err := ParseYamlResourceHeaders(document.Data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", document.Path, err)
+	}
+
+	for _, applier := range appliers {
+		if applier.Kind() == kind {
+			if err := applier.Add(document); err != nil {
+				return fmt.Errorf("%s: %w", document.Path, err)
+			}
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s: unsupported resource kind '%s'", document.Path, kind)
+}
+
+func planApply(ctx context.Context, appliers []resourceApplier, prune bool) (*applyPlan, error) {
+	plan := &applyPlan{}
+	for _, applier := range appliers {
+		changes, deletions, err := applier.Plan(ctx, prune)
+		if err != nil {
+			return nil, err
+		}
+
+		plan.Changes = append(plan.Changes, changes...)
+		plan.Deletions = append(deletions, plan.Deletions...)
+	}
+
+	return plan, nil
+}
+
+func printPlan(plan *applyPlan) {
+	if plan.Empty() {
+		fmt.Fprintln(os.Stdout, "No changes. Resources are up to date.")
+		return
+	}
+
+	counts := map[string]int{}
+	for _, step := range plan.Steps() {
+		symbol := "~"
+		switch step.Action {
+		case ApplyActionCreate:
+			symbol = "+"
+		case ApplyActionDelete:
+			symbol = "-"
+		}
+
+		if step.MayChange {
+			counts[applyMayChange]++
+			fmt.Fprintf(os.Stdout, "%s %s/%s (may change)\n", symbol, step.Kind, step.Name)
+			continue
+		}
+
+		counts[step.Action]++
+		fmt.Fprintf(os.Stdout, "%s %s/%s\n", symbol, step.Kind, step.Name)
+	}
+
+	summary := fmt.Sprintf(
+		"Plan: %d to create, %d to update, %d to delete",
+		counts[ApplyActionCreate],
+		counts[ApplyActionUpdate],
+		counts[ApplyActionDelete],
+	)
+
+	if counts[applyMayChange] > 0 {
+		summary += fmt.Sprintf(", %d may change", counts[applyMayChange])
+	}
+
+	fmt.Fprintf(os.Stdout, "\n%s.\n", summary)
+}
+
+const applyMayChange = "may change"
+
+/*
+ * kindApplier implements resourceApplier for a kind,
+ * with D being the type of the resources read from the files,
+ * and L the type of the resources returned by the API.
+ */
+type kindApplier[D any, L any] struct {
+	kind string
+
+	parse   func(document YamlDocument) (string, D, error)
+	list    func(ctx context.Context) (map[string]L, error)
+	changed func(ctx context.Context, desired D, live L) (bool, error)
+	create  func(ctx context.Context, desired D) error
+	update  func(ctx context.Context, desired D, live L) error
+	remove  func(ctx context.Context, name string, live L) error
+
+	// Optional. Live resources that are never deleted when pruning.
+	protected func(name string, live L) bool
+
+	// Whether the API leaves out parts of the resources, so the ones
+	// that look unchanged are updated anyway, as changes that may happen.
+	partial bool
+
+	names   []string
+	desired map[string]D
+}
+
+func (a *kindApplier[D, L]) Kind() string {
+	return a.kind
+}
+
+func (a *kindApplier[D, L]) Add(document YamlDocument) error {
+	name, resource, err := a.parse(document)
+	if err != nil {
+		return err
+	}
+
+	if a.desired == nil {
+		a.desired = map[string]D{}
+	}
+
+	if _, ok := a.desired[name]; ok {
+		return fmt.Errorf("%s %q is defined more than once", a.kind, name)
+	}
+
+	a.names = append(a.names, name)
+	a.desired[name] = resource
+	return nil
+}
+
+// Plan only looks at the live resources if the files have resources of this kind,
+// so pruning never deletes resources of kinds the files do not manage.
+func (a *kindApplier[D, L]) Plan(ctx context.Context, prune bool) ([]applyStep, []applyStep, error) {
+	if len(a.names) == 0 {
+		return nil, nil, nil
+	}
+
+	live, err := a.list(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list %s resources: %w", a.kind, err)
+	}
+
+	changes := []applyStep{}
+	for _, name := range a.names {
+		desired := a.desired[name]
+		current, exists := live[name]
+		if !exists {
+			changes = append(changes, applyStep{
+				Action: ApplyActionCreate,
+				Kind:   a.kind,
+				Name:   name,
+				run:    func(ctx context.Context) error { return a.create(ctx, desired) },
+			})
+
+			continue
+		}
+
+		changed, err := a.changed(ctx, desired, current)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to compare %s/%s: %w", a.kind, name, err)
+		}
+
+		if changed || a.partial {
+			changes = append(changes, applyStep{
+				Action:    ApplyActionUpdate,
+				Kind:      a.kind,
+				Name:      name,
+				MayChange: !changed,
+				run:       func(ctx context.Context) error { return a.update(ctx, desired, current) },
+			})
+		}
+	}
+
+	deletions := []applyStep{}
+	if !prune {
+		return changes, deletions, nil
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(live)) {
+		current := live[name]
+		if _, ok := a.desired[name]; ok {
+			continue
+		}
+
+		if a.protected != nil && a.protected(name, current) {
+			continue
+		}
+
+		deletions = append(deletions, applyStep{
+			Action: ApplyActionDelete,
+			Kind:   a.kind,
+			Name:   name,
+			run:    func(ctx context.Context) error { return a.remove(ctx, name, current) },
+		})
+	}
+
+	return changes, deletions, nil
+}
+
+// sameJSON compares two values by their JSON representation,
+// so missing fields and fields with empty values are treated the same.
+func sameJSON(a, b any) bool {
+	return reflect.DeepEqual(normalizeJSON(a), normalizeJSON(b))
+}
+
+func normalizeJSON(value any) any {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+
+	var normalized any
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return value
+	}
+
+	return dropEmpty(normalized)
+}
+
+func dropEmpty(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			field = dropEmpty(field)
+			if isEmptyJSON(field) {
+				delete(v, key)
+				continue
+			}
+
+			v[key] = field
+		}
+
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = dropEmpty(item)
+		}
+
+		return v
+	default:
+		return v
+	}
+}
+
+func isEmptyJSON(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case map[string]any:
+		return len(v) == 0
+	case []any:
+		return len(v) == 0
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	default:
+		return false
+	}
+}
+
+func init() {
+	RootCmd.AddCommand(applyCmd)
+
+	desc := "Filename or directory with the resources to apply. Can be given multiple times"
+	applyCmd.Flags().StringSliceP("file", "f", []string{}, desc)
+	applyCmd.Flags().Bool("prune", false, "Delete resources of the applied kinds that are not in the files")
+	applyCmd.Flags().Bool("dry-run", false, "Only show the plan, without applying it")
+}
@@ -0,0 +1,433 @@
+package cli
+
+import (
+	"context"
+	"maps"
+	"path/filepath"
+	"slices"
+
+	"github.com/superplanehq/superplane/pkg/cli/models"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+// newResourceAppliers returns the appliers for all supported kinds,
+// in the order resources are created.
+func newResourceAppliers(client *openapi_client.APIClient, domainType, domainID string) []resourceApplier {
+	return []resourceApplier{
+		newSecretApplier(client, domainType, domainID),
+		newIntegrationApplier(client, domainID),
+		newRoleApplier(client, domainType, domainID),
+		newGroupApplier(client, domainType, domainID),
+		newBlueprintApplier(client),
+		newCanvasApplier(client),
+	}
+}
+
+type secretResource struct {
+	resource models.Secret
+	baseDir  string
+}
+
+/*
+ * The API only returns the keys of secrets, not their values,
+ * so secrets with the same keys as the files may still change.
+ * Their values are always read and sent, so rotated values reach the server.
+ */
+func newSecretApplier(client *openapi_client.APIClient, domainType, domainID string) resourceApplier {
+	send := func(desired secretResource) (*openapi_client.SecretsSecret, error) {
+		values, err := desired.resource.Resolve(desired.baseDir)
+		if err != nil {
+			return nil, err
+		}
+
+		provider := openapi_client.SECRETPROVIDER_PROVIDER_LOCAL
+		return &openapi_client.SecretsSecret{
+			Metadata: &openapi_client.SecretsSecretMetadata{Name: desired.resource.Metadata.Name},
+			Spec: &openapi_client.SecretsSecretSpec{
+				Provider: &provider,
+				Local:    &openapi_client.SecretLocal{Data: &values},
+			},
+		}, nil
+	}
+
+	return &kindApplier[secretResource, openapi_client.SecretsSecret]{
+		kind: models.SecretKind,
+		parse: func(document YamlDocument) (string, secretResource, error) {
+			resource, err := models.ParseSecret(document.Data)
+			if err != nil {
+				return "", secretResource{}, err
+			}
+
+			return *resource.Metadata.Name, secretResource{resource: *resource, baseDir: filepath.Dir(document.Path)}, nil
+		},
+		list: func(ctx context.Context) (map[string]openapi_client.SecretsSecret, error) {
+			response, _, err := client.SecretAPI.SecretsListSecrets(ctx).DomainType(domainType).DomainId(domainID).Execute()
+			if err != nil {
+				return nil, err
+			}
+
+			secrets := map[string]openapi_client.SecretsSecret{}
+			for _, secret := range response.GetSecrets() {
+				secrets[secret.Metadata.GetName()] = secret
+			}
+
+			return secrets, nil
+		},
+		changed: func(ctx context.Context, desired secretResource, live openapi_client.SecretsSecret) (bool, error) {
+			spec := live.GetSpec()
+			local := spec.GetLocal()
+			desiredKeys := slices.Sorted(maps.Keys(desired.resource.Spec.Data))
+			liveKeys := slices.Sorted(maps.Keys(local.GetData()))
+			return !slices.Equal(desiredKeys, liveKeys), nil
+		},
+		partial: true,
+		create: func(ctx context.Context, desired secretResource) error {
+			secret, err := send(desired)
+			if err != nil {
+				return err
+			}
+
+			request := openapi_client.SecretsCreateSecretRequest{Secret: secret}
+			request.SetDomainType(openapi_client.AuthorizationDomainType(domainType))
+			request.SetDomainId(domainID)
+			_, _, err = client.SecretAPI.SecretsCreateSecret(ctx).Body(request).Execute()
+			return err
+		},
+		update: func(ctx context.Context, desired secretResource, live openapi_client.SecretsSecret) error {
+			secret, err := send(desired)
+			if err != nil {
+				return err
+			}
+
+			body := openapi_client.SecretsUpdateSecretBody{Secret: secret}
+			body.SetDomainType(openapi_client.AuthorizationDomainType(domainType))
+			body.SetDomainId(domainID)
+			_, _, err = client.SecretAPI.SecretsUpdateSecret(ctx, live.Metadata.GetId()).Body(body).Execute()
+			return err
+		},
+		remove: func(ctx context.Context, name string, live openapi_client.SecretsSecret) error {
+			_, _, err := client.SecretAPI.SecretsDeleteSecret(ctx, live.Metadata.GetId()).DomainType(domainType).DomainId(domainID).Execute()
+			return err
+		},
+	}
+}
+
+func newIntegrationApplier(client *openapi_client.APIClient, organizationID string) resourceApplier {
+	return &kindApplier[models.Integration, openapi_client.OrganizationsIntegration]{
+		kind: models.IntegrationKind,
+		parse: func(document YamlDocument) (string, models.Integration, error) {
+			resource, err := models.ParseIntegration(document.Data)
+			if err != nil {
+				return "", models.Integration{}, err
+			}
+
+			return *resource.Metadata.Name, *resource, nil
+		},
+		list: func(ctx context.Context) (map[string]openapi_client.OrganizationsIntegration, error) {
+			response, _, err := client.OrganizationAPI.OrganizationsListIntegrations(ctx, organizationID).Execute()
+			if err != nil {
+				return nil, err
+			}
+
+			integrations := map[string]openapi_client.OrganizationsIntegration{}
+			for _, integration := range response.GetIntegrations() {
+				integrations[integration.Metadata.GetName()] = integration
+			}
+
+			return integrations, nil
+		},
+		changed: func(ctx context.Context, desired models.Integration, live openapi_client.OrganizationsIntegration) (bool, error) {
+			spec := live.GetSpec()
+			return !sameJSON(desired.Spec.Configuration, spec.GetConfiguration()), nil
+		},
+		create: func(ctx context.Context, desired models.Integration) error {
+			body := openapi_client.OrganizationsCreateIntegrationBody{
+				Name:            desired.Metadata.Name,
+				IntegrationName: desired.Spec.IntegrationName,
+				Configuration:   desired.Spec.Configuration,
+			}
+
+			_, _, err := client.OrganizationAPI.OrganizationsCreateIntegration(ctx, organizationID).Body(body).Execute()
+			return err
+		},
+		update: func(ctx context.Context, desired models.Integration, live openapi_client.OrganizationsIntegration) error {
+			body := openapi_client.OrganizationsUpdateIntegrationBody{
+				Name:          desired.Metadata.Name,
+				Configuration: desired.Spec.Configuration,
+			}
+
+			_, _, err := client.OrganizationAPI.
+				OrganizationsUpdateIntegration(ctx, organizationID, live.Metadata.GetId()).
+				Body(body).
+				Execute()
+
+			return err
+		},
+		remove: func(ctx context.Context, name string, live openapi_client.OrganizationsIntegration) error {
+			_, _, err := client.OrganizationAPI.OrganizationsDeleteIntegration(ctx, organizationID, live.Metadata.GetId()).Execute()
+			return err
+		},
+	}
+}
+
+func newRoleApplier(client *openapi_client.APIClient, domainType, domainID string) resourceApplier {
+	return &kindApplier[models.Role, openapi_client.RolesRole]{
+		kind: models.RoleKind,
+		parse: func(document YamlDocument) (string, models.Role, error) {
+			resource, err := models.ParseRole(document.Data)
+			if err != nil {
+				return "", models.Role{}, err
+			}
+
+			return *resource.Metadata.Name, *resource, nil
+		},
+		list: func(ctx context.Context) (map[string]openapi_client.RolesRole, error) {
+			response, _, err := client.RolesAPI.RolesListRoles(ctx).DomainType(domainType).DomainId(domainID).Execute()
+			if err != nil {
+				return nil, err
+			}
+
+			roles := map[string]openapi_client.RolesRole{}
+			for _, role := range response.GetRoles() {
+				roles[role.Metadata.GetName()] = role
+			}
+
+			return roles, nil
+		},
+		changed: func(ctx context.Context, desired models.Role, live openapi_client.RolesRole) (bool, error) {
+			return !sameJSON(roleSpecForComparison(*desired.Spec), roleSpecForComparison(live.GetSpec())), nil
+		},
+		create: func(ctx context.Context, desired models.Role) error {
+			request := openapi_client.RolesCreateRoleRequest{}
+			request.SetDomainType(openapi_client.AuthorizationDomainType(domainType))
+			request.SetDomainId(domainID)
+			request.SetRole(models.RoleFromRole(desired))
+			_, _, err := client.RolesAPI.RolesCreateRole(ctx).Body(request).Execute()
+			return err
+		},
+		update: func(ctx context.Context, desired models.Role, live openapi_client.RolesRole) error {
+			body := openapi_client.RolesUpdateRoleBody{}
+			body.SetDomainType(openapi_client.AuthorizationDomainType(domainType))
+			body.SetDomainId(domainID)
+			body.SetRole(models.RoleFromRole(desired))
+			_, _, err := client.RolesAPI.RolesUpdateRole(ctx, *desired.Metadata.Name).Body(body).Execute()
+			return err
+		},
+		remove: func(ctx context.Context, name string, live openapi_client.RolesRole) error {
+			_, _, err := client.RolesAPI.RolesDeleteRole(ctx, name).DomainType(domainType).DomainId(domainID).Execute()
+			return err
+		},
+		protected: func(name string, live openapi_client.RolesRole) bool {
+			return slices.Contains(models.DefaultRoleNames, name)
+		},
+	}
+}
+
+// roleSpecForComparison only keeps the name of the inherited role,
+// and sorts the permissions, since their order does not matter.
+func roleSpecForComparison(spec openapi_client.RolesRoleSpec) any {
+	permissions := []string{}
+	for _, permission := range spec.Permissions {
+		permissions = append(permissions, permission.GetResource()+"."+permission.GetAction())
+	}
+
+	slices.Sort(permissions)
+
+	inherited := ""
+	if spec.InheritedRole != nil && spec.InheritedRole.Metadata != nil {
+		inherited = spec.InheritedRole.Metadata.GetName()
+	}
+
+	return map[string]any{
+		"displayName":   spec.GetDisplayName(),
+		"description":   spec.GetDescription(),
+		"permissions":   permissions,
+		"inheritedRole": inherited,
+	}
+}
+
+func newGroupApplier(client *openapi_client.APIClient, domainType, domainID string) resourceApplier {
+	return &kindApplier[models.Group, openapi_client.GroupsGroup]{
+		kind: models.GroupKind,
+		parse: func(document YamlDocument) (string, models.Group, error) {
+			resource, err := models.ParseGroup(document.Data)
+			if err != nil {
+				return "", models.Group{}, err
+			}
+
+			return *resource.Metadata.Name, *resource, nil
+		},
+		list: func(ctx context.Context) (map[string]openapi_client.GroupsGroup, error) {
+			response, _, err := client.GroupsAPI.GroupsListGroups(ctx).DomainType(domainType).DomainId(domainID).Execute()
+			if err != nil {
+				return nil, err
+			}
+
+			groups := map[string]openapi_client.GroupsGroup{}
+			for _, group := range response.GetGroups() {
+				groups[group.Metadata.GetName()] = group
+			}
+
+			return groups, nil
+		},
+		changed: func(ctx context.Context, desired models.Group, live openapi_client.GroupsGroup) (bool, error) {
+			return !sameJSON(desired.Spec, live.GetSpec()), nil
+		},
+		create: func(ctx context.Context, desired models.Group) error {
+			request := openapi_client.GroupsCreateGroupRequest{}
+			request.SetDomainType(openapi_client.AuthorizationDomainType(domainType))
+			request.SetDomainId(domainID)
+			request.SetGroup(models.GroupFromGroup(desired))
+			_, _, err := client.GroupsAPI.GroupsCreateGroup(ctx).Body(request).Execute()
+			return err
+		},
+		update: func(ctx context.Context, desired models.Group, live openapi_client.GroupsGroup) error {
+			body := openapi_client.GroupsUpdateGroupBody{}
+			body.SetDomainType(openapi_client.AuthorizationDomainType(domainType))
+			body.SetDomainId(domainID)
+			body.SetGroup(models.GroupFromGroup(desired))
+			_, _, err := client.GroupsAPI.GroupsUpdateGroup(ctx, *desired.Metadata.Name).Body(body).Execute()
+			return err
+		},
+		remove: func(ctx context.Context, name string, live openapi_client.GroupsGroup) error {
+			_, _, err := client.GroupsAPI.GroupsDeleteGroup(ctx, name).DomainType(domainType).DomainId(domainID).Execute()
+			return err
+		},
+	}
+}
+
+func newBlueprintApplier(client *openapi_client.APIClient) resourceApplier {
+	return &kindApplier[models.Blueprint, openapi_client.BlueprintsBlueprint]{
+		kind: models.BlueprintKind,
+		parse: func(document YamlDocument) (string, models.Blueprint, error) {
+			resource, err := models.ParseBlueprint(document.Data)
+			if err != nil {
+				return "", models.Blueprint{}, err
+			}
+
+			return *resource.Metadata.Name, *resource, nil
+		},
+		list: func(ctx context.Context) (map[string]openapi_client.BlueprintsBlueprint, error) {
+			response, _, err := client.BlueprintAPI.BlueprintsListBlueprints(ctx).Execute()
+			if err != nil {
+				return nil, err
+			}
+
+			blueprints := map[string]openapi_client.BlueprintsBlueprint{}
+			for _, blueprint := range response.GetBlueprints() {
+				blueprints[blueprint.GetName()] = blueprint
+			}
+
+			return blueprints, nil
+		},
+		changed: func(ctx context.Context, desired models.Blueprint, live openapi_client.BlueprintsBlueprint) (bool, error) {
+			current := models.BlueprintResourceFromBlueprint(live)
+			return blueprintSpecChanged(*desired.Spec, *current.Spec), nil
+		},
+		create: func(ctx context.Context, desired models.Blueprint) error {
+			request := openapi_client.BlueprintsCreateBlueprintRequest{}
+			request.SetBlueprint(models.BlueprintFromBlueprint(desired))
+			_, _, err := client.BlueprintAPI.BlueprintsCreateBlueprint(ctx).Body(request).Execute()
+			return err
+		},
+		update: func(ctx context.Context, desired models.Blueprint, live openapi_client.BlueprintsBlueprint) error {
+			desired.Metadata.Id = live.Id
+			body := openapi_client.BlueprintsUpdateBlueprintBody{}
+			body.SetBlueprint(models.BlueprintFromBlueprint(desired))
+			_, _, err := client.BlueprintAPI.BlueprintsUpdateBlueprint(ctx, live.GetId()).Body(body).Execute()
+			return err
+		},
+		remove: func(ctx context.Context, name string, live openapi_client.BlueprintsBlueprint) error {
+			_, _, err := client.BlueprintAPI.BlueprintsDeleteBlueprint(ctx, live.GetId()).Execute()
+			return err
+		},
+	}
+}
+
+func newCanvasApplier(client *openapi_client.APIClient) resourceApplier {
+	return &kindApplier[models.Canvas, openapi_client.CanvasesCanvas]{
+		kind: models.CanvasKind,
+		parse: func(document YamlDocument) (string, models.Canvas, error) {
+			resource, err := models.ParseCanvas(document.Data)
+			if err != nil {
+				return "", models.Canvas{}, err
+			}
+
+			if resource.Spec == nil {
+				resource.Spec = models.EmptyCanvasSpec()
+			}
+
+			return *resource.Metadata.Name, *resource, nil
+		},
+		list: func(ctx context.Context) (map[string]openapi_client.CanvasesCanvas, error) {
+			response, _, err := client.CanvasAPI.CanvasesListCanvases(ctx).Execute()
+			if err != nil {
+				return nil, err
+			}
+
+			canvases := map[string]openapi_client.CanvasesCanvas{}
+			for _, canvas := range response.GetCanvases() {
+				metadata := canvas.GetMetadata()
+				if metadata.GetIsTemplate() {
+					continue
+				}
+
+				canvases[metadata.GetName()] = canvas
+			}
+
+			return canvases, nil
+		},
+		changed: func(ctx context.Context, desired models.Canvas, live openapi_client.CanvasesCanvas) (bool, error) {
+			//
+			// Canvases are listed without their spec,
+			// so the canvas is described to compare it.
+			//
+			metadata := live.GetMetadata()
+			response, _, err := client.CanvasAPI.CanvasesDescribeCanvas(ctx, metadata.GetId()).Execute()
+			if err != nil {
+				return false, err
+			}
+
+			current := response.Canvas.GetMetadata()
+			if desired.Metadata.GetDescription() != current.GetDescription() {
+				return true, nil
+			}
+
+			spec := response.Canvas.GetSpec()
+			diff := diffCanvasSpecs(desired.Spec, &spec)
+			return !diff.Empty(), nil
+		},
+		create: func(ctx context.Context, desired models.Canvas) error {
+			request := openapi_client.CanvasesCreateCanvasRequest{}
+			request.SetCanvas(models.CanvasFromCanvas(desired))
+			_, _, err := client.CanvasAPI.CanvasesCreateCanvas(ctx).Body(request).Execute()
+			return err
+		},
+		update: func(ctx context.Context, desired models.Canvas, live openapi_client.CanvasesCanvas) error {
+			metadata := live.GetMetadata()
+			desired.Metadata.Id = metadata.Id
+			body := openapi_client.CanvasesUpdateCanvasBody{}
+			body.SetCanvas(models.CanvasFromCanvas(desired))
+			_, _, err := client.CanvasAPI.CanvasesUpdateCanvas(ctx, metadata.GetId()).Body(body).Execute()
+			return err
+		},
+		remove: func(ctx context.Context, name string, live openapi_client.CanvasesCanvas) error {
+			metadata := live.GetMetadata()
+			_, _, err := client.CanvasAPI.CanvasesDeleteCanvas(ctx, metadata.GetId()).Execute()
+			return err
+		},
+	}
+}
+
+// blueprintSpecChanged compares the nodes and edges of blueprints
+// the same way as the ones of canvases, and the rest of the spec as is.
+func blueprintSpecChanged(desired, live models.BlueprintSpec) bool {
+	diff := diffCanvasSpecs(
+		&openapi_client.CanvasesCanvasSpec{Nodes: desired.Nodes, Edges: desired.Edges},
+		&openapi_client.CanvasesCanvasSpec{Nodes: live.Nodes, Edges: live.Edges},
+	)
+
+	desired.Nodes, desired.Edges = nil, nil
+	live.Nodes, live.Edges = nil, nil
+	return !diff.Empty() || !sameJSON(desired, live)
+}
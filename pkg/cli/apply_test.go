@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/cli/models"
+)
+
+type fakeResource struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+func newFakeApplier(live map[string]fakeResource, calls *[]string) *kindApplier[fakeResource, fakeResource] {
+	return &kindApplier[fakeResource, fakeResource]{
+		kind: "Fake",
+		parse: func(document YamlDocument) (string, fakeResource, error) {
+			var resource fakeResource
+			err := yaml.Unmarshal(document.Data, &resource)
+			return resource.Name, resource, err
+		},
+		list: func(ctx context.Context) (map[string]fakeResource, error) {
+			return live, nil
+		},
+		changed: func(ctx context.Context, desired fakeResource, live fakeResource) (bool, error) {
+			return !sameJSON(desired, live), nil
+		},
+		create: func(ctx context.Context, desired fakeResource) error {
+			*calls = append(*calls, "create "+desired.Name)
+			return nil
+		},
+		update: func(ctx context.Context, desired fakeResource, live fakeResource) error {
+			*calls = append(*calls, "update "+desired.Name)
+			return nil
+		},
+		remove: func(ctx context.Context, name string, live fakeResource) error {
+			*calls = append(*calls, "delete "+name)
+			return nil
+		},
+		protected: func(name string, live fakeResource) bool {
+			return name == "builtin"
+		},
+	}
+}
+
+func Test__Apply__Plan(t *testing.T) {
+	live := map[string]fakeResource{
+		"same":    {Name: "same", Value: "a"},
+		"changed": {Name: "changed", Value: "a"},
+		"extra":   {Name: "extra", Value: "a"},
+		"builtin": {Name: "builtin", Value: "a"},
+	}
+
+	documents := SplitYamlDocuments([]byte(`
+apiVersion: v1
+kind: Fake
+name: same
+value: a
+---
+# only a comment
+---
+apiVersion: v1
+kind: Fake
+name: changed
+value: b
+---
+apiVersion: v1
+kind: Fake
+name: new
+value: c
+`))
+
+	require.Len(t, documents, 3)
+
+	t.Run("without prune -> creates and updates", func(t *testing.T) {
+		calls := []string{}
+		applier := newFakeApplier(live, &calls)
+		for _, document := range documents {
+			require.NoError(t, addDocument([]resourceApplier{applier}, YamlDocument{Path: "fake.yaml", Data: document}))
+		}
+
+		plan, err := planApply(context.Background(), []resourceApplier{applier}, false)
+		require.NoError(t, err)
+		require.Len(t, plan.Changes, 2)
+		assert.Empty(t, plan.Deletions)
+
+		for _, step := range plan.Steps() {
+			require.NoError(t, step.run(context.Background()))
+		}
+
+		assert.Equal(t, []string{"update changed", "create new"}, calls)
+	})
+
+	t.Run("with prune -> unprotected resources not in files are deleted", func(t *testing.T) {
+		calls := []string{}
+		applier := newFakeApplier(live, &calls)
+		for _, document := range documents {
+			require.NoError(t, applier.Add(YamlDocument{Path: "fake.yaml", Data: document}))
+		}
+
+		plan, err := planApply(context.Background(), []resourceApplier{applier}, true)
+		require.NoError(t, err)
+		require.Len(t, plan.Deletions, 1)
+		assert.Equal(t, ApplyActionDelete, plan.Deletions[0].Action)
+		assert.Equal(t, "extra", plan.Deletions[0].Name)
+	})
+
+	t.Run("partial resources that look the same -> updates that may change", func(t *testing.T) {
+		calls := []string{}
+		applier := newFakeApplier(live, &calls)
+		applier.partial = true
+		for _, document := range documents {
+			require.NoError(t, applier.Add(YamlDocument{Path: "fake.yaml", Data: document}))
+		}
+
+		plan, err := planApply(context.Background(), []resourceApplier{applier}, false)
+		require.NoError(t, err)
+		require.Len(t, plan.Changes, 3)
+		assert.Equal(t, "same", plan.Changes[0].Name)
+		assert.True(t, plan.Changes[0].MayChange)
+		assert.Equal(t, "changed", plan.Changes[1].Name)
+		assert.False(t, plan.Changes[1].MayChange)
+		assert.False(t, plan.Changes[2].MayChange)
+	})
+
+	t.Run("kinds not in files are not pruned", func(t *testing.T) {
+		calls := []string{}
+		applier := newFakeApplier(live, &calls)
+		plan, err := planApply(context.Background(), []resourceApplier{applier}, true)
+		require.NoError(t, err)
+		assert.True(t, plan.Empty())
+	})
+
+	t.Run("duplicate resource -> error", func(t *testing.T) {
+		calls := []string{}
+		applier := newFakeApplier(live, &calls)
+		require.NoError(t, applier.Add(YamlDocument{Data: documents[0]}))
+		assert.ErrorContains(t, applier.Add(YamlDocument{Data: documents[0]}), `Fake "same" is defined more than once`)
+	})
+
+	t.Run("unsupported kind -> error", func(t *testing.T) {
+		err := addDocument([]resourceApplier{}, YamlDocument{Path: "x.yaml", Data: []byte("apiVersion: v1\nkind: Project")})
+		assert.ErrorContains(t, err, "unsupported resource kind 'Project'")
+	})
+}
+
+func Test__ReadYamlDocuments(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "canvases"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yaml"), []byte("kind: A\n---\nkind: B\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "canvases", "c.yml"), []byte("kind: C\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# not a resource"), 0600))
+
+	documents, err := ReadYamlDocuments([]string{dir})
+	require.NoError(t, err)
+	require.Len(t, documents, 3)
+	assert.Equal(t, "kind: A\n", string(documents[0].Data))
+	assert.Equal(t, filepath.Join(dir, "canvases", "c.yml"), documents[2].Path)
+}
+
+func Test__SameJSON(t *testing.T) {
+	assert.True(t, sameJSON(map[string]any{"a": "x", "b": ""}, map[string]any{"a": "x"}))
+	assert.True(t, sameJSON(map[string]any{"a": []string{}}, map[string]any{}))
+	assert.False(t, sameJSON(map[string]any{"a": "x"}, map[string]any{"a": "y"}))
+	assert.False(t, sameJSON([]string{"a", "b"}, []string{"b", "a"}))
+}
+
+func Test__BlueprintSpecChanged(t *testing.T) {
+	live := parseSpec(t, `
+nodes:
+  - {id: generated-1, name: build, type: TYPE_COMPONENT, component: {name: http}, position: {x: 10, y: 20}, errorMessage: "wrong"}
+  - {id: generated-2, name: notify, type: TYPE_COMPONENT, component: {name: noop}}
+edges:
+  - {sourceId: generated-1, targetId: generated-2, channel: default}
+`)
+
+	desired := parseSpec(t, `
+nodes:
+  - {id: build, name: build, type: TYPE_COMPONENT, component: {name: http}}
+  - {id: notify, name: notify, type: TYPE_COMPONENT, component: {name: noop}}
+edges:
+  - {sourceId: build, targetId: notify}
+`)
+
+	description := "Builds"
+	liveSpec := models.BlueprintSpec{Description: &description, Nodes: live.Nodes, Edges: live.Edges}
+	desiredSpec := models.BlueprintSpec{Description: &description, Nodes: desired.Nodes, Edges: desired.Edges}
+
+	t.Run("same nodes with other IDs and server fields -> not changed", func(t *testing.T) {
+		assert.False(t, blueprintSpecChanged(desiredSpec, liveSpec))
+	})
+
+	t.Run("other description -> changed", func(t *testing.T) {
+		other := "Builds and notifies"
+		changed := desiredSpec
+		changed.Description = &other
+		assert.True(t, blueprintSpecChanged(changed, liveSpec))
+	})
+
+	t.Run("rewired edge -> changed", func(t *testing.T) {
+		changed := desiredSpec
+		changed.Edges = parseSpec(t, "edges: [{sourceId: notify, targetId: build}]").Edges
+		assert.True(t, blueprintSpecChanged(changed, liveSpec))
+	})
+}
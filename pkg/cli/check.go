@@ -38,7 +38,7 @@ func Fail(message string) {
 
 func Exit(code int) {
 	if flag.Lookup("test.v") == nil {
-		os.Exit(1)
+		os.Exit(code)
 	} else {
 		panic(fmt.Sprintf("exit %d", code))
 	}
@@ -78,6 +78,10 @@ var configViewCmd = &cobra.Command{
 }
 
 func GetAPIURL() string {
+	if c := selectedContext(); c != nil {
+		return c.APIURL
+	}
+
 	if viper.IsSet(ConfigKeyAPIURL) {
 		return viper.GetString(ConfigKeyAPIURL)
 	}
@@ -86,6 +90,12 @@ func GetAPIURL() string {
 }
 
 func GetAPIToken() string {
+	if c := selectedContext(); c != nil {
+		token, err := c.Token()
+		Check(err)
+		return token
+	}
+
 	return viper.GetString(ConfigKeyAPIToken)
 }
 
@@ -0,0 +1,355 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zalando/go-keyring"
+)
+
+const (
+	ConfigKeyContexts       = "contexts"
+	ConfigKeyCurrentContext = "current_context"
+
+	CredentialsKeyring = "keyring"
+	CredentialsFile    = "file"
+
+	// Service name for the tokens stored in the OS keyring.
+	KeyringService = "superplane"
+)
+
+// Set with the --context flag.
+var contextName string
+
+// Context is a named SuperPlane server and organization,
+// with the credentials used to access it.
+type Context struct {
+	Name         string `mapstructure:"name" json:"name"`
+	APIURL       string `mapstructure:"api_url" json:"apiUrl"`
+	Organization string `mapstructure:"organization" json:"organization"`
+	Credentials  string `mapstructure:"credentials" json:"credentials"`
+
+	// Only set if the credentials are stored in the config file.
+	APIToken string `mapstructure:"api_token" json:"-"`
+}
+
+func (c *Context) toConfig() map[string]any {
+	config := map[string]any{
+		"name":         c.Name,
+		"api_url":      c.APIURL,
+		"organization": c.Organization,
+		"credentials":  c.Credentials,
+	}
+
+	if c.Credentials == CredentialsFile {
+		config["api_token"] = c.APIToken
+	}
+
+	return config
+}
+
+// Token returns the API token of the context,
+// reading it from the OS keyring if it is stored there.
+func (c *Context) Token() (string, error) {
+	if c.Credentials != CredentialsKeyring {
+		return c.APIToken, nil
+	}
+
+	token, err := keyring.Get(KeyringService, c.Name)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token for context %q from keyring: %w", c.Name, err)
+	}
+
+	return token, nil
+}
+
+// SetToken stores the token in the OS keyring,
+// or in the config file if no keyring is available.
+func (c *Context) SetToken(token string) {
+	err := keyring.Set(KeyringService, c.Name, token)
+	if err == nil {
+		c.Credentials = CredentialsKeyring
+		c.APIToken = ""
+		return
+	}
+
+	c.Credentials = CredentialsFile
+	c.APIToken = token
+}
+
+func (c *Context) deleteToken() error {
+	if c.Credentials != CredentialsKeyring {
+		return nil
+	}
+
+	err := keyring.Delete(KeyringService, c.Name)
+	if err != nil && !errors.Is(err, keyring.ErrNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+func listContexts() ([]Context, error) {
+	contexts := []Context{}
+	if err := viper.UnmarshalKey(ConfigKeyContexts, &contexts); err != nil {
+		return nil, fmt.Errorf("invalid contexts in config: %w", err)
+	}
+
+	return contexts, nil
+}
+
+func findContext(name string) (*Context, error) {
+	contexts, err := listContexts()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range contexts {
+		if c.Name == name {
+			return &c, nil
+		}
+	}
+
+	return nil, fmt.Errorf("context %q not found", name)
+}
+
+// saveContext adds the context to the config file,
+// replacing the one with the same name, if any.
+func saveContext(c Context) error {
+	contexts, err := listContexts()
+	if err != nil {
+		return err
+	}
+
+	updated := []map[string]any{}
+	for _, existing := range contexts {
+		if existing.Name != c.Name {
+			updated = append(updated, existing.toConfig())
+		}
+	}
+
+	updated = append(updated, c.toConfig())
+	viper.Set(ConfigKeyContexts, updated)
+	return writeConfig()
+}
+
+func removeContext(name string) error {
+	contexts, err := listContexts()
+	if err != nil {
+		return err
+	}
+
+	updated := []map[string]any{}
+	for _, existing := range contexts {
+		if existing.Name != name {
+			updated = append(updated, existing.toConfig())
+		}
+	}
+
+	viper.Set(ConfigKeyContexts, updated)
+	if viper.GetString(ConfigKeyCurrentContext) == name {
+		viper.Set(ConfigKeyCurrentContext, "")
+	}
+
+	return writeConfig()
+}
+
+// writeConfig writes the config file, only readable by the user,
+// since tokens are stored in it when no keyring is available.
+func writeConfig() error {
+	if err := viper.WriteConfig(); err != nil {
+		return err
+	}
+
+	return os.Chmod(viper.ConfigFileUsed(), 0600)
+}
+
+// selectedContextName returns the context given with --context
+// or the SUPERPLANE_CONTEXT environment variable,
+// or the current one if none is given.
+func selectedContextName() string {
+	if contextName != "" {
+		return contextName
+	}
+
+	if name := viper.GetString("context"); name != "" {
+		return name
+	}
+
+	return viper.GetString(ConfigKeyCurrentContext)
+}
+
+// selectedContext returns nil if no context is in use,
+// in which case the top-level API URL and token are used.
+func selectedContext() *Context {
+	name := selectedContextName()
+	if name == "" {
+		return nil
+	}
+
+	c, err := findContext(name)
+	Check(err)
+	return c
+}
+
+var contextCmd = &cobra.Command{
+	Use:     "context",
+	Short:   "Manage the SuperPlane servers and organizations used",
+	Aliases: []string{"contexts", "ctx"},
+	Long: `Manage named contexts, each with the URL of a SuperPlane server,
+an organization and the token used to access it.
+
+Every command uses the current context, unless another one is given
+with --context. Tokens are kept in the OS keyring when one is available,
+and in the config file otherwise. Without contexts, the api_url and
+api_token config values are used.`,
+}
+
+var addContextCmd = &cobra.Command{
+	Use:   "add <name>",
+	Short: "Add a context, or update an existing one",
+	Long: `Add a context, or update an existing one.
+
+The token is read from the standard input if --token is not given.
+The organization is the one the token belongs to.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		apiURL, _ := cmd.Flags().GetString("url")
+		if apiURL == "" {
+			Fail("--url is required")
+		}
+
+		token, _ := cmd.Flags().GetString("token")
+		if token == "" {
+			var err error
+			token, err = readToken(os.Stdin, os.Stderr)
+			Check(err)
+		}
+
+		config := NewClientConfig()
+		config.BaseURL = apiURL
+		config.APIToken = token
+
+		me, _, err := NewAPIClient(config).MeAPI.MeMe(context.Background()).Execute()
+		if err != nil {
+			Fail(fmt.Sprintf("failed to authenticate with %s: %v", apiURL, err))
+		}
+
+		c := Context{
+			Name:         args[0],
+			APIURL:       apiURL,
+			Organization: me.GetOrganizationId(),
+		}
+
+		c.SetToken(token)
+		Check(saveContext(c))
+
+		if c.Credentials == CredentialsFile {
+			fmt.Fprintln(os.Stderr, "No keyring available, the token is stored in the config file.")
+		}
+
+		fmt.Printf("Context %q added for organization %s.\n", c.Name, c.Organization)
+
+		use, _ := cmd.Flags().GetBool("use")
+		if use {
+			useContext(c.Name)
+		}
+	},
+}
+
+var useContextCmd = &cobra.Command{
+	Use:   "use <name>",
+	Short: "Set the current context",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		_, err := findContext(args[0])
+		Check(err)
+
+		useContext(args[0])
+	},
+}
+
+var listContextsCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List contexts",
+	Aliases: []string{"ls"},
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		contexts, err := listContexts()
+		Check(err)
+
+		current := selectedContextName()
+		printOutput(cmd, contexts, func(w io.Writer) {
+			fmt.Fprintln(w, "CURRENT\tNAME\tURL\tORGANIZATION\tCREDENTIALS")
+			for _, c := range contexts {
+				marker := ""
+				if c.Name == current {
+					marker = "*"
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", marker, c.Name, c.APIURL, c.Organization, c.Credentials)
+			}
+		})
+	},
+}
+
+var deleteContextCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete a context and its stored token",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		c, err := findContext(args[0])
+		Check(err)
+
+		Check(c.deleteToken())
+		Check(removeContext(c.Name))
+		fmt.Printf("Context %q deleted.\n", c.Name)
+	},
+}
+
+func useContext(name string) {
+	viper.Set(ConfigKeyCurrentContext, name)
+	CheckWithMessage(writeConfig(), "Failed to write configuration")
+	fmt.Printf("Switched to context %q.\n", name)
+}
+
+func readToken(in io.Reader, prompt io.Writer) (string, error) {
+	fmt.Fprint(prompt, "API token: ")
+	line, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	token := strings.TrimSpace(line)
+	if token == "" {
+		return "", fmt.Errorf("token is required")
+	}
+
+	return token, nil
+}
+
+func init() {
+	RootCmd.PersistentFlags().StringVar(&contextName, "context", "", "context to use instead of the current one")
+
+	RootCmd.AddCommand(contextCmd)
+
+	contextCmd.AddCommand(addContextCmd)
+	addContextCmd.Flags().String("url", "", "URL of the SuperPlane server")
+	addContextCmd.Flags().String("token", "", "API token, read from the standard input if not given")
+	addContextCmd.Flags().Bool("use", false, "also make it the current context")
+
+	contextCmd.AddCommand(useContextCmd)
+
+	contextCmd.AddCommand(listContextsCmd)
+	addOutputFlag(listContextsCmd)
+
+	contextCmd.AddCommand(deleteContextCmd)
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+func setupTestConfig(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "superplane.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("api_url: http://legacy\napi_token: legacy-token\n"), 0644))
+
+	viper.Reset()
+	viper.SetConfigFile(path)
+	require.NoError(t, viper.ReadInConfig())
+
+	t.Cleanup(func() {
+		viper.Reset()
+		contextName = ""
+	})
+
+	return path
+}
+
+func Test__Contexts(t *testing.T) {
+	t.Run("no context in use -> top-level values are used", func(t *testing.T) {
+		setupTestConfig(t)
+
+		assert.Equal(t, "http://legacy", GetAPIURL())
+		assert.Equal(t, "legacy-token", GetAPIToken())
+	})
+
+	t.Run("token is stored in the keyring when available", func(t *testing.T) {
+		keyring.MockInit()
+		path := setupTestConfig(t)
+
+		c := Context{Name: "Staging", APIURL: "http://staging", Organization: "org-1"}
+		c.SetToken("staging-token")
+		require.NoError(t, saveContext(c))
+		assert.Equal(t, CredentialsKeyring, c.Credentials)
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "staging-token")
+
+		contextName = "Staging"
+		assert.Equal(t, "http://staging", GetAPIURL())
+		assert.Equal(t, "staging-token", GetAPIToken())
+	})
+
+	t.Run("no keyring -> token is stored in the config file", func(t *testing.T) {
+		keyring.MockInitWithError(errors.New("no keyring"))
+		path := setupTestConfig(t)
+
+		c := Context{Name: "production", APIURL: "http://production", Organization: "org-2"}
+		c.SetToken("production-token")
+		require.NoError(t, saveContext(c))
+		assert.Equal(t, CredentialsFile, c.Credentials)
+
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+		viper.Set(ConfigKeyCurrentContext, "production")
+		assert.Equal(t, "http://production", GetAPIURL())
+		assert.Equal(t, "production-token", GetAPIToken())
+	})
+
+	t.Run("contexts are replaced by name and removed", func(t *testing.T) {
+		keyring.MockInit()
+		setupTestConfig(t)
+
+		require.NoError(t, saveContext(Context{Name: "a", APIURL: "http://a1"}))
+		require.NoError(t, saveContext(Context{Name: "b", APIURL: "http://b"}))
+		require.NoError(t, saveContext(Context{Name: "a", APIURL: "http://a2"}))
+
+		contexts, err := listContexts()
+		require.NoError(t, err)
+		require.Len(t, contexts, 2)
+		assert.Equal(t, "b", contexts[0].Name)
+		assert.Equal(t, "http://a2", contexts[1].APIURL)
+
+		viper.Set(ConfigKeyCurrentContext, "a")
+		require.NoError(t, removeContext("a"))
+
+		contexts, err = listContexts()
+		require.NoError(t, err)
+		require.Len(t, contexts, 1)
+		assert.Equal(t, "", viper.GetString(ConfigKeyCurrentContext))
+
+		_, err = findContext("a")
+		require.EqualError(t, err, `context "a" not found`)
+	})
+}
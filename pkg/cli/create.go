@@ -38,6 +38,16 @@ var createCmd = &cobra.Command{
 			client := DefaultClient()
 			_, _, err = client.CanvasAPI.CanvasesCreateCanvas(context.Background()).Body(request).Execute()
 			Check(err)
+		case models.BlueprintKind:
+			resource, err := models.ParseBlueprint(data)
+			Check(err)
+
+			request := openapi_client.BlueprintsCreateBlueprintRequest{}
+			request.SetBlueprint(models.BlueprintFromBlueprint(*resource))
+
+			client := DefaultClient()
+			_, _, err = client.BlueprintAPI.BlueprintsCreateBlueprint(context.Background()).Body(request).Execute()
+			Check(err)
 		default:
 			Fail(fmt.Sprintf("Unsupported resource kind '%s'", kind))
 		}
@@ -68,9 +78,33 @@ var createCanvasCmd = &cobra.Command{
 	},
 }
 
+var createBlueprintCmd = &cobra.Command{
+	Use:   "blueprint <blueprint-name>",
+	Short: "Create a blueprint",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+		client := DefaultClient()
+
+		resource := models.Blueprint{
+			APIVersion: APIVersion,
+			Kind:       models.BlueprintKind,
+			Metadata:   &models.BlueprintMetadata{Name: &name},
+			Spec:       models.EmptyBlueprintSpec(),
+		}
+
+		request := openapi_client.BlueprintsCreateBlueprintRequest{}
+		request.SetBlueprint(models.BlueprintFromBlueprint(resource))
+
+		_, _, err := client.BlueprintAPI.BlueprintsCreateBlueprint(context.Background()).Body(request).Execute()
+		Check(err)
+	},
+}
+
 func init() {
 	RootCmd.AddCommand(createCmd)
 	createCmd.AddCommand(createCanvasCmd)
+	createCmd.AddCommand(createBlueprintCmd)
 
 	// File flag for root create command
 	desc := "Filename, directory, or URL to files to use to create the resource"
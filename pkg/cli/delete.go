@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/spf13/cobra"
 )
 
@@ -11,6 +14,25 @@ var deleteCmd = &cobra.Command{
 	Long:  `Delete a SuperPlane resource by ID or name.`,
 }
 
+var deleteBlueprintCmd = &cobra.Command{
+	Use:   "blueprint <name-or-id>",
+	Short: "Delete a blueprint",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := DefaultClient()
+		ctx := context.Background()
+
+		blueprintID, err := findBlueprintID(ctx, client, args[0])
+		Check(err)
+
+		_, _, err = client.BlueprintAPI.BlueprintsDeleteBlueprint(ctx, blueprintID).Execute()
+		Check(err)
+
+		fmt.Printf("Blueprint %s deleted.\n", args[0])
+	},
+}
+
 func init() {
 	RootCmd.AddCommand(deleteCmd)
+	deleteCmd.AddCommand(deleteBlueprintCmd)
 }
@@ -0,0 +1,340 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/cli/models"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Show changes between a file and the live resource.",
+	Long: `Compare a canvas YAML file with the canvas in SuperPlane, and show
+the nodes added, removed or changed, and the edges rewired.
+
+Node positions, generated IDs and fields populated by the server are ignored.
+Like diff(1), exits with 0 if there are no changes, 1 if there are,
+and 2 if the comparison fails.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		path, err := cmd.Flags().GetString("file")
+		if err != nil {
+			failDiff("Path not provided")
+		}
+
+		// #nosec
+		data, err := os.ReadFile(path)
+		if err != nil {
+			failDiff("Failed to read from resource file.")
+		}
+
+		_, kind, err := ParseYamlResourceHeaders(data)
+		checkDiff(err)
+
+		if kind != models.CanvasKind {
+			failDiff(fmt.Sprintf("Unsupported resource kind '%s' for diff", kind))
+		}
+
+		resource, err := models.ParseCanvas(data)
+		checkDiff(err)
+
+		client := DefaultClient()
+		ctx := context.Background()
+
+		canvasID := resource.Metadata.GetId()
+		if canvasID == "" {
+			canvasID, err = findCanvasIDByName(ctx, client, *resource.Metadata.Name)
+			checkDiff(err)
+		}
+
+		response, _, err := client.CanvasAPI.CanvasesDescribeCanvas(ctx, canvasID).Execute()
+		checkDiff(err)
+
+		live := response.Canvas.GetSpec()
+		diff := diffCanvasSpecs(resource.Spec, &live)
+		if diff.Empty() {
+			fmt.Fprintf(os.Stdout, "Canvas %q is up to date.\n", *resource.Metadata.Name)
+			return
+		}
+
+		fmt.Fprintf(os.Stdout, "Canvas %q:\n", *resource.Metadata.Name)
+		diff.Print(os.Stdout)
+		Exit(1)
+	},
+}
+
+// Differences exit with 1, so errors exit with another code.
+const diffErrorExitCode = 2
+
+func checkDiff(err error) {
+	if err != nil {
+		failDiff(err.Error())
+	}
+}
+
+func failDiff(message string) {
+	fmt.Fprintf(os.Stderr, "error: %s\n", message)
+	Exit(diffErrorExitCode)
+}
+
+type canvasDiff struct {
+	AddedNodes   []string
+	RemovedNodes []string
+	ChangedNodes []nodeDiff
+	AddedEdges   []string
+	RemovedEdges []string
+}
+
+type nodeDiff struct {
+	Node    string
+	Changes []fieldChange
+}
+
+type fieldChange struct {
+	Path string
+	From any
+	To   any
+}
+
+func (d *canvasDiff) Empty() bool {
+	return len(d.AddedNodes) == 0 &&
+		len(d.RemovedNodes) == 0 &&
+		len(d.ChangedNodes) == 0 &&
+		len(d.AddedEdges) == 0 &&
+		len(d.RemovedEdges) == 0
+}
+
+func (d *canvasDiff) Print(w io.Writer) {
+	for _, node := range d.AddedNodes {
+		fmt.Fprintf(w, "+ node %s\n", node)
+	}
+
+	for _, node := range d.RemovedNodes {
+		fmt.Fprintf(w, "- node %s\n", node)
+	}
+
+	for _, node := range d.ChangedNodes {
+		fmt.Fprintf(w, "~ node %s\n", node.Node)
+		for _, change := range node.Changes {
+			fmt.Fprintf(w, "    %s: %s -> %s\n", change.Path, formatDiffValue(change.From), formatDiffValue(change.To))
+		}
+	}
+
+	for _, edge := range d.AddedEdges {
+		fmt.Fprintf(w, "+ edge %s\n", edge)
+	}
+
+	for _, edge := range d.RemovedEdges {
+		fmt.Fprintf(w, "- edge %s\n", edge)
+	}
+}
+
+func formatDiffValue(value any) string {
+	if value == nil {
+		return "<none>"
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(data)
+}
+
+// Node fields that are not part of the canvas definition:
+// the node ID is used to match edges, and the others are
+// either layout or populated by the server.
+var ignoredNodeFields = []string{
+	"id",
+	"position",
+	"isCollapsed",
+	"errorMessage",
+	"warningMessage",
+	"metadata",
+	"paused",
+}
+
+/*
+ * diffCanvasSpecs compares the nodes of both specs by name,
+ * since the IDs of nodes created without one are generated by the server.
+ * Edges are compared using the node names too.
+ */
+func diffCanvasSpecs(desired, live *openapi_client.CanvasesCanvasSpec) canvasDiff {
+	if desired == nil {
+		desired = models.EmptyCanvasSpec()
+	}
+
+	if live == nil {
+		live = models.EmptyCanvasSpec()
+	}
+
+	desiredNodes, desiredNames := nodesByKey(desired.Nodes)
+	liveNodes, liveNames := nodesByKey(live.Nodes)
+
+	diff := canvasDiff{}
+	for _, key := range slices.Sorted(maps.Keys(desiredNodes)) {
+		liveNode, ok := liveNodes[key]
+		if !ok {
+			diff.AddedNodes = append(diff.AddedNodes, describeNode(key, desiredNodes[key]))
+			continue
+		}
+
+		changes := diffValues("", comparableNode(desiredNodes[key]), comparableNode(liveNode))
+		if len(changes) > 0 {
+			diff.ChangedNodes = append(diff.ChangedNodes, nodeDiff{Node: key, Changes: changes})
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(liveNodes)) {
+		if _, ok := desiredNodes[key]; !ok {
+			diff.RemovedNodes = append(diff.RemovedNodes, describeNode(key, liveNodes[key]))
+		}
+	}
+
+	desiredEdges := edgeSet(desired.Edges, desiredNames)
+	liveEdges := edgeSet(live.Edges, liveNames)
+	for _, edge := range slices.Sorted(maps.Keys(desiredEdges)) {
+		if !liveEdges[edge] {
+			diff.AddedEdges = append(diff.AddedEdges, edge)
+		}
+	}
+
+	for _, edge := range slices.Sorted(maps.Keys(liveEdges)) {
+		if !desiredEdges[edge] {
+			diff.RemovedEdges = append(diff.RemovedEdges, edge)
+		}
+	}
+
+	return diff
+}
+
+// nodesByKey indexes the nodes by name, and returns the key for each node ID.
+// Nodes sharing a name are told apart by their ID.
+func nodesByKey(nodes []openapi_client.ComponentsNode) (map[string]openapi_client.ComponentsNode, map[string]string) {
+	counts := map[string]int{}
+	for _, node := range nodes {
+		counts[node.GetName()]++
+	}
+
+	byKey := map[string]openapi_client.ComponentsNode{}
+	keys := map[string]string{}
+	for _, node := range nodes {
+		key := node.GetName()
+		if key == "" || counts[key] > 1 {
+			key = fmt.Sprintf("%s (%s)", node.GetName(), node.GetId())
+		}
+
+		byKey[key] = node
+		keys[node.GetId()] = key
+	}
+
+	return byKey, keys
+}
+
+func edgeSet(edges []openapi_client.ComponentsEdge, keys map[string]string) map[string]bool {
+	set := map[string]bool{}
+	for _, edge := range edges {
+		source, ok := keys[edge.GetSourceId()]
+		if !ok {
+			source = edge.GetSourceId()
+		}
+
+		target, ok := keys[edge.GetTargetId()]
+		if !ok {
+			target = edge.GetTargetId()
+		}
+
+		channel := edge.GetChannel()
+		if channel == "" {
+			channel = "default"
+		}
+
+		set[fmt.Sprintf("%s -[%s]-> %s", source, channel, target)] = true
+	}
+
+	return set
+}
+
+func describeNode(key string, node openapi_client.ComponentsNode) string {
+	switch {
+	case node.Component != nil:
+		return fmt.Sprintf("%s (component: %s)", key, node.Component.GetName())
+	case node.Trigger != nil:
+		return fmt.Sprintf("%s (trigger: %s)", key, node.Trigger.GetName())
+	case node.Blueprint != nil:
+		return fmt.Sprintf("%s (blueprint: %s)", key, node.Blueprint.GetId())
+	case node.Widget != nil:
+		return fmt.Sprintf("%s (widget: %s)", key, node.Widget.GetName())
+	default:
+		return key
+	}
+}
+
+func comparableNode(node openapi_client.ComponentsNode) any {
+	normalized, ok := normalizeJSON(node).(map[string]any)
+	if !ok {
+		return normalized
+	}
+
+	for _, field := range ignoredNodeFields {
+		delete(normalized, field)
+	}
+
+	return normalized
+}
+
+// diffValues returns the paths where the values differ,
+// going into nested objects. Lists are compared as a whole.
+func diffValues(path string, desired, live any) []fieldChange {
+	desiredMap, desiredIsMap := desired.(map[string]any)
+	liveMap, liveIsMap := live.(map[string]any)
+	if !desiredIsMap || !liveIsMap {
+		if reflect.DeepEqual(desired, live) {
+			return nil
+		}
+
+		return []fieldChange{{Path: path, From: live, To: desired}}
+	}
+
+	keys := map[string]bool{}
+	for key := range desiredMap {
+		keys[key] = true
+	}
+
+	for key := range liveMap {
+		keys[key] = true
+	}
+
+	changes := []fieldChange{}
+	for _, key := range slices.Sorted(maps.Keys(keys)) {
+		changes = append(changes, diffValues(joinPath(path, key), desiredMap[key], liveMap[key])...)
+	}
+
+	return changes
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return strings.Join([]string{path, key}, ".")
+}
+
+func init() {
+	RootCmd.AddCommand(diffCmd)
+
+	desc := "Filename of the resource to compare"
+	diffCmd.Flags().StringP("file", "f", "", desc)
+}
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func parseSpec(t *testing.T, raw string) *openapi_client.CanvasesCanvasSpec {
+	spec := &openapi_client.CanvasesCanvasSpec{}
+	require.NoError(t, yaml.Unmarshal([]byte(raw), spec))
+	return spec
+}
+
+func Test__DiffCanvasSpecs(t *testing.T) {
+	live := parseSpec(t, `
+nodes:
+  - id: generated-1
+    name: start
+    type: TYPE_TRIGGER
+    trigger: {name: start}
+    position: {x: 10, y: 20}
+  - id: generated-2
+    name: build
+    type: TYPE_COMPONENT
+    component: {name: http}
+    configuration: {url: "https://a.example.com", method: GET}
+    errorMessage: "something is wrong"
+  - id: generated-3
+    name: notify
+    type: TYPE_COMPONENT
+    component: {name: noop}
+edges:
+  - {sourceId: generated-1, targetId: generated-2, channel: default}
+  - {sourceId: generated-2, targetId: generated-3, channel: default}
+`)
+
+	t.Run("same definition with other IDs and positions -> no changes", func(t *testing.T) {
+		desired := parseSpec(t, `
+nodes:
+  - id: start
+    name: start
+    type: TYPE_TRIGGER
+    trigger: {name: start}
+  - id: build
+    name: build
+    type: TYPE_COMPONENT
+    component: {name: http}
+    configuration: {url: "https://a.example.com", method: GET}
+  - id: notify
+    name: notify
+    type: TYPE_COMPONENT
+    component: {name: noop}
+edges:
+  - {sourceId: start, targetId: build}
+  - {sourceId: build, targetId: notify, channel: default}
+`)
+
+		diff := diffCanvasSpecs(desired, live)
+		assert.True(t, diff.Empty())
+	})
+
+	t.Run("nodes added, removed and changed, and edges rewired", func(t *testing.T) {
+		desired := parseSpec(t, `
+nodes:
+  - id: start
+    name: start
+    type: TYPE_TRIGGER
+    trigger: {name: start}
+  - id: build
+    name: build
+    type: TYPE_COMPONENT
+    component: {name: http}
+    configuration: {url: "https://b.example.com", method: GET}
+  - id: approve
+    name: approve
+    type: TYPE_COMPONENT
+    component: {name: approval}
+edges:
+  - {sourceId: start, targetId: build}
+  - {sourceId: build, targetId: approve}
+`)
+
+		diff := diffCanvasSpecs(desired, live)
+		assert.Equal(t, []string{"approve (component: approval)"}, diff.AddedNodes)
+		assert.Equal(t, []string{"notify (component: noop)"}, diff.RemovedNodes)
+		require.Len(t, diff.ChangedNodes, 1)
+		assert.Equal(t, "build", diff.ChangedNodes[0].Node)
+		assert.Equal(t, []fieldChange{{Path: "configuration.url", From: "https://a.example.com", To: "https://b.example.com"}}, diff.ChangedNodes[0].Changes)
+		assert.Equal(t, []string{"build -[default]-> approve"}, diff.AddedEdges)
+		assert.Equal(t, []string{"build -[default]-> notify"}, diff.RemovedEdges)
+
+		output := bytes.Buffer{}
+		diff.Print(&output)
+		assert.Contains(t, output.String(), `    configuration.url: "https://a.example.com" -> "https://b.example.com"`)
+	})
+}
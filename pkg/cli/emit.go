@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+var emitCmd = &cobra.Command{
+	Use:   "emit <canvas> <node>",
+	Short: "Emit an event from a node",
+	Long: `Emit an event from a canvas node, as if the node produced it,
+starting a run of the nodes connected to it.
+
+The payload is a JSON object given with --data, either inline,
+from a file with @path, or from the standard input with @-.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := DefaultClient()
+		ctx := context.Background()
+
+		canvasID, err := findCanvasID(ctx, client, args[0])
+		Check(err)
+
+		nodeID, err := findNodeID(ctx, client, canvasID, args[1])
+		Check(err)
+
+		rawData, _ := cmd.Flags().GetString("data")
+		data, err := readEventData(rawData, os.Stdin)
+		Check(err)
+
+		channel, _ := cmd.Flags().GetString("channel")
+		body := openapi_client.CanvasesEmitNodeEventBody{}
+		body.SetChannel(channel)
+		body.SetData(data)
+
+		response, _, err := client.CanvasNodeAPI.CanvasesEmitNodeEvent(ctx, canvasID, nodeID).Body(body).Execute()
+		Check(err)
+
+		fmt.Printf("Event %s emitted.\n", response.GetEventId())
+	},
+}
+
+func readEventData(raw string, stdin io.Reader) (map[string]any, error) {
+	if raw == "" {
+		return map[string]any{}, nil
+	}
+
+	content := []byte(raw)
+	if path, ok := strings.CutPrefix(raw, "@"); ok {
+		var err error
+		if path == "-" {
+			content, err = io.ReadAll(stdin)
+		} else {
+			// #nosec
+			content, err = os.ReadFile(path)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read event data: %w", err)
+		}
+	}
+
+	data := map[string]any{}
+	if err := json.Unmarshal(content, &data); err != nil {
+		return nil, fmt.Errorf("event data must be a JSON object: %w", err)
+	}
+
+	return data, nil
+}
+
+func init() {
+	RootCmd.AddCommand(emitCmd)
+	emitCmd.Flags().String("data", "", "event payload as JSON, @file or @- for the standard input")
+	emitCmd.Flags().String("channel", core.DefaultOutputChannel.Name, "output channel of the event")
+}
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__ReadEventData(t *testing.T) {
+	t.Run("no data -> empty payload", func(t *testing.T) {
+		data, err := readEventData("", nil)
+		require.NoError(t, err)
+		assert.Empty(t, data)
+	})
+
+	t.Run("inline JSON", func(t *testing.T) {
+		data, err := readEventData(`{"ref": "main"}`, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"ref": "main"}, data)
+	})
+
+	t.Run("JSON from file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "payload.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{"count": 2}`), 0600))
+
+		data, err := readEventData("@"+path, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"count": float64(2)}, data)
+	})
+
+	t.Run("JSON from standard input", func(t *testing.T) {
+		data, err := readEventData("@-", strings.NewReader(`{"ok": true}`))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"ok": true}, data)
+	})
+
+	t.Run("not an object -> error", func(t *testing.T) {
+		_, err := readEventData(`[1, 2]`, nil)
+		require.ErrorContains(t, err, "event data must be a JSON object")
+	})
+}
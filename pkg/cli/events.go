@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+var eventsCmd = &cobra.Command{
+	Use:     "events",
+	Short:   "Manage canvas events",
+	Aliases: []string{"event"},
+}
+
+var listEventsCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the events of a canvas or node",
+	Long: `List the most recent events of a canvas.
+
+Without --node, the events that started a run of the canvas are listed.
+With --node, the events emitted by that node are listed.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		client := DefaultClient()
+		ctx := context.Background()
+		canvasID := getCanvasIDOrExit(ctx, client, cmd)
+		limit, _ := cmd.Flags().GetInt64("limit")
+
+		nodeNameOrID, _ := cmd.Flags().GetString("node")
+		if nodeNameOrID == "" {
+			response, _, err := client.CanvasEventAPI.CanvasesListCanvasEvents(ctx, canvasID).Limit(limit).Execute()
+			Check(err)
+
+			printOutput(cmd, response.GetEvents(), func(w io.Writer) {
+				fmt.Fprintln(w, "ID\tNODE\tCHANNEL\tNAME\tEXECUTIONS\tCREATED_AT")
+				for _, event := range response.GetEvents() {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n", event.GetId(), event.GetNodeId(), event.GetChannel(), event.GetCustomName(), len(event.GetExecutions()), formatTime(event.CreatedAt))
+				}
+			})
+
+			return
+		}
+
+		nodeID, err := findNodeID(ctx, client, canvasID, nodeNameOrID)
+		Check(err)
+
+		response, _, err := client.CanvasNodeAPI.CanvasesListNodeEvents(ctx, canvasID, nodeID).Limit(limit).Execute()
+		Check(err)
+
+		printOutput(cmd, response.GetEvents(), func(w io.Writer) {
+			fmt.Fprintln(w, "ID\tNODE\tCHANNEL\tNAME\tCREATED_AT")
+			for _, event := range response.GetEvents() {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", event.GetId(), event.GetNodeId(), event.GetChannel(), event.GetCustomName(), formatTime(event.CreatedAt))
+			}
+		})
+	},
+}
+
+var showEventCmd = &cobra.Command{
+	Use:   "show <event-id>",
+	Short: "Show an event and the executions it started",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := DefaultClient()
+		ctx := context.Background()
+		canvasID := getCanvasIDOrExit(ctx, client, cmd)
+
+		event, err := findCanvasEvent(ctx, client, canvasID, args[0])
+		Check(err)
+
+		response, _, err := client.CanvasEventAPI.CanvasesListEventExecutions(ctx, canvasID, args[0]).Execute()
+		Check(err)
+
+		event.Executions = response.GetExecutions()
+		printOutput(cmd, event, func(w io.Writer) {
+			fmt.Fprintf(w, "ID:\t%s\n", event.GetId())
+			fmt.Fprintf(w, "Node:\t%s\n", event.GetNodeId())
+			fmt.Fprintf(w, "Channel:\t%s\n", event.GetChannel())
+			fmt.Fprintf(w, "Name:\t%s\n", event.GetCustomName())
+			fmt.Fprintf(w, "Created at:\t%s\n", formatTime(event.CreatedAt))
+			fmt.Fprintln(w)
+			writeExecutionsTable(w, event.Executions)
+		})
+	},
+}
+
+// findCanvasEvent goes through the pages of canvas events
+// until it finds the one with the given ID.
+func findCanvasEvent(ctx context.Context, client *openapi_client.APIClient, canvasID, eventID string) (*openapi_client.CanvasesCanvasEventWithExecutions, error) {
+	request := client.CanvasEventAPI.CanvasesListCanvasEvents(ctx, canvasID)
+	for {
+		response, _, err := request.Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range response.GetEvents() {
+			if event.GetId() == eventID {
+				return &event, nil
+			}
+		}
+
+		if !response.GetHasNextPage() || len(response.GetEvents()) == 0 {
+			return nil, fmt.Errorf("event %q not found", eventID)
+		}
+
+		request = request.Before(response.GetLastTimestamp())
+	}
+}
+
+func init() {
+	RootCmd.AddCommand(eventsCmd)
+
+	eventsCmd.PersistentFlags().String("canvas", "", "canvas name or ID")
+	eventsCmd.AddCommand(listEventsCmd)
+	listEventsCmd.Flags().String("node", "", "node name or ID")
+	listEventsCmd.Flags().Int64("limit", 20, "maximum number of events to list")
+	addOutputFlag(listEventsCmd)
+
+	eventsCmd.AddCommand(showEventCmd)
+	addOutputFlag(showEventCmd)
+}
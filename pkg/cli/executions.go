@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+var executionsCmd = &cobra.Command{
+	Use:     "executions",
+	Short:   "Manage node executions",
+	Aliases: []string{"execution"},
+}
+
+var listExecutionsCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the executions of a node",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		client := DefaultClient()
+		ctx := context.Background()
+		canvasID := getCanvasIDOrExit(ctx, client, cmd)
+		nodeID := getNodeIDOrExit(ctx, client, cmd, canvasID)
+
+		limit, _ := cmd.Flags().GetInt64("limit")
+		states, _ := cmd.Flags().GetStringSlice("state")
+		results, _ := cmd.Flags().GetStringSlice("result")
+
+		request := client.CanvasNodeAPI.CanvasesListNodeExecutions(ctx, canvasID, nodeID).Limit(limit)
+		if len(states) > 0 {
+			request = request.States(withEnumPrefix("STATE_", states))
+		}
+
+		if len(results) > 0 {
+			request = request.Results(withEnumPrefix("RESULT_", results))
+		}
+
+		response, _, err := request.Execute()
+		Check(err)
+
+		printOutput(cmd, response.GetExecutions(), func(w io.Writer) {
+			writeExecutionsTable(w, response.GetExecutions())
+		})
+	},
+}
+
+var showExecutionCmd = &cobra.Command{
+	Use:   "show <execution-id>",
+	Short: "Show an execution",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := DefaultClient()
+		ctx := context.Background()
+		canvasID := getCanvasIDOrExit(ctx, client, cmd)
+
+		response, _, err := client.CanvasNodeExecutionAPI.CanvasesDescribeExecution(ctx, canvasID, args[0]).Execute()
+		Check(err)
+
+		execution := response.Execution
+		if execution == nil {
+			Fail(fmt.Sprintf("execution %q not found", args[0]))
+		}
+
+		printOutput(cmd, execution, func(w io.Writer) {
+			fmt.Fprintf(w, "ID:\t%s\n", execution.GetId())
+			fmt.Fprintf(w, "Node:\t%s\n", execution.GetNodeId())
+			fmt.Fprintf(w, "State:\t%s\n", executionState(*execution))
+			fmt.Fprintf(w, "Message:\t%s\n", execution.GetResultMessage())
+			fmt.Fprintf(w, "Root event:\t%s\n", execution.RootEvent.GetId())
+			fmt.Fprintf(w, "Created at:\t%s\n", formatTime(execution.CreatedAt))
+			fmt.Fprintf(w, "Updated at:\t%s\n", formatTime(execution.UpdatedAt))
+
+			if len(execution.GetChildExecutions()) > 0 {
+				fmt.Fprintln(w)
+				writeExecutionsTable(w, execution.GetChildExecutions())
+			}
+		})
+	},
+}
+
+var cancelExecutionCmd = &cobra.Command{
+	Use:   "cancel <execution-id>",
+	Short: "Cancel an execution",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := DefaultClient()
+		ctx := context.Background()
+		canvasID := getCanvasIDOrExit(ctx, client, cmd)
+
+		_, _, err := client.CanvasNodeExecutionAPI.
+			CanvasesCancelExecution(ctx, canvasID, args[0]).
+			Body(map[string]any{}).
+			Execute()
+		Check(err)
+
+		fmt.Printf("Execution %s cancelled.\n", args[0])
+	},
+}
+
+var approveCmd = &cobra.Command{
+	Use:   "approve <execution-id>",
+	Short: "Approve a pending approval",
+	Long: `Approve one of the requirements of an execution waiting for approval.
+
+The requirement is chosen with --index, in the order the approvers are
+configured in the node, starting at 0.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		index, _ := cmd.Flags().GetInt("index")
+		comment, _ := cmd.Flags().GetString("comment")
+
+		parameters := map[string]any{"index": index}
+		if comment != "" {
+			parameters["comment"] = comment
+		}
+
+		invokeExecutionAction(cmd, args[0], "approve", parameters)
+		fmt.Printf("Execution %s approved.\n", args[0])
+	},
+}
+
+var rejectCmd = &cobra.Command{
+	Use:   "reject <execution-id>",
+	Short: "Reject a pending approval",
+	Long: `Reject one of the requirements of an execution waiting for approval.
+
+The requirement is chosen with --index, in the order the approvers are
+configured in the node, starting at 0.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		index, _ := cmd.Flags().GetInt("index")
+		reason, _ := cmd.Flags().GetString("reason")
+		if reason == "" {
+			Fail("--reason is required")
+		}
+
+		invokeExecutionAction(cmd, args[0], "reject", map[string]any{"index": index, "reason": reason})
+		fmt.Printf("Execution %s rejected.\n", args[0])
+	},
+}
+
+func invokeExecutionAction(cmd *cobra.Command, executionID, action string, parameters map[string]any) {
+	client := DefaultClient()
+	ctx := context.Background()
+	canvasID := getCanvasIDOrExit(ctx, client, cmd)
+
+	body := openapi_client.CanvasesInvokeNodeExecutionActionBody{}
+	body.SetParameters(parameters)
+
+	_, _, err := client.CanvasNodeExecutionAPI.
+		CanvasesInvokeNodeExecutionAction(ctx, canvasID, executionID, action).
+		Body(body).
+		Execute()
+	Check(err)
+}
+
+// getNodeIDOrExit returns the ID of the node given with --node.
+func getNodeIDOrExit(ctx context.Context, client *openapi_client.APIClient, cmd *cobra.Command, canvasID string) string {
+	nameOrID, _ := cmd.Flags().GetString("node")
+	if nameOrID == "" {
+		Fail("--node is required")
+	}
+
+	nodeID, err := findNodeID(ctx, client, canvasID, nameOrID)
+	Check(err)
+
+	return nodeID
+}
+
+func writeExecutionsTable(w io.Writer, executions []openapi_client.CanvasesCanvasNodeExecution) {
+	fmt.Fprintln(w, "ID\tNODE\tSTATE\tROOT_EVENT\tCREATED_AT\tUPDATED_AT")
+	for _, execution := range executions {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			execution.GetId(),
+			execution.GetNodeId(),
+			executionState(execution),
+			execution.RootEvent.GetId(),
+			formatTime(execution.CreatedAt),
+			formatTime(execution.UpdatedAt),
+		)
+	}
+}
+
+// executionState combines the state and result of the execution,
+// e.g. "started" or "finished/failed".
+func executionState(execution openapi_client.CanvasesCanvasNodeExecution) string {
+	state := strings.ToLower(strings.TrimPrefix(string(execution.GetState()), "STATE_"))
+	if execution.GetState() != openapi_client.CANVASNODEEXECUTIONSTATE_STATE_FINISHED {
+		return state
+	}
+
+	result := strings.ToLower(strings.TrimPrefix(string(execution.GetResult()), "RESULT_"))
+	return state + "/" + result
+}
+
+// withEnumPrefix allows filters to be given as "failed" instead of "RESULT_FAILED".
+func withEnumPrefix(prefix string, values []string) []string {
+	prefixed := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.ToUpper(value)
+		if !strings.HasPrefix(value, prefix) {
+			value = prefix + value
+		}
+
+		prefixed = append(prefixed, value)
+	}
+
+	return prefixed
+}
+
+func init() {
+	RootCmd.AddCommand(executionsCmd)
+
+	executionsCmd.PersistentFlags().String("canvas", "", "canvas name or ID")
+	executionsCmd.AddCommand(listExecutionsCmd)
+	listExecutionsCmd.Flags().String("node", "", "node name or ID")
+	listExecutionsCmd.Flags().Int64("limit", 20, "maximum number of executions to list")
+	listExecutionsCmd.Flags().StringSlice("state", nil, "only list executions in these states: pending, started, finished")
+	listExecutionsCmd.Flags().StringSlice("result", nil, "only list executions with these results: passed, failed, cancelled")
+	addOutputFlag(listExecutionsCmd)
+
+	executionsCmd.AddCommand(showExecutionCmd)
+	addOutputFlag(showExecutionCmd)
+
+	executionsCmd.AddCommand(cancelExecutionCmd)
+
+	RootCmd.AddCommand(approveCmd)
+	approveCmd.Flags().String("canvas", "", "canvas name or ID")
+	approveCmd.Flags().Int("index", 0, "index of the approval requirement")
+	approveCmd.Flags().String("comment", "", "comment for the approval")
+
+	RootCmd.AddCommand(rejectCmd)
+	rejectCmd.Flags().String("canvas", "", "canvas name or ID")
+	rejectCmd.Flags().Int("index", 0, "index of the approval requirement")
+	rejectCmd.Flags().String("reason", "", "reason for the rejection")
+}
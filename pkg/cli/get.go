@@ -43,6 +43,28 @@ var getCanvasCmd = &cobra.Command{
 	},
 }
 
+var getBlueprintCmd = &cobra.Command{
+	Use:   "blueprint <name-or-id>",
+	Short: "Get a blueprint",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := DefaultClient()
+		ctx := context.Background()
+
+		blueprintID, err := findBlueprintID(ctx, client, args[0])
+		Check(err)
+
+		response, _, err := client.BlueprintAPI.BlueprintsDescribeBlueprint(ctx, blueprintID).Execute()
+		Check(err)
+
+		resource := models.BlueprintResourceFromBlueprint(response.GetBlueprint())
+		output, err := yaml.Marshal(resource)
+		Check(err)
+
+		fmt.Fprintln(os.Stdout, string(output))
+	},
+}
+
 func findCanvasID(ctx context.Context, client *openapi_client.APIClient, nameOrID string) (string, error) {
 	_, err := uuid.Parse(nameOrID)
 	if err == nil {
@@ -55,4 +77,5 @@ func findCanvasID(ctx context.Context, client *openapi_client.APIClient, nameOrI
 func init() {
 	RootCmd.AddCommand(getCmd)
 	getCmd.AddCommand(getCanvasCmd)
+	getCmd.AddCommand(getBlueprintCmd)
 }
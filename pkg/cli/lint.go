@@ -0,0 +1,601 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/parser"
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/cli/models"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+var lintCmd = &cobra.Command{
+	Use:   "lint [FILE]...",
+	Short: "Check canvas files for errors.",
+	Long: `Check canvas YAML files without talking to SuperPlane.
+
+Component, trigger and widget names are checked against the ones built into
+this CLI, and node configurations are validated the same way the server does.
+Edges must reference existing nodes and output channels, the canvas cannot
+have cycles, and every {{ }} expression must parse and only reference nodes
+in the canvas. Nodes not reachable from any trigger are reported as warnings.
+
+Blueprint nodes are only checked for a blueprint reference, since blueprints
+are stored in SuperPlane. Documents of other kinds are ignored.
+
+Exits with a non-zero code if any errors are found.`,
+	Args: cobra.MinimumNArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		documents, err := ReadYamlDocuments(args)
+		Check(err)
+
+		reg, err := localRegistry()
+		Check(err)
+
+		errorCount := 0
+		for _, document := range documents {
+			_, kind, err := ParseYamlResourceHeaders(document.Data)
+			CheckWithMessage(err, fmt.Sprintf("%s: invalid resource", document.Path))
+
+			if kind != models.CanvasKind {
+				continue
+			}
+
+			canvas, err := models.ParseCanvas(document.Data)
+			CheckWithMessage(err, fmt.Sprintf("%s: invalid canvas", document.Path))
+
+			issues := lintCanvas(reg, canvas.Spec)
+			printLintIssues(os.Stdout, document.Path, *canvas.Metadata.Name, issues)
+			errorCount += countLintErrors(issues)
+		}
+
+		if errorCount > 0 {
+			Exit(1)
+		}
+	},
+}
+
+const (
+	LintSeverityError   = "error"
+	LintSeverityWarning = "warning"
+)
+
+type lintIssue struct {
+	Severity string
+	Node     string
+	Message  string
+}
+
+func (i lintIssue) String() string {
+	if i.Node == "" {
+		return fmt.Sprintf("%s: %s", i.Severity, i.Message)
+	}
+
+	return fmt.Sprintf("%s: node %q: %s", i.Severity, i.Node, i.Message)
+}
+
+func printLintIssues(w io.Writer, path, canvasName string, issues []lintIssue) {
+	if len(issues) == 0 {
+		fmt.Fprintf(w, "%s: canvas %q has no issues.\n", path, canvasName)
+		return
+	}
+
+	fmt.Fprintf(w, "%s: canvas %q:\n", path, canvasName)
+	for _, issue := range issues {
+		fmt.Fprintf(w, "  %s\n", issue)
+	}
+}
+
+func countLintErrors(issues []lintIssue) int {
+	count := 0
+	for _, issue := range issues {
+		if issue.Severity == LintSeverityError {
+			count++
+		}
+	}
+
+	return count
+}
+
+type canvasLinter struct {
+	registry *registry.Registry
+	spec     *openapi_client.CanvasesCanvasSpec
+	issues   []lintIssue
+
+	// Nodes by ID, the output channels of each node,
+	// and the IDs of the nodes with each name.
+	nodes    map[string]openapi_client.ComponentsNode
+	channels map[string][]string
+	names    map[string][]string
+
+	// Edges by source and target node ID.
+	outgoing map[string][]string
+	incoming map[string][]string
+}
+
+/*
+ * lintCanvas checks the canvas spec with the same rules used
+ * by the server when a canvas is saved, plus a few more that
+ * the server does not enforce: edge channels, reachability
+ * and node references in expressions.
+ */
+func lintCanvas(registry *registry.Registry, spec *openapi_client.CanvasesCanvasSpec) []lintIssue {
+	if spec == nil {
+		spec = models.EmptyCanvasSpec()
+	}
+
+	l := &canvasLinter{
+		registry: registry,
+		spec:     spec,
+		nodes:    map[string]openapi_client.ComponentsNode{},
+		channels: map[string][]string{},
+		names:    map[string][]string{},
+		outgoing: map[string][]string{},
+		incoming: map[string][]string{},
+	}
+
+	l.lintNodes()
+	l.lintEdges()
+	l.lintCycles()
+	l.lintReachability()
+	l.lintExpressions()
+
+	return l.issues
+}
+
+func (l *canvasLinter) errorf(node, format string, args ...any) {
+	l.issues = append(l.issues, lintIssue{Severity: LintSeverityError, Node: node, Message: fmt.Sprintf(format, args...)})
+}
+
+func (l *canvasLinter) warnf(node, format string, args ...any) {
+	l.issues = append(l.issues, lintIssue{Severity: LintSeverityWarning, Node: node, Message: fmt.Sprintf(format, args...)})
+}
+
+func (l *canvasLinter) lintNodes() {
+	for i, node := range l.spec.Nodes {
+		id := node.GetId()
+		name := node.GetName()
+		if id == "" {
+			l.errorf(name, "node %d: id is required", i)
+			continue
+		}
+
+		if name == "" {
+			l.errorf("", "node %s: name is required", id)
+		}
+
+		if _, ok := l.nodes[id]; ok {
+			l.errorf(name, "duplicate node id %s", id)
+			continue
+		}
+
+		l.nodes[id] = node
+		if name != "" {
+			l.names[name] = append(l.names[name], id)
+		}
+
+		channels, err := l.lintNode(node)
+		if err != nil {
+			l.errorf(name, "%v", err)
+		}
+
+		l.channels[id] = channels
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(l.names)) {
+		if ids := l.names[name]; len(ids) > 1 {
+			l.warnf(name, "name is used by %d nodes, so expressions referencing it are ambiguous", len(ids))
+		}
+	}
+}
+
+// lintNode validates the component, trigger or widget used by the node
+// and its configuration, returning the output channels of the node.
+func (l *canvasLinter) lintNode(node openapi_client.ComponentsNode) ([]string, error) {
+	defaultChannels := []string{core.DefaultOutputChannel.Name}
+	config := node.Configuration
+	if config == nil {
+		config = map[string]any{}
+	}
+
+	switch nodeType(node) {
+	case openapi_client.COMPONENTSNODETYPE_TYPE_COMPONENT:
+		if node.Component == nil || node.Component.GetName() == "" {
+			return defaultChannels, fmt.Errorf("component name is required")
+		}
+
+		component, err := l.registry.GetComponent(node.Component.GetName())
+		if err != nil {
+			return defaultChannels, err
+		}
+
+		channels := []string{}
+		for _, channel := range component.OutputChannels(config) {
+			channels = append(channels, channel.Name)
+		}
+
+		if len(channels) == 0 {
+			channels = defaultChannels
+		}
+
+		return channels, configuration.ValidateConfiguration(component.Configuration(), config)
+
+	case openapi_client.COMPONENTSNODETYPE_TYPE_TRIGGER:
+		if node.Trigger == nil || node.Trigger.GetName() == "" {
+			return defaultChannels, fmt.Errorf("trigger name is required")
+		}
+
+		trigger, err := l.registry.GetTrigger(node.Trigger.GetName())
+		if err != nil {
+			return defaultChannels, err
+		}
+
+		return defaultChannels, configuration.ValidateConfiguration(trigger.Configuration(), config)
+
+	case openapi_client.COMPONENTSNODETYPE_TYPE_BLUEPRINT:
+		if node.Blueprint == nil || node.Blueprint.GetId() == "" {
+			return defaultChannels, fmt.Errorf("blueprint ID is required")
+		}
+
+		//
+		// Blueprints are stored in SuperPlane,
+		// so their output channels are not known here.
+		//
+		return nil, nil
+
+	case openapi_client.COMPONENTSNODETYPE_TYPE_WIDGET:
+		if node.Widget == nil || node.Widget.GetName() == "" {
+			return nil, fmt.Errorf("widget name is required")
+		}
+
+		widget, err := l.registry.GetWidget(node.Widget.GetName())
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, configuration.ValidateConfiguration(widget.Configuration(), config)
+
+	default:
+		return defaultChannels, fmt.Errorf("invalid node type: %s", node.GetType())
+	}
+}
+
+func nodeType(node openapi_client.ComponentsNode) openapi_client.ComponentsNodeType {
+	if node.Type == nil {
+		return openapi_client.COMPONENTSNODETYPE_TYPE_COMPONENT
+	}
+
+	return *node.Type
+}
+
+func (l *canvasLinter) lintEdges() {
+	for i, edge := range l.spec.Edges {
+		sourceID := edge.GetSourceId()
+		targetID := edge.GetTargetId()
+		if sourceID == "" || targetID == "" {
+			l.errorf("", "edge %d: sourceId and targetId are required", i)
+			continue
+		}
+
+		source, sourceExists := l.nodes[sourceID]
+		target, targetExists := l.nodes[targetID]
+		if !sourceExists {
+			l.errorf("", "edge %d: source node %s not found", i, sourceID)
+		}
+
+		if !targetExists {
+			l.errorf("", "edge %d: target node %s not found", i, targetID)
+		}
+
+		if !sourceExists || !targetExists {
+			continue
+		}
+
+		if nodeType(source) == openapi_client.COMPONENTSNODETYPE_TYPE_WIDGET {
+			l.errorf(source.GetName(), "edge %d: widget nodes cannot be used as source nodes", i)
+			continue
+		}
+
+		if nodeType(target) == openapi_client.COMPONENTSNODETYPE_TYPE_WIDGET {
+			l.errorf(target.GetName(), "edge %d: widget nodes cannot be used as target nodes", i)
+			continue
+		}
+
+		channel := edge.GetChannel()
+		if channel == "" {
+			channel = core.DefaultOutputChannel.Name
+		}
+
+		channels := l.channels[sourceID]
+		if channels != nil && !slices.Contains(channels, channel) {
+			l.errorf(source.GetName(), "edge %d: unknown output channel %q, expected one of: %s", i, channel, strings.Join(channels, ", "))
+		}
+
+		l.outgoing[sourceID] = append(l.outgoing[sourceID], targetID)
+		l.incoming[targetID] = append(l.incoming[targetID], sourceID)
+	}
+}
+
+// lintCycles uses Kahn's algorithm, like the server does, removing
+// nodes without incoming edges and then the ones without outgoing edges.
+// The nodes left are the ones in a cycle.
+func (l *canvasLinter) lintCycles() {
+	remaining := map[string]bool{}
+	for id := range l.nodes {
+		remaining[id] = true
+	}
+
+	trimCycleCandidates(remaining, l.incoming, l.outgoing)
+	trimCycleCandidates(remaining, l.outgoing, l.incoming)
+	if len(remaining) == 0 {
+		return
+	}
+
+	names := []string{}
+	for _, node := range l.spec.Nodes {
+		if remaining[node.GetId()] {
+			names = append(names, node.GetName())
+			delete(remaining, node.GetId())
+		}
+	}
+
+	l.errorf("", "graph contains a cycle through nodes: %s", strings.Join(names, ", "))
+}
+
+// trimCycleCandidates removes the nodes without edges coming from other
+// remaining nodes, and the nodes they lead to, once those are left without them.
+func trimCycleCandidates(remaining map[string]bool, in, out map[string][]string) {
+	degree := map[string]int{}
+	queue := []string{}
+	for id := range remaining {
+		for _, source := range in[id] {
+			if remaining[source] {
+				degree[id]++
+			}
+		}
+
+		if degree[id] == 0 {
+			queue = append(queue, id)
+		}
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		delete(remaining, current)
+		for _, target := range out[current] {
+			if !remaining[target] {
+				continue
+			}
+
+			degree[target]--
+			if degree[target] == 0 {
+				queue = append(queue, target)
+			}
+		}
+	}
+}
+
+// lintReachability warns about nodes that no trigger leads to,
+// since events can never reach them.
+func (l *canvasLinter) lintReachability() {
+	reached := map[string]bool{}
+	queue := []string{}
+	for id, node := range l.nodes {
+		if nodeType(node) == openapi_client.COMPONENTSNODETYPE_TYPE_TRIGGER {
+			reached[id] = true
+			queue = append(queue, id)
+		}
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, target := range l.outgoing[current] {
+			if !reached[target] {
+				reached[target] = true
+				queue = append(queue, target)
+			}
+		}
+	}
+
+	for _, node := range l.spec.Nodes {
+		switch nodeType(node) {
+		case openapi_client.COMPONENTSNODETYPE_TYPE_TRIGGER, openapi_client.COMPONENTSNODETYPE_TYPE_WIDGET:
+			continue
+		}
+
+		if _, ok := l.nodes[node.GetId()]; ok && !reached[node.GetId()] {
+			l.warnf(node.GetName(), "node is not reachable from any trigger")
+		}
+	}
+}
+
+var lintExpressionRegex = regexp.MustCompile(`\{\{(.*?)\}\}`)
+
+func (l *canvasLinter) lintExpressions() {
+	for _, node := range l.spec.Nodes {
+		if _, ok := l.nodes[node.GetId()]; !ok {
+			continue
+		}
+
+		upstream := l.upstreamNames(node.GetId())
+		for _, expression := range l.nodeExpressions(node) {
+			refs, err := parseLintExpression(expression.Value)
+			if err != nil {
+				//
+				// Parser errors also include the expression with a marker
+				// under the position of the error, which only the first line needs.
+				//
+				message, _, _ := strings.Cut(err.Error(), "\n")
+				l.errorf(node.GetName(), "%s: invalid expression %q: %s", expression.Path, expression.Value, message)
+				continue
+			}
+
+			for _, ref := range refs {
+				if _, ok := l.names[ref]; !ok {
+					l.errorf(node.GetName(), "%s: expression references unknown node %q", expression.Path, ref)
+					continue
+				}
+
+				if !upstream[ref] {
+					l.warnf(node.GetName(), "%s: expression references node %q, which is not upstream", expression.Path, ref)
+				}
+			}
+		}
+	}
+}
+
+// upstreamNames returns the names of all the nodes with a path to the given one.
+func (l *canvasLinter) upstreamNames(nodeID string) map[string]bool {
+	names := map[string]bool{}
+	visited := map[string]bool{nodeID: true}
+	queue := []string{nodeID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, source := range l.incoming[current] {
+			if visited[source] {
+				continue
+			}
+
+			node := l.nodes[source]
+			visited[source] = true
+			names[node.GetName()] = true
+			queue = append(queue, source)
+		}
+	}
+
+	return names
+}
+
+type lintExpression struct {
+	Path  string
+	Value string
+}
+
+// nodeExpressions collects the expressions in the node configuration.
+// Fields of the expression type hold a single expression without braces,
+// and fields that do not allow expressions are skipped.
+func (l *canvasLinter) nodeExpressions(node openapi_client.ComponentsNode) []lintExpression {
+	fields := map[string]configuration.Field{}
+	for _, field := range l.configurationFields(node) {
+		fields[field.Name] = field
+	}
+
+	expressions := []lintExpression{}
+	for _, key := range slices.Sorted(maps.Keys(node.Configuration)) {
+		value := node.Configuration[key]
+		field, ok := fields[key]
+		if ok && field.DisallowExpression {
+			continue
+		}
+
+		if text, isString := value.(string); ok && isString && field.Type == configuration.FieldTypeExpression {
+			if !lintExpressionRegex.MatchString(text) {
+				expressions = append(expressions, lintExpression{Path: key, Value: text})
+				continue
+			}
+		}
+
+		expressions = appendExpressions(expressions, key, value)
+	}
+
+	return expressions
+}
+
+func (l *canvasLinter) configurationFields(node openapi_client.ComponentsNode) []configuration.Field {
+	switch nodeType(node) {
+	case openapi_client.COMPONENTSNODETYPE_TYPE_COMPONENT:
+		if component, err := l.registry.GetComponent(node.Component.GetName()); err == nil {
+			return component.Configuration()
+		}
+	case openapi_client.COMPONENTSNODETYPE_TYPE_TRIGGER:
+		if trigger, err := l.registry.GetTrigger(node.Trigger.GetName()); err == nil {
+			return trigger.Configuration()
+		}
+	}
+
+	return nil
+}
+
+func appendExpressions(expressions []lintExpression, path string, value any) []lintExpression {
+	switch v := value.(type) {
+	case string:
+		for _, match := range lintExpressionRegex.FindAllStringSubmatch(v, -1) {
+			expressions = append(expressions, lintExpression{Path: path, Value: match[1]})
+		}
+
+	case map[string]any:
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			expressions = appendExpressions(expressions, path+"."+key, v[key])
+		}
+
+	case []any:
+		for i, item := range v {
+			expressions = appendExpressions(expressions, fmt.Sprintf("%s[%d]", path, i), item)
+		}
+	}
+
+	return expressions
+}
+
+// parseLintExpression parses the expression and returns the names
+// of the nodes it references through $["Node name"] or $.name.
+func parseLintExpression(expression string) ([]string, error) {
+	tree, err := parser.Parse(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	collector := &lintReferenceCollector{seen: map[string]bool{}}
+	ast.Walk(&tree.Node, collector)
+	return collector.names, nil
+}
+
+type lintReferenceCollector struct {
+	names []string
+	seen  map[string]bool
+}
+
+func (c *lintReferenceCollector) Visit(node *ast.Node) {
+	member, ok := (*node).(*ast.MemberNode)
+	if !ok {
+		return
+	}
+
+	root, ok := member.Node.(*ast.IdentifierNode)
+	if !ok || root.Value != "$" {
+		return
+	}
+
+	name := ""
+	switch property := member.Property.(type) {
+	case *ast.StringNode:
+		name = property.Value
+	case *ast.IdentifierNode:
+		name = property.Value
+	}
+
+	if name == "" || c.seen[name] {
+		return
+	}
+
+	c.seen[name] = true
+	c.names = append(c.names, name)
+}
+
+func init() {
+	RootCmd.AddCommand(lintCmd)
+}
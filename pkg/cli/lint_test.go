@@ -0,0 +1,155 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+func lintSpec(t *testing.T, reg *registry.Registry, raw string) []string {
+	issues := lintCanvas(reg, parseSpec(t, raw))
+	messages := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		messages = append(messages, issue.String())
+	}
+
+	return messages
+}
+
+func Test__LintCanvas(t *testing.T) {
+	reg, err := localRegistry()
+	require.NoError(t, err)
+
+	t.Run("valid canvas -> no issues", func(t *testing.T) {
+		issues := lintSpec(t, reg, `
+nodes:
+  - id: start
+    name: start
+    type: TYPE_TRIGGER
+    trigger: {name: start}
+  - id: check
+    name: check
+    component: {name: if}
+    configuration: {expression: '$["start"].ok == true'}
+  - id: call
+    name: call
+    component: {name: http}
+    configuration: {method: GET, url: 'https://example.com/{{ $["start"].id }}'}
+edges:
+  - {sourceId: start, targetId: check}
+  - {sourceId: check, targetId: call, channel: "true"}
+`)
+
+		assert.Empty(t, issues)
+	})
+
+	t.Run("unknown components and invalid configuration -> errors", func(t *testing.T) {
+		issues := lintSpec(t, reg, `
+nodes:
+  - id: start
+    name: start
+    type: TYPE_TRIGGER
+    trigger: {name: nope}
+  - id: call
+    name: call
+    component: {name: http}
+    configuration: {method: GET}
+edges:
+  - {sourceId: start, targetId: call}
+`)
+
+		require.Len(t, issues, 2)
+		assert.Contains(t, issues[0], `error: node "start": trigger nope not registered`)
+		assert.Contains(t, issues[1], `error: node "call": `)
+		assert.Contains(t, issues[1], `url`)
+	})
+
+	t.Run("edges to missing nodes and unknown channels -> errors", func(t *testing.T) {
+		issues := lintSpec(t, reg, `
+nodes:
+  - id: start
+    name: start
+    type: TYPE_TRIGGER
+    trigger: {name: start}
+  - id: check
+    name: check
+    component: {name: if}
+    configuration: {expression: "true"}
+  - id: done
+    name: done
+    component: {name: noop}
+edges:
+  - {sourceId: start, targetId: check}
+  - {sourceId: check, targetId: done, channel: maybe}
+  - {sourceId: check, targetId: missing, channel: "true"}
+`)
+
+		assert.Equal(t, []string{
+			`error: node "check": edge 1: unknown output channel "maybe", expected one of: true, false`,
+			`error: edge 2: target node missing not found`,
+		}, issues)
+	})
+
+	t.Run("cycles -> error with the nodes in it", func(t *testing.T) {
+		issues := lintSpec(t, reg, `
+nodes:
+  - id: start
+    name: start
+    type: TYPE_TRIGGER
+    trigger: {name: start}
+  - id: a
+    name: a
+    component: {name: noop}
+  - id: b
+    name: b
+    component: {name: noop}
+  - id: c
+    name: c
+    component: {name: noop}
+edges:
+  - {sourceId: start, targetId: a}
+  - {sourceId: a, targetId: b}
+  - {sourceId: b, targetId: a}
+  - {sourceId: b, targetId: c}
+`)
+
+		assert.Equal(t, []string{`error: graph contains a cycle through nodes: a, b`}, issues)
+	})
+
+	t.Run("expressions are parsed and node references checked", func(t *testing.T) {
+		issues := lintSpec(t, reg, `
+nodes:
+  - id: start
+    name: start
+    type: TYPE_TRIGGER
+    trigger: {name: start}
+  - id: check
+    name: check
+    component: {name: if}
+    configuration: {expression: '$["missing"].ok'}
+  - id: call
+    name: call
+    component: {name: http}
+    configuration:
+      method: GET
+      url: 'https://example.com/{{ $["start"].id + }}'
+      headers:
+        - {name: X-Other, value: '{{ $["other"].id }}'}
+  - id: other
+    name: other
+    component: {name: noop}
+edges:
+  - {sourceId: start, targetId: check}
+  - {sourceId: check, targetId: call, channel: "true"}
+  - {sourceId: start, targetId: other}
+`)
+
+		assert.Equal(t, []string{
+			`error: node "check": expression: expression references unknown node "missing"`,
+			`warning: node "call": headers[0].value: expression references node "other", which is not upstream`,
+			`error: node "call": url: invalid expression " $[\"start\"].id + ": unexpected token EOF (1:17)`,
+		}, issues)
+	})
+}
@@ -43,7 +43,32 @@ var listCanvasCmd = &cobra.Command{
 	},
 }
 
+var listBlueprintCmd = &cobra.Command{
+	Use:     "blueprint",
+	Short:   "List blueprints",
+	Aliases: []string{"blueprints"},
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		client := DefaultClient()
+		ctx := context.Background()
+		response, _, err := client.BlueprintAPI.BlueprintsListBlueprints(ctx).Execute()
+		Check(err)
+
+		writer := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(writer, "ID\tNAME\tNODES\tCREATED_AT")
+		for _, blueprint := range response.GetBlueprints() {
+			createdAt := ""
+			if blueprint.HasCreatedAt() {
+				createdAt = blueprint.GetCreatedAt().Format(time.RFC3339)
+			}
+			fmt.Fprintf(writer, "%s\t%s\t%d\t%s\n", blueprint.GetId(), blueprint.GetName(), len(blueprint.GetNodes()), createdAt)
+		}
+		_ = writer.Flush()
+	},
+}
+
 func init() {
 	RootCmd.AddCommand(listCmd)
 	listCmd.AddCommand(listCanvasCmd)
+	listCmd.AddCommand(listBlueprintCmd)
 }
@@ -0,0 +1,355 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/crypto"
+)
+
+// How long the CLI waits for the login to be completed in the browser.
+const LoginTimeout = 5 * time.Minute
+
+var loginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Log in to SuperPlane through the browser",
+	Long: `Log in to SuperPlane through the browser.
+
+The browser is opened to log in with any of the methods enabled on the
+server, and to choose the organization to use. The CLI then receives a
+token for it, which expires after 30 days, and saves it in the context
+given with --context, or in the current one. Without contexts, or when
+--url is not the server of the current context, a context named after
+the server is used instead. The context becomes the current one.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiURL, _ := cmd.Flags().GetString("url")
+		if apiURL == "" {
+			apiURL = GetAPIURL()
+		}
+
+		name := loginContextName(apiURL)
+
+		noBrowser, _ := cmd.Flags().GetBool("no-browser")
+		login := &browserLogin{
+			apiURL:      apiURL,
+			name:        deviceName(),
+			out:         os.Stderr,
+			openBrowser: openBrowser,
+		}
+
+		if noBrowser {
+			login.openBrowser = nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), LoginTimeout)
+		defer cancel()
+
+		token, err := login.Run(ctx)
+		Check(err)
+
+		c := Context{
+			Name:         name,
+			APIURL:       apiURL,
+			Organization: token.OrganizationID,
+		}
+
+		c.SetToken(token.Token)
+		Check(saveContext(c))
+
+		if c.Credentials == CredentialsFile {
+			fmt.Fprintln(os.Stderr, "No keyring available, the token is stored in the config file.")
+		}
+
+		fmt.Printf("Logged in to organization %s, until %s.\n", c.Organization, token.ExpiresAt.Local().Format(time.DateOnly))
+		useContext(c.Name)
+	},
+}
+
+var logoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Revoke the token of the current context",
+	Long: `Revoke the token of the current context, or of the one given with
+--context, and remove it from the local credentials.
+
+Only tokens created with 'superplane login' can be revoked this way.
+API tokens created in the UI are only removed locally.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		c := selectedContext()
+		if c == nil {
+			Fail("no context in use, nothing to log out from")
+		}
+
+		token, err := c.Token()
+		Check(err)
+
+		if token != "" {
+			revoked, err := revokeToken(context.Background(), c.APIURL, token)
+			Check(err)
+
+			if !revoked {
+				fmt.Fprintln(os.Stderr, "The token was not created with 'superplane login', so it was only removed locally.")
+			}
+		}
+
+		Check(c.deleteToken())
+		c.Credentials = ""
+		c.APIToken = ""
+		Check(saveContext(*c))
+
+		fmt.Printf("Logged out from context %q.\n", c.Name)
+	},
+}
+
+type loginToken struct {
+	Token          string    `json:"token"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+	OrganizationID string    `json:"organizationId"`
+}
+
+/*
+ * browserLogin gets a token for the CLI through the browser.
+ * It listens on a local port for the redirect with the login code,
+ * and exchanges the code for the token with the verifier
+ * of the challenge sent when the login started.
+ */
+type browserLogin struct {
+	apiURL string
+	name   string
+	out    io.Writer
+
+	// If nil, the user is asked to open the URL.
+	openBrowser func(string) error
+}
+
+func (l *browserLogin) Run(ctx context.Context) (*loginToken, error) {
+	state, err := crypto.Base64String(32)
+	if err != nil {
+		return nil, err
+	}
+
+	verifier, err := randomURLSafeString(32)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for the login redirect: %w", err)
+	}
+
+	codes := make(chan string, 1)
+	server := &http.Server{
+		Handler:           l.callbackHandler(state, codes),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	defer server.Close()
+
+	query := url.Values{}
+	query.Set("port", fmt.Sprintf("%d", listener.Addr().(*net.TCPAddr).Port))
+	query.Set("state", state)
+	query.Set("code_challenge", codeChallenge(verifier))
+	query.Set("name", l.name)
+	loginURL := strings.TrimSuffix(l.apiURL, "/") + "/cli/login?" + query.Encode()
+
+	if l.openBrowser == nil || l.openBrowser(loginURL) != nil {
+		fmt.Fprintf(l.out, "Open this URL in your browser to log in:\n\n  %s\n\n", loginURL)
+	} else {
+		fmt.Fprintf(l.out, "Opened the browser to log in. If it did not open, use this URL:\n\n  %s\n\n", loginURL)
+	}
+
+	select {
+	case code := <-codes:
+		return l.exchange(ctx, code, verifier)
+	case <-ctx.Done():
+		return nil, fmt.Errorf("login was not completed in the browser: %w", ctx.Err())
+	}
+}
+
+func (l *browserLogin) callbackHandler(state string, codes chan<- string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+		code := r.URL.Query().Get("code")
+		if r.URL.Query().Get("state") != state || code == "" {
+			http.Error(w, "Invalid login request", http.StatusBadRequest)
+			return
+		}
+
+		select {
+		case codes <- code:
+			fmt.Fprintln(w, "Logged in. You can close this window and return to the terminal.")
+		default:
+			http.Error(w, "Login already completed", http.StatusConflict)
+		}
+	})
+
+	return mux
+}
+
+func (l *browserLogin) exchange(ctx context.Context, code, verifier string) (*loginToken, error) {
+	body, err := json.Marshal(map[string]string{"code": code, "codeVerifier": verifier})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := strings.TrimSuffix(l.apiURL, "/") + "/cli/token"
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 4096))
+		return nil, fmt.Errorf("failed to get token: %s: %s", response.Status, strings.TrimSpace(string(message)))
+	}
+
+	var token loginToken
+	if err := json.NewDecoder(response.Body).Decode(&token); err != nil {
+		return nil, fmt.Errorf("error decoding token: %w", err)
+	}
+
+	return &token, nil
+}
+
+// revokeToken revokes a token created with the browser login,
+// returning false if the token is not one of those.
+func revokeToken(ctx context.Context, apiURL, token string) (bool, error) {
+	endpoint := strings.TrimSuffix(apiURL, "/") + "/cli/logout"
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, nil)
+	if err != nil {
+		return false, err
+	}
+
+	request.Header.Set("Authorization", "Bearer "+token)
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return false, err
+	}
+
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusNoContent, http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 4096))
+		return false, fmt.Errorf("failed to revoke token: %s: %s", response.Status, strings.TrimSpace(string(message)))
+	}
+}
+
+func randomURLSafeString(size int) (string, error) {
+	value, err := crypto.Base64String(size)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(value, "="), nil
+}
+
+// codeChallenge returns the S256 challenge for the verifier.
+func codeChallenge(verifier string) string {
+	hash := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+/*
+ * loginContextName returns the context the token of a login is saved in:
+ * the one given with --context, or the current one if it is for the same
+ * server. Logging in to another server uses a context named after it,
+ * so the URL and token of the current context are not replaced.
+ */
+func loginContextName(apiURL string) string {
+	if contextName != "" {
+		return contextName
+	}
+
+	name := selectedContextName()
+	if name == "" {
+		return contextNameForURL(apiURL)
+	}
+
+	current, err := findContext(name)
+	if err != nil || strings.TrimSuffix(current.APIURL, "/") == strings.TrimSuffix(apiURL, "/") {
+		return name
+	}
+
+	return contextNameForURL(apiURL)
+}
+
+func contextNameForURL(apiURL string) string {
+	u, err := url.Parse(apiURL)
+	if err != nil || u.Host == "" {
+		return "default"
+	}
+
+	return u.Host
+}
+
+func deviceName() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		return "CLI"
+	}
+
+	return hostname
+}
+
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	go func() {
+		_ = cmd.Wait()
+	}()
+
+	return nil
+}
+
+func init() {
+	RootCmd.AddCommand(loginCmd)
+	loginCmd.Flags().String("url", "", "URL of the SuperPlane server, the one of the current context if not given")
+	loginCmd.Flags().Bool("no-browser", false, "print the login URL instead of opening the browser")
+
+	RootCmd.AddCommand(logoutCmd)
+}
@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+func Test__BrowserLogin(t *testing.T) {
+	var challenge string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/cli/token", r.URL.Path)
+
+		var request map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		if request["code"] != "the-code" || codeChallenge(request["codeVerifier"]) != challenge {
+			http.Error(w, "Invalid or expired code", http.StatusBadRequest)
+			return
+		}
+
+		fmt.Fprint(w, `{"token": "cli-token", "expiresAt": "2026-11-17T10:00:00Z", "organizationId": "org-1"}`)
+	}))
+
+	defer server.Close()
+
+	//
+	// The browser logs in and is redirected
+	// to the CLI with the code and the state.
+	//
+	browser := func(state string) func(string) error {
+		return func(loginURL string) error {
+			u, err := url.Parse(loginURL)
+			require.NoError(t, err)
+			assert.Equal(t, "/cli/login", u.Path)
+			assert.Equal(t, "my-laptop", u.Query().Get("name"))
+			challenge = u.Query().Get("code_challenge")
+
+			if state == "" {
+				state = u.Query().Get("state")
+			}
+
+			go func() {
+				callback := url.Values{"code": {"the-code"}, "state": {state}}
+				response, err := http.Get("http://127.0.0.1:" + u.Query().Get("port") + "/callback?" + callback.Encode())
+				if err == nil {
+					_, _ = io.Copy(io.Discard, response.Body)
+					response.Body.Close()
+				}
+			}()
+
+			return nil
+		}
+	}
+
+	t.Run("code from the browser is exchanged for the token", func(t *testing.T) {
+		login := &browserLogin{apiURL: server.URL, name: "my-laptop", out: io.Discard, openBrowser: browser("")}
+		token, err := login.Run(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, "cli-token", token.Token)
+		assert.Equal(t, "org-1", token.OrganizationID)
+		assert.Equal(t, time.Date(2026, 11, 17, 10, 0, 0, 0, time.UTC), token.ExpiresAt)
+	})
+
+	t.Run("redirect with another state -> ignored", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+
+		login := &browserLogin{apiURL: server.URL, name: "my-laptop", out: io.Discard, openBrowser: browser("other")}
+		_, err := login.Run(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func Test__RevokeToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cli/logout", r.URL.Path)
+		switch r.Header.Get("Authorization") {
+		case "Bearer cli-token":
+			w.WriteHeader(http.StatusNoContent)
+		case "Bearer api-token":
+			http.Error(w, "Token not found", http.StatusNotFound)
+		default:
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		}
+	}))
+
+	defer server.Close()
+
+	revoked, err := revokeToken(context.Background(), server.URL, "cli-token")
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	revoked, err = revokeToken(context.Background(), server.URL, "api-token")
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	_, err = revokeToken(context.Background(), server.URL, "")
+	require.EqualError(t, err, "failed to revoke token: 401 Unauthorized: Unauthorized")
+}
+
+func Test__LoginContextName(t *testing.T) {
+	keyring.MockInit()
+	setupTestConfig(t)
+	require.NoError(t, saveContext(Context{Name: "staging", APIURL: "http://staging.example.com"}))
+
+	t.Run("no context in use -> named after the server", func(t *testing.T) {
+		assert.Equal(t, "staging.example.com", loginContextName("http://staging.example.com"))
+	})
+
+	t.Run("same server as the current context -> current context", func(t *testing.T) {
+		viper.Set(ConfigKeyCurrentContext, "staging")
+		assert.Equal(t, "staging", loginContextName("http://staging.example.com/"))
+	})
+
+	t.Run("other server than the current context -> named after the server", func(t *testing.T) {
+		viper.Set(ConfigKeyCurrentContext, "staging")
+		assert.Equal(t, "prod.example.com", loginContextName("https://prod.example.com"))
+	})
+
+	t.Run("context given -> given context", func(t *testing.T) {
+		contextName = "prod"
+		defer func() { contextName = "" }()
+		assert.Equal(t, "prod", loginContextName("https://prod.example.com"))
+	})
+}
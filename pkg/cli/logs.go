@@ -0,0 +1,329 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"os/signal"
+	"slices"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+	"github.com/superplanehq/superplane/pkg/sdk"
+)
+
+const (
+	WatchTypeEventCreated          = "TYPE_EVENT_CREATED"
+	WatchTypeExecutionStateChanged = "TYPE_EXECUTION_STATE_CHANGED"
+	WatchTypeQueueItemCreated      = "TYPE_QUEUE_ITEM_CREATED"
+)
+
+var logsCmd = &cobra.Command{
+	Use:   "logs <canvas>",
+	Short: "Show the activity of a canvas run",
+	Long: `Show the events, queued items and execution state changes of a canvas.
+
+With --event, only the run started by that root event is shown. Without
+--follow, the executions of the run are shown and the command exits.
+With --follow, the activity is streamed as it happens. If --event is also
+given, the command exits once every execution of the run is finished,
+nothing of the run is left in the queues of nodes, and nothing else
+happened for the --settle duration.
+
+When following a run, or showing a finished one, the command exits with
+a non-zero code if any execution of the run failed or was cancelled.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		config := NewClientConfig()
+		client := NewAPIClient(config)
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		canvasID, err := findCanvasID(ctx, client, args[0])
+		Check(err)
+
+		eventID, _ := cmd.Flags().GetString("event")
+		follow, _ := cmd.Flags().GetBool("follow")
+		settle, _ := cmd.Flags().GetDuration("settle")
+		if eventID == "" && !follow {
+			Fail("--event is required without --follow")
+		}
+
+		nodeIDs := []string{}
+		nodeNames, _ := cmd.Flags().GetStringSlice("node")
+		for _, name := range nodeNames {
+			nodeID, err := findNodeID(ctx, client, canvasID, name)
+			Check(err)
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+
+		printer, err := newRunPrinter(ctx, client, canvasID, os.Stdout)
+		Check(err)
+
+		run := &canvasRun{
+			config:   config,
+			client:   sdk.NewClient(config.BaseURL, config.APIToken, sdk.WithHTTPClient(config.HTTPClient)),
+			canvasID: canvasID,
+			eventID:  eventID,
+			nodeIDs:  nodeIDs,
+			printer:  printer,
+			settle:   settle,
+		}
+
+		var status sdk.RunStatus
+		if follow {
+			status, err = run.Follow(ctx)
+		} else {
+			status, err = run.Show(ctx)
+		}
+
+		Check(err)
+
+		if status == sdk.RunStatusFailed {
+			Exit(1)
+		}
+	},
+}
+
+// Delay before reconnecting to the WatchCanvas stream after it ends.
+const watchReconnectDelay = time.Second
+
+// watchMessage is a message from the WatchCanvas stream.
+type watchMessage struct {
+	Type      string                                      `json:"type"`
+	Cursor    string                                      `json:"cursor"`
+	Timestamp *time.Time                                  `json:"timestamp"`
+	Event     *openapi_client.CanvasesCanvasEvent         `json:"event"`
+	Execution *openapi_client.CanvasesCanvasNodeExecution `json:"execution"`
+	QueueItem *openapi_client.CanvasesCanvasNodeQueueItem `json:"queueItem"`
+}
+
+type canvasRun struct {
+	config   *ClientConfig
+	client   *sdk.Client
+	canvasID string
+	eventID  string
+	nodeIDs  []string
+	printer  *runPrinter
+	settle   time.Duration
+
+	// Last state printed for each execution.
+	states map[string]string
+}
+
+// Show prints the executions of the run so far.
+func (r *canvasRun) Show(ctx context.Context) (sdk.RunStatus, error) {
+	r.states = map[string]string{}
+
+	activity, err := r.activity(ctx)
+	if err != nil {
+		return sdk.RunStatusUnknown, err
+	}
+
+	for _, execution := range activity.Executions {
+		r.printExecution(execution)
+	}
+
+	return activity.Status(), nil
+}
+
+/*
+ * Follow streams the activity on the canvas. If the run of an event
+ * is followed, it prints what happened before the stream started,
+ * and returns once the run looks finished, as told by sdk.RunSettler
+ * from the activity of the run polled from the API.
+ */
+func (r *canvasRun) Follow(ctx context.Context) (sdk.RunStatus, error) {
+	r.states = map[string]string{}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	messages := make(chan watchMessage)
+	streamErr := make(chan error, 1)
+	go func() {
+		streamErr <- r.watch(ctx, messages)
+	}()
+
+	if r.eventID == "" {
+		for {
+			select {
+			case message := <-messages:
+				r.printMessage(message)
+			case err := <-streamErr:
+				return sdk.RunStatusUnknown, err
+			}
+		}
+	}
+
+	status, err := r.Show(ctx)
+	if err != nil {
+		return sdk.RunStatusUnknown, err
+	}
+
+	ticker := time.NewTicker(sdk.DefaultPollInterval)
+	defer ticker.Stop()
+
+	settler := sdk.NewRunSettler(r.settle)
+	for {
+		select {
+		case message := <-messages:
+			r.printMessage(message)
+
+		case err := <-streamErr:
+			if err == nil && ctx.Err() != nil {
+				return status, nil
+			}
+
+			return sdk.RunStatusUnknown, err
+
+		case <-ticker.C:
+			//
+			// Checking with the API avoids relying
+			// only on the changes received through the stream.
+			//
+			activity, err := r.activity(ctx)
+			if err != nil {
+				return sdk.RunStatusUnknown, err
+			}
+
+			status = activity.Status()
+			if settler.Settled(activity) {
+				r.printer.Summary(status)
+				return status, nil
+			}
+		}
+	}
+}
+
+/*
+ * watch streams the activity on the canvas into messages. The stream
+ * may be closed by the server or a proxy in between, so it is opened
+ * again from the cursor of the last message received, until the
+ * context is cancelled or the stream returns an error.
+ */
+func (r *canvasRun) watch(ctx context.Context, messages chan<- watchMessage) error {
+	cursor := ""
+	for {
+		query := url.Values{}
+		for _, nodeID := range r.nodeIDs {
+			query.Add("node_ids", nodeID)
+		}
+
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		}
+
+		path := fmt.Sprintf("/api/v1/canvases/%s/watch", url.PathEscape(r.canvasID))
+		err := streamJSON(ctx, r.config, path, query, func(raw json.RawMessage) error {
+			var message watchMessage
+			if err := json.Unmarshal(raw, &message); err != nil {
+				return fmt.Errorf("error decoding canvas activity: %w", err)
+			}
+
+			if message.Cursor != "" {
+				cursor = message.Cursor
+			}
+
+			select {
+			case messages <- message:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+
+		if err != nil || ctx.Err() != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(watchReconnectDelay):
+		}
+	}
+}
+
+// activity returns the executions and queue items of the run, for the nodes shown.
+func (r *canvasRun) activity(ctx context.Context) (*sdk.RunActivity, error) {
+	activity, err := r.client.RunActivity(ctx, r.canvasID, r.eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	shown := &sdk.RunActivity{}
+	for _, execution := range activity.Executions {
+		if r.includesNode(execution.GetNodeId()) {
+			shown.Executions = append(shown.Executions, execution)
+		}
+	}
+
+	for _, item := range activity.QueueItems {
+		if r.includesNode(item.GetNodeId()) {
+			shown.QueueItems = append(shown.QueueItems, item)
+		}
+	}
+
+	return shown, nil
+}
+
+func (r *canvasRun) includesNode(nodeID string) bool {
+	return len(r.nodeIDs) == 0 || slices.Contains(r.nodeIDs, nodeID)
+}
+
+// printMessage prints the message if it is part of the run,
+// returning true if it was printed.
+func (r *canvasRun) printMessage(message watchMessage) bool {
+	switch message.Type {
+	case WatchTypeEventCreated:
+		if message.Event == nil || (r.eventID != "" && message.Event.GetId() != r.eventID) {
+			return false
+		}
+
+		r.printer.Event(*message.Event)
+		return true
+
+	case WatchTypeQueueItemCreated:
+		if message.QueueItem == nil || (r.eventID != "" && message.QueueItem.RootEvent.GetId() != r.eventID) {
+			return false
+		}
+
+		r.printer.QueueItem(*message.QueueItem)
+		return true
+
+	case WatchTypeExecutionStateChanged:
+		if message.Execution == nil || (r.eventID != "" && message.Execution.RootEvent.GetId() != r.eventID) {
+			return false
+		}
+
+		return r.printExecution(*message.Execution)
+
+	default:
+		return false
+	}
+}
+
+// printExecution prints the execution if its state
+// is not the same one printed for it before.
+func (r *canvasRun) printExecution(execution openapi_client.CanvasesCanvasNodeExecution) bool {
+	state := executionState(execution)
+	if r.states[execution.GetId()] == state {
+		return false
+	}
+
+	r.states[execution.GetId()] = state
+	r.printer.Execution(execution)
+	return true
+}
+
+func init() {
+	RootCmd.AddCommand(logsCmd)
+	logsCmd.Flags().String("event", "", "only show the run started by this root event")
+	logsCmd.Flags().StringSlice("node", nil, "only show these nodes, by name or ID")
+	logsCmd.Flags().BoolP("follow", "f", false, "stream the activity as it happens")
+	logsCmd.Flags().Duration("settle", 10*time.Second, "time without activity before a followed run is considered finished")
+}
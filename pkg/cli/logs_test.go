@@ -0,0 +1,150 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func Test__StreamJSON(t *testing.T) {
+	t.Run("messages are handled until the stream ends", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v1/canvases/c1/watch", r.URL.Path)
+			assert.Equal(t, []string{"n1", "n2"}, r.URL.Query()["node_ids"])
+			assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+			fmt.Fprintln(w, `{"result": {"type": "TYPE_EVENT_CREATED", "cursor": "a"}}`)
+			fmt.Fprintln(w, `{"result": {"type": "TYPE_QUEUE_ITEM_CREATED", "cursor": "b"}}`)
+		}))
+
+		defer server.Close()
+
+		types := []string{}
+		config := &ClientConfig{BaseURL: server.URL, APIToken: "token"}
+		err := streamJSON(context.Background(), config, "/api/v1/canvases/c1/watch", map[string][]string{"node_ids": {"n1", "n2"}}, func(raw json.RawMessage) error {
+			var message watchMessage
+			require.NoError(t, json.Unmarshal(raw, &message))
+			types = append(types, message.Type)
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{WatchTypeEventCreated, WatchTypeQueueItemCreated}, types)
+	})
+
+	t.Run("error in the stream -> error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(w, `{"error": {"code": 5, "message": "canvas not found"}}`)
+		}))
+
+		defer server.Close()
+
+		config := &ClientConfig{BaseURL: server.URL}
+		err := streamJSON(context.Background(), config, "/watch", nil, func(raw json.RawMessage) error {
+			t.Fatal("no message expected")
+			return nil
+		})
+
+		require.EqualError(t, err, "canvas not found")
+	})
+
+	t.Run("unsuccessful response -> error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+		}))
+
+		defer server.Close()
+
+		config := &ClientConfig{BaseURL: server.URL}
+		err := streamJSON(context.Background(), config, "/watch", nil, func(raw json.RawMessage) error {
+			return nil
+		})
+
+		require.EqualError(t, err, "401 Unauthorized: unauthorized")
+	})
+}
+
+func testExecution(id, rootEventID string, state openapi_client.CanvasNodeExecutionState, result openapi_client.CanvasNodeExecutionResult) openapi_client.CanvasesCanvasNodeExecution {
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	updatedAt := createdAt.Add(2 * time.Second)
+
+	execution := openapi_client.CanvasesCanvasNodeExecution{CreatedAt: &createdAt, UpdatedAt: &updatedAt}
+	execution.SetId(id)
+	execution.SetNodeId("build")
+	execution.SetState(state)
+	execution.SetResult(result)
+	execution.SetRootEvent(openapi_client.CanvasesCanvasEvent{Id: &rootEventID})
+	return execution
+}
+
+func Test__CanvasRunWatch(t *testing.T) {
+	connections := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		connections++
+		switch connections {
+		case 1:
+			assert.Empty(t, r.URL.Query().Get("cursor"))
+			fmt.Fprintln(w, `{"result": {"type": "TYPE_EVENT_CREATED", "cursor": "a"}}`)
+		case 2:
+			assert.Equal(t, "a", r.URL.Query().Get("cursor"))
+			fmt.Fprintln(w, `{"result": {"type": "TYPE_QUEUE_ITEM_CREATED", "cursor": "b"}}`)
+		default:
+			<-r.Context().Done()
+		}
+	}))
+
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	run := &canvasRun{config: &ClientConfig{BaseURL: server.URL}, canvasID: "c1"}
+	messages := make(chan watchMessage)
+	watchErr := make(chan error, 1)
+	go func() {
+		watchErr <- run.watch(ctx, messages)
+	}()
+
+	//
+	// The stream is opened again, from the last cursor,
+	// after the server closes it.
+	//
+	assert.Equal(t, "a", (<-messages).Cursor)
+	assert.Equal(t, "b", (<-messages).Cursor)
+
+	cancel()
+	require.NoError(t, <-watchErr)
+}
+
+func Test__CanvasRunPrintMessage(t *testing.T) {
+	output := bytes.Buffer{}
+	run := &canvasRun{
+		eventID: "r1",
+		printer: &runPrinter{w: &output, nodeNames: map[string]string{"build": "Build"}},
+		states:  map[string]string{},
+	}
+
+	started := testExecution("e1", "r1", openapi_client.CANVASNODEEXECUTIONSTATE_STATE_STARTED, openapi_client.CANVASNODEEXECUTIONRESULT_RESULT_UNKNOWN)
+	failed := testExecution("e1", "r1", openapi_client.CANVASNODEEXECUTIONSTATE_STATE_FINISHED, openapi_client.CANVASNODEEXECUTIONRESULT_RESULT_FAILED)
+	failed.SetResultMessage("exit code 1")
+	other := testExecution("e2", "r2", openapi_client.CANVASNODEEXECUTIONSTATE_STATE_STARTED, openapi_client.CANVASNODEEXECUTIONRESULT_RESULT_UNKNOWN)
+
+	assert.True(t, run.printMessage(watchMessage{Type: WatchTypeExecutionStateChanged, Execution: &started}))
+	assert.False(t, run.printMessage(watchMessage{Type: WatchTypeExecutionStateChanged, Execution: &started}), "same state is not printed again")
+	assert.False(t, run.printMessage(watchMessage{Type: WatchTypeExecutionStateChanged, Execution: &other}), "other runs are not printed")
+	assert.True(t, run.printMessage(watchMessage{Type: WatchTypeExecutionStateChanged, Execution: &failed}))
+
+	at := failed.UpdatedAt.Local().Format(time.TimeOnly)
+	assert.Equal(t, fmt.Sprintf(
+		"%s  Build                     started\n%s  Build                     failed     in 2s: exit code 1\n", at, at,
+	), output.String())
+}
@@ -0,0 +1,102 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/ghodss/yaml"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const (
+	BlueprintKind = "Blueprint"
+)
+
+type Blueprint struct {
+	APIVersion string             `json:"apiVersion" yaml:"apiVersion"`
+	Kind       string             `json:"kind" yaml:"kind"`
+	Metadata   *BlueprintMetadata `json:"metadata" yaml:"metadata"`
+	Spec       *BlueprintSpec     `json:"spec,omitempty" yaml:"spec,omitempty"`
+}
+
+type BlueprintMetadata struct {
+	Id   *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name *string `json:"name" yaml:"name"`
+}
+
+type BlueprintSpec struct {
+	Description    *string                                            `json:"description,omitempty" yaml:"description,omitempty"`
+	Icon           *string                                            `json:"icon,omitempty" yaml:"icon,omitempty"`
+	Color          *string                                            `json:"color,omitempty" yaml:"color,omitempty"`
+	Configuration  []openapi_client.ConfigurationField                `json:"configuration,omitempty" yaml:"configuration,omitempty"`
+	OutputChannels []openapi_client.SuperplaneBlueprintsOutputChannel `json:"outputChannels,omitempty" yaml:"outputChannels,omitempty"`
+	Nodes          []openapi_client.ComponentsNode                    `json:"nodes,omitempty" yaml:"nodes,omitempty"`
+	Edges          []openapi_client.ComponentsEdge                    `json:"edges,omitempty" yaml:"edges,omitempty"`
+}
+
+func ParseBlueprint(raw []byte) (*Blueprint, error) {
+	var resource Blueprint
+	if err := yaml.Unmarshal(raw, &resource); err != nil {
+		return nil, fmt.Errorf("failed to parse blueprint resource: %w", err)
+	}
+
+	if resource.Kind != BlueprintKind {
+		return nil, fmt.Errorf("unsupported resource kind %q", resource.Kind)
+	}
+
+	if resource.APIVersion == "" {
+		return nil, fmt.Errorf("blueprint apiVersion is required")
+	}
+
+	if resource.Metadata == nil || resource.Metadata.Name == nil {
+		return nil, fmt.Errorf("blueprint metadata.name is required")
+	}
+
+	if resource.Spec == nil {
+		resource.Spec = &BlueprintSpec{}
+	}
+
+	return &resource, nil
+}
+
+func EmptyBlueprintSpec() *BlueprintSpec {
+	return &BlueprintSpec{
+		Nodes: []openapi_client.ComponentsNode{},
+		Edges: []openapi_client.ComponentsEdge{},
+	}
+}
+
+func BlueprintFromBlueprint(resource Blueprint) openapi_client.BlueprintsBlueprint {
+	blueprint := openapi_client.BlueprintsBlueprint{
+		Id:             resource.Metadata.Id,
+		Name:           resource.Metadata.Name,
+		Description:    resource.Spec.Description,
+		Icon:           resource.Spec.Icon,
+		Color:          resource.Spec.Color,
+		Configuration:  resource.Spec.Configuration,
+		OutputChannels: resource.Spec.OutputChannels,
+		Nodes:          resource.Spec.Nodes,
+		Edges:          resource.Spec.Edges,
+	}
+
+	return blueprint
+}
+
+func BlueprintResourceFromBlueprint(blueprint openapi_client.BlueprintsBlueprint) Blueprint {
+	return Blueprint{
+		APIVersion: "v1",
+		Kind:       BlueprintKind,
+		Metadata: &BlueprintMetadata{
+			Id:   blueprint.Id,
+			Name: blueprint.Name,
+		},
+		Spec: &BlueprintSpec{
+			Description:    blueprint.Description,
+			Icon:           blueprint.Icon,
+			Color:          blueprint.Color,
+			Configuration:  blueprint.Configuration,
+			OutputChannels: blueprint.OutputChannels,
+			Nodes:          blueprint.Nodes,
+			Edges:          blueprint.Edges,
+		},
+	}
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__ParseBlueprint(t *testing.T) {
+	t.Run("configuration and output channels are kept", func(t *testing.T) {
+		raw := []byte(`
+apiVersion: v1
+kind: Blueprint
+metadata:
+  name: deploy
+spec:
+  description: Deploys a service
+  configuration:
+    - name: environment
+      label: Environment
+      type: string
+      required: true
+  outputChannels:
+    - name: done
+      nodeId: http
+      nodeOutputChannel: default
+  nodes:
+    - id: http
+      name: http
+      type: TYPE_COMPONENT
+      component:
+        name: http
+`)
+
+		resource, err := ParseBlueprint(raw)
+		require.NoError(t, err)
+
+		blueprint := BlueprintFromBlueprint(*resource)
+		assert.Equal(t, "deploy", blueprint.GetName())
+		require.Len(t, blueprint.Configuration, 1)
+		assert.Equal(t, "environment", blueprint.Configuration[0].GetName())
+		assert.True(t, blueprint.Configuration[0].GetRequired())
+		require.Len(t, blueprint.OutputChannels, 1)
+		assert.Equal(t, "http", blueprint.OutputChannels[0].GetNodeId())
+
+		//
+		// The resource written by "get blueprint" is the same one.
+		//
+		output, err := yaml.Marshal(BlueprintResourceFromBlueprint(blueprint))
+		require.NoError(t, err)
+
+		again, err := ParseBlueprint(output)
+		require.NoError(t, err)
+		assert.Equal(t, resource, again)
+	})
+
+	t.Run("missing name -> error", func(t *testing.T) {
+		_, err := ParseBlueprint([]byte("apiVersion: v1\nkind: Blueprint\nmetadata: {}\n"))
+		require.EqualError(t, err, "blueprint metadata.name is required")
+	})
+
+	t.Run("other kind -> error", func(t *testing.T) {
+		_, err := ParseBlueprint([]byte("apiVersion: v1\nkind: Canvas\nmetadata:\n  name: deploy\n"))
+		require.EqualError(t, err, `unsupported resource kind "Canvas"`)
+	})
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/ghodss/yaml"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const (
+	GroupKind = "Group"
+)
+
+type Group struct {
+	APIVersion string                              `json:"apiVersion" yaml:"apiVersion"`
+	Kind       string                              `json:"kind" yaml:"kind"`
+	Metadata   *openapi_client.GroupsGroupMetadata `json:"metadata" yaml:"metadata"`
+	Spec       *openapi_client.GroupsGroupSpec     `json:"spec,omitempty" yaml:"spec,omitempty"`
+}
+
+func ParseGroup(raw []byte) (*Group, error) {
+	var resource Group
+	if err := yaml.Unmarshal(raw, &resource); err != nil {
+		return nil, fmt.Errorf("failed to parse group resource: %w", err)
+	}
+
+	if resource.Kind != GroupKind {
+		return nil, fmt.Errorf("unsupported resource kind %q", resource.Kind)
+	}
+
+	if resource.APIVersion == "" {
+		return nil, fmt.Errorf("group apiVersion is required")
+	}
+
+	if resource.Metadata == nil || resource.Metadata.Name == nil {
+		return nil, fmt.Errorf("group metadata.name is required")
+	}
+
+	if resource.Spec == nil || resource.Spec.Role == nil {
+		return nil, fmt.Errorf("group %s: spec.role is required", *resource.Metadata.Name)
+	}
+
+	return &resource, nil
+}
+
+func GroupFromGroup(resource Group) openapi_client.GroupsGroup {
+	group := openapi_client.GroupsGroup{}
+	group.SetMetadata(openapi_client.GroupsGroupMetadata{Name: resource.Metadata.Name})
+	group.SetSpec(*resource.Spec)
+	return group
+}
+
+func GroupResourceFromGroup(group openapi_client.GroupsGroup) Group {
+	return Group{
+		APIVersion: "v1",
+		Kind:       GroupKind,
+		Metadata:   group.Metadata,
+		Spec:       group.Spec,
+	}
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/ghodss/yaml"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const (
+	IntegrationKind = "Integration"
+)
+
+type Integration struct {
+	APIVersion string                                           `json:"apiVersion" yaml:"apiVersion"`
+	Kind       string                                           `json:"kind" yaml:"kind"`
+	Metadata   *openapi_client.OrganizationsIntegrationMetadata `json:"metadata" yaml:"metadata"`
+	Spec       *openapi_client.OrganizationsIntegrationSpec     `json:"spec,omitempty" yaml:"spec,omitempty"`
+}
+
+func ParseIntegration(raw []byte) (*Integration, error) {
+	var resource Integration
+	if err := yaml.Unmarshal(raw, &resource); err != nil {
+		return nil, fmt.Errorf("failed to parse integration resource: %w", err)
+	}
+
+	if resource.Kind != IntegrationKind {
+		return nil, fmt.Errorf("unsupported resource kind %q", resource.Kind)
+	}
+
+	if resource.APIVersion == "" {
+		return nil, fmt.Errorf("integration apiVersion is required")
+	}
+
+	if resource.Metadata == nil || resource.Metadata.Name == nil {
+		return nil, fmt.Errorf("integration metadata.name is required")
+	}
+
+	if resource.Spec == nil || resource.Spec.IntegrationName == nil {
+		return nil, fmt.Errorf("integration %s: spec.integrationName is required", *resource.Metadata.Name)
+	}
+
+	return &resource, nil
+}
+
+func IntegrationResourceFromIntegration(integration openapi_client.OrganizationsIntegration) Integration {
+	return Integration{
+		APIVersion: "v1",
+		Kind:       IntegrationKind,
+		Metadata:   integration.Metadata,
+		Spec:       integration.Spec,
+	}
+}
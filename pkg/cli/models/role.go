@@ -0,0 +1,63 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/ghodss/yaml"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const (
+	RoleKind = "Role"
+)
+
+type Role struct {
+	APIVersion string                            `json:"apiVersion" yaml:"apiVersion"`
+	Kind       string                            `json:"kind" yaml:"kind"`
+	Metadata   *openapi_client.RolesRoleMetadata `json:"metadata" yaml:"metadata"`
+	Spec       *openapi_client.RolesRoleSpec     `json:"spec,omitempty" yaml:"spec,omitempty"`
+}
+
+// Roles every organization has, which cannot be changed.
+var DefaultRoleNames = []string{"org_owner", "org_admin", "org_viewer"}
+
+func ParseRole(raw []byte) (*Role, error) {
+	var resource Role
+	if err := yaml.Unmarshal(raw, &resource); err != nil {
+		return nil, fmt.Errorf("failed to parse role resource: %w", err)
+	}
+
+	if resource.Kind != RoleKind {
+		return nil, fmt.Errorf("unsupported resource kind %q", resource.Kind)
+	}
+
+	if resource.APIVersion == "" {
+		return nil, fmt.Errorf("role apiVersion is required")
+	}
+
+	if resource.Metadata == nil || resource.Metadata.Name == nil {
+		return nil, fmt.Errorf("role metadata.name is required")
+	}
+
+	if resource.Spec == nil {
+		resource.Spec = &openapi_client.RolesRoleSpec{}
+	}
+
+	return &resource, nil
+}
+
+func RoleFromRole(resource Role) openapi_client.RolesRole {
+	role := openapi_client.RolesRole{}
+	role.SetMetadata(openapi_client.RolesRoleMetadata{Name: resource.Metadata.Name})
+	role.SetSpec(*resource.Spec)
+	return role
+}
+
+func RoleResourceFromRole(role openapi_client.RolesRole) Role {
+	return Role{
+		APIVersion: "v1",
+		Kind:       RoleKind,
+		Metadata:   role.Metadata,
+		Spec:       role.Spec,
+	}
+}
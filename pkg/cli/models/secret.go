@@ -0,0 +1,110 @@
+package models
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+)
+
+const (
+	SecretKind = "Secret"
+)
+
+/*
+ * Secret values are never written in the resource file.
+ * Each key references where its value is read from when the
+ * secret is applied: an environment variable, or a file.
+ */
+type Secret struct {
+	APIVersion string          `json:"apiVersion" yaml:"apiVersion"`
+	Kind       string          `json:"kind" yaml:"kind"`
+	Metadata   *SecretMetadata `json:"metadata" yaml:"metadata"`
+	Spec       *SecretSpec     `json:"spec" yaml:"spec"`
+}
+
+type SecretMetadata struct {
+	Name *string `json:"name" yaml:"name"`
+}
+
+type SecretSpec struct {
+	Data map[string]SecretValueRef `json:"data" yaml:"data"`
+}
+
+type SecretValueRef struct {
+	FromEnv  string `json:"fromEnv,omitempty" yaml:"fromEnv,omitempty"`
+	FromFile string `json:"fromFile,omitempty" yaml:"fromFile,omitempty"`
+}
+
+func ParseSecret(raw []byte) (*Secret, error) {
+	var resource Secret
+	if err := yaml.Unmarshal(raw, &resource); err != nil {
+		return nil, fmt.Errorf("failed to parse secret resource: %w", err)
+	}
+
+	if resource.Kind != SecretKind {
+		return nil, fmt.Errorf("unsupported resource kind %q", resource.Kind)
+	}
+
+	if resource.APIVersion == "" {
+		return nil, fmt.Errorf("secret apiVersion is required")
+	}
+
+	if resource.Metadata == nil || resource.Metadata.Name == nil {
+		return nil, fmt.Errorf("secret metadata.name is required")
+	}
+
+	if resource.Spec == nil || len(resource.Spec.Data) == 0 {
+		return nil, fmt.Errorf("secret %s: spec.data is required", *resource.Metadata.Name)
+	}
+
+	for key, ref := range resource.Spec.Data {
+		if (ref.FromEnv == "") == (ref.FromFile == "") {
+			return nil, fmt.Errorf("secret %s: key %s must set exactly one of fromEnv or fromFile", *resource.Metadata.Name, key)
+		}
+	}
+
+	return &resource, nil
+}
+
+// Resolve reads the values of all keys.
+// Files are read relative to baseDir.
+func (s *Secret) Resolve(baseDir string) (map[string]string, error) {
+	values := make(map[string]string, len(s.Spec.Data))
+	for key, ref := range s.Spec.Data {
+		value, err := ref.Resolve(baseDir)
+		if err != nil {
+			return nil, fmt.Errorf("secret %s: key %s: %w", *s.Metadata.Name, key, err)
+		}
+
+		values[key] = value
+	}
+
+	return values, nil
+}
+
+func (r SecretValueRef) Resolve(baseDir string) (string, error) {
+	if r.FromEnv != "" {
+		value, ok := os.LookupEnv(r.FromEnv)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", r.FromEnv)
+		}
+
+		return value, nil
+	}
+
+	path := r.FromFile
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(baseDir, path)
+	}
+
+	// #nosec
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(string(data), "\n"), nil
+}
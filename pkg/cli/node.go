@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+var nodeCmd = &cobra.Command{
+	Use:     "node",
+	Short:   "Manage canvas nodes",
+	Aliases: []string{"nodes"},
+}
+
+var pauseNodeCmd = &cobra.Command{
+	Use:   "pause <node>",
+	Short: "Pause a node",
+	Long: `Pause a node, so new items are kept in its queue
+until it is resumed. The node can be given by name or ID.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		updateNodePause(cmd, args[0], true)
+		fmt.Printf("Node %s paused.\n", args[0])
+	},
+}
+
+var resumeNodeCmd = &cobra.Command{
+	Use:   "resume <node>",
+	Short: "Resume a paused node",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		updateNodePause(cmd, args[0], false)
+		fmt.Printf("Node %s resumed.\n", args[0])
+	},
+}
+
+func updateNodePause(cmd *cobra.Command, nodeNameOrID string, paused bool) {
+	client := DefaultClient()
+	ctx := context.Background()
+	canvasID := getCanvasIDOrExit(ctx, client, cmd)
+
+	nodeID, err := findNodeID(ctx, client, canvasID, nodeNameOrID)
+	Check(err)
+
+	body := openapi_client.CanvasesUpdateNodePauseBody{}
+	body.SetPaused(paused)
+
+	_, _, err = client.CanvasNodeAPI.CanvasesUpdateNodePause(ctx, canvasID, nodeID).Body(body).Execute()
+	Check(err)
+}
+
+func init() {
+	RootCmd.AddCommand(nodeCmd)
+
+	nodeCmd.PersistentFlags().String("canvas", "", "canvas name or ID")
+	nodeCmd.AddCommand(pauseNodeCmd)
+	nodeCmd.AddCommand(resumeNodeCmd)
+}
@@ -0,0 +1,215 @@
+package cli
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+/*
+ * organizationArchive has the fields of an exported archive
+ * the CLI needs to read before importing it.
+ */
+type organizationArchive struct {
+	SecretValues []byte `json:"secretValues,omitempty"`
+}
+
+var organizationCmd = &cobra.Command{
+	Use:     "organization",
+	Short:   "Manage the current organization",
+	Aliases: []string{"org"},
+}
+
+var exportOrganizationCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the resources of the organization to an archive",
+	Long: `Export the custom roles, groups, integrations, secrets, blueprints
+and canvases of the organization to a JSON archive, which can be
+imported into another organization, or another installation.
+
+Sensitive integration settings are never exported. Secret values are
+only exported with --include-secret-values, by users allowed to read
+secrets, encrypted with the RSA public key given with --public-key,
+so only the holder of the private key can import them.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		client := DefaultClient()
+		orgID, err := currentOrganizationID(ctx, client)
+		Check(err)
+
+		body := openapi_client.OrganizationsExportOrganizationBody{}
+		includeSecretValues, _ := cmd.Flags().GetBool("include-secret-values")
+		publicKeyPath, _ := cmd.Flags().GetString("public-key")
+		if includeSecretValues {
+			if publicKeyPath == "" {
+				Fail("--public-key is required with --include-secret-values")
+			}
+
+			// #nosec
+			publicKey, err := os.ReadFile(publicKeyPath)
+			CheckWithMessage(err, "failed to read public key")
+			body.SetIncludeSecretValues(true)
+			body.SetPublicKey(string(publicKey))
+		}
+
+		response, _, err := client.OrganizationAPI.
+			OrganizationsExportOrganization(ctx, orgID).
+			Body(body).
+			Execute()
+		Check(err)
+
+		//
+		// The gateway encodes bytes fields as base64.
+		//
+		archive, err := base64.StdEncoding.DecodeString(response.GetArchive())
+		CheckWithMessage(err, "failed to decode archive")
+
+		file, _ := cmd.Flags().GetString("file")
+		if file == "" {
+			_, err = os.Stdout.Write(archive)
+			Check(err)
+			return
+		}
+
+		err = os.WriteFile(file, archive, 0600)
+		CheckWithMessage(err, "failed to write archive")
+		fmt.Printf("Organization exported to %s.\n", file)
+	},
+}
+
+var importOrganizationCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import the resources of an archive into the organization",
+	Long: `Import an archive created with "superplane organization export"
+into the current organization.
+
+Resources with the same name as an existing one are skipped, so an
+import can be run again after fixing what made it fail. Canvases using
+imported blueprints and integrations are updated to use the new ones.
+Secret values are only imported if the archive has them, and the
+private key matching the public key used on export is given. They
+are decrypted here, so the private key is never sent to the server.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		client := DefaultClient()
+		orgID, err := currentOrganizationID(ctx, client)
+		Check(err)
+
+		// #nosec
+		archive, err := os.ReadFile(args[0])
+		CheckWithMessage(err, "failed to read archive")
+
+		body := openapi_client.OrganizationsImportOrganizationBody{}
+		body.SetArchive(base64.StdEncoding.EncodeToString(archive))
+
+		privateKeyPath, _ := cmd.Flags().GetString("private-key")
+		if privateKeyPath != "" {
+			// #nosec
+			privateKey, err := os.ReadFile(privateKeyPath)
+			CheckWithMessage(err, "failed to read private key")
+
+			secretValues, err := openSecretValues(archive, privateKey)
+			Check(err)
+			body.SetSecretValues(secretValues)
+		}
+
+		response, _, err := client.OrganizationAPI.
+			OrganizationsImportOrganization(ctx, orgID).
+			Body(body).
+			Execute()
+		Check(err)
+
+		printOutput(cmd, response, func(w io.Writer) {
+			printImportedResources(w, response)
+		})
+	},
+}
+
+/*
+ * openSecretValues decrypts the secret values of an archive
+ * with the private key, returning the values of each secret.
+ */
+func openSecretValues(archive []byte, privateKey []byte) (map[string]openapi_client.OrganizationsSecretValues, error) {
+	var a organizationArchive
+	err := json.Unmarshal(archive, &a)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse archive: %v", err)
+	}
+
+	if len(a.SecretValues) == 0 {
+		return nil, nil
+	}
+
+	data, err := crypto.OpenWithPrivateKey(privateKey, a.SecretValues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt secret values: %v", err)
+	}
+
+	values := map[string]map[string]string{}
+	err = json.Unmarshal(data, &values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse secret values: %v", err)
+	}
+
+	secretValues := map[string]openapi_client.OrganizationsSecretValues{}
+	for name, secret := range values {
+		secretValues[name] = openapi_client.OrganizationsSecretValues{Data: &secret}
+	}
+
+	return secretValues, nil
+}
+
+func printImportedResources(w io.Writer, response *openapi_client.OrganizationsImportOrganizationResponse) {
+	fmt.Fprintln(w, "KIND\tNAME\tID\tSTATUS")
+	for _, resource := range response.GetResources() {
+		status := "created"
+		if resource.GetSkipped() {
+			status = "skipped"
+		}
+
+		kind := strings.ToLower(strings.TrimPrefix(string(resource.GetKind()), "KIND_"))
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", kind, resource.GetName(), resource.GetId(), status)
+	}
+
+	for _, warning := range response.GetWarnings() {
+		fmt.Fprintf(w, "Warning: %s\n", warning)
+	}
+}
+
+// currentOrganizationID returns the organization of the current
+// context, or the one of the token, if the context has none.
+func currentOrganizationID(ctx context.Context, client *openapi_client.APIClient) (string, error) {
+	if c := selectedContext(); c != nil && c.Organization != "" {
+		return c.Organization, nil
+	}
+
+	me, _, err := client.MeAPI.MeMe(ctx).Execute()
+	if err != nil {
+		return "", err
+	}
+
+	return me.GetOrganizationId(), nil
+}
+
+func init() {
+	RootCmd.AddCommand(organizationCmd)
+
+	organizationCmd.AddCommand(exportOrganizationCmd)
+	exportOrganizationCmd.Flags().StringP("file", "f", "", "file to write the archive to, instead of the standard output")
+	exportOrganizationCmd.Flags().Bool("include-secret-values", false, "include the values of secrets, encrypted with --public-key")
+	exportOrganizationCmd.Flags().String("public-key", "", "PEM file with the RSA public key used to encrypt secret values")
+
+	organizationCmd.AddCommand(importOrganizationCmd)
+	importOrganizationCmd.Flags().String("private-key", "", "PEM file with the RSA private key used to decrypt secret values")
+	addOutputFlag(importOrganizationCmd)
+}
@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func Test__OpenSecretValues(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	privateKey, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	publicKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})
+	privateKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKey})
+
+	t.Run("values are decrypted with the private key", func(t *testing.T) {
+		sealed, err := crypto.SealWithPublicKey(publicKeyPEM, []byte(`{"token": {"value": "hello"}}`))
+		require.NoError(t, err)
+		archive, err := json.Marshal(organizationArchive{SecretValues: sealed})
+		require.NoError(t, err)
+
+		values, err := openSecretValues(archive, privateKeyPEM)
+		require.NoError(t, err)
+		require.Len(t, values, 1)
+		assert.Equal(t, map[string]string{"value": "hello"}, *values["token"].Data)
+	})
+
+	t.Run("archive without values -> no values", func(t *testing.T) {
+		values, err := openSecretValues([]byte(`{"version": 1}`), privateKeyPEM)
+		require.NoError(t, err)
+		assert.Nil(t, values)
+	})
+
+	t.Run("invalid private key -> error", func(t *testing.T) {
+		sealed, err := crypto.SealWithPublicKey(publicKeyPEM, []byte(`{}`))
+		require.NoError(t, err)
+		archive, err := json.Marshal(organizationArchive{SecretValues: sealed})
+		require.NoError(t, err)
+
+		_, err = openSecretValues(archive, []byte("not a key"))
+		require.ErrorContains(t, err, "failed to decrypt secret values")
+	})
+}
+
+func Test__ImportOrganization(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/organizations/o1/import", r.URL.Path)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		//
+		// The gateway encodes bytes fields as base64,
+		// and only decrypted secret values are sent.
+		//
+		body := map[string]any{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "eyJ2ZXJzaW9uIjoxfQ==", body["archive"])
+		assert.Equal(t, map[string]any{"token": map[string]any{"data": map[string]any{"value": "hello"}}}, body["secretValues"])
+		assert.NotContains(t, body, "privateKey")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"resources": [
+				{"kind": "KIND_CANVAS", "name": "deploy", "archiveId": "c1", "id": "c2"},
+				{"kind": "KIND_SECRET", "name": "token", "id": "s1", "skipped": true}
+			],
+			"warnings": ["integration github needs its credentials to be set again"]
+		}`))
+	}))
+
+	defer server.Close()
+
+	body := openapi_client.OrganizationsImportOrganizationBody{}
+	body.SetArchive(base64.StdEncoding.EncodeToString([]byte(`{"version":1}`)))
+	body.SetSecretValues(map[string]openapi_client.OrganizationsSecretValues{
+		"token": {Data: &map[string]string{"value": "hello"}},
+	})
+
+	client := NewAPIClient(&ClientConfig{BaseURL: server.URL, APIToken: "token"})
+	response, _, err := client.OrganizationAPI.
+		OrganizationsImportOrganization(context.Background(), "o1").
+		Body(body).
+		Execute()
+	require.NoError(t, err)
+	require.Len(t, response.GetResources(), 2)
+
+	out := bytes.Buffer{}
+	printImportedResources(&out, response)
+	assert.Equal(t, "KIND\tNAME\tID\tSTATUS\n"+
+		"canvas\tdeploy\tc2\tcreated\n"+
+		"secret\ttoken\ts1\tskipped\n"+
+		"Warning: integration github needs its credentials to be set again\n", out.String())
+}
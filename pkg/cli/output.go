@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+)
+
+const (
+	OutputFormatTable = "table"
+	OutputFormatJSON  = "json"
+	OutputFormatYAML  = "yaml"
+)
+
+func addOutputFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP("output", "o", OutputFormatTable, "output format: table, json or yaml")
+}
+
+// printOutput writes the value in the format given with --output.
+// For the table format, the table function writes the rows,
+// which are aligned in columns before being printed.
+func printOutput(cmd *cobra.Command, value any, table func(w io.Writer)) {
+	format, _ := cmd.Flags().GetString("output")
+	Check(writeOutput(os.Stdout, format, value, table))
+}
+
+func writeOutput(w io.Writer, format string, value any, table func(w io.Writer)) error {
+	switch format {
+	case OutputFormatJSON:
+		output, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(w, string(output))
+		return err
+
+	case OutputFormatYAML:
+		output, err := yaml.Marshal(value)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprint(w, string(output))
+		return err
+
+	case OutputFormatTable, "":
+		writer := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+		table(writer)
+		return writer.Flush()
+
+	default:
+		return fmt.Errorf("unsupported output format %q, expected one of: table, json, yaml", format)
+	}
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
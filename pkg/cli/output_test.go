@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__WriteOutput(t *testing.T) {
+	value := []map[string]any{{"id": "1", "name": "first"}}
+	table := func(w io.Writer) {
+		fmt.Fprintln(w, "ID\tNAME")
+		fmt.Fprintln(w, "1\tfirst")
+	}
+
+	t.Run("table", func(t *testing.T) {
+		output := bytes.Buffer{}
+		require.NoError(t, writeOutput(&output, OutputFormatTable, value, table))
+		assert.Equal(t, "ID  NAME\n1   first\n", output.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		output := bytes.Buffer{}
+		require.NoError(t, writeOutput(&output, OutputFormatJSON, value, table))
+		assert.JSONEq(t, `[{"id": "1", "name": "first"}]`, output.String())
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		output := bytes.Buffer{}
+		require.NoError(t, writeOutput(&output, OutputFormatYAML, value, table))
+		assert.Equal(t, "- id: \"1\"\n  name: first\n", output.String())
+	})
+
+	t.Run("unknown format -> error", func(t *testing.T) {
+		output := bytes.Buffer{}
+		err := writeOutput(&output, "xml", value, table)
+		require.ErrorContains(t, err, `unsupported output format "xml"`)
+		assert.Empty(t, output.String())
+	})
+}
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+)
+
+var queueCmd = &cobra.Command{
+	Use:   "queue",
+	Short: "Manage the queue of a node",
+}
+
+var listQueueCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the items waiting in the queue of a node",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		client := DefaultClient()
+		ctx := context.Background()
+		canvasID := getCanvasIDOrExit(ctx, client, cmd)
+		nodeID := getNodeIDOrExit(ctx, client, cmd, canvasID)
+		limit, _ := cmd.Flags().GetInt64("limit")
+
+		response, _, err := client.CanvasNodeAPI.CanvasesListNodeQueueItems(ctx, canvasID, nodeID).Limit(limit).Execute()
+		Check(err)
+
+		printOutput(cmd, response.GetItems(), func(w io.Writer) {
+			fmt.Fprintln(w, "ID\tNODE\tROOT_EVENT\tCREATED_AT")
+			for _, item := range response.GetItems() {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", item.GetId(), item.GetNodeId(), item.RootEvent.GetId(), formatTime(item.CreatedAt))
+			}
+		})
+	},
+}
+
+var deleteQueueItemCmd = &cobra.Command{
+	Use:   "delete <item-id>",
+	Short: "Remove an item from the queue of a node",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := DefaultClient()
+		ctx := context.Background()
+		canvasID := getCanvasIDOrExit(ctx, client, cmd)
+		nodeID := getNodeIDOrExit(ctx, client, cmd, canvasID)
+
+		_, _, err := client.CanvasNodeAPI.CanvasesDeleteNodeQueueItem(ctx, canvasID, nodeID, args[0]).Execute()
+		Check(err)
+
+		fmt.Printf("Queue item %s deleted.\n", args[0])
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(queueCmd)
+
+	queueCmd.PersistentFlags().String("canvas", "", "canvas name or ID")
+	queueCmd.PersistentFlags().String("node", "", "node name or ID")
+
+	queueCmd.AddCommand(listQueueCmd)
+	listQueueCmd.Flags().Int64("limit", 20, "maximum number of items to list")
+	addOutputFlag(listQueueCmd)
+
+	queueCmd.AddCommand(deleteQueueItemCmd)
+}
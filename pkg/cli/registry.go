@@ -0,0 +1,15 @@
+package cli
+
+import (
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/registry"
+
+	_ "github.com/superplanehq/superplane/pkg/registry/builtin"
+)
+
+// localRegistry returns a registry with the same components,
+// triggers and widgets as the server, so canvases can be checked
+// without talking to it. Nothing is executed through it.
+func localRegistry() (*registry.Registry, error) {
+	return registry.NewRegistry(crypto.NewNoOpEncryptor(), registry.HTTPOptions{})
+}
@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+	"github.com/superplanehq/superplane/pkg/sdk"
+)
+
+const (
+	colorReset  = "\033[0m"
+	colorGray   = "\033[90m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+	colorBlue   = "\033[34m"
+	colorCyan   = "\033[36m"
+
+	// Payloads are printed in a single line, cut at this length.
+	maxPayloadSummaryLength = 120
+)
+
+// runPrinter prints the activity of a canvas run, one line per change.
+type runPrinter struct {
+	w         io.Writer
+	colors    bool
+	nodeNames map[string]string
+}
+
+func newRunPrinter(ctx context.Context, client *openapi_client.APIClient, canvasID string, out *os.File) (*runPrinter, error) {
+	response, _, err := client.CanvasAPI.CanvasesDescribeCanvas(ctx, canvasID).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	canvas := response.GetCanvas()
+	spec := canvas.GetSpec()
+	nodeNames := map[string]string{}
+	for _, node := range spec.GetNodes() {
+		nodeNames[node.GetId()] = node.GetName()
+	}
+
+	return &runPrinter{
+		w:         out,
+		colors:    useColors(out),
+		nodeNames: nodeNames,
+	}, nil
+}
+
+// useColors returns true if the output is a terminal,
+// unless disabled with the NO_COLOR environment variable.
+func useColors(out *os.File) bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	info, err := out.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+func (p *runPrinter) Event(event openapi_client.CanvasesCanvasEvent) {
+	detail := fmt.Sprintf("channel=%s", event.GetChannel())
+	if event.GetCustomName() != "" {
+		detail += fmt.Sprintf(" name=%q", event.GetCustomName())
+	}
+
+	if len(event.Data) > 0 {
+		detail += " " + summarizePayload(event.Data)
+	}
+
+	p.line(event.CreatedAt, event.GetNodeId(), colorCyan, "event", detail)
+}
+
+func (p *runPrinter) QueueItem(item openapi_client.CanvasesCanvasNodeQueueItem) {
+	p.line(item.CreatedAt, item.GetNodeId(), colorGray, "queued", "")
+}
+
+func (p *runPrinter) Execution(execution openapi_client.CanvasesCanvasNodeExecution) {
+	switch execution.GetState() {
+	case openapi_client.CANVASNODEEXECUTIONSTATE_STATE_PENDING:
+		p.line(execution.UpdatedAt, execution.GetNodeId(), colorGray, "pending", "")
+		return
+
+	case openapi_client.CANVASNODEEXECUTIONSTATE_STATE_STARTED:
+		p.line(execution.UpdatedAt, execution.GetNodeId(), colorBlue, "started", "")
+		return
+	}
+
+	detail := ""
+	if execution.CreatedAt != nil && execution.UpdatedAt != nil {
+		detail = "in " + execution.UpdatedAt.Sub(*execution.CreatedAt).Round(time.Millisecond).String()
+	}
+
+	switch execution.GetResult() {
+	case openapi_client.CANVASNODEEXECUTIONRESULT_RESULT_PASSED:
+		if len(execution.Outputs) > 0 {
+			detail += " " + summarizePayload(execution.Outputs)
+		}
+
+		p.line(execution.UpdatedAt, execution.GetNodeId(), colorGreen, "passed", detail)
+
+	case openapi_client.CANVASNODEEXECUTIONRESULT_RESULT_CANCELLED:
+		p.line(execution.UpdatedAt, execution.GetNodeId(), colorYellow, "cancelled", detail)
+
+	default:
+		if execution.GetResultMessage() != "" {
+			detail += ": " + execution.GetResultMessage()
+		}
+
+		p.line(execution.UpdatedAt, execution.GetNodeId(), colorRed, "failed", detail)
+	}
+}
+
+func (p *runPrinter) Summary(status sdk.RunStatus) {
+	switch status {
+	case sdk.RunStatusPassed:
+		fmt.Fprintln(p.w, p.color(colorGreen, "Run passed."))
+	case sdk.RunStatusFailed:
+		fmt.Fprintln(p.w, p.color(colorRed, "Run failed."))
+	}
+}
+
+func (p *runPrinter) line(timestamp *time.Time, nodeID, color, status, detail string) {
+	at := ""
+	if timestamp != nil {
+		at = timestamp.Local().Format(time.TimeOnly)
+	}
+
+	node := p.nodeNames[nodeID]
+	if node == "" {
+		node = nodeID
+	}
+
+	line := fmt.Sprintf("%s  %-24s  %s", p.color(colorGray, at), node, p.color(color, fmt.Sprintf("%-9s", status)))
+	if detail != "" {
+		line += "  " + detail
+	}
+
+	fmt.Fprintln(p.w, strings.TrimRight(line, " "))
+}
+
+func (p *runPrinter) color(color, text string) string {
+	if !p.colors {
+		return text
+	}
+
+	return color + text + colorReset
+}
+
+func summarizePayload(payload map[string]any) string {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return ""
+	}
+
+	if len(data) > maxPayloadSummaryLength {
+		return string(data[:maxPayloadSummaryLength-3]) + "..."
+	}
+
+	return string(data)
+}
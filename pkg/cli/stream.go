@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Streaming endpoints respond with one of these per line,
+// with either the message or the error that ended the stream.
+type streamMessage struct {
+	Result json.RawMessage `json:"result"`
+	Error  *streamError    `json:"error"`
+}
+
+type streamError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *streamError) Error() string {
+	return e.Message
+}
+
+/*
+ * streamJSON calls a streaming endpoint of the API, which is not
+ * supported by the generated client, and calls handle with each
+ * message received, until the stream ends, the context is cancelled
+ * or handle returns an error.
+ */
+func streamJSON(ctx context.Context, config *ClientConfig, path string, query url.Values, handle func(json.RawMessage) error) error {
+	endpoint := strings.TrimSuffix(config.BaseURL, "/") + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Accept", "application/json")
+	if config.APIToken != "" {
+		request.Header.Set("Authorization", "Bearer "+config.APIToken)
+	}
+
+	//
+	// The client used for other requests has a timeout,
+	// which would end the stream while it is still open.
+	//
+	client := &http.Client{}
+	if config.HTTPClient != nil {
+		client.Transport = config.HTTPClient.Transport
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 4096))
+		return fmt.Errorf("%s: %s", response.Status, strings.TrimSpace(string(body)))
+	}
+
+	decoder := json.NewDecoder(response.Body)
+	for {
+		var message streamMessage
+		err := decoder.Decode(&message)
+		if errors.Is(err, io.EOF) || ctx.Err() != nil {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading stream: %w", err)
+		}
+
+		if message.Error != nil {
+			return message.Error
+		}
+
+		if err := handle(message.Result); err != nil {
+			return err
+		}
+	}
+}
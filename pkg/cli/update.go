@@ -44,6 +44,35 @@ var updateCmd = &cobra.Command{
 				Body(body).
 				Execute()
 
+			Check(err)
+		case models.BlueprintKind:
+			resource, err := models.ParseBlueprint(data)
+			Check(err)
+
+			client := DefaultClient()
+			ctx := context.Background()
+
+			//
+			// Blueprint files kept in git usually have no ID,
+			// so the blueprint is found by name in that case.
+			//
+			nameOrID := *resource.Metadata.Name
+			if resource.Metadata.Id != nil {
+				nameOrID = *resource.Metadata.Id
+			}
+
+			blueprintID, err := findBlueprintID(ctx, client, nameOrID)
+			Check(err)
+
+			resource.Metadata.Id = &blueprintID
+			body := openapi_client.BlueprintsUpdateBlueprintBody{}
+			body.SetBlueprint(models.BlueprintFromBlueprint(*resource))
+
+			_, _, err = client.BlueprintAPI.
+				BlueprintsUpdateBlueprint(ctx, blueprintID).
+				Body(body).
+				Execute()
+
 			Check(err)
 		default:
 			Fail(fmt.Sprintf("Unsupported resource kind '%s' for update", kind))
@@ -71,3 +71,77 @@ func findCanvasIDByName(ctx context.Context, client *openapi_client.APIClient, n
 
 	return *matches[0].Metadata.Id, nil
 }
+
+// findBlueprintID returns the ID of the blueprint with the given ID or name.
+func findBlueprintID(ctx context.Context, client *openapi_client.APIClient, nameOrID string) (string, error) {
+	response, _, err := client.BlueprintAPI.BlueprintsListBlueprints(ctx).Execute()
+	if err != nil {
+		return "", err
+	}
+
+	var matches []string
+	for _, blueprint := range response.GetBlueprints() {
+		if blueprint.GetId() == nameOrID {
+			return nameOrID, nil
+		}
+
+		if blueprint.GetName() == nameOrID {
+			matches = append(matches, blueprint.GetId())
+		}
+	}
+
+	if len(matches) == 0 {
+		return "", fmt.Errorf("blueprint %q not found", nameOrID)
+	}
+
+	if len(matches) > 1 {
+		return "", fmt.Errorf("multiple blueprints named %q found, use the blueprint ID instead", nameOrID)
+	}
+
+	return matches[0], nil
+}
+
+// findNodeID returns the ID of the canvas node with the given ID or name.
+func findNodeID(ctx context.Context, client *openapi_client.APIClient, canvasID string, nameOrID string) (string, error) {
+	response, _, err := client.CanvasAPI.CanvasesDescribeCanvas(ctx, canvasID).Execute()
+	if err != nil {
+		return "", err
+	}
+
+	canvas := response.GetCanvas()
+	spec := canvas.GetSpec()
+
+	var matches []string
+	for _, node := range spec.GetNodes() {
+		if node.GetId() == nameOrID {
+			return nameOrID, nil
+		}
+
+		if node.GetName() == nameOrID {
+			matches = append(matches, node.GetId())
+		}
+	}
+
+	if len(matches) == 0 {
+		return "", fmt.Errorf("node %q not found", nameOrID)
+	}
+
+	if len(matches) > 1 {
+		return "", fmt.Errorf("multiple nodes named %q found, use the node ID instead", nameOrID)
+	}
+
+	return matches[0], nil
+}
+
+// getCanvasIDOrExit returns the ID of the canvas given with --canvas.
+func getCanvasIDOrExit(ctx context.Context, client *openapi_client.APIClient, cmd *cobra.Command) string {
+	nameOrID, _ := cmd.Flags().GetString("canvas")
+	if nameOrID == "" {
+		Fail("--canvas is required")
+	}
+
+	canvasID, err := findCanvasID(ctx, client, nameOrID)
+	Check(err)
+
+	return canvasID
+}
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__FindBlueprintID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/blueprints", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"blueprints": [
+			{"id": "b1", "name": "deploy"},
+			{"id": "b2", "name": "notify"},
+			{"id": "b3", "name": "notify"}
+		]}`)
+	}))
+
+	defer server.Close()
+
+	client := NewAPIClient(&ClientConfig{BaseURL: server.URL, HTTPClient: server.Client()})
+	ctx := context.Background()
+
+	t.Run("by ID", func(t *testing.T) {
+		id, err := findBlueprintID(ctx, client, "b2")
+		require.NoError(t, err)
+		assert.Equal(t, "b2", id)
+	})
+
+	t.Run("by name", func(t *testing.T) {
+		id, err := findBlueprintID(ctx, client, "deploy")
+		require.NoError(t, err)
+		assert.Equal(t, "b1", id)
+	})
+
+	t.Run("unknown blueprint -> error", func(t *testing.T) {
+		_, err := findBlueprintID(ctx, client, "build")
+		require.EqualError(t, err, `blueprint "build" not found`)
+	})
+
+	t.Run("ambiguous name -> error", func(t *testing.T) {
+		_, err := findBlueprintID(ctx, client, "notify")
+		require.EqualError(t, err, `multiple blueprints named "notify" found, use the blueprint ID instead`)
+	})
+}
@@ -8,10 +8,9 @@ import (
 )
 
 var whoamiCmd = &cobra.Command{
-	Use:     "whoami",
-	Short:   "Get information about the currently authenticated user",
-	Aliases: []string{"events"},
-	Args:    cobra.NoArgs,
+	Use:   "whoami",
+	Short: "Get information about the currently authenticated user",
+	Args:  cobra.NoArgs,
 
 	Run: func(cmd *cobra.Command, args []string) {
 		c := DefaultClient()
@@ -2,6 +2,12 @@ package cli
 
 import (
 	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/ghodss/yaml"
 )
@@ -33,3 +39,95 @@ func ParseYamlResourceHeaders(raw []byte) (string, string, error) {
 
 	return apiVersion, kind, nil
 }
+
+type YamlDocument struct {
+	Path string
+	Data []byte
+}
+
+var yamlDocumentSeparator = regexp.MustCompile(`(?m)^---[ \t]*$`)
+
+// ReadYamlDocuments reads all documents in the given files.
+// Directories are read recursively, taking only .yaml and .yml files.
+// Empty documents are skipped.
+func ReadYamlDocuments(paths []string) ([]YamlDocument, error) {
+	documents := []YamlDocument{}
+	for _, path := range paths {
+		files, err := yamlFiles(path)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, file := range files {
+			// #nosec
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read %s: %w", file, err)
+			}
+
+			for _, document := range SplitYamlDocuments(data) {
+				documents = append(documents, YamlDocument{Path: file, Data: document})
+			}
+		}
+	}
+
+	return documents, nil
+}
+
+// SplitYamlDocuments splits a multi-document YAML file,
+// skipping documents that are empty or only have comments.
+func SplitYamlDocuments(data []byte) [][]byte {
+	documents := [][]byte{}
+	for _, document := range yamlDocumentSeparator.Split(string(data), -1) {
+		if isEmptyYamlDocument(document) {
+			continue
+		}
+
+		documents = append(documents, []byte(document))
+	}
+
+	return documents
+}
+
+func isEmptyYamlDocument(document string) bool {
+	for _, line := range strings.Split(document, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			return false
+		}
+	}
+
+	return true
+}
+
+func yamlFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	files := []string{}
+	err = filepath.WalkDir(path, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		ext := filepath.Ext(file)
+		if !entry.IsDir() && (ext == ".yaml" || ext == ".yml") {
+			files = append(files, file)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
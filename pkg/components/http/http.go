@@ -49,6 +49,7 @@ type Spec struct {
 	TimeoutStrategy *string     `json:"timeoutStrategy,omitempty"`
 	TimeoutSeconds  *int        `json:"timeoutSeconds,omitempty"`
 	Retries         *int        `json:"retries,omitempty"`
+	OIDCAudience    *string     `json:"oidcAudience,omitempty"`
 }
 
 type RetryMetadata struct {
@@ -96,6 +97,7 @@ func (e *HTTP) Documentation() string {
 - **Method**: HTTP method to use
 - **Query Parameters**: Optional URL query parameters
 - **Headers**: Custom HTTP headers (header names cannot use expressions)
+- **OIDC Token**: Send a short-lived OIDC token identifying this execution in the ` + "`Authorization`" + ` header, for services that trust SuperPlane through workload identity federation
 - **Body**: Request body in various formats:
   - **JSON**: Structured JSON payload
   - **Form Data**: URL-encoded form data
@@ -146,6 +148,14 @@ func (e *HTTP) Setup(ctx core.SetupContext) error {
 		return fmt.Errorf("method is required")
 	}
 
+	if spec.OIDCAudience != nil && *spec.OIDCAudience != "" && spec.Headers != nil {
+		for _, header := range *spec.Headers {
+			if strings.EqualFold(header.Name, "Authorization") {
+				return fmt.Errorf("authorization header cannot be set when an OIDC token is sent")
+			}
+		}
+	}
+
 	if spec.ContentType == nil {
 		return nil
 	}
@@ -273,6 +283,15 @@ func (e *HTTP) Configuration() []configuration.Field {
 			},
 			Default: "[{\"name\": \"X-Foo\", \"value\": \"Bar\"}]",
 		},
+		{
+			Name:        "oidcAudience",
+			Label:       "OIDC Token",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Togglable:   true,
+			Description: "Audience of the OIDC token sent as a bearer token in the Authorization header",
+			Placeholder: "https://api.example.com",
+		},
 		{
 			Name:        "contentType",
 			Label:       "Body",
@@ -494,7 +513,7 @@ func (e *HTTP) Execute(ctx core.ExecutionContext) error {
 func (e *HTTP) executeHTTPRequest(ctx core.ExecutionContext, spec Spec, retryMetadata RetryMetadata) error {
 	currentTimeout := e.calculateTimeoutForAttempt(retryMetadata.TimeoutStrategy, retryMetadata.TimeoutSeconds, retryMetadata.Attempt)
 
-	resp, err := e.executeRequest(ctx.HTTP, spec, currentTimeout)
+	resp, err := e.executeRequest(ctx, spec, currentTimeout)
 	if err != nil {
 		if retryMetadata.Attempt < retryMetadata.MaxRetries {
 			return e.scheduleRetry(ctx, err.Error(), retryMetadata)
@@ -522,6 +541,7 @@ func (e *HTTP) scheduleRetry(ctx core.ExecutionContext, lastError string, retryM
 	retryMetadata.Attempt++
 	retryMetadata.TotalRetries++
 	retryMetadata.LastError = lastError
+	ctx.Logger.Infof("Retrying request (attempt %d of %d): %s", retryMetadata.Attempt+1, retryMetadata.MaxRetries+1, lastError)
 
 	err := ctx.Metadata.Set(retryMetadata)
 	if err != nil {
@@ -557,6 +577,8 @@ func (e *HTTP) handleRetryRequest(ctx core.ActionContext) error {
 		Requests:       ctx.Requests,
 		Auth:           ctx.Auth,
 		HTTP:           ctx.HTTP,
+		OIDC:           ctx.OIDC,
+		Logger:         ctx.Logger,
 	}
 
 	return e.executeHTTPRequest(execCtx, spec, retryMetadata)
@@ -578,7 +600,7 @@ func (e *HTTP) calculateTimeoutForAttempt(strategy string, timeoutSeconds int, a
 	return baseTimeout
 }
 
-func (e *HTTP) executeRequest(httpCtx core.HTTPContext, spec Spec, timeout time.Duration) (*http.Response, error) {
+func (e *HTTP) executeRequest(ctx core.ExecutionContext, spec Spec, timeout time.Duration) (*http.Response, error) {
 	var body io.Reader
 	var contentType string
 	var err error
@@ -623,14 +645,47 @@ func (e *HTTP) executeRequest(httpCtx core.HTTPContext, spec Spec, timeout time.
 		}
 	}
 
-	resp, err := httpCtx.Do(req)
+	//
+	// The OIDC token is set after the custom headers,
+	// so they cannot replace it.
+	//
+	if spec.OIDCAudience != nil && *spec.OIDCAudience != "" {
+		if ctx.OIDC == nil {
+			return nil, fmt.Errorf("OIDC tokens are not available")
+		}
+
+		token, err := ctx.OIDC.Token(*spec.OIDCAudience, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate OIDC token: %w", err)
+		}
+
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	requestURL = redactURL(req.URL)
+	ctx.Logger.Infof("Sending %s request to %s", spec.Method, requestURL)
+
+	start := time.Now()
+	resp, err := ctx.HTTP.Do(req)
 	if err != nil {
+		ctx.Logger.Warnf("%s request to %s failed after %s: %v", spec.Method, requestURL, time.Since(start).Round(time.Millisecond), err)
 		return nil, err
 	}
 
+	ctx.Logger.Infof("Received %s from %s in %s", resp.Status, requestURL, time.Since(start).Round(time.Millisecond))
 	return resp, nil
 }
 
+// redactURL removes the credentials and query from a URL,
+// since they may contain secrets, before logging it.
+func redactURL(u *url.URL) string {
+	redacted := *u
+	redacted.User = nil
+	redacted.RawQuery = ""
+	redacted.Fragment = ""
+	return redacted.String()
+}
+
 func (e *HTTP) handleRequestError(ctx core.ExecutionContext, err error, totalAttempts int) error {
 	// Get current metadata and update with final result
 	metadata := ctx.Metadata.Get()
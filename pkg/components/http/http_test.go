@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/core"
@@ -20,6 +21,7 @@ func createExecutionContext(config map[string]any) (core.ExecutionContext, *cont
 	stateCtx := &contexts.ExecutionStateContext{}
 	metadataCtx := &contexts.MetadataContext{}
 	return core.ExecutionContext{
+		Logger:         logrus.NewEntry(logrus.New()),
 		Configuration:  config,
 		ExecutionState: stateCtx,
 		Metadata:       metadataCtx,
@@ -158,6 +160,18 @@ func TestHTTP__Setup__ValidationErrors(t *testing.T) {
 			},
 			expectErr: "form data is required",
 		},
+		{
+			name: "authorization header with OIDC token",
+			config: map[string]any{
+				"method":       "GET",
+				"url":          "https://api.example.com",
+				"oidcAudience": "https://api.example.com",
+				"headers": []map[string]any{
+					{"name": "authorization", "value": "Bearer other"},
+				},
+			},
+			expectErr: "authorization header cannot be set when an OIDC token is sent",
+		},
 	}
 
 	for _, tt := range tests {
@@ -658,6 +672,7 @@ func TestHTTP__Execute__WithoutRetryStrategy(t *testing.T) {
 	stateCtx := &contexts.ExecutionStateContext{}
 	metadataCtx := &contexts.MetadataContext{}
 	ctx := core.ExecutionContext{
+		Logger: logrus.NewEntry(logrus.New()),
 		Configuration: map[string]any{
 			"method": "GET",
 			"url":    server.URL,
@@ -703,6 +718,7 @@ func TestHTTP__Execute__FixedTimeoutStrategy_Success(t *testing.T) {
 	stateCtx := &contexts.ExecutionStateContext{}
 	metadataCtx := &contexts.MetadataContext{}
 	ctx := core.ExecutionContext{
+		Logger: logrus.NewEntry(logrus.New()),
 		Configuration: map[string]any{
 			"method":          "GET",
 			"url":             server.URL,
@@ -749,6 +765,7 @@ func TestHTTP__Execute__ExponentialTimeoutStrategy_Success(t *testing.T) {
 	stateCtx := &contexts.ExecutionStateContext{}
 	metadataCtx := &contexts.MetadataContext{}
 	ctx := core.ExecutionContext{
+		Logger: logrus.NewEntry(logrus.New()),
 		Configuration: map[string]any{
 			"method":          "GET",
 			"url":             server.URL,
@@ -798,6 +815,7 @@ func TestHTTP__HandleAction__RetryRequest_SuccessOnRetry(t *testing.T) {
 
 	httpCtx := &http.Client{}
 	ctx := core.ExecutionContext{
+		Logger: logrus.NewEntry(logrus.New()),
 		Configuration: map[string]any{
 			"method":          "GET",
 			"url":             server.URL,
@@ -819,6 +837,7 @@ func TestHTTP__HandleAction__RetryRequest_SuccessOnRetry(t *testing.T) {
 	assert.Equal(t, 1*time.Second, requestCtx.Duration)
 
 	actionCtx := core.ActionContext{
+		Logger:         logrus.NewEntry(logrus.New()),
 		Name:           "retryRequest",
 		Configuration:  ctx.Configuration,
 		ExecutionState: stateCtx,
@@ -864,6 +883,7 @@ func TestHTTP__HandleAction__RetryRequest_ExhaustedRetries(t *testing.T) {
 	httpCtx := &http.Client{}
 
 	ctx := core.ExecutionContext{
+		Logger: logrus.NewEntry(logrus.New()),
 		Configuration: map[string]any{
 			"method":          "GET",
 			"url":             server.URL,
@@ -881,6 +901,7 @@ func TestHTTP__HandleAction__RetryRequest_ExhaustedRetries(t *testing.T) {
 	assert.NoError(t, err)
 
 	actionCtx := core.ActionContext{
+		Logger:         logrus.NewEntry(logrus.New()),
 		Name:           "retryRequest",
 		Configuration:  ctx.Configuration,
 		ExecutionState: stateCtx,
@@ -952,7 +973,8 @@ func TestHTTP__HandleAction__UnknownAction(t *testing.T) {
 	h := &HTTP{}
 
 	ctx := core.ActionContext{
-		Name: "unknownAction",
+		Logger: logrus.NewEntry(logrus.New()),
+		Name:   "unknownAction",
 	}
 
 	err := h.HandleAction(ctx)
@@ -992,6 +1014,7 @@ func TestHTTP__RetryProgression_ExponentialStrategy(t *testing.T) {
 	httpCtx := &http.Client{}
 
 	ctx := core.ExecutionContext{
+		Logger: logrus.NewEntry(logrus.New()),
 		Configuration: map[string]any{
 			"method":          "GET",
 			"url":             server.URL,
@@ -1013,6 +1036,7 @@ func TestHTTP__RetryProgression_ExponentialStrategy(t *testing.T) {
 
 	requestCtx1 := &contexts.RequestContext{}
 	actionCtx := core.ActionContext{
+		Logger:         logrus.NewEntry(logrus.New()),
 		Name:           "retryRequest",
 		Configuration:  ctx.Configuration,
 		ExecutionState: stateCtx,
@@ -1069,6 +1093,7 @@ func TestHTTP__RetryProgression_NetworkError(t *testing.T) {
 	httpCtx := &http.Client{}
 
 	ctx := core.ExecutionContext{
+		Logger: logrus.NewEntry(logrus.New()),
 		Configuration: map[string]any{
 			"method":          "GET",
 			"url":             "http://invalid-host-that-does-not-exist.com",
@@ -1093,6 +1118,7 @@ func TestHTTP__RetryProgression_NetworkError(t *testing.T) {
 	// Simulate retries until exhaustion
 	for i := 0; i < 2; i++ {
 		actionCtx := core.ActionContext{
+			Logger:         logrus.NewEntry(logrus.New()),
 			Name:           "retryRequest",
 			Configuration:  ctx.Configuration,
 			ExecutionState: stateCtx,
@@ -1132,6 +1158,7 @@ func TestHTTP__RetryMetadata_Progression(t *testing.T) {
 	httpCtx := &http.Client{}
 
 	ctx := core.ExecutionContext{
+		Logger: logrus.NewEntry(logrus.New()),
 		Configuration: map[string]any{
 			"method":          "GET",
 			"url":             server.URL,
@@ -1162,6 +1189,7 @@ func TestHTTP__RetryMetadata_Progression(t *testing.T) {
 
 	// Simulate first retry (attempt 1)
 	actionCtx := core.ActionContext{
+		Logger:         logrus.NewEntry(logrus.New()),
 		Name:           "retryRequest",
 		Configuration:  ctx.Configuration,
 		ExecutionState: stateCtx,
@@ -1187,3 +1215,73 @@ func TestHTTP__RetryMetadata_Progression(t *testing.T) {
 
 	assert.Equal(t, int32(3), atomic.LoadInt32(&requestCount))
 }
+
+func TestHTTP__Execute__WithOIDCToken(t *testing.T) {
+	//
+	// Create test server.
+	// Here, we verify that the OIDC token is sent as a bearer token.
+	//
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer oidc-token", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	h := &HTTP{}
+	ctx, stateCtx, _ := createExecutionContext(map[string]any{
+		"method":       "GET",
+		"url":          server.URL,
+		"oidcAudience": "https://api.example.com",
+	})
+
+	oidcCtx := &contexts.OIDCContext{}
+	ctx.OIDC = oidcCtx
+
+	err := h.Execute(ctx)
+	assert.NoError(t, err)
+	assert.True(t, stateCtx.Passed)
+	assert.Equal(t, []string{"https://api.example.com"}, oidcCtx.Audiences)
+}
+
+func TestHTTP__Execute__WithOIDCTokenAndAuthorizationHeader(t *testing.T) {
+	//
+	// Here, we verify that custom headers cannot replace the OIDC token.
+	//
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer oidc-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "value", r.Header.Get("X-Custom"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	h := &HTTP{}
+	ctx, stateCtx, _ := createExecutionContext(map[string]any{
+		"method":       "GET",
+		"url":          server.URL,
+		"oidcAudience": "https://api.example.com",
+		"headers": []map[string]any{
+			{"name": "Authorization", "value": "Bearer other"},
+			{"name": "X-Custom", "value": "value"},
+		},
+	})
+
+	ctx.OIDC = &contexts.OIDCContext{}
+
+	err := h.Execute(ctx)
+	assert.NoError(t, err)
+	assert.True(t, stateCtx.Passed)
+}
+
+func TestHTTP__Execute__WithOIDCTokenUnavailable(t *testing.T) {
+	h := &HTTP{}
+	ctx, stateCtx, _ := createExecutionContext(map[string]any{
+		"method":       "GET",
+		"url":          "https://api.example.com",
+		"oidcAudience": "https://api.example.com",
+	})
+
+	err := h.Execute(ctx)
+	assert.NoError(t, err)
+	assert.False(t, stateCtx.Passed)
+	assert.Contains(t, stateCtx.FailureMessage, "OIDC tokens are not available")
+}
@@ -0,0 +1,215 @@
+package respond
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const ComponentName = "respond"
+const PayloadType = "webhook.response"
+
+func init() {
+	registry.RegisterComponent(ComponentName, &Respond{})
+}
+
+type Respond struct{}
+
+type Spec struct {
+	Status  int      `json:"status" mapstructure:"status"`
+	Headers []Header `json:"headers,omitempty" mapstructure:"headers"`
+	Body    any      `json:"body,omitempty" mapstructure:"body"`
+}
+
+type Header struct {
+	Name  string `json:"name" mapstructure:"name"`
+	Value string `json:"value" mapstructure:"value"`
+}
+
+func (c *Respond) Name() string {
+	return ComponentName
+}
+
+func (c *Respond) Label() string {
+	return "Respond to Webhook"
+}
+
+func (c *Respond) Description() string {
+	return "Return a response to the caller of a synchronous webhook"
+}
+
+func (c *Respond) Documentation() string {
+	return `The Respond to Webhook component returns a response to the caller of a webhook trigger configured in synchronous mode.
+
+## Use Cases
+
+- **Canvas as an API**: Call a canvas from internal tools and get its result back in the same request
+- **Request validation**: Reply with an error status when a request cannot be processed
+- **Custom replies**: Return the format expected by the system calling the webhook
+
+## Configuration
+
+- **Status Code**: The HTTP status code returned to the caller
+- **Headers**: Additional headers returned to the caller
+- **Body**: The JSON object returned to the caller
+
+## Behavior
+
+When executed, the component emits the response on the default output channel, so the chain can continue after it. The webhook trigger waiting for the chain returns the first response emitted for its request.
+
+If the webhook is not in synchronous mode, or its timeout was already hit, the response is still emitted, but not returned to anyone.`
+}
+
+func (c *Respond) Icon() string {
+	return "reply"
+}
+
+func (c *Respond) Color() string {
+	return "blue"
+}
+
+func (c *Respond) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *Respond) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "status",
+			Label:    "Status Code",
+			Type:     configuration.FieldTypeNumber,
+			Required: true,
+			Default:  http.StatusOK,
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 100; return &min }(),
+					Max: func() *int { max := 599; return &max }(),
+				},
+			},
+		},
+		{
+			Name:        "headers",
+			Label:       "Headers",
+			Type:        configuration.FieldTypeList,
+			Required:    false,
+			Togglable:   true,
+			Description: "Additional headers returned to the caller",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Header",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:               "name",
+								Type:               configuration.FieldTypeString,
+								Label:              "Header Name",
+								Required:           true,
+								Placeholder:        "X-Request-Id",
+								DisallowExpression: true,
+							},
+							{
+								Name:        "value",
+								Type:        configuration.FieldTypeString,
+								Label:       "Header Value",
+								Required:    true,
+								Placeholder: "123",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "body",
+			Label:       "Body",
+			Type:        configuration.FieldTypeObject,
+			Required:    false,
+			Togglable:   true,
+			Description: "The JSON object returned to the caller",
+			Default:     map[string]any{"ok": true},
+		},
+	}
+}
+
+func (c *Respond) Setup(ctx core.SetupContext) error {
+	spec := Spec{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return err
+	}
+
+	return validateSpec(spec)
+}
+
+func (c *Respond) Execute(ctx core.ExecutionContext) error {
+	spec := Spec{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return err
+	}
+
+	err = validateSpec(spec)
+	if err != nil {
+		return ctx.ExecutionState.Fail(models.CanvasNodeExecutionResultReasonError, err.Error())
+	}
+
+	headers := map[string]string{}
+	for _, header := range spec.Headers {
+		headers[header.Name] = header.Value
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		PayloadType,
+		[]any{map[string]any{
+			"status":  spec.Status,
+			"headers": headers,
+			"body":    spec.Body,
+		}},
+	)
+}
+
+func validateSpec(spec Spec) error {
+	if spec.Status < 100 || spec.Status > 599 {
+		return fmt.Errorf("status must be between 100 and 599")
+	}
+
+	for _, header := range spec.Headers {
+		if header.Name == "" {
+			return fmt.Errorf("header name is required")
+		}
+	}
+
+	return nil
+}
+
+func (c *Respond) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *Respond) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *Respond) HandleAction(ctx core.ActionContext) error {
+	return fmt.Errorf("respond does not support actions")
+}
+
+func (c *Respond) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *Respond) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (c *Respond) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,85 @@
+package respond
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func TestRespond_Setup(t *testing.T) {
+	respond := &Respond{}
+
+	t.Run("valid configuration", func(t *testing.T) {
+		err := respond.Setup(core.SetupContext{
+			Configuration: map[string]any{"status": 201, "body": map[string]any{"ok": true}},
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		err := respond.Setup(core.SetupContext{
+			Configuration: map[string]any{"status": 0},
+		})
+
+		require.ErrorContains(t, err, "status must be between 100 and 599")
+	})
+
+	t.Run("header without name", func(t *testing.T) {
+		err := respond.Setup(core.SetupContext{
+			Configuration: map[string]any{
+				"status":  200,
+				"headers": []any{map[string]any{"name": "", "value": "x"}},
+			},
+		})
+
+		require.ErrorContains(t, err, "header name is required")
+	})
+}
+
+func TestRespond_Execute(t *testing.T) {
+	t.Run("emits response", func(t *testing.T) {
+		respond := &Respond{}
+		stateCtx := &contexts.ExecutionStateContext{}
+
+		err := respond.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"status":  float64(201),
+				"headers": []any{map[string]any{"name": "X-Request-Id", "value": "123"}},
+				"body":    map[string]any{"id": "abc"},
+			},
+			ExecutionState: stateCtx,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, stateCtx.Passed)
+		assert.Equal(t, "default", stateCtx.Channel)
+		assert.Equal(t, PayloadType, stateCtx.Type)
+		require.Len(t, stateCtx.Payloads, 1)
+
+		payload := stateCtx.Payloads[0].(map[string]any)["data"].(map[string]any)
+		assert.Equal(t, 201, payload["status"])
+		assert.Equal(t, map[string]string{"X-Request-Id": "123"}, payload["headers"])
+		assert.Equal(t, map[string]any{"id": "abc"}, payload["body"])
+	})
+
+	t.Run("invalid status fails execution", func(t *testing.T) {
+		respond := &Respond{}
+		stateCtx := &contexts.ExecutionStateContext{}
+
+		err := respond.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"status": 1000},
+			ExecutionState: stateCtx,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, stateCtx.Finished)
+		assert.False(t, stateCtx.Passed)
+		assert.Equal(t, models.CanvasNodeExecutionResultReasonError, stateCtx.FailureReason)
+	})
+}
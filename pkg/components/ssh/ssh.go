@@ -3,10 +3,12 @@ package ssh
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/mitchellh/mapstructure"
+	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/registry"
@@ -49,8 +51,12 @@ type Spec struct {
 	Command          string   `json:"command" mapstructure:"command"`
 	WorkingDirectory string   `json:"workingDirectory,omitempty" mapstructure:"workingDirectory"`
 	Timeout          int      `json:"timeout" mapstructure:"timeout"` // command timeout in seconds (default 60)
+	OIDCAudience     string   `json:"oidcAudience,omitempty" mapstructure:"oidcAudience"`
 }
 
+// OIDCTokenEnvVar is the environment variable holding the OIDC token for the command, when requested.
+const OIDCTokenEnvVar = "SUPERPLANE_OIDC_TOKEN"
+
 type ExecutionMetadata struct {
 	Result *CommandResult `json:"result" mapstructure:"result"`
 }
@@ -76,6 +82,7 @@ Choose **SSH key** or **Password**, then select the organization Secret and the
 - **Command**: The command to run (supports expressions).
 - **Working directory**: Optional; Changes to this directory before running the command.
 - **Timeout (seconds)**: How long the command may run (default 60).
+- **OIDC Token**: Optional; Exposes a short-lived OIDC token identifying this execution to the command, in the ` + "`SUPERPLANE_OIDC_TOKEN`" + ` environment variable. Use it to exchange for cloud credentials through workload identity federation.
 
 ## Output
 
@@ -212,6 +219,15 @@ func (c *SSHCommand) Configuration() []configuration.Field {
 			Default:     60,
 			Description: "Limit how long the command may run (seconds).",
 		},
+		{
+			Name:        "oidcAudience",
+			Label:       "OIDC Token",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Togglable:   true,
+			Description: "Audience of the OIDC token exposed to the command in the SUPERPLANE_OIDC_TOKEN environment variable",
+			Placeholder: "e.g. sts.amazonaws.com",
+		},
 	}
 }
 
@@ -334,11 +350,25 @@ func (c *SSHCommand) Execute(ctx core.ExecutionContext) error {
 
 	ctx.Logger.Infof("Executing SSH command on %s@%s:%d: %s", spec.User, spec.Host, port, command)
 
+	if spec.OIDCAudience != "" {
+		oidcCommand, err := withOIDCToken(ctx.OIDC, spec.OIDCAudience, command)
+		if err != nil {
+			return err
+		}
+
+		command = oidcCommand
+
+		ctx.Logger.Infof("Exposing OIDC token for audience %s to the command", spec.OIDCAudience)
+	}
+
 	result, err := client.ExecuteCommand(command, timeout)
 	if err != nil {
 		return fmt.Errorf("SSH execution failed: %w", err)
 	}
 
+	logCommandOutput(ctx.Logger, result)
+	ctx.Logger.Infof("SSH command exited with code %d", result.ExitCode)
+
 	if err := ctx.Metadata.Set(ExecutionMetadata{Result: result}); err != nil {
 		return fmt.Errorf("set metadata: %w", err)
 	}
@@ -349,6 +379,27 @@ func (c *SSHCommand) Execute(ctx core.ExecutionContext) error {
 	return ctx.ExecutionState.Emit(channelFailed, "ssh.command.failed", []any{result})
 }
 
+// logCommandOutput logs each line of the command output,
+// so it shows up in the execution logs while debugging.
+func logCommandOutput(logger *log.Entry, result *CommandResult) {
+	for _, line := range outputLines(result.Stdout) {
+		logger.WithField("stream", "stdout").Info(line)
+	}
+
+	for _, line := range outputLines(result.Stderr) {
+		logger.WithField("stream", "stderr").Warn(line)
+	}
+}
+
+func outputLines(output string) []string {
+	output = strings.TrimRight(output, "\n")
+	if output == "" {
+		return nil
+	}
+
+	return strings.Split(output, "\n")
+}
+
 func (c *SSHCommand) Cancel(ctx core.ExecutionContext) error {
 	return nil
 }
@@ -372,3 +423,18 @@ func (c *SSHCommand) HandleWebhook(ctx core.WebhookRequestContext) (int, error)
 func (c *SSHCommand) Cleanup(ctx core.SetupContext) error {
 	return nil
 }
+
+// withOIDCToken exports an OIDC token for the audience
+// in the OIDCTokenEnvVar environment variable, before running the command.
+func withOIDCToken(oidc core.OIDCContext, audience, command string) (string, error) {
+	if oidc == nil {
+		return "", fmt.Errorf("OIDC tokens are not available")
+	}
+
+	token, err := oidc.Token(audience, 0)
+	if err != nil {
+		return "", fmt.Errorf("generate OIDC token: %w", err)
+	}
+
+	return fmt.Sprintf("export %s='%s'; %s", OIDCTokenEnvVar, token, command), nil
+}
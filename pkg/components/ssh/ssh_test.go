@@ -140,3 +140,25 @@ func TestSSHCommand_Execute_RequiresSecretsContext(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "secrets")
 }
+
+func TestSSHCommand_OutputLines(t *testing.T) {
+	assert.Nil(t, outputLines(""))
+	assert.Nil(t, outputLines("\n"))
+	assert.Equal(t, []string{"one", "", "two"}, outputLines("one\n\ntwo\n"))
+}
+
+func TestSSHCommand_WithOIDCToken(t *testing.T) {
+	t.Run("token is exported before the command", func(t *testing.T) {
+		oidcCtx := &contexts.OIDCContext{}
+
+		command, err := withOIDCToken(oidcCtx, "sts.amazonaws.com", "aws sts get-caller-identity")
+		require.NoError(t, err)
+		assert.Equal(t, "export SUPERPLANE_OIDC_TOKEN='oidc-token'; aws sts get-caller-identity", command)
+		assert.Equal(t, []string{"sts.amazonaws.com"}, oidcCtx.Audiences)
+	})
+
+	t.Run("OIDC context not available -> error", func(t *testing.T) {
+		_, err := withOIDCToken(nil, "sts.amazonaws.com", "ls")
+		require.EqualError(t, err, "OIDC tokens are not available")
+	})
+}
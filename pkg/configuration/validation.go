@@ -2,6 +2,7 @@ package configuration
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
 	"slices"
@@ -500,28 +501,36 @@ func validateDayInYear(field Field, value any) error {
 		format = field.TypeOptions.DayInYear.Format
 	}
 
-	var month, day int
-	var extra string
-	n, err := fmt.Sscanf(dayStr, "%d/%d%s", &month, &day, &extra)
-
-	if n < 2 || n > 2 {
+	_, _, err := ParseDayInYear(dayStr)
+	if errors.Is(err, errInvalidDayInYearFormat) {
 		return fmt.Errorf("must be a valid day in format %s (e.g., 12/25)", format)
 	}
 
-	if err != nil && n < 2 {
-		return fmt.Errorf("must be a valid day in format %s (e.g., 12/25)", format)
+	return err
+}
+
+var errInvalidDayInYearFormat = errors.New("invalid day format")
+
+// ParseDayInYear parses a day of the year in the MM/DD format,
+// used by day-in-year fields, returning its month and day.
+func ParseDayInYear(value string) (int, int, error) {
+	var month, day int
+	var extra string
+	n, _ := fmt.Sscanf(value, "%d/%d%s", &month, &day, &extra)
+	if n != 2 {
+		return 0, 0, fmt.Errorf("%w '%s': expected MM/DD (e.g., 12/25)", errInvalidDayInYearFormat, value)
 	}
 
 	if month < 1 || month > 12 || day < 1 || day > 31 {
-		return fmt.Errorf("invalid day values: month must be 1-12, day must be 1-31")
+		return 0, 0, fmt.Errorf("invalid day values: month must be 1-12, day must be 1-31")
 	}
 
 	daysInMonth := []int{0, 31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
 	if day > daysInMonth[month] {
-		return fmt.Errorf("invalid day '%d' for month '%d'", day, month)
+		return 0, 0, fmt.Errorf("invalid day '%d' for month '%d'", day, month)
 	}
 
-	return nil
+	return month, day, nil
 }
 
 func validateCron(_ Field, value any) error {
@@ -449,3 +449,22 @@ func TestValidateDayInYearComparison(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDayInYear(t *testing.T) {
+	month, day, err := ParseDayInYear("02/29")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, month)
+	assert.Equal(t, 29, day)
+
+	_, _, err = ParseDayInYear("12/25/2025")
+	assert.ErrorContains(t, err, "expected MM/DD")
+
+	_, _, err = ParseDayInYear("13/01")
+	assert.ErrorContains(t, err, "month must be 1-12")
+
+	_, _, err = ParseDayInYear("04/31")
+	assert.EqualError(t, err, "invalid day '31' for month '4'")
+
+	err = validateDayInYear(Field{Name: "day"}, "not-a-day")
+	assert.EqualError(t, err, "must be a valid day in format MM/DD (e.g., 12/25)")
+}
@@ -153,6 +153,7 @@ type ExecutionContext struct {
 	Integration    IntegrationContext
 	Notifications  NotificationContext
 	Secrets        SecretsContext
+	OIDC           OIDCContext
 }
 
 /*
@@ -251,6 +252,7 @@ type ActionContext struct {
 	Requests       RequestContext
 	Integration    IntegrationContext
 	Notifications  NotificationContext
+	OIDC           OIDCContext
 }
 
 /*
@@ -324,6 +326,21 @@ type SecretsContext interface {
 	GetKey(secretName, keyName string) ([]byte, error)
 }
 
+/*
+ * OIDCContext allows components to mint short-lived OIDC tokens
+ * identifying the organization, canvas, node and execution they run for.
+ * External systems can trust these tokens through the public JWKS endpoint,
+ * instead of relying on long-lived credentials.
+ */
+type OIDCContext interface {
+
+	//
+	// Issues a token for the given audience.
+	// If duration is zero, a default short-lived duration is used.
+	//
+	Token(audience string, duration time.Duration) (string, error)
+}
+
 type User struct {
 	ID    string `mapstructure:"id" json:"id"`
 	Name  string `mapstructure:"name" json:"name"`
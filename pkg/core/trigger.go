@@ -2,6 +2,8 @@ package core
 
 import (
 	"net/http"
+	"net/url"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/configuration"
@@ -112,6 +114,9 @@ type TriggerActionContext struct {
 type WebhookRequestContext struct {
 	Body          []byte
 	Headers       http.Header
+	Method        string
+	Query         url.Values
+	RemoteIP      string
 	WorkflowID    string
 	NodeID        string
 	Configuration any
@@ -130,6 +135,28 @@ type WebhookRequestContext struct {
 	// Do not make HTTP calls as part of handling the webhook. This is useful for
 	// retrieving more data that is not part of the webhook payload.
 	HTTP HTTPContext
+
+	//
+	// Allows the handler to customize the response returned to the caller.
+	// If not changed, an empty response with the handler status code is returned.
+	//
+	Response *WebhookResponse
+}
+
+/*
+ * WebhookResponse is the response returned to the caller of a webhook.
+ */
+type WebhookResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+
+	//
+	// If set, the caller is held until a respond component
+	// in the execution chain started by the request emits,
+	// for up to this duration.
+	//
+	WaitTimeout time.Duration
 }
 
 type NodeWebhookContext interface {
@@ -7,13 +7,17 @@ import (
 )
 
 func VerifySignature(key []byte, data []byte, signature string) error {
-	h := hmac.New(sha256.New, key)
-	h.Write(data)
-
-	computed := fmt.Sprintf("%x", h.Sum(nil))
+	computed := ComputeSignature(key, data)
 	if computed != signature {
 		return fmt.Errorf("invalid signature")
 	}
 
 	return nil
 }
+
+// ComputeSignature returns the hex-encoded HMAC-SHA256 of data.
+func ComputeSignature(key []byte, data []byte) string {
+	h := hmac.New(sha256.New, key)
+	h.Write(data)
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
@@ -0,0 +1,124 @@
+package crypto
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+)
+
+// Associated data used when sealing, so boxes are not mixed
+// with other data encrypted with the AES-GCM encryptor.
+const publicKeyBoxContext = "superplane-public-key-box"
+
+/*
+ * SealWithPublicKey encrypts data so only the holder of the private key
+ * for the PEM-encoded RSA public key can decrypt it. The data is encrypted
+ * with a random AES-GCM key, which is encrypted with RSA-OAEP.
+ *
+ * The result is the length of the encrypted key, the encrypted key,
+ * and the nonce and ciphertext of the data.
+ */
+func SealWithPublicKey(publicKeyPEM []byte, data []byte) ([]byte, error) {
+	publicKey, err := parseRSAPublicKey(publicKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+
+	encryptedKey, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, publicKey, key, []byte(publicKeyBoxContext))
+	if err != nil {
+		return nil, fmt.Errorf("error encrypting key: %v", err)
+	}
+
+	ciphertext, err := NewAESGCMEncryptor(key).Encrypt(context.Background(), data, []byte(publicKeyBoxContext))
+	if err != nil {
+		return nil, err
+	}
+
+	box := binary.BigEndian.AppendUint16(nil, uint16(len(encryptedKey)))
+	box = append(box, encryptedKey...)
+	return append(box, ciphertext...), nil
+}
+
+// OpenWithPrivateKey decrypts data sealed with SealWithPublicKey.
+func OpenWithPrivateKey(privateKeyPEM []byte, box []byte) ([]byte, error) {
+	privateKey, err := parseRSAPrivateKey(privateKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(box) < 2 {
+		return nil, fmt.Errorf("invalid box")
+	}
+
+	keySize := int(binary.BigEndian.Uint16(box))
+
+	//
+	// AES-GCM uses a 12 bytes nonce and a 16 bytes tag.
+	//
+	if len(box) < 2+keySize+12+16 {
+		return nil, fmt.Errorf("invalid box")
+	}
+
+	key, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, privateKey, box[2:2+keySize], []byte(publicKeyBoxContext))
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting key: %v", err)
+	}
+
+	return NewAESGCMEncryptor(key).Decrypt(context.Background(), box[2+keySize:], []byte(publicKeyBoxContext))
+}
+
+func parseRSAPublicKey(publicKeyPEM []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(publicKeyPEM)
+	if block == nil {
+		return nil, fmt.Errorf("invalid public key: no PEM data found")
+	}
+
+	if block.Type == "RSA PUBLIC KEY" {
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %v", err)
+	}
+
+	publicKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("invalid public key: only RSA keys are supported")
+	}
+
+	return publicKey, nil
+}
+
+func parseRSAPrivateKey(privateKeyPEM []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(privateKeyPEM)
+	if block == nil {
+		return nil, fmt.Errorf("invalid private key: no PEM data found")
+	}
+
+	if block.Type == "RSA PRIVATE KEY" {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %v", err)
+	}
+
+	privateKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("invalid private key: only RSA keys are supported")
+	}
+
+	return privateKey, nil
+}
@@ -0,0 +1,56 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testRSAKeys(t *testing.T) ([]byte, []byte) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	privateKey, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKey})
+}
+
+func Test__PublicKeyBox(t *testing.T) {
+	publicKey, privateKey := testRSAKeys(t)
+
+	t.Run("sealed data is opened with the private key", func(t *testing.T) {
+		box, err := SealWithPublicKey(publicKey, []byte("secret values"))
+		require.NoError(t, err)
+		require.NotContains(t, string(box), "secret values")
+
+		data, err := OpenWithPrivateKey(privateKey, box)
+		require.NoError(t, err)
+		require.Equal(t, []byte("secret values"), data)
+	})
+
+	t.Run("other private key -> error", func(t *testing.T) {
+		_, otherPrivateKey := testRSAKeys(t)
+		box, err := SealWithPublicKey(publicKey, []byte("secret values"))
+		require.NoError(t, err)
+
+		_, err = OpenWithPrivateKey(otherPrivateKey, box)
+		require.ErrorContains(t, err, "error decrypting key")
+	})
+
+	t.Run("invalid keys and boxes -> error", func(t *testing.T) {
+		_, err := SealWithPublicKey([]byte("not a key"), []byte("data"))
+		require.EqualError(t, err, "invalid public key: no PEM data found")
+
+		_, err = OpenWithPrivateKey(privateKey, []byte{0, 10, 1})
+		require.EqualError(t, err, "invalid box")
+	})
+}
@@ -43,7 +43,9 @@ func dbPoolSize() int {
 	return size
 }
 
-func connect() *gorm.DB {
+// DSN returns the connection string for the database
+// configured through the environment.
+func DSN() string {
 	postgresDbSSL := os.Getenv("POSTGRES_DB_SSL")
 	sslMode := "disable"
 	if postgresDbSSL == "true" {
@@ -61,7 +63,11 @@ func connect() *gorm.DB {
 	}
 
 	dsnTemplate := "host=%s port=%s user=%s password=%s dbname=%s sslmode=%s application_name=%s"
-	dsn := fmt.Sprintf(dsnTemplate, c.Host, c.Port, c.User, c.Pass, c.Name, c.Ssl, c.ApplicationName)
+	return fmt.Sprintf(dsnTemplate, c.Host, c.Port, c.User, c.Pass, c.Name, c.Ssl, c.ApplicationName)
+}
+
+func connect() *gorm.DB {
+	dsn := DSN()
 
 	logger := gormLogger.New(log.New(os.Stdout, "\r\n", log.LstdFlags), gormLogger.Config{
 		SlowThreshold:             200 * time.Millisecond,
@@ -95,9 +101,12 @@ func TruncateTables() error {
 			accounts,
 			account_providers,
 			users,
+			cli_tokens,
 			organizations,
 			organization_invitations,
 			organization_invite_links,
+			organization_webhook_subscriptions,
+			organization_webhook_deliveries,
 			app_installations,
 			app_installation_secrets,
 			app_installation_requests,
@@ -110,9 +119,11 @@ func TruncateTables() error {
 			workflow_nodes,
 			workflow_events,
 			workflow_node_execution_kvs,
+			workflow_node_execution_logs,
 			workflow_node_executions,
 			workflow_node_queue_items,
 			workflow_node_requests,
+			webhook_deliveries,
 			webhooks
 		restart identity cascade;
 	`).Error
@@ -0,0 +1,128 @@
+package database
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	ListenerMinReconnectDelay = 500 * time.Millisecond
+	ListenerMaxReconnectDelay = 30 * time.Second
+)
+
+/*
+ * Listener keeps a dedicated connection to the database,
+ * listening for notifications sent with NOTIFY / pg_notify(),
+ * and wakes up the subscribers of each channel.
+ *
+ * Wakeups are coalesced: a subscriber that is busy when
+ * several notifications arrive is woken up only once.
+ */
+type Listener struct {
+	dsn         string
+	mu          sync.Mutex
+	subscribers map[string][]chan struct{}
+	logger      *log.Entry
+}
+
+func NewListener(dsn string) *Listener {
+	return &Listener{
+		dsn:         dsn,
+		subscribers: map[string][]chan struct{}{},
+		logger:      log.WithFields(log.Fields{"worker": "DatabaseListener"}),
+	}
+}
+
+// Subscribe returns a channel that receives a value
+// whenever a notification is sent to the given channel.
+// Subscriptions must be created before the listener is started.
+func (l *Listener) Subscribe(channel string) <-chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	ch := make(chan struct{}, 1)
+	l.subscribers[channel] = append(l.subscribers[channel], ch)
+	return ch
+}
+
+func (l *Listener) Start(ctx context.Context) {
+	delay := ListenerMinReconnectDelay
+
+	for {
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		l.logger.Errorf("Error listening for notifications - reconnecting in %v: %v", delay, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		delay = min(delay*2, ListenerMaxReconnectDelay)
+	}
+}
+
+func (l *Listener) listen(ctx context.Context) error {
+	conn, err := pgx.Connect(ctx, l.dsn)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close(context.Background())
+
+	for _, channel := range l.channels() {
+		_, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize())
+		if err != nil {
+			return err
+		}
+	}
+
+	//
+	// Notifications sent while we were not listening are lost,
+	// so every subscriber is woken up after (re)connecting.
+	//
+	for _, channel := range l.channels() {
+		l.notify(channel)
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		l.notify(notification.Channel)
+	}
+}
+
+func (l *Listener) channels() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	channels := make([]string, 0, len(l.subscribers))
+	for channel := range l.subscribers {
+		channels = append(channels, channel)
+	}
+
+	return channels
+}
+
+func (l *Listener) notify(channel string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, ch := range l.subscribers[channel] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
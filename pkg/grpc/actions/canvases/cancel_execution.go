@@ -9,7 +9,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/crypto"
-	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
@@ -41,14 +41,15 @@ func CancelExecution(ctx context.Context, authService authorization.Authorizatio
 		return nil, status.Error(codes.InvalidArgument, "cannot cancel child execution directly, cancel the parent execution instead")
 	}
 
-	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+	var cancelled []*models.CanvasNodeExecution
+	err = models.TransactionWithMetrics(func(tx *gorm.DB) error {
 		node, err := models.FindCanvasNode(tx, workflowID, execution.NodeID)
 
 		if err != nil {
 			return status.Error(codes.NotFound, "Node not found for execution")
 		}
 
-		err = cancelExecutionInTransaction(tx, authService, encryptor, organizationID, registry, execution, node, user)
+		cancelled, err = cancelExecutionInTransaction(tx, authService, encryptor, organizationID, registry, execution, node, user)
 
 		if err != nil {
 			return status.Error(codes.Internal, "It was not possible to cancel the execution")
@@ -61,16 +62,31 @@ func CancelExecution(ctx context.Context, authService authorization.Authorizatio
 		return nil, err
 	}
 
+	//
+	// Cancelled executions are finished like any other,
+	// so consumers are told the same way as when they complete.
+	//
+	for _, execution := range cancelled {
+		messages.NewCanvasExecutionMessage(execution.WorkflowID.String(), execution.ID.String(), execution.NodeID).
+			WithState(models.CanvasNodeExecutionStateFinished).
+			Publish()
+	}
+
 	return &pb.CancelExecutionResponse{}, nil
 }
 
-func cancelExecutionInTransaction(tx *gorm.DB, authService authorization.Authorization, encryptor crypto.Encryptor, organizationID string, registry *registry.Registry, execution *models.CanvasNodeExecution, node *models.CanvasNode, user *models.User) error {
+// cancelExecutionInTransaction cancels an execution and its children,
+// returning all the executions cancelled.
+func cancelExecutionInTransaction(tx *gorm.DB, authService authorization.Authorization, encryptor crypto.Encryptor, organizationID string, registry *registry.Registry, execution *models.CanvasNodeExecution, node *models.CanvasNode, user *models.User) ([]*models.CanvasNodeExecution, error) {
+	cancelled := []*models.CanvasNodeExecution{}
 	if node.Type == models.NodeTypeBlueprint {
-		err := cancelChildExecutions(tx, authService, organizationID, encryptor, registry, execution, user)
+		children, err := cancelChildExecutions(tx, authService, organizationID, encryptor, registry, execution, user)
 		if err != nil {
 			log.Errorf("failed to cancel child executions for %s: %v", execution.ID.String(), err)
-			return err
+			return nil, err
 		}
+
+		cancelled = append(cancelled, children...)
 	}
 
 	if node.Type == models.NodeTypeComponent {
@@ -79,7 +95,7 @@ func cancelExecutionInTransaction(tx *gorm.DB, authService authorization.Authori
 			component, err := registry.GetComponent(ref.Component.Name)
 			if err != nil {
 				log.Errorf("component %s not found: %v", ref.Component.Name, err)
-				return err
+				return nil, err
 			}
 
 			logger := logging.ForExecution(execution, nil)
@@ -100,7 +116,7 @@ func cancelExecutionInTransaction(tx *gorm.DB, authService authorization.Authori
 				integration, err := models.FindUnscopedIntegrationInTransaction(tx, *node.AppInstallationID)
 				if err != nil {
 					logger.Errorf("error finding app installation: %v", err)
-					return status.Error(codes.Internal, "error building context")
+					return nil, status.Error(codes.Internal, "error building context")
 				}
 
 				logger = logging.WithIntegration(logger, *integration)
@@ -119,7 +135,12 @@ func cancelExecutionInTransaction(tx *gorm.DB, authService authorization.Authori
 		cancelledBy = &user.ID
 	}
 
-	return execution.CancelInTransaction(tx, cancelledBy)
+	err := execution.CancelInTransaction(tx, cancelledBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(cancelled, execution), nil
 }
 
 func cancelChildExecutions(
@@ -130,7 +151,7 @@ func cancelChildExecutions(
 	registry *registry.Registry,
 	parentExecution *models.CanvasNodeExecution,
 	user *models.User,
-) error {
+) ([]*models.CanvasNodeExecution, error) {
 	childExecutions, err := models.FindChildExecutionsInTransaction(
 		tx,
 		parentExecution.ID,
@@ -138,11 +159,11 @@ func cancelChildExecutions(
 	)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if len(childExecutions) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	nodeIDMap := make(map[string]bool)
@@ -157,7 +178,7 @@ func cancelChildExecutions(
 
 	nodes, err := models.FindCanvasNodesByIDs(tx, parentExecution.WorkflowID, nodeIDs)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	nodeMap := make(map[string]*models.CanvasNode)
@@ -165,19 +186,22 @@ func cancelChildExecutions(
 		nodeMap[nodes[i].NodeID] = &nodes[i]
 	}
 
+	cancelled := []*models.CanvasNodeExecution{}
 	for _, childExecution := range childExecutions {
 		childNode, exists := nodeMap[childExecution.NodeID]
 		if !exists {
 			log.Errorf("failed to find child node %s in fetched nodes", childExecution.NodeID)
-			return err
+			return nil, err
 		}
 
-		err = cancelExecutionInTransaction(tx, authService, encryptor, organizationID, registry, &childExecution, childNode, user)
+		children, err := cancelExecutionInTransaction(tx, authService, encryptor, organizationID, registry, &childExecution, childNode, user)
 		if err != nil {
 			log.Errorf("failed to cancel child execution %s: %v", childExecution.ID.String(), err)
-			return err
+			return nil, err
 		}
+
+		cancelled = append(cancelled, children...)
 	}
 
-	return nil
+	return cancelled, nil
 }
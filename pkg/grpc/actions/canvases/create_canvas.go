@@ -6,8 +6,10 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/authentication"
 	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
 	"github.com/superplanehq/superplane/pkg/registry"
@@ -108,6 +110,11 @@ func CreateCanvas(ctx context.Context, registry *registry.Registry, organization
 		return nil, err
 	}
 
+	err = messages.NewCanvasChangedMessage(organizationID, canvas.ID.String(), pb.CanvasChangedMessage_ACTION_CREATED).Publish()
+	if err != nil {
+		log.Errorf("failed to publish canvas created message for canvas %s: %v", canvas.ID, err)
+	}
+
 	proto, err := SerializeCanvas(&canvas, false)
 	if err != nil {
 		return nil, err
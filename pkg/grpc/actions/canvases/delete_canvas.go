@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
 	"github.com/superplanehq/superplane/pkg/registry"
@@ -42,5 +43,10 @@ func DeleteCanvas(ctx context.Context, registry *registry.Registry, organization
 		return nil, status.Error(codes.Internal, "failed to delete canvas")
 	}
 
+	err = messages.NewCanvasChangedMessage(organizationID.String(), canvas.ID.String(), pb.CanvasChangedMessage_ACTION_DELETED).Publish()
+	if err != nil {
+		log.Errorf("failed to publish canvas deleted message for canvas %s: %v", canvas.ID, err)
+	}
+
 	return &pb.DeleteCanvasResponse{}, nil
 }
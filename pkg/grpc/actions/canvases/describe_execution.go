@@ -0,0 +1,48 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func DescribeExecution(ctx context.Context, orgID uuid.UUID, canvasID uuid.UUID, executionID uuid.UUID) (*pb.DescribeExecutionResponse, error) {
+	_, err := models.FindCanvas(orgID, canvasID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "canvas not found")
+		}
+
+		return nil, err
+	}
+
+	execution, err := models.FindNodeExecution(canvasID, executionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "execution not found")
+		}
+
+		return nil, err
+	}
+
+	executions := []models.CanvasNodeExecution{*execution}
+	childExecutions, err := models.FindChildExecutionsForMultiple(executionIDs(executions))
+	if err != nil {
+		return nil, err
+	}
+
+	serialized, err := SerializeNodeExecutions(executions, childExecutions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DescribeExecutionResponse{
+		Execution: serialized[0],
+	}, nil
+}
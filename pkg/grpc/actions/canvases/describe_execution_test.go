@@ -0,0 +1,69 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func Test__DescribeExecution(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "Node 1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	event := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", event.ID, event.ID, nil)
+
+	t.Run("canvas does not exist -> not found", func(t *testing.T) {
+		_, err := DescribeExecution(context.Background(), r.Organization.ID, uuid.New(), execution.ID)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("canvas of another organization -> not found", func(t *testing.T) {
+		_, err := DescribeExecution(context.Background(), uuid.New(), canvas.ID, execution.ID)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("execution does not exist -> not found", func(t *testing.T) {
+		_, err := DescribeExecution(context.Background(), r.Organization.ID, canvas.ID, uuid.New())
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("execution is returned", func(t *testing.T) {
+		response, err := DescribeExecution(context.Background(), r.Organization.ID, canvas.ID, execution.ID)
+		require.NoError(t, err)
+		require.NotNil(t, response.Execution)
+		assert.Equal(t, execution.ID.String(), response.Execution.Id)
+		assert.Equal(t, "node-1", response.Execution.NodeId)
+		assert.Equal(t, pb.CanvasNodeExecution_STATE_PENDING, response.Execution.State)
+		assert.Equal(t, event.ID.String(), response.Execution.RootEvent.Id)
+	})
+}
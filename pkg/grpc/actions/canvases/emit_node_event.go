@@ -12,7 +12,9 @@ import (
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	"go.opentelemetry.io/otel/trace"
 	"gorm.io/datatypes"
 )
 
@@ -49,7 +51,17 @@ func EmitNodeEvent(
 		event.CustomName = customName
 	}
 
-	if err := database.Conn().Create(&event).Error; err != nil {
+	spanCtx, span := telemetry.StartSpan(ctx, "canvas.event", trace.WithAttributes(
+		telemetry.AttributeCanvasID.String(canvas.ID.String()),
+		telemetry.AttributeNodeID.String(nodeID),
+	))
+
+	event.Traceparent = telemetry.Traceparent(spanCtx)
+	err = database.Conn().Create(&event).Error
+	span.SetAttributes(telemetry.AttributeEventID.String(event.ID.String()))
+	telemetry.EndSpan(span, err)
+
+	if err != nil {
 		log.Errorf("failed to publish workflow event: %v", err)
 		return nil, fmt.Errorf("failed to create workflow event: %w", err)
 	}
@@ -0,0 +1,119 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultCanvasMetricsWindow = 24 * time.Hour
+	MaxCanvasMetricsWindow     = 90 * 24 * time.Hour
+)
+
+func GetCanvasMetrics(ctx context.Context, orgID uuid.UUID, canvasID uuid.UUID, startTime, endTime *timestamppb.Timestamp, nodeIDs []string) (*pb.GetCanvasMetricsResponse, error) {
+	_, err := models.FindCanvas(orgID, canvasID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "canvas not found")
+		}
+
+		return nil, err
+	}
+
+	start, end, err := metricsWindow(startTime, endTime, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	canvasMetrics, err := models.FindCanvasExecutionMetrics(canvasID, nodeIDs, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeMetrics, err := models.ListNodeExecutionMetrics(canvasID, nodeIDs, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*pb.ExecutionMetrics, 0, len(nodeMetrics))
+	for _, metrics := range nodeMetrics {
+		nodes = append(nodes, serializeExecutionMetrics(&metrics))
+	}
+
+	return &pb.GetCanvasMetricsResponse{
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(end),
+		Canvas:    serializeExecutionMetrics(canvasMetrics),
+		Nodes:     nodes,
+	}, nil
+}
+
+func metricsWindow(startTime, endTime *timestamppb.Timestamp, now time.Time) (time.Time, time.Time, error) {
+	end := now
+	if endTime != nil {
+		end = endTime.AsTime()
+	}
+
+	start := end.Add(-DefaultCanvasMetricsWindow)
+	if startTime != nil {
+		start = startTime.AsTime()
+	}
+
+	if !start.Before(end) {
+		return start, end, status.Error(codes.InvalidArgument, "start_time must be before end_time")
+	}
+
+	if end.Sub(start) > MaxCanvasMetricsWindow {
+		return start, end, status.Error(codes.InvalidArgument, "time window cannot be longer than 90 days")
+	}
+
+	return start, end, nil
+}
+
+func serializeExecutionMetrics(metrics *models.ExecutionMetrics) *pb.ExecutionMetrics {
+	results := make([]*pb.ExecutionMetrics_ResultCount, 0, len(metrics.Results))
+	for _, result := range metrics.Results {
+		results = append(results, &pb.ExecutionMetrics_ResultCount{
+			Result:       NodeExecutionResultToProto(result.Result),
+			ResultReason: NodeExecutionResultReasonToProto(result.ResultReason),
+			Count:        uint32(result.Count),
+		})
+	}
+
+	return &pb.ExecutionMetrics{
+		NodeId:       metrics.NodeID,
+		Component:    metrics.Component,
+		Executions:   uint32(metrics.Executions),
+		Results:      results,
+		Duration:     serializeDurationStats(metrics.Duration),
+		QueueWait:    serializeDurationStats(metrics.QueueWait),
+		ApprovalWait: serializeDurationStats(metrics.ApprovalWait),
+	}
+}
+
+func serializeDurationStats(stats models.ExecutionDurationStats) *pb.DurationStats {
+	valueOf := func(v *float64) float64 {
+		if v == nil {
+			return 0
+		}
+
+		return *v
+	}
+
+	return &pb.DurationStats{
+		Count:          uint32(stats.Count),
+		AverageSeconds: valueOf(stats.Average),
+		P50Seconds:     valueOf(stats.P50),
+		P95Seconds:     valueOf(stats.P95),
+		MaxSeconds:     valueOf(stats.Max),
+	}
+}
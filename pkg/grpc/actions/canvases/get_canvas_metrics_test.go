@@ -0,0 +1,133 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/datatypes"
+)
+
+func Test__GetCanvasMetrics(t *testing.T) {
+	r := support.Setup(t)
+	ctx := context.Background()
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "trigger-1",
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: "noop-1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+			{
+				NodeID: "approval-1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "approval"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: "trigger-1", TargetID: "noop-1", Channel: "default"},
+			{SourceID: "noop-1", TargetID: "approval-1", Channel: "default"},
+		},
+	)
+
+	now := time.Now()
+	finishExecution := func(nodeID string, queued, started time.Duration, result, reason string) {
+		event := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, nodeID, event.ID, event.ID, nil)
+		require.NoError(t, database.Conn().Model(execution).Updates(map[string]any{
+			"state":         models.CanvasNodeExecutionStateFinished,
+			"result":        result,
+			"result_reason": reason,
+			"queued_at":     now.Add(-queued),
+			"started_at":    now.Add(-started),
+			"finished_at":   now,
+		}).Error)
+	}
+
+	finishExecution("noop-1", 12*time.Second, 10*time.Second, models.CanvasNodeExecutionResultPassed, models.CanvasNodeExecutionResultReasonOk)
+	finishExecution("noop-1", 25*time.Second, 20*time.Second, models.CanvasNodeExecutionResultFailed, models.CanvasNodeExecutionResultReasonError)
+	finishExecution("approval-1", 61*time.Second, time.Minute, models.CanvasNodeExecutionResultPassed, models.CanvasNodeExecutionResultReasonOk)
+
+	t.Run("canvas not found -> error", func(t *testing.T) {
+		_, err := GetCanvasMetrics(ctx, r.Organization.ID, uuid.New(), nil, nil, nil)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("aggregates for canvas and nodes", func(t *testing.T) {
+		response, err := GetCanvasMetrics(ctx, r.Organization.ID, canvas.ID, nil, nil, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(3), response.Canvas.Executions)
+		assert.Equal(t, uint32(3), response.Canvas.Duration.Count)
+		assert.InDelta(t, 60, response.Canvas.Duration.MaxSeconds, 0.01)
+		assert.Equal(t, uint32(1), response.Canvas.ApprovalWait.Count)
+		assert.InDelta(t, 60, response.Canvas.ApprovalWait.AverageSeconds, 0.01)
+
+		require.Len(t, response.Nodes, 2)
+		approval := response.Nodes[0]
+		assert.Equal(t, "approval-1", approval.NodeId)
+		assert.Equal(t, "approval", approval.Component)
+		assert.Equal(t, uint32(1), approval.Executions)
+
+		noop := response.Nodes[1]
+		assert.Equal(t, "noop-1", noop.NodeId)
+		assert.Equal(t, "noop", noop.Component)
+		assert.Equal(t, uint32(2), noop.Executions)
+		assert.InDelta(t, 15, noop.Duration.AverageSeconds, 0.01)
+		assert.InDelta(t, 3.5, noop.QueueWait.AverageSeconds, 0.01)
+		assert.Equal(t, uint32(0), noop.ApprovalWait.Count)
+		require.Len(t, noop.Results, 2)
+	})
+
+	t.Run("only selected nodes", func(t *testing.T) {
+		response, err := GetCanvasMetrics(ctx, r.Organization.ID, canvas.ID, nil, nil, []string{"approval-1"})
+		require.NoError(t, err)
+		require.Len(t, response.Nodes, 1)
+		assert.Equal(t, uint32(1), response.Canvas.Executions)
+		require.Len(t, response.Canvas.Results, 1)
+		assert.Equal(t, pb.CanvasNodeExecution_RESULT_PASSED, response.Canvas.Results[0].Result)
+	})
+
+	t.Run("executions outside of the window are not included", func(t *testing.T) {
+		response, err := GetCanvasMetrics(ctx, r.Organization.ID, canvas.ID, nil, timestamppb.New(now.Add(-time.Hour)), nil)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(0), response.Canvas.Executions)
+		assert.Empty(t, response.Nodes)
+	})
+}
+
+func Test__MetricsWindow(t *testing.T) {
+	now := time.Now()
+
+	start, end, err := metricsWindow(nil, nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, now, end)
+	assert.Equal(t, now.Add(-DefaultCanvasMetricsWindow), start)
+
+	_, _, err = metricsWindow(timestamppb.New(now), timestamppb.New(now.Add(-time.Hour)), now)
+	assert.ErrorContains(t, err, "start_time must be before end_time")
+
+	_, _, err = metricsWindow(timestamppb.New(now.Add(-MaxCanvasMetricsWindow-time.Hour)), nil, now)
+	assert.ErrorContains(t, err, "cannot be longer than 90 days")
+}
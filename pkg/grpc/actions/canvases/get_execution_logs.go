@@ -0,0 +1,135 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	ExecutionLogsPollInterval = time.Second
+	ExecutionLogsBatchSize    = 200
+)
+
+type GetExecutionLogsSender interface {
+	Send(*pb.GetExecutionLogsResponse) error
+}
+
+func GetExecutionLogs(ctx context.Context, orgID uuid.UUID, canvasID uuid.UUID, executionID uuid.UUID, follow bool, afterSequence uint64, sender GetExecutionLogsSender) error {
+	_, err := models.FindCanvas(orgID, canvasID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Error(codes.NotFound, "canvas not found")
+		}
+
+		return err
+	}
+
+	_, err = models.FindNodeExecution(canvasID, executionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Error(codes.NotFound, "execution not found")
+		}
+
+		return err
+	}
+
+	ticker := time.NewTicker(ExecutionLogsPollInterval)
+	defer ticker.Stop()
+
+	after := int64(afterSequence)
+	for {
+		//
+		// The state is checked before reading the lines,
+		// so no lines logged before the execution finished are missed.
+		//
+		finished := false
+		if follow {
+			execution, err := models.FindNodeExecution(canvasID, executionID)
+			if err != nil {
+				log.Errorf("error finding execution %s: %v", executionID, err)
+				return status.Error(codes.Internal, "error finding execution")
+			}
+
+			finished = execution.State == models.CanvasNodeExecutionStateFinished
+		}
+
+		after, err = sendExecutionLogs(executionID, after, sender)
+		if err != nil {
+			return err
+		}
+
+		if !follow || finished {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendExecutionLogs sends all lines after the given sequence,
+// and returns the sequence of the last line sent.
+func sendExecutionLogs(executionID uuid.UUID, after int64, sender GetExecutionLogsSender) (int64, error) {
+	for {
+		lines, err := models.ListCanvasNodeExecutionLogs(executionID, after, ExecutionLogsBatchSize)
+		if err != nil {
+			log.Errorf("error listing logs for execution %s: %v", executionID, err)
+			return after, status.Error(codes.Internal, "error listing execution logs")
+		}
+
+		if len(lines) == 0 {
+			return after, nil
+		}
+
+		err = sender.Send(&pb.GetExecutionLogsResponse{Lines: serializeExecutionLogLines(lines)})
+		if err != nil {
+			return after, err
+		}
+
+		after = lines[len(lines)-1].ID
+		if len(lines) < ExecutionLogsBatchSize {
+			return after, nil
+		}
+	}
+}
+
+func serializeExecutionLogLines(lines []models.CanvasNodeExecutionLog) []*pb.ExecutionLogLine {
+	serialized := make([]*pb.ExecutionLogLine, 0, len(lines))
+	for _, line := range lines {
+		serialized = append(serialized, &pb.ExecutionLogLine{
+			Sequence:  uint64(line.ID),
+			Level:     executionLogLevelToProto(line.Level),
+			Message:   line.Message,
+			Fields:    line.Fields.Data(),
+			Timestamp: timestamppb.New(*line.CreatedAt),
+		})
+	}
+
+	return serialized
+}
+
+func executionLogLevelToProto(level string) pb.ExecutionLogLine_Level {
+	switch level {
+	case models.CanvasNodeExecutionLogLevelInfo:
+		return pb.ExecutionLogLine_LEVEL_INFO
+	case models.CanvasNodeExecutionLogLevelWarning:
+		return pb.ExecutionLogLine_LEVEL_WARNING
+	case models.CanvasNodeExecutionLogLevelError:
+		return pb.ExecutionLogLine_LEVEL_ERROR
+	default:
+		return pb.ExecutionLogLine_LEVEL_UNKNOWN
+	}
+}
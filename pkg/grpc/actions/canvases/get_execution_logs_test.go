@@ -0,0 +1,177 @@
+package canvases
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/logging"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+type executionLogsSender struct {
+	lines []*pb.ExecutionLogLine
+}
+
+func (s *executionLogsSender) Send(response *pb.GetExecutionLogsResponse) error {
+	s.lines = append(s.lines, response.Lines...)
+	return nil
+}
+
+func Test__GetExecutionLogs(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "Node 1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	event := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", event.ID, event.ID, nil)
+
+	logger, logs := logging.WithExecutionLogs(logging.ForExecution(execution, nil), execution)
+	logger.Info("Sending request")
+	logger.WithField("stream", "stderr").Warn("something is off")
+	logger.Debug("not stored")
+	logger.Errorf("request failed: %v", "timeout")
+	logs.Flush()
+
+	t.Run("canvas does not exist -> not found", func(t *testing.T) {
+		err := GetExecutionLogs(context.Background(), r.Organization.ID, uuid.New(), execution.ID, false, 0, &executionLogsSender{})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("execution does not exist -> not found", func(t *testing.T) {
+		err := GetExecutionLogs(context.Background(), r.Organization.ID, canvas.ID, uuid.New(), false, 0, &executionLogsSender{})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("lines logged so far are sent", func(t *testing.T) {
+		sender := &executionLogsSender{}
+		require.NoError(t, GetExecutionLogs(context.Background(), r.Organization.ID, canvas.ID, execution.ID, false, 0, sender))
+		require.Len(t, sender.lines, 3)
+
+		assert.Equal(t, pb.ExecutionLogLine_LEVEL_INFO, sender.lines[0].Level)
+		assert.Equal(t, "Sending request", sender.lines[0].Message)
+		assert.Empty(t, sender.lines[0].Fields)
+
+		assert.Equal(t, pb.ExecutionLogLine_LEVEL_WARNING, sender.lines[1].Level)
+		assert.Equal(t, map[string]string{"stream": "stderr"}, sender.lines[1].Fields)
+
+		assert.Equal(t, pb.ExecutionLogLine_LEVEL_ERROR, sender.lines[2].Level)
+		assert.Equal(t, "request failed: timeout", sender.lines[2].Message)
+	})
+
+	t.Run("only lines after the sequence are sent", func(t *testing.T) {
+		all := &executionLogsSender{}
+		require.NoError(t, GetExecutionLogs(context.Background(), r.Organization.ID, canvas.ID, execution.ID, false, 0, all))
+
+		sender := &executionLogsSender{}
+		require.NoError(t, GetExecutionLogs(context.Background(), r.Organization.ID, canvas.ID, execution.ID, false, all.lines[0].Sequence, sender))
+		require.Len(t, sender.lines, 2)
+		assert.Equal(t, all.lines[1].Sequence, sender.lines[0].Sequence)
+	})
+
+	t.Run("follow stops when the execution finishes", func(t *testing.T) {
+		followed := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", event.ID, event.ID, nil)
+		followedLogger, followedLogs := logging.WithExecutionLogs(log.NewEntry(log.StandardLogger()), followed)
+		followedLogger.Info("first")
+		followedLogs.Flush()
+
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			followedLogger.Info("second")
+			followedLogs.Flush()
+			_ = database.Conn().Model(followed).Update("state", models.CanvasNodeExecutionStateFinished).Error
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		sender := &executionLogsSender{}
+		require.NoError(t, GetExecutionLogs(ctx, r.Organization.ID, canvas.ID, followed.ID, true, 0, sender))
+		require.NoError(t, ctx.Err())
+		require.Len(t, sender.lines, 2)
+		assert.Equal(t, "first", sender.lines[0].Message)
+		assert.Equal(t, "second", sender.lines[1].Message)
+	})
+
+	t.Run("lines are bounded per execution", func(t *testing.T) {
+		bounded := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", event.ID, event.ID, nil)
+		quiet := log.New()
+		quiet.Out = io.Discard
+
+		boundedLogger, boundedLogs := logging.WithExecutionLogs(log.NewEntry(quiet), bounded)
+		for range models.MaxCanvasNodeExecutionLogLines + 10 {
+			boundedLogger.Info(strings.Repeat("a", models.MaxCanvasNodeExecutionLogMessageLength+1))
+		}
+
+		boundedLogs.Flush()
+
+		//
+		// Lines logged through another logger are bounded too.
+		//
+		otherLogger, otherLogs := logging.WithExecutionLogs(log.NewEntry(quiet), bounded)
+		otherLogger.Info("not stored")
+		otherLogs.Flush()
+
+		sender := &executionLogsSender{}
+		require.NoError(t, GetExecutionLogs(context.Background(), r.Organization.ID, canvas.ID, bounded.ID, false, 0, sender))
+		require.Len(t, sender.lines, models.MaxCanvasNodeExecutionLogLines)
+		assert.True(t, strings.HasSuffix(sender.lines[0].Message, "... (truncated)"))
+
+		last := sender.lines[len(sender.lines)-1]
+		assert.Equal(t, pb.ExecutionLogLine_LEVEL_WARNING, last.Level)
+		assert.Contains(t, last.Message, "Log limit of 1000 lines reached")
+	})
+
+	t.Run("lines written by interleaved loggers are bounded", func(t *testing.T) {
+		bounded := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", event.ID, event.ID, nil)
+		quiet := log.New()
+		quiet.Out = io.Discard
+
+		firstLogger, firstLogs := logging.WithExecutionLogs(log.NewEntry(quiet), bounded)
+		secondLogger, secondLogs := logging.WithExecutionLogs(log.NewEntry(quiet), bounded)
+		logLines := func(logger *log.Entry, logs *logging.ExecutionLogs) {
+			for range models.MaxCanvasNodeExecutionLogLines / 2 {
+				logger.Info("line")
+			}
+
+			logs.Flush()
+		}
+
+		logLines(firstLogger, firstLogs)
+		logLines(secondLogger, secondLogs)
+		logLines(firstLogger, firstLogs)
+
+		count, err := models.CountCanvasNodeExecutionLogs(bounded.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(models.MaxCanvasNodeExecutionLogLines), count)
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
 	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -85,7 +86,6 @@ func InvokeNodeExecutionAction(
 		Name:           actionName,
 		Parameters:     parameters,
 		Configuration:  node.Configuration.Data(),
-		HTTP:           registry.HTTPContext(),
 		Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
 		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
 		Auth:           contexts.NewAuthContext(tx, orgID, authService, user),
@@ -104,8 +104,13 @@ func InvokeNodeExecutionAction(
 		actionCtx.Integration = contexts.NewIntegrationContext(tx, node, integration, encryptor, registry)
 	}
 
-	actionCtx.Logger = logger
+	spanCtx, span := telemetry.StartActionSpan(execution, component.Name(), actionName)
+	actionCtx.HTTP = telemetry.TraceHTTP(spanCtx, registry.HTTPContext())
+	actionLogger, executionLogs := logging.WithExecutionLogs(logger, execution)
+	actionCtx.Logger = actionLogger
 	err = component.HandleAction(actionCtx)
+	executionLogs.Flush()
+	telemetry.EndSpan(span, err)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "action execution failed: %v", err)
 	}
@@ -87,6 +87,7 @@ func InvokeNodeTriggerAction(
 		Metadata:      contexts.NewNodeMetadataContext(tx, node),
 		Requests:      contexts.NewNodeRequestContext(tx, node),
 		Webhook:       contexts.NewNodeWebhookContext(ctx, tx, encryptor, node, webhookBaseURL),
+		Events:        contexts.NewEventContext(tx, node),
 	}
 
 	if node.AppInstallationID != nil {
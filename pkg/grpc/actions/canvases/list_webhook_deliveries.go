@@ -0,0 +1,124 @@
+package canvases
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/webhooks"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Values of these headers are never returned by the API.
+var redactedWebhookHeaders = []string{"Authorization", "Cookie", "Proxy-Authorization"}
+
+func ListWebhookDeliveries(ctx context.Context, encryptor crypto.Encryptor, orgID uuid.UUID, canvasID uuid.UUID, nodeID string, limit uint32, before *timestamppb.Timestamp) (*pb.ListWebhookDeliveriesResponse, error) {
+	node, err := findWebhookNode(orgID, canvasID, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit = getLimit(limit)
+	deliveries, err := models.ListWebhookDeliveries(*node.WebhookID, int(limit), getBefore(before))
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := models.CountWebhookDeliveries(*node.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	serialized := make([]*pb.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		serialized = append(serialized, serializeWebhookDelivery(ctx, encryptor, &delivery))
+	}
+
+	response := &pb.ListWebhookDeliveriesResponse{
+		Deliveries:  serialized,
+		TotalCount:  uint32(totalCount),
+		HasNextPage: hasNextPage(len(deliveries), int(limit), totalCount),
+	}
+
+	if len(deliveries) > 0 {
+		response.LastTimestamp = timestamppb.New(*deliveries[len(deliveries)-1].CreatedAt)
+	}
+
+	return response, nil
+}
+
+func findWebhookNode(orgID uuid.UUID, canvasID uuid.UUID, nodeID string) (*models.CanvasNode, error) {
+	canvas, err := models.FindCanvas(orgID, canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	node, err := canvas.FindNode(nodeID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "node not found")
+	}
+
+	if node.WebhookID == nil {
+		return nil, status.Error(codes.FailedPrecondition, "node does not receive webhooks")
+	}
+
+	return node, nil
+}
+
+func serializeWebhookDelivery(ctx context.Context, encryptor crypto.Encryptor, delivery *models.WebhookDelivery) *pb.WebhookDelivery {
+	serialized := &pb.WebhookDelivery{
+		Id:            delivery.ID.String(),
+		Method:        delivery.Method,
+		RemoteIp:      delivery.RemoteIP,
+		Query:         delivery.Query,
+		Headers:       map[string]string{},
+		Body:          string(delivery.Body),
+		BodyTruncated: delivery.BodyTruncated,
+		StatusCode:    int32(delivery.StatusCode),
+		Nodes:         []*pb.WebhookDelivery_RoutedNode{},
+		EventIds:      delivery.EventIDs,
+		CreatedAt:     timestamppb.New(*delivery.CreatedAt),
+	}
+
+	if delivery.ReplayOf != nil {
+		serialized.ReplayOf = delivery.ReplayOf.String()
+	}
+
+	if delivery.Error != nil {
+		serialized.Error = *delivery.Error
+	}
+
+	for _, node := range delivery.Nodes {
+		serialized.Nodes = append(serialized.Nodes, &pb.WebhookDelivery_RoutedNode{
+			CanvasId:   node.CanvasID,
+			NodeId:     node.NodeID,
+			StatusCode: int32(node.StatusCode),
+			Error:      node.Error,
+		})
+	}
+
+	headers, err := webhooks.DecryptHeaders(ctx, encryptor, delivery)
+	if err != nil {
+		log.Errorf("error decrypting headers for webhook delivery %s: %v", delivery.ID, err)
+		return serialized
+	}
+
+	for name := range headers {
+		serialized.Headers[name] = strings.Join(headers.Values(name), ", ")
+	}
+
+	for _, name := range redactedWebhookHeaders {
+		if headers.Get(name) != "" {
+			serialized.Headers[http.CanonicalHeaderKey(name)] = "[REDACTED]"
+		}
+	}
+
+	return serialized
+}
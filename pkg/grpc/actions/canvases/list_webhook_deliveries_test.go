@@ -0,0 +1,57 @@
+package canvases
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/webhooks"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+func Test__ListWebhookDeliveries(t *testing.T) {
+	r := support.Setup(t)
+	ctx := context.Background()
+	canvas, webhook := createWebhookTriggerCanvas(t, r, "none")
+	dispatcher := webhooks.NewDispatcher(r.Encryptor, r.Registry, "http://localhost", "http://localhost/api/v1")
+
+	nodes, err := models.FindWebhookNodes(webhook.ID)
+	require.NoError(t, err)
+
+	dispatcher.Dispatch(ctx, webhook, nodes, webhooks.Request{
+		Body:     []byte(`{"ref":"main"}`),
+		Headers:  http.Header{"Authorization": []string{"Bearer secret"}, "X-Request-Id": []string{"123"}},
+		Method:   http.MethodPost,
+		RemoteIP: "10.0.0.1",
+	}, nil)
+
+	dispatcher.Dispatch(ctx, webhook, nodes, webhooks.Request{
+		Body:     []byte(`not-json`),
+		Headers:  http.Header{},
+		Method:   http.MethodPost,
+		RemoteIP: "10.0.0.1",
+	}, nil)
+
+	response, err := ListWebhookDeliveries(ctx, r.Encryptor, r.Organization.ID, canvas.ID, "trigger-1", 0, nil)
+	require.NoError(t, err)
+	require.Len(t, response.Deliveries, 2)
+	assert.Equal(t, uint32(2), response.TotalCount)
+	assert.False(t, response.HasNextPage)
+
+	failed := response.Deliveries[0]
+	assert.Equal(t, int32(http.StatusBadRequest), failed.StatusCode)
+	assert.Contains(t, failed.Error, "error parsing request body")
+	assert.Empty(t, failed.EventIds)
+
+	succeeded := response.Deliveries[1]
+	assert.Equal(t, int32(http.StatusOK), succeeded.StatusCode)
+	assert.Equal(t, "[REDACTED]", succeeded.Headers["Authorization"])
+	assert.Equal(t, "123", succeeded.Headers["X-Request-Id"])
+	assert.Equal(t, "10.0.0.1", succeeded.RemoteIp)
+	require.Len(t, succeeded.Nodes, 1)
+	assert.Equal(t, "trigger-1", succeeded.Nodes[0].NodeId)
+	assert.Len(t, succeeded.EventIds, 1)
+}
@@ -0,0 +1,72 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/webhooks"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func ReplayWebhookDelivery(
+	ctx context.Context,
+	encryptor crypto.Encryptor,
+	registry *registry.Registry,
+	orgID uuid.UUID,
+	canvasID uuid.UUID,
+	nodeID string,
+	deliveryID uuid.UUID,
+	baseURL string,
+	webhookBaseURL string,
+) (*pb.ReplayWebhookDeliveryResponse, error) {
+	node, err := findWebhookNode(orgID, canvasID, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := models.FindWebhookDelivery(*node.WebhookID, deliveryID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "delivery not found")
+		}
+
+		return nil, err
+	}
+
+	if delivery.BodyTruncated {
+		return nil, status.Error(codes.FailedPrecondition, "delivery body was truncated and cannot be replayed")
+	}
+
+	webhook, err := models.FindWebhook(*node.WebhookID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "webhook not found")
+	}
+
+	dispatcher := webhooks.NewDispatcher(encryptor, registry, baseURL, webhookBaseURL)
+	request, err := dispatcher.DecodeRequest(ctx, delivery)
+	if err != nil {
+		log.Errorf("error decoding webhook delivery %s: %v", delivery.ID, err)
+		return nil, status.Error(codes.Internal, "error decoding delivery")
+	}
+
+	//
+	// The delivery is only replayed for the requested node,
+	// even if the webhook is shared with other nodes.
+	//
+	result := dispatcher.Dispatch(ctx, webhook, []models.CanvasNode{*node}, *request, &delivery.ID)
+	if result.Delivery == nil {
+		return nil, status.Error(codes.Internal, "error recording replayed delivery")
+	}
+
+	return &pb.ReplayWebhookDeliveryResponse{
+		Delivery: serializeWebhookDelivery(ctx, encryptor, result.Delivery),
+	}, nil
+}
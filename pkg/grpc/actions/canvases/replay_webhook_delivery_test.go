@@ -0,0 +1,162 @@
+package canvases
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/webhooks"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func Test__ReplayWebhookDelivery(t *testing.T) {
+	r := support.Setup(t)
+	ctx := context.Background()
+	canvas, webhook := createWebhookTriggerCanvas(t, r, "none")
+	dispatcher := webhooks.NewDispatcher(r.Encryptor, r.Registry, "http://localhost", "http://localhost/api/v1")
+
+	t.Run("delivery not found -> error", func(t *testing.T) {
+		_, err := ReplayWebhookDelivery(ctx, r.Encryptor, r.Registry, r.Organization.ID, canvas.ID, "trigger-1", uuid.New(), "", "")
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("node without webhook -> error", func(t *testing.T) {
+		_, err := ReplayWebhookDelivery(ctx, r.Encryptor, r.Registry, r.Organization.ID, canvas.ID, "component-1", uuid.New(), "", "")
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+	})
+
+	t.Run("replays delivery and records a new one", func(t *testing.T) {
+		nodes, err := models.FindWebhookNodes(webhook.ID)
+		require.NoError(t, err)
+
+		original := dispatcher.Dispatch(ctx, webhook, nodes, webhooks.Request{
+			Body:     []byte(`{"ref":"main"}`),
+			Headers:  http.Header{"Content-Type": []string{"application/json"}},
+			Method:   http.MethodPost,
+			Query:    url.Values{"env": []string{"production"}},
+			RemoteIP: "10.0.0.1",
+		}, nil)
+
+		require.NoError(t, original.Err)
+		require.NotNil(t, original.Delivery)
+		require.Len(t, original.Events, 1)
+
+		response, err := ReplayWebhookDelivery(ctx, r.Encryptor, r.Registry, r.Organization.ID, canvas.ID, "trigger-1", original.Delivery.ID, "", "")
+		require.NoError(t, err)
+		require.NotNil(t, response.Delivery)
+		assert.NotEqual(t, original.Delivery.ID.String(), response.Delivery.Id)
+		assert.Equal(t, original.Delivery.ID.String(), response.Delivery.ReplayOf)
+		assert.Equal(t, int32(http.StatusOK), response.Delivery.StatusCode)
+		assert.Equal(t, "env=production", response.Delivery.Query)
+		assert.Equal(t, `{"ref":"main"}`, response.Delivery.Body)
+		require.Len(t, response.Delivery.EventIds, 1)
+		assert.NotEqual(t, original.Events[0].ID.String(), response.Delivery.EventIds[0])
+
+		support.VerifyCanvasEventsCount(t, canvas.ID, 2)
+	})
+
+	t.Run("replays delivery authenticated with bearer token", func(t *testing.T) {
+		bearerCanvas, bearerWebhook := createWebhookTriggerCanvas(t, r, "bearer")
+		nodes, err := models.FindWebhookNodes(bearerWebhook.ID)
+		require.NoError(t, err)
+
+		original := dispatcher.Dispatch(ctx, bearerWebhook, nodes, webhooks.Request{
+			Body: []byte(`{"ref":"main"}`),
+			Headers: http.Header{
+				"Content-Type":  []string{"application/json"},
+				"Authorization": []string{"Bearer secret"},
+			},
+			Method: http.MethodPost,
+		}, nil)
+
+		require.NoError(t, original.Err)
+		require.NotNil(t, original.Delivery)
+
+		headers, err := webhooks.DecryptHeaders(ctx, r.Encryptor, original.Delivery)
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer secret", headers.Get("Authorization"))
+
+		response, err := ReplayWebhookDelivery(ctx, r.Encryptor, r.Registry, r.Organization.ID, bearerCanvas.ID, "trigger-1", original.Delivery.ID, "", "")
+		require.NoError(t, err)
+		assert.Equal(t, int32(http.StatusOK), response.Delivery.StatusCode)
+		assert.Empty(t, response.Delivery.Error)
+		require.Len(t, response.Delivery.EventIds, 1)
+
+		support.VerifyCanvasEventsCount(t, bearerCanvas.ID, 2)
+	})
+
+	t.Run("truncated delivery -> error", func(t *testing.T) {
+		now := time.Now()
+		delivery := &models.WebhookDelivery{
+			WebhookID:     webhook.ID,
+			Method:        http.MethodPost,
+			Headers:       []byte("{}"),
+			Body:          []byte("{"),
+			BodyTruncated: true,
+			StatusCode:    http.StatusOK,
+			CreatedAt:     &now,
+		}
+
+		require.NoError(t, models.CreateWebhookDeliveryInTransaction(database.Conn(), delivery))
+
+		_, err := ReplayWebhookDelivery(ctx, r.Encryptor, r.Registry, r.Organization.ID, canvas.ID, "trigger-1", delivery.ID, "", "")
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+	})
+}
+
+func createWebhookTriggerCanvas(t *testing.T, r *support.ResourceRegistry, authentication string) (*models.Canvas, *models.Webhook) {
+	webhook := &models.Webhook{
+		ID:     uuid.New(),
+		State:  models.WebhookStateReady,
+		Secret: []byte("secret"),
+	}
+
+	require.NoError(t, database.Conn().Create(webhook).Error)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID:        "trigger-1",
+				Name:          "Webhook",
+				Type:          models.NodeTypeTrigger,
+				Ref:           datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "webhook"}}),
+				Configuration: datatypes.NewJSONType(map[string]any{"authentication": authentication}),
+			},
+			{
+				NodeID: "component-1",
+				Name:   "Noop",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	require.NoError(t, database.Conn().
+		Model(&models.CanvasNode{}).
+		Where("workflow_id = ?", canvas.ID).
+		Where("node_id = ?", "trigger-1").
+		Update("webhook_id", webhook.ID).
+		Error)
+
+	return canvas, webhook
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
@@ -147,6 +148,11 @@ func UpdateCanvas(ctx context.Context, encryptor crypto.Encryptor, registry *reg
 		return nil, actions.ToStatus(err)
 	}
 
+	err = messages.NewCanvasChangedMessage(organizationID, existingCanvas.ID.String(), pb.CanvasChangedMessage_ACTION_UPDATED).Publish()
+	if err != nil {
+		log.Errorf("failed to publish canvas updated message for canvas %s: %v", existingCanvas.ID, err)
+	}
+
 	protoCanvas, err := SerializeCanvas(existingCanvas, true)
 	if err != nil {
 		return nil, actions.ToStatus(err)
@@ -0,0 +1,459 @@
+package canvases
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	WatchCanvasPollInterval = time.Second
+	WatchCanvasBatchSize    = 100
+
+	// Pages of new changes fetched of each kind, on every poll.
+	// Changes left behind are fetched on the next polls.
+	WatchCanvasMaxPages = 10
+
+	// Changes may be committed slightly after the time recorded on them,
+	// so every poll looks this far behind the changes already fetched for changes it missed.
+	WatchCanvasCursorOverlap = 5 * time.Second
+)
+
+type WatchCanvasSender interface {
+	Send(*pb.WatchCanvasResponse) error
+}
+
+func WatchCanvas(ctx context.Context, orgID uuid.UUID, canvasID uuid.UUID, nodeIDs []string, types []pb.WatchCanvasResponse_Type, cursor string, sender WatchCanvasSender) error {
+	canvas, err := models.FindCanvas(orgID, canvasID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Error(codes.NotFound, "canvas not found")
+		}
+
+		return err
+	}
+
+	since := watchPosition{timestamp: time.Now()}
+	if cursor != "" {
+		since, err = decodeWatchCursor(cursor)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+	}
+
+	watcher := newCanvasWatcher(canvas.ID, nodeIDs, types, since)
+	ticker := time.NewTicker(WatchCanvasPollInterval)
+	defer ticker.Stop()
+
+	for {
+		messages, err := watcher.poll()
+		if err != nil {
+			log.Errorf("error watching canvas %s: %v", canvas.ID, err)
+			return status.Error(codes.Internal, "error watching canvas")
+		}
+
+		for _, message := range messages {
+			if err := sender.Send(message); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+/*
+ * watchPosition is the position of a change in a list
+ * of changes ordered by their timestamp and ID.
+ */
+type watchPosition struct {
+	timestamp time.Time
+	id        uuid.UUID
+}
+
+func (p watchPosition) before(other watchPosition) bool {
+	if !p.timestamp.Equal(other.timestamp) {
+		return p.timestamp.Before(other.timestamp)
+	}
+
+	return bytes.Compare(p.id[:], other.id[:]) < 0
+}
+
+/*
+ * canvasWatcher finds the changes on a canvas since its cursor.
+ */
+type canvasWatcher struct {
+	canvasID uuid.UUID
+	nodeIDs  []string
+	sources  []*changeSource
+
+	//
+	// Changes at or before the floor were sent before
+	// the stream was (re)started, so they are not sent again.
+	//
+	floor  watchPosition
+	cursor watchPosition
+
+	//
+	// Changes fetched, but not sent yet, because changes
+	// of other kinds before them are still left to fetch.
+	//
+	pending     []canvasChange
+	pendingKeys map[watchPosition]bool
+
+	//
+	// Events, queue items and finished executions already sent,
+	// to skip them when looking behind the changes already fetched.
+	//
+	seen map[string]time.Time
+
+	//
+	// Last state sent for each unfinished execution, since
+	// executions are also updated without their state changing.
+	//
+	executionStates map[string]string
+}
+
+/*
+ * changeSource is a kind of change, fetched by pages
+ * following the position of the last change fetched.
+ */
+type changeSource struct {
+	fetch    func(after watchPosition) ([]canvasChange, error)
+	position watchPosition
+}
+
+type canvasChange struct {
+	position watchPosition
+	state    string
+	message  *pb.WatchCanvasResponse
+}
+
+func newCanvasWatcher(canvasID uuid.UUID, nodeIDs []string, types []pb.WatchCanvasResponse_Type, since watchPosition) *canvasWatcher {
+	typeFilter := map[pb.WatchCanvasResponse_Type]bool{}
+	for _, t := range types {
+		typeFilter[t] = true
+	}
+
+	wants := func(t pb.WatchCanvasResponse_Type) bool {
+		return len(typeFilter) == 0 || typeFilter[t]
+	}
+
+	w := &canvasWatcher{
+		canvasID:        canvasID,
+		nodeIDs:         nodeIDs,
+		floor:           since,
+		cursor:          since,
+		pendingKeys:     map[watchPosition]bool{},
+		seen:            map[string]time.Time{},
+		executionStates: map[string]string{},
+	}
+
+	if wants(pb.WatchCanvasResponse_TYPE_EVENT_CREATED) {
+		w.sources = append(w.sources, &changeSource{fetch: w.eventChanges, position: since})
+	}
+
+	if wants(pb.WatchCanvasResponse_TYPE_EXECUTION_STATE_CHANGED) {
+		w.sources = append(w.sources, &changeSource{fetch: w.executionChanges, position: since})
+	}
+
+	if wants(pb.WatchCanvasResponse_TYPE_QUEUE_ITEM_CREATED) {
+		w.sources = append(w.sources, &changeSource{fetch: w.queueItemChanges, position: since})
+	}
+
+	return w
+}
+
+func (w *canvasWatcher) poll() ([]*pb.WatchCanvasResponse, error) {
+	//
+	// If there are changes of a kind left to fetch, there may be
+	// changes of that kind before the ones fetched of other kinds,
+	// so nothing after the last change fetched of it is sent in this poll.
+	//
+	var limit *watchPosition
+	for _, source := range w.sources {
+		changes, complete, err := w.fetch(source)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, change := range changes {
+			if !w.pendingKeys[change.position] {
+				w.pendingKeys[change.position] = true
+				w.pending = append(w.pending, change)
+			}
+		}
+
+		if !complete && (limit == nil || source.position.before(*limit)) {
+			position := source.position
+			limit = &position
+		}
+	}
+
+	sort.SliceStable(w.pending, func(i, j int) bool {
+		return w.pending[i].position.before(w.pending[j].position)
+	})
+
+	messages := []*pb.WatchCanvasResponse{}
+	processed := 0
+	for _, change := range w.pending {
+		if limit != nil && limit.before(change.position) {
+			break
+		}
+
+		processed++
+		delete(w.pendingKeys, change.position)
+		if !w.record(change) {
+			continue
+		}
+
+		if w.cursor.before(change.position) {
+			w.cursor = change.position
+		}
+
+		change.message.Cursor = encodeWatchCursor(w.cursor)
+		change.message.Timestamp = timestamppb.New(change.position.timestamp)
+		messages = append(messages, change.message)
+	}
+
+	w.pending = w.pending[processed:]
+	w.forgetSeen()
+	return messages, nil
+}
+
+/*
+ * fetch returns the new changes of a source, and the changes committed
+ * late in the overlap behind the ones already fetched. Pages are followed
+ * by position, so changes with the same timestamp do not stop the stream.
+ * It returns false if there are new changes left to fetch.
+ */
+func (w *canvasWatcher) fetch(source *changeSource) ([]canvasChange, bool, error) {
+	changes := []canvasChange{}
+
+	after := watchPosition{timestamp: source.position.timestamp.Add(-WatchCanvasCursorOverlap)}
+	for after.before(source.position) {
+		page, err := source.fetch(after)
+		if err != nil {
+			return nil, false, err
+		}
+
+		for _, change := range page {
+			if !source.position.before(change.position) {
+				changes = append(changes, change)
+			}
+		}
+
+		if len(page) < WatchCanvasBatchSize {
+			break
+		}
+
+		after = page[len(page)-1].position
+	}
+
+	for range WatchCanvasMaxPages {
+		page, err := source.fetch(source.position)
+		if err != nil {
+			return nil, false, err
+		}
+
+		changes = append(changes, page...)
+		if len(page) > 0 {
+			source.position = page[len(page)-1].position
+		}
+
+		if len(page) < WatchCanvasBatchSize {
+			return changes, true, nil
+		}
+	}
+
+	return changes, false, nil
+}
+
+// record returns true if the change should be sent.
+func (w *canvasWatcher) record(change canvasChange) bool {
+	id := change.position.id.String()
+	if change.message.Type == pb.WatchCanvasResponse_TYPE_EXECUTION_STATE_CHANGED {
+		if _, ok := w.seen[id]; ok {
+			return false
+		}
+
+		//
+		// Finished executions do not change state again,
+		// so they move to the seen changes, which are forgotten
+		// once no source looks at them again.
+		//
+		state, ok := w.executionStates[id]
+		if change.state == models.CanvasNodeExecutionStateFinished {
+			delete(w.executionStates, id)
+			w.seen[id] = change.position.timestamp
+		} else {
+			w.executionStates[id] = change.state
+		}
+
+		if ok && state == change.state {
+			return false
+		}
+	} else {
+		if _, ok := w.seen[id]; ok {
+			return false
+		}
+
+		w.seen[id] = change.position.timestamp
+	}
+
+	return w.floor.before(change.position)
+}
+
+// forgetSeen forgets the changes no source looks at again.
+func (w *canvasWatcher) forgetSeen() {
+	if len(w.sources) == 0 {
+		return
+	}
+
+	oldest := w.sources[0].position.timestamp
+	for _, source := range w.sources[1:] {
+		if source.position.timestamp.Before(oldest) {
+			oldest = source.position.timestamp
+		}
+	}
+
+	for id, timestamp := range w.seen {
+		if timestamp.Before(oldest.Add(-WatchCanvasCursorOverlap)) {
+			delete(w.seen, id)
+		}
+	}
+}
+
+func (w *canvasWatcher) eventChanges(after watchPosition) ([]canvasChange, error) {
+	events, err := models.ListCanvasEventsCreatedSince(w.canvasID, w.nodeIDs, after.timestamp, after.id, WatchCanvasBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]canvasChange, 0, len(events))
+	for _, event := range events {
+		serialized, err := SerializeCanvasEvent(event)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, canvasChange{
+			position: watchPosition{timestamp: *event.CreatedAt, id: event.ID},
+			message: &pb.WatchCanvasResponse{
+				Type:  pb.WatchCanvasResponse_TYPE_EVENT_CREATED,
+				Event: serialized,
+			},
+		})
+	}
+
+	return changes, nil
+}
+
+func (w *canvasWatcher) executionChanges(after watchPosition) ([]canvasChange, error) {
+	executions, err := models.ListNodeExecutionsUpdatedSince(w.canvasID, w.nodeIDs, after.timestamp, after.id, WatchCanvasBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	serialized, err := SerializeNodeExecutions(executions, []models.CanvasNodeExecution{})
+	if err != nil {
+		return nil, err
+	}
+
+	serializedByID := make(map[string]*pb.CanvasNodeExecution, len(serialized))
+	for _, execution := range serialized {
+		serializedByID[execution.Id] = execution
+	}
+
+	changes := make([]canvasChange, 0, len(executions))
+	for _, execution := range executions {
+		s, ok := serializedByID[execution.ID.String()]
+		if !ok {
+			return nil, fmt.Errorf("execution %s not serialized", execution.ID)
+		}
+
+		changes = append(changes, canvasChange{
+			position: watchPosition{timestamp: *execution.UpdatedAt, id: execution.ID},
+			state:    execution.State,
+			message: &pb.WatchCanvasResponse{
+				Type:      pb.WatchCanvasResponse_TYPE_EXECUTION_STATE_CHANGED,
+				Execution: s,
+			},
+		})
+	}
+
+	return changes, nil
+}
+
+func (w *canvasWatcher) queueItemChanges(after watchPosition) ([]canvasChange, error) {
+	queueItems, err := models.ListNodeQueueItemsCreatedSince(w.canvasID, w.nodeIDs, after.timestamp, after.id, WatchCanvasBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	serialized, err := SerializeNodeQueueItems(queueItems)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]canvasChange, 0, len(queueItems))
+	for i, queueItem := range queueItems {
+		changes = append(changes, canvasChange{
+			position: watchPosition{timestamp: *queueItem.CreatedAt, id: queueItem.ID},
+			message: &pb.WatchCanvasResponse{
+				Type:      pb.WatchCanvasResponse_TYPE_QUEUE_ITEM_CREATED,
+				QueueItem: serialized[i],
+			},
+		})
+	}
+
+	return changes, nil
+}
+
+// Cursors hold the timestamp and ID of the last change sent,
+// so changes with the same timestamp are not skipped on resume.
+func encodeWatchCursor(p watchPosition) string {
+	value := p.timestamp.UTC().Format(time.RFC3339Nano) + "|" + p.id.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(value))
+}
+
+func decodeWatchCursor(cursor string) (watchPosition, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return watchPosition{}, err
+	}
+
+	timestampValue, idValue, hasID := strings.Cut(string(data), "|")
+	timestamp, err := time.Parse(time.RFC3339Nano, timestampValue)
+	if err != nil {
+		return watchPosition{}, err
+	}
+
+	position := watchPosition{timestamp: timestamp}
+	if hasID {
+		position.id, err = uuid.Parse(idValue)
+		if err != nil {
+			return watchPosition{}, err
+		}
+	}
+
+	return position, nil
+}
@@ -0,0 +1,211 @@
+package canvases
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+type watchCanvasSender struct {
+	messages []*pb.WatchCanvasResponse
+}
+
+func (s *watchCanvasSender) Send(message *pb.WatchCanvasResponse) error {
+	s.messages = append(s.messages, message)
+	return nil
+}
+
+func Test__WatchCanvas(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "Node 1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+			{
+				NodeID: "node-2",
+				Name:   "Node 2",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	t.Run("canvas does not exist -> not found", func(t *testing.T) {
+		err := WatchCanvas(context.Background(), r.Organization.ID, uuid.New(), nil, nil, "", &watchCanvasSender{})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("invalid cursor -> invalid argument", func(t *testing.T) {
+		err := WatchCanvas(context.Background(), r.Organization.ID, canvas.ID, nil, nil, "not-a-cursor", &watchCanvasSender{})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("only changes after the cursor are sent, once", func(t *testing.T) {
+		before := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+		watcher := newCanvasWatcher(canvas.ID, nil, nil, watchPosition{timestamp: *before.CreatedAt, id: before.ID})
+
+		event := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", event.ID, event.ID, nil)
+		queueItem := support.CreateQueueItem(t, canvas.ID, "node-2", event.ID, event.ID)
+
+		messages, err := watcher.poll()
+		require.NoError(t, err)
+		require.Len(t, messages, 3)
+
+		assert.Equal(t, pb.WatchCanvasResponse_TYPE_EVENT_CREATED, messages[0].Type)
+		assert.Equal(t, event.ID.String(), messages[0].Event.Id)
+		assert.Equal(t, pb.WatchCanvasResponse_TYPE_EXECUTION_STATE_CHANGED, messages[1].Type)
+		assert.Equal(t, execution.ID.String(), messages[1].Execution.Id)
+		assert.Equal(t, pb.WatchCanvasResponse_TYPE_QUEUE_ITEM_CREATED, messages[2].Type)
+		assert.Equal(t, queueItem.ID.String(), messages[2].QueueItem.Id)
+
+		//
+		// Nothing changed, so nothing is sent again.
+		//
+		messages, err = watcher.poll()
+		require.NoError(t, err)
+		assert.Empty(t, messages)
+
+		//
+		// Execution state changes are sent.
+		//
+		require.NoError(t, execution.StartInTransaction(database.Conn(), canvas, nil))
+		messages, err = watcher.poll()
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		assert.Equal(t, pb.CanvasNodeExecution_STATE_STARTED, messages[0].Execution.State)
+
+		//
+		// Cursors point at the last change sent.
+		//
+		cursor, err := decodeWatchCursor(messages[0].Cursor)
+		require.NoError(t, err)
+		assert.False(t, cursor.timestamp.Before(*event.CreatedAt))
+		assert.Equal(t, execution.ID, cursor.id)
+	})
+
+	t.Run("filter by node and type", func(t *testing.T) {
+		watcher := newCanvasWatcher(canvas.ID, []string{"node-2"}, []pb.WatchCanvasResponse_Type{pb.WatchCanvasResponse_TYPE_EVENT_CREATED}, watchPosition{timestamp: time.Now()})
+
+		support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+		event := support.EmitCanvasEventForNode(t, canvas.ID, "node-2", "default", nil)
+		support.CreateCanvasNodeExecution(t, canvas.ID, "node-2", event.ID, event.ID, nil)
+
+		messages, err := watcher.poll()
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		assert.Equal(t, event.ID.String(), messages[0].Event.Id)
+	})
+
+	t.Run("more changes with the same timestamp than a batch are all sent", func(t *testing.T) {
+		createdAt := time.Now().Add(-time.Minute).Truncate(time.Microsecond)
+		total := WatchCanvasBatchSize + 20
+		for range total {
+			support.EmitCanvasEventForNode(t, canvas.ID, "node-3", "batch", nil)
+		}
+
+		require.NoError(t, database.Conn().
+			Model(&models.CanvasEvent{}).
+			Where("workflow_id = ?", canvas.ID).
+			Where("node_id = ?", "node-3").
+			Where("channel = ?", "batch").
+			Update("created_at", createdAt).
+			Error)
+
+		types := []pb.WatchCanvasResponse_Type{pb.WatchCanvasResponse_TYPE_EVENT_CREATED}
+		watcher := newCanvasWatcher(canvas.ID, []string{"node-3"}, types, watchPosition{timestamp: createdAt.Add(-time.Second)})
+
+		messages, err := watcher.poll()
+		require.NoError(t, err)
+		require.Len(t, messages, total)
+
+		ids := map[string]bool{}
+		for _, message := range messages {
+			ids[message.Event.Id] = true
+		}
+
+		assert.Len(t, ids, total)
+
+		messages, err = watcher.poll()
+		require.NoError(t, err)
+		assert.Empty(t, messages)
+
+		//
+		// Resuming in the middle of the changes with
+		// the same timestamp sends the ones left.
+		//
+		cursor, err := decodeWatchCursor(encodeWatchCursor(watchPosition{timestamp: createdAt, id: uuid.MustParse(sortedIDs(ids)[49])}))
+		require.NoError(t, err)
+
+		resumed := newCanvasWatcher(canvas.ID, []string{"node-3"}, types, cursor)
+		messages, err = resumed.poll()
+		require.NoError(t, err)
+		assert.Len(t, messages, total-50)
+	})
+
+	t.Run("stream stops when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		sender := &watchCanvasSender{}
+		err := WatchCanvas(ctx, r.Organization.ID, canvas.ID, nil, nil, "", sender)
+		require.NoError(t, err)
+	})
+}
+
+func sortedIDs(ids map[string]bool) []string {
+	sorted := make([]string, 0, len(ids))
+	for id := range ids {
+		sorted = append(sorted, id)
+	}
+
+	sort.Strings(sorted)
+	return sorted
+}
+
+func Test__CanvasWatcher__FinishedExecutions(t *testing.T) {
+	since := watchPosition{timestamp: time.Now().Add(-time.Minute)}
+	w := newCanvasWatcher(uuid.New(), nil, nil, since)
+
+	id := uuid.New()
+	change := func(state string, offset time.Duration) canvasChange {
+		return canvasChange{
+			position: watchPosition{timestamp: since.timestamp.Add(offset), id: id},
+			state:    state,
+			message:  &pb.WatchCanvasResponse{Type: pb.WatchCanvasResponse_TYPE_EXECUTION_STATE_CHANGED},
+		}
+	}
+
+	assert.True(t, w.record(change(models.CanvasNodeExecutionStateStarted, time.Second)))
+	assert.False(t, w.record(change(models.CanvasNodeExecutionStateStarted, 2*time.Second)))
+	assert.Contains(t, w.executionStates, id.String())
+
+	assert.True(t, w.record(change(models.CanvasNodeExecutionStateFinished, 3*time.Second)))
+	assert.NotContains(t, w.executionStates, id.String())
+	assert.False(t, w.record(change(models.CanvasNodeExecutionStateFinished, 4*time.Second)))
+}
@@ -0,0 +1,27 @@
+package messages
+
+import (
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const CanvasChangedRoutingKey = "canvas-changed"
+
+type CanvasChangedMessage struct {
+	message *pb.CanvasChangedMessage
+}
+
+func NewCanvasChangedMessage(organizationID, canvasID string, action pb.CanvasChangedMessage_Action) CanvasChangedMessage {
+	return CanvasChangedMessage{
+		message: &pb.CanvasChangedMessage{
+			CanvasId:       canvasID,
+			OrganizationId: organizationID,
+			Action:         action,
+			Timestamp:      timestamppb.Now(),
+		},
+	}
+}
+
+func (m CanvasChangedMessage) Publish() error {
+	return Publish(WorkflowExchange, CanvasChangedRoutingKey, toBytes(m.message))
+}
@@ -22,6 +22,13 @@ func NewCanvasExecutionMessage(canvasId string, executionID, nodeID string) Canv
 	}
 }
 
+// WithState records the state the execution moved to,
+// so consumers see the transition even if the execution already moved on.
+func (m CanvasExecutionMessage) WithState(state string) CanvasExecutionMessage {
+	m.message.State = state
+	return m
+}
+
 func (m CanvasExecutionMessage) Publish() error {
 	return Publish(WorkflowExchange, WorkflowExecutionRoutingKey, toBytes(m.message))
 }
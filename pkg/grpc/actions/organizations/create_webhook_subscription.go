@@ -0,0 +1,134 @@
+package organizations
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func CreateWebhookSubscription(ctx context.Context, encryptor crypto.Encryptor, orgID string, subscriptionURL string, eventTypes []string) (*pb.CreateWebhookSubscriptionResponse, error) {
+	org, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization ID: %v", err)
+	}
+
+	err = validateWebhookSubscriptionURL(subscriptionURL)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = validateWebhookSubscriptionEventTypes(eventTypes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	//
+	// The secret is encrypted with the subscription ID,
+	// so we generate the ID before creating the record.
+	//
+	id := uuid.New()
+	secret, encryptedSecret, err := crypto.NewRandomKey(ctx, encryptor, id.String())
+	if err != nil {
+		log.Errorf("error generating secret for webhook subscription: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create webhook subscription")
+	}
+
+	now := time.Now()
+	subscription := models.OrganizationWebhookSubscription{
+		ID:             id,
+		OrganizationID: org,
+		URL:            subscriptionURL,
+		Secret:         encryptedSecret,
+		EventTypes:     datatypes.NewJSONSlice(eventTypes),
+		Enabled:        true,
+		CreatedAt:      &now,
+		UpdatedAt:      &now,
+	}
+
+	if userID, ok := authentication.GetUserIdFromMetadata(ctx); ok {
+		if createdBy, err := uuid.Parse(userID); err == nil {
+			subscription.CreatedBy = &createdBy
+		}
+	}
+
+	err = models.CreateOrganizationWebhookSubscription(&subscription)
+	if err != nil {
+		log.Errorf("error creating webhook subscription for organization %s: %v", org, err)
+		return nil, status.Error(codes.Internal, "failed to create webhook subscription")
+	}
+
+	return &pb.CreateWebhookSubscriptionResponse{
+		Subscription: serializeWebhookSubscription(&subscription),
+		Secret:       secret,
+	}, nil
+}
+
+func validateWebhookSubscriptionURL(value string) error {
+	if value == "" {
+		return fmt.Errorf("url is required")
+	}
+
+	u, err := url.Parse(value)
+	if err != nil {
+		return fmt.Errorf("invalid url: %v", err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("url must use http or https")
+	}
+
+	if u.Hostname() == "" {
+		return fmt.Errorf("url must include a host")
+	}
+
+	return validateWebhookSubscriptionHost(u.Hostname())
+}
+
+// validateWebhookSubscriptionHost rejects hosts in private or loopback networks,
+// so deliveries cannot be used to reach the internal services of the installation.
+// Hosts that do not resolve yet are allowed.
+func validateWebhookSubscriptionHost(host string) error {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("url must not point to a private or loopback address")
+	}
+
+	ips := []net.IP{}
+	if ip := net.ParseIP(host); ip != nil {
+		ips = append(ips, ip)
+	} else if resolved, err := net.LookupIP(host); err == nil {
+		ips = resolved
+	}
+
+	for _, ip := range ips {
+		if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsUnspecified() {
+			return fmt.Errorf("url must not point to a private or loopback address")
+		}
+	}
+
+	return nil
+}
+
+func validateWebhookSubscriptionEventTypes(eventTypes []string) error {
+	for _, eventType := range eventTypes {
+		if !slices.Contains(models.OrganizationWebhookEventTypes, eventType) {
+			return fmt.Errorf("unknown event type %s", eventType)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package organizations
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func DeleteWebhookSubscription(ctx context.Context, orgID string, subscriptionID string) (*pb.DeleteWebhookSubscriptionResponse, error) {
+	subscription, err := findWebhookSubscription(orgID, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	//
+	// Deliveries are removed with the subscription.
+	//
+	err = subscription.Delete()
+	if err != nil {
+		log.Errorf("error deleting webhook subscription %s: %v", subscription.ID, err)
+		return nil, status.Error(codes.Internal, "failed to delete webhook subscription")
+	}
+
+	return &pb.DeleteWebhookSubscriptionResponse{}, nil
+}
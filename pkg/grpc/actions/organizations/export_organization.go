@@ -0,0 +1,370 @@
+package organizations
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func ExportOrganization(ctx context.Context, authService authorization.Authorization, registry *registry.Registry, orgID string, includeSecretValues bool, publicKey string) (*pb.ExportOrganizationResponse, error) {
+	organization, err := models.FindOrganizationByID(orgID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "organization not found")
+	}
+
+	if includeSecretValues && publicKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "public key is required to export secret values")
+	}
+
+	if includeSecretValues {
+		if err := checkCanReadSecrets(ctx, authService, orgID); err != nil {
+			return nil, err
+		}
+	}
+
+	archive := OrganizationArchive{
+		Version:    OrganizationArchiveVersion,
+		ExportedAt: time.Now(),
+		Organization: ArchivedOrganization{
+			ID:          organization.ID.String(),
+			Name:        organization.Name,
+			Description: organization.Description,
+		},
+	}
+
+	archive.Roles, err = exportRoles(authService, orgID)
+	if err != nil {
+		log.Errorf("error exporting roles of organization %s: %v", orgID, err)
+		return nil, status.Error(codes.Internal, "failed to export roles")
+	}
+
+	archive.Groups, err = exportGroups(authService, orgID)
+	if err != nil {
+		log.Errorf("error exporting groups of organization %s: %v", orgID, err)
+		return nil, status.Error(codes.Internal, "failed to export groups")
+	}
+
+	archive.Integrations, err = exportIntegrations(registry, organization.ID)
+	if err != nil {
+		log.Errorf("error exporting integrations of organization %s: %v", orgID, err)
+		return nil, status.Error(codes.Internal, "failed to export integrations")
+	}
+
+	secrets, values, err := exportSecrets(ctx, registry.Encryptor, organization.ID)
+	if err != nil {
+		log.Errorf("error exporting secrets of organization %s: %v", orgID, err)
+		return nil, status.Error(codes.Internal, "failed to export secrets")
+	}
+
+	archive.Secrets = secrets
+	if includeSecretValues {
+		data, err := json.Marshal(values)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to export secrets")
+		}
+
+		archive.SecretValues, err = crypto.SealWithPublicKey([]byte(publicKey), data)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to encrypt secret values: %v", err)
+		}
+	}
+
+	archive.Blueprints, err = exportBlueprints(organization.ID)
+	if err != nil {
+		log.Errorf("error exporting blueprints of organization %s: %v", orgID, err)
+		return nil, status.Error(codes.Internal, "failed to export blueprints")
+	}
+
+	archive.Canvases, err = exportCanvases(organization.ID)
+	if err != nil {
+		log.Errorf("error exporting canvases of organization %s: %v", orgID, err)
+		return nil, status.Error(codes.Internal, "failed to export canvases")
+	}
+
+	data, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to encode archive")
+	}
+
+	return &pb.ExportOrganizationResponse{Archive: data}, nil
+}
+
+/*
+ * checkCanReadSecrets only allows users who can read the secrets
+ * of the organization to export their values. Exporting the rest
+ * of the organization is allowed to everyone who can update it.
+ */
+func checkCanReadSecrets(ctx context.Context, authService authorization.Authorization, orgID string) error {
+	userID, ok := authentication.GetUserIdFromMetadata(ctx)
+	if !ok {
+		return status.Error(codes.PermissionDenied, "not allowed to export secret values")
+	}
+
+	allowed, err := authService.CheckOrganizationPermission(userID, orgID, "secrets", "read")
+	if err != nil {
+		log.Errorf("error checking permission of user %s to read secrets of organization %s: %v", userID, orgID, err)
+		return status.Error(codes.Internal, "failed to check permissions")
+	}
+
+	if !allowed {
+		return status.Error(codes.PermissionDenied, "not allowed to export secret values")
+	}
+
+	return nil
+}
+
+func exportRoles(authService authorization.Authorization, orgID string) ([]ArchivedRole, error) {
+	definitions, err := authService.GetAllRoleDefinitions(models.DomainTypeOrganization, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := []ArchivedRole{}
+	for _, definition := range definitions {
+		if authService.IsDefaultRole(definition.Name, models.DomainTypeOrganization) {
+			continue
+		}
+
+		role := ArchivedRole{
+			Name:        definition.Name,
+			DisplayName: definition.DisplayName,
+			Description: definition.Description,
+			Permissions: []ArchivedPermission{},
+		}
+
+		metadata, err := models.FindRoleMetadata(definition.Name, models.DomainTypeOrganization, orgID)
+		if err == nil {
+			role.DisplayName = metadata.DisplayName
+			role.Description = metadata.Description
+		}
+
+		if definition.InheritsFrom != nil {
+			role.InheritsFrom = definition.InheritsFrom.Name
+		}
+
+		for _, permission := range definition.Permissions {
+			role.Permissions = append(role.Permissions, ArchivedPermission{
+				Resource: permission.Resource,
+				Action:   permission.Action,
+			})
+		}
+
+		roles = append(roles, role)
+	}
+
+	slices.SortFunc(roles, func(a, b ArchivedRole) int { return strings.Compare(a.Name, b.Name) })
+	return roles, nil
+}
+
+func exportGroups(authService authorization.Authorization, orgID string) ([]ArchivedGroup, error) {
+	names, err := authService.GetGroups(orgID, models.DomainTypeOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := []ArchivedGroup{}
+	for _, name := range names {
+		role, err := authService.GetGroupRole(orgID, models.DomainTypeOrganization, name)
+		if err != nil {
+			return nil, err
+		}
+
+		userIDs, err := authService.GetGroupUsers(orgID, models.DomainTypeOrganization, name)
+		if err != nil {
+			return nil, err
+		}
+
+		members, err := findMemberEmails(userIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		group := ArchivedGroup{
+			Name:        name,
+			DisplayName: name,
+			Role:        role,
+			Members:     members,
+		}
+
+		metadata, err := models.FindGroupMetadata(name, models.DomainTypeOrganization, orgID)
+		if err == nil {
+			group.DisplayName = metadata.DisplayName
+			group.Description = metadata.Description
+		}
+
+		groups = append(groups, group)
+	}
+
+	slices.SortFunc(groups, func(a, b ArchivedGroup) int { return strings.Compare(a.Name, b.Name) })
+	return groups, nil
+}
+
+func findMemberEmails(userIDs []string) ([]string, error) {
+	ids := []uuid.UUID{}
+	for _, userID := range userIDs {
+		id, err := uuid.Parse(userID)
+		if err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	users, err := models.FindMaybeDeletedUsersByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	emails := []string{}
+	for _, user := range users {
+		if !user.DeletedAt.Valid {
+			emails = append(emails, user.Email)
+		}
+	}
+
+	slices.Sort(emails)
+	return emails, nil
+}
+
+func exportIntegrations(registry *registry.Registry, orgID uuid.UUID) ([]ArchivedIntegration, error) {
+	integrations, err := models.ListIntegrations(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	archived := []ArchivedIntegration{}
+	for _, integration := range integrations {
+		configuration := integration.Configuration.Data()
+
+		//
+		// Sensitive fields are encrypted with the key of this installation,
+		// so they are not exported, and must be set again after the import.
+		// If the integration is no longer registered, we can not tell which
+		// fields are sensitive, so no configuration is exported for it.
+		//
+		definition, err := registry.GetIntegration(integration.AppName)
+		if err != nil {
+			configuration = map[string]any{}
+		} else {
+			configuration = withoutSensitiveFields(definition.Configuration(), configuration)
+		}
+
+		archived = append(archived, ArchivedIntegration{
+			ID:            integration.ID.String(),
+			AppName:       integration.AppName,
+			Name:          integration.InstallationName,
+			Configuration: configuration,
+		})
+	}
+
+	return archived, nil
+}
+
+func exportSecrets(ctx context.Context, encryptor crypto.Encryptor, orgID uuid.UUID) ([]ArchivedSecret, map[string]map[string]string, error) {
+	secrets, err := models.ListSecrets(models.DomainTypeOrganization, orgID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	archived := []ArchivedSecret{}
+	values := map[string]map[string]string{}
+	for _, secret := range secrets {
+		decrypted, err := encryptor.Decrypt(ctx, secret.Data, []byte(secret.Name))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		data := map[string]string{}
+		if err := json.Unmarshal(decrypted, &data); err != nil {
+			return nil, nil, err
+		}
+
+		keys := []string{}
+		for key := range data {
+			keys = append(keys, key)
+		}
+
+		slices.Sort(keys)
+		archived = append(archived, ArchivedSecret{
+			Name:     secret.Name,
+			Provider: secret.Provider,
+			Keys:     keys,
+		})
+
+		values[secret.Name] = data
+	}
+
+	return archived, values, nil
+}
+
+func exportBlueprints(orgID uuid.UUID) ([]ArchivedBlueprint, error) {
+	blueprints, err := models.ListBlueprints(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	archived := []ArchivedBlueprint{}
+	for _, blueprint := range blueprints {
+		archived = append(archived, ArchivedBlueprint{
+			ID:             blueprint.ID.String(),
+			Name:           blueprint.Name,
+			Description:    blueprint.Description,
+			Icon:           blueprint.Icon,
+			Color:          blueprint.Color,
+			Nodes:          exportNodes(blueprint.Nodes),
+			Edges:          blueprint.Edges,
+			Configuration:  blueprint.Configuration,
+			OutputChannels: blueprint.OutputChannels,
+		})
+	}
+
+	return archived, nil
+}
+
+func exportCanvases(orgID uuid.UUID) ([]ArchivedCanvas, error) {
+	canvases, err := models.ListCanvases(orgID.String(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	archived := []ArchivedCanvas{}
+	for _, canvas := range canvases {
+		archived = append(archived, ArchivedCanvas{
+			ID:          canvas.ID.String(),
+			Name:        canvas.Name,
+			Description: canvas.Description,
+			Nodes:       exportNodes(canvas.Nodes),
+			Edges:       canvas.Edges,
+		})
+	}
+
+	return archived, nil
+}
+
+// exportNodes leaves out the internal nodes of blueprints,
+// and the errors and warnings, which are computed again on import.
+func exportNodes(nodes []models.Node) []models.Node {
+	exported := []models.Node{}
+	for _, node := range nodes {
+		if strings.Contains(node.ID, ":") {
+			continue
+		}
+
+		node.ErrorMessage = nil
+		node.WarningMessage = nil
+		exported = append(exported, node)
+	}
+
+	return exported
+}
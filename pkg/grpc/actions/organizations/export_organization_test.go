@@ -0,0 +1,183 @@
+package organizations
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/canvases"
+	"github.com/superplanehq/superplane/pkg/models"
+	canvaspb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	componentpb "github.com/superplanehq/superplane/pkg/protos/components"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__ExportAndImportOrganization(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+	orgID := r.Organization.ID.String()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	publicKey := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDER}))
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	secret, err := support.CreateSecret(t, r, map[string]string{"token": "hello"})
+	require.NoError(t, err)
+
+	require.NoError(t, r.AuthService.CreateGroup(orgID, models.DomainTypeOrganization, "ops", models.RoleOrgViewer, "Ops", "Operations"))
+	require.NoError(t, r.AuthService.AddUserToGroup(orgID, models.DomainTypeOrganization, r.User.String(), "ops"))
+
+	blueprint := support.CreateBlueprint(t, r.Organization.ID, []models.Node{
+		{
+			ID:   "noop",
+			Name: "noop",
+			Type: models.NodeTypeComponent,
+			Ref:  models.NodeRef{Component: &models.ComponentRef{Name: "noop"}},
+		},
+	}, []models.Edge{}, []models.BlueprintOutputChannel{
+		{Name: "default", NodeID: "noop", NodeOutputChannel: "default"},
+	})
+
+	canvasName := support.RandomName("canvas")
+	_, err = canvases.CreateCanvas(ctx, r.Registry, orgID, &canvaspb.Canvas{
+		Metadata: &canvaspb.Canvas_Metadata{Name: canvasName},
+		Spec: &canvaspb.Canvas_Spec{
+			Nodes: []*componentpb.Node{
+				{
+					Id:        "blueprint-node",
+					Name:      "Blueprint Node",
+					Type:      componentpb.Node_TYPE_BLUEPRINT,
+					Blueprint: &componentpb.Node_BlueprintRef{Id: blueprint.ID.String()},
+				},
+			},
+			Edges: []*componentpb.Edge{},
+		},
+	})
+
+	require.NoError(t, err)
+
+	t.Run("secret values without public key -> error", func(t *testing.T) {
+		_, err := ExportOrganization(ctx, r.AuthService, r.Registry, orgID, true, "")
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("secret values without permission to read secrets -> error", func(t *testing.T) {
+		viewer := support.CreateUser(t, r, r.Organization.ID)
+		viewerCtx := authentication.SetUserIdInMetadata(context.Background(), viewer.ID.String())
+		_, err := ExportOrganization(viewerCtx, r.AuthService, r.Registry, orgID, true, publicKey)
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("user without permission to create resources -> error", func(t *testing.T) {
+		require.NoError(t, r.AuthService.CreateCustomRole(orgID, &authorization.RoleDefinition{
+			Name:       "org-updater",
+			DomainType: models.DomainTypeOrganization,
+			Permissions: []*authorization.Permission{
+				{Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
+			},
+		}))
+
+		updater := support.CreateUser(t, r, r.Organization.ID)
+		require.NoError(t, r.AuthService.AssignRole(updater.ID.String(), "org-updater", orgID, models.DomainTypeOrganization))
+		updaterCtx := authentication.SetUserIdInMetadata(context.Background(), updater.ID.String())
+
+		exported, err := ExportOrganization(ctx, r.AuthService, r.Registry, orgID, false, "")
+		require.NoError(t, err)
+
+		_, err = ImportOrganization(updaterCtx, r.AuthService, r.Registry, orgID, exported.Archive, nil)
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("unsupported archive version -> error", func(t *testing.T) {
+		_, err := ImportOrganization(ctx, r.AuthService, r.Registry, orgID, []byte(`{"version": 2}`), nil)
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, "unsupported archive version 2", status.Convert(err).Message())
+	})
+
+	t.Run("exported organization is imported into another one", func(t *testing.T) {
+		exported, err := ExportOrganization(ctx, r.AuthService, r.Registry, orgID, true, publicKey)
+		require.NoError(t, err)
+
+		var archive OrganizationArchive
+		require.NoError(t, json.Unmarshal(exported.Archive, &archive))
+		assert.NotContains(t, string(exported.Archive), "hello")
+		require.Len(t, archive.Canvases, 1)
+		require.Len(t, archive.Canvases[0].Nodes, 1)
+
+		//
+		// Secret values are decrypted by the client,
+		// so the private key never leaves it.
+		//
+		opened, err := crypto.OpenWithPrivateKey(privateKey, archive.SecretValues)
+		require.NoError(t, err)
+		values := map[string]map[string]string{}
+		require.NoError(t, json.Unmarshal(opened, &values))
+		secretValues := map[string]*pb.SecretValues{}
+		for name, data := range values {
+			secretValues[name] = &pb.SecretValues{Data: data}
+		}
+
+		other := support.CreateOrganization(t, r, r.User)
+		otherID := other.ID.String()
+		response, err := ImportOrganization(ctx, r.AuthService, r.Registry, otherID, exported.Archive, secretValues)
+		require.NoError(t, err)
+		for _, resource := range response.Resources {
+			assert.False(t, resource.Skipped, "%s %s was skipped", resource.Kind, resource.Name)
+		}
+
+		//
+		// The canvas uses the imported blueprint.
+		//
+		importedBlueprint, err := models.FindBlueprintByName(blueprint.Name, other.ID)
+		require.NoError(t, err)
+		assert.Len(t, importedBlueprint.OutputChannels, 1)
+
+		importedCanvas, err := models.FindCanvasByName(canvasName, other.ID)
+		require.NoError(t, err)
+		topLevel := exportNodes(importedCanvas.Nodes)
+		require.Len(t, topLevel, 1)
+		assert.Equal(t, importedBlueprint.ID.String(), topLevel[0].Ref.Blueprint.ID)
+
+		//
+		// Secret values are decrypted and imported.
+		//
+		importedSecret, err := models.FindSecretByName(models.DomainTypeOrganization, other.ID, secret.Name)
+		require.NoError(t, err)
+		data, err := r.Encryptor.Decrypt(ctx, importedSecret.Data, []byte(secret.Name))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"token": "hello"}`, string(data))
+
+		groups, err := r.AuthService.GetGroups(otherID, models.DomainTypeOrganization)
+		require.NoError(t, err)
+		assert.Contains(t, groups, "ops")
+
+		//
+		// Importing again skips everything.
+		//
+		response, err = ImportOrganization(ctx, r.AuthService, r.Registry, otherID, exported.Archive, secretValues)
+		require.NoError(t, err)
+		require.NotEmpty(t, response.Resources)
+		for _, resource := range response.Resources {
+			assert.True(t, resource.Skipped, "%s %s was not skipped", resource.Kind, resource.Name)
+		}
+	})
+}
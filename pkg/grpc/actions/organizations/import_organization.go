@@ -0,0 +1,488 @@
+package organizations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/blueprints"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/canvases"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/secrets"
+	"github.com/superplanehq/superplane/pkg/models"
+	blueprintpb "github.com/superplanehq/superplane/pkg/protos/blueprints"
+	canvaspb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	secretpb "github.com/superplanehq/superplane/pkg/protos/secrets"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+ * ImportOrganization recreates the resources of an archive in the organization.
+ * Resources are created in dependency order, so the IDs of the integrations
+ * and blueprints referenced by canvases can be remapped to the new ones.
+ *
+ * Resources with the same name as an existing one are skipped, and the
+ * existing one is referenced instead, so an import that fails halfway
+ * can be run again. Resources are created through the same actions
+ * used by the API, so they are validated the same way. Since those
+ * actions do not check permissions themselves, the permissions to create
+ * each kind of resource in the archive are checked before anything is created.
+ */
+func ImportOrganization(ctx context.Context, authService authorization.Authorization, registry *registry.Registry, orgID string, data []byte, secretValues map[string]*pb.SecretValues) (*pb.ImportOrganizationResponse, error) {
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	var archive OrganizationArchive
+	if err := json.Unmarshal(data, &archive); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid archive: %v", err)
+	}
+
+	if archive.Version < 1 || archive.Version > OrganizationArchiveVersion {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported archive version %d", archive.Version)
+	}
+
+	if err := checkCanImport(ctx, authService, orgID, &archive); err != nil {
+		return nil, err
+	}
+
+	i := &organizationImport{
+		ctx:            ctx,
+		authService:    authService,
+		registry:       registry,
+		orgID:          orgID,
+		organizationID: organizationID,
+		archive:        &archive,
+		integrationIDs: map[string]string{},
+		blueprintIDs:   map[string]string{},
+		response:       &pb.ImportOrganizationResponse{Resources: []*pb.ImportedResource{}, Warnings: []string{}},
+	}
+
+	steps := []func() error{
+		i.importRoles,
+		i.importGroups,
+		i.importIntegrations,
+		func() error { return i.importSecrets(secretValues) },
+		i.importBlueprints,
+		i.importCanvases,
+	}
+
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return nil, err
+		}
+	}
+
+	return i.response, nil
+}
+
+func checkCanImport(ctx context.Context, authService authorization.Authorization, orgID string, archive *OrganizationArchive) error {
+	userID, ok := authentication.GetUserIdFromMetadata(ctx)
+	if !ok {
+		return status.Error(codes.PermissionDenied, "not allowed to import organization")
+	}
+
+	permissions := []authorization.Permission{}
+	if len(archive.Roles) > 0 {
+		permissions = append(permissions, authorization.Permission{Resource: "roles", Action: "create"})
+	}
+
+	if len(archive.Groups) > 0 {
+		permissions = append(permissions,
+			authorization.Permission{Resource: "groups", Action: "create"},
+			authorization.Permission{Resource: "groups", Action: "update"},
+		)
+	}
+
+	if len(archive.Integrations) > 0 {
+		permissions = append(permissions, authorization.Permission{Resource: "integrations", Action: "create"})
+	}
+
+	if len(archive.Secrets) > 0 {
+		permissions = append(permissions, authorization.Permission{Resource: "secrets", Action: "create"})
+	}
+
+	if len(archive.Blueprints) > 0 {
+		permissions = append(permissions, authorization.Permission{Resource: "blueprints", Action: "create"})
+	}
+
+	if len(archive.Canvases) > 0 {
+		permissions = append(permissions, authorization.Permission{Resource: "canvases", Action: "create"})
+	}
+
+	for _, permission := range permissions {
+		allowed, err := authService.CheckOrganizationPermission(userID, orgID, permission.Resource, permission.Action)
+		if err != nil {
+			log.Errorf("error checking permission of user %s to %s %s of organization %s: %v", userID, permission.Action, permission.Resource, orgID, err)
+			return status.Error(codes.Internal, "failed to check permissions")
+		}
+
+		if !allowed {
+			return status.Errorf(codes.PermissionDenied, "not allowed to %s %s", permission.Action, permission.Resource)
+		}
+	}
+
+	return nil
+}
+
+type organizationImport struct {
+	ctx            context.Context
+	authService    authorization.Authorization
+	registry       *registry.Registry
+	orgID          string
+	organizationID uuid.UUID
+	archive        *OrganizationArchive
+
+	// IDs in the archive to IDs in the organization.
+	integrationIDs map[string]string
+	blueprintIDs   map[string]string
+
+	response *pb.ImportOrganizationResponse
+}
+
+func (i *organizationImport) created(kind pb.ImportedResource_Kind, name, archiveID, id string) {
+	i.response.Resources = append(i.response.Resources, &pb.ImportedResource{
+		Kind:      kind,
+		Name:      name,
+		ArchiveId: archiveID,
+		Id:        id,
+	})
+}
+
+func (i *organizationImport) skipped(kind pb.ImportedResource_Kind, name, archiveID, id string) {
+	i.response.Resources = append(i.response.Resources, &pb.ImportedResource{
+		Kind:      kind,
+		Name:      name,
+		ArchiveId: archiveID,
+		Id:        id,
+		Skipped:   true,
+	})
+}
+
+func (i *organizationImport) warn(format string, args ...any) {
+	i.response.Warnings = append(i.response.Warnings, fmt.Sprintf(format, args...))
+}
+
+func importError(err error, resource, name string) error {
+	s := status.Convert(err)
+	return status.Errorf(s.Code(), "failed to import %s %s: %s", resource, name, s.Message())
+}
+
+func (i *organizationImport) importRoles() error {
+	roles := map[string]ArchivedRole{}
+	for _, role := range i.archive.Roles {
+		roles[role.Name] = role
+	}
+
+	done := map[string]bool{}
+
+	//
+	// Roles can inherit from other custom roles,
+	// which need to be created before them.
+	//
+	var importRole func(role ArchivedRole, path []string) error
+	importRole = func(role ArchivedRole, path []string) error {
+		if done[role.Name] {
+			return nil
+		}
+
+		if slices.Contains(path, role.Name) {
+			return status.Errorf(codes.InvalidArgument, "failed to import role %s: inheritance cycle", role.Name)
+		}
+
+		if parent, ok := roles[role.InheritsFrom]; ok {
+			if err := importRole(parent, append(path, role.Name)); err != nil {
+				return err
+			}
+		}
+
+		done[role.Name] = true
+		return i.importRole(role)
+	}
+
+	for _, role := range i.archive.Roles {
+		if err := importRole(role, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *organizationImport) importRole(role ArchivedRole) error {
+	_, err := i.authService.GetRoleDefinition(role.Name, models.DomainTypeOrganization, i.orgID)
+	if err == nil {
+		i.skipped(pb.ImportedResource_KIND_ROLE, role.Name, "", "")
+		return nil
+	}
+
+	definition := &authorization.RoleDefinition{
+		Name:        role.Name,
+		DisplayName: role.DisplayName,
+		Description: role.Description,
+		DomainType:  models.DomainTypeOrganization,
+		Permissions: []*authorization.Permission{},
+	}
+
+	for _, p := range role.Permissions {
+		permission := &authorization.Permission{
+			Resource:   p.Resource,
+			Action:     p.Action,
+			DomainType: models.DomainTypeOrganization,
+		}
+
+		if !i.authService.IsValidPermission(models.DomainTypeOrganization, permission) {
+			return status.Errorf(codes.InvalidArgument, "failed to import role %s: invalid permission: %s %s", role.Name, p.Resource, p.Action)
+		}
+
+		definition.Permissions = append(definition.Permissions, permission)
+	}
+
+	if role.InheritsFrom != "" {
+		inherited, err := i.authService.GetRoleDefinition(role.InheritsFrom, models.DomainTypeOrganization, i.orgID)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "failed to import role %s: inherited role %s not found", role.Name, role.InheritsFrom)
+		}
+
+		definition.InheritsFrom = inherited
+	}
+
+	if err := i.authService.CreateCustomRole(i.orgID, definition); err != nil {
+		log.Errorf("error importing role %s in organization %s: %v", role.Name, i.orgID, err)
+		return status.Errorf(codes.Internal, "failed to import role %s", role.Name)
+	}
+
+	i.created(pb.ImportedResource_KIND_ROLE, role.Name, "", "")
+	return nil
+}
+
+func (i *organizationImport) importGroups() error {
+	existing, err := i.authService.GetGroups(i.orgID, models.DomainTypeOrganization)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to list groups")
+	}
+
+	for _, group := range i.archive.Groups {
+		if slices.Contains(existing, group.Name) {
+			i.skipped(pb.ImportedResource_KIND_GROUP, group.Name, "", "")
+			continue
+		}
+
+		err := i.authService.CreateGroup(i.orgID, models.DomainTypeOrganization, group.Name, group.Role, group.DisplayName, group.Description)
+		if err != nil {
+			log.Errorf("error importing group %s in organization %s: %v", group.Name, i.orgID, err)
+			return status.Errorf(codes.Internal, "failed to import group %s", group.Name)
+		}
+
+		for _, email := range group.Members {
+			user, err := models.FindActiveUserByEmail(i.orgID, email)
+			if err != nil {
+				i.warn("%s is not a member of the organization, so it was not added to group %s", email, group.Name)
+				continue
+			}
+
+			err = i.authService.AddUserToGroup(i.orgID, models.DomainTypeOrganization, user.ID.String(), group.Name)
+			if err != nil {
+				log.Errorf("error adding user %s to group %s in organization %s: %v", user.ID, group.Name, i.orgID, err)
+				return status.Errorf(codes.Internal, "failed to add %s to group %s", email, group.Name)
+			}
+		}
+
+		i.created(pb.ImportedResource_KIND_GROUP, group.Name, "", "")
+	}
+
+	return nil
+}
+
+func (i *organizationImport) importIntegrations() error {
+	for _, archived := range i.archive.Integrations {
+		existing, err := models.FindIntegrationByName(i.organizationID, archived.Name)
+		if err == nil {
+			i.integrationIDs[archived.ID] = existing.ID.String()
+			i.skipped(pb.ImportedResource_KIND_INTEGRATION, archived.Name, archived.ID, existing.ID.String())
+			continue
+		}
+
+		definition, err := i.registry.GetIntegration(archived.AppName)
+		if err != nil {
+			i.warn("integration %s was not imported, since %s is not available", archived.Name, archived.AppName)
+			continue
+		}
+
+		configuration := withoutSensitiveFields(definition.Configuration(), archived.Configuration)
+		integration, err := models.CreateIntegration(uuid.New(), i.organizationID, archived.AppName, archived.Name, configuration)
+		if err != nil {
+			log.Errorf("error importing integration %s in organization %s: %v", archived.Name, i.orgID, err)
+			return status.Errorf(codes.Internal, "failed to import integration %s", archived.Name)
+		}
+
+		//
+		// The integration is synced by the workers, which
+		// fails until the credentials are set again, if needed.
+		//
+		if err := integration.CreateSyncRequest(database.Conn(), nil); err != nil {
+			log.Errorf("error creating sync request for integration %s: %v", integration.ID, err)
+		}
+
+		if hasSensitiveFields(definition.Configuration()) {
+			i.warn("integration %s needs its credentials to be set again", archived.Name)
+		}
+
+		i.integrationIDs[archived.ID] = integration.ID.String()
+		i.created(pb.ImportedResource_KIND_INTEGRATION, archived.Name, archived.ID, integration.ID.String())
+	}
+
+	return nil
+}
+
+func (i *organizationImport) importSecrets(values map[string]*pb.SecretValues) error {
+	if len(i.archive.SecretValues) > 0 && len(values) == 0 {
+		i.warn("secret values were not imported, since they were not given")
+	}
+
+	for _, archived := range i.archive.Secrets {
+		existing, err := models.FindSecretByName(models.DomainTypeOrganization, i.organizationID, archived.Name)
+		if err == nil {
+			i.skipped(pb.ImportedResource_KIND_SECRET, archived.Name, "", existing.ID.String())
+			continue
+		}
+
+		data := values[archived.Name].GetData()
+		if data == nil {
+			data = map[string]string{}
+			for _, key := range archived.Keys {
+				data[key] = ""
+			}
+		}
+
+		response, err := secrets.CreateSecret(i.ctx, i.registry.Encryptor, models.DomainTypeOrganization, i.orgID, &secretpb.Secret{
+			Metadata: &secretpb.Secret_Metadata{Name: archived.Name},
+			Spec: &secretpb.Secret_Spec{
+				Provider: secretpb.Secret_PROVIDER_LOCAL,
+				Local:    &secretpb.Secret_Local{Data: data},
+			},
+		})
+
+		if err != nil {
+			return importError(err, "secret", archived.Name)
+		}
+
+		i.created(pb.ImportedResource_KIND_SECRET, archived.Name, "", response.Secret.Metadata.Id)
+	}
+
+	return nil
+}
+
+func (i *organizationImport) importBlueprints() error {
+	for _, archived := range i.archive.Blueprints {
+		existing, err := models.FindBlueprintByName(archived.Name, i.organizationID)
+		if err == nil {
+			i.blueprintIDs[archived.ID] = existing.ID.String()
+			i.skipped(pb.ImportedResource_KIND_BLUEPRINT, archived.Name, archived.ID, existing.ID.String())
+			continue
+		}
+
+		response, err := blueprints.CreateBlueprint(i.ctx, i.registry, i.orgID, &blueprintpb.Blueprint{
+			Name:           archived.Name,
+			Description:    archived.Description,
+			Icon:           archived.Icon,
+			Color:          archived.Color,
+			Nodes:          actions.NodesToProto(i.remapNodes(archived.Nodes)),
+			Edges:          actions.EdgesToProto(archived.Edges),
+			Configuration:  blueprints.ConfigurationToProto(archived.Configuration),
+			OutputChannels: blueprints.OutputChannelsToProto(archived.OutputChannels),
+		})
+
+		if err != nil {
+			return importError(err, "blueprint", archived.Name)
+		}
+
+		i.blueprintIDs[archived.ID] = response.Blueprint.Id
+		i.created(pb.ImportedResource_KIND_BLUEPRINT, archived.Name, archived.ID, response.Blueprint.Id)
+	}
+
+	return nil
+}
+
+func (i *organizationImport) importCanvases() error {
+	for _, canvas := range i.archive.Canvases {
+		existing, err := models.FindCanvasByName(canvas.Name, i.organizationID)
+		if err == nil {
+			i.skipped(pb.ImportedResource_KIND_CANVAS, canvas.Name, canvas.ID, existing.ID.String())
+			continue
+		}
+
+		response, err := canvases.CreateCanvas(i.ctx, i.registry, i.orgID, &canvaspb.Canvas{
+			Metadata: &canvaspb.Canvas_Metadata{
+				Name:        canvas.Name,
+				Description: canvas.Description,
+			},
+			Spec: &canvaspb.Canvas_Spec{
+				Nodes: actions.NodesToProto(i.remapNodes(canvas.Nodes)),
+				Edges: actions.EdgesToProto(canvas.Edges),
+			},
+		})
+
+		if err != nil {
+			return importError(err, "canvas", canvas.Name)
+		}
+
+		i.created(pb.ImportedResource_KIND_CANVAS, canvas.Name, canvas.ID, response.Canvas.Metadata.Id)
+	}
+
+	return nil
+}
+
+// remapNodes replaces the IDs of the integrations and blueprints
+// used by the nodes with the IDs of the imported ones.
+func (i *organizationImport) remapNodes(nodes []models.Node) []models.Node {
+	remapped := make([]models.Node, 0, len(nodes))
+	for _, node := range nodes {
+		if node.Ref.Blueprint != nil {
+			if id, ok := i.blueprintIDs[node.Ref.Blueprint.ID]; ok {
+				node.Ref.Blueprint = &models.BlueprintRef{ID: id}
+			}
+		}
+
+		if node.IntegrationID != nil {
+			if id, ok := i.integrationIDs[*node.IntegrationID]; ok {
+				node.IntegrationID = &id
+			}
+		}
+
+		remapped = append(remapped, node)
+	}
+
+	return remapped
+}
+
+func withoutSensitiveFields(fields []configuration.Field, config map[string]any) map[string]any {
+	result := map[string]any{}
+	maps.Copy(result, config)
+
+	for _, field := range fields {
+		if field.Sensitive {
+			delete(result, field.Name)
+		}
+	}
+
+	return result
+}
+
+func hasSensitiveFields(fields []configuration.Field) bool {
+	return slices.ContainsFunc(fields, func(field configuration.Field) bool {
+		return field.Sensitive
+	})
+}
@@ -0,0 +1,68 @@
+package organizations
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ListStuckWork(ctx context.Context, registry *registry.Registry, orgID string, thresholds models.StuckWorkThresholds) (*pb.ListStuckWorkResponse, error) {
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	now := time.Now()
+	stuck, err := models.FindStuckWork(&organizationID, thresholds, registry.ListComponentsWaitingForUsers(), now)
+	if err != nil {
+		log.Errorf("error finding stuck work for organization %s: %v", orgID, err)
+		return nil, status.Error(codes.Internal, "failed to list stuck work")
+	}
+
+	items := make([]*pb.StuckWork, 0, len(stuck))
+	for _, work := range stuck {
+		items = append(items, serializeStuckWork(&work, now))
+	}
+
+	return &pb.ListStuckWorkResponse{Items: items}, nil
+}
+
+func serializeStuckWork(work *models.StuckWork, now time.Time) *pb.StuckWork {
+	serialized := &pb.StuckWork{
+		Kind:     stuckWorkKindToProto(work.Kind),
+		CanvasId: work.WorkflowID.String(),
+		NodeId:   work.NodeID,
+		Count:    work.Count,
+		Since:    timestamppb.New(work.Since),
+		Reason:   work.Reason(now),
+	}
+
+	if work.ResourceID != nil {
+		serialized.ResourceId = work.ResourceID.String()
+	}
+
+	return serialized
+}
+
+func stuckWorkKindToProto(kind string) pb.StuckWork_Kind {
+	switch kind {
+	case models.StuckWorkKindNodeProcessing:
+		return pb.StuckWork_KIND_NODE_PROCESSING
+	case models.StuckWorkKindQueueItems:
+		return pb.StuckWork_KIND_QUEUE_ITEMS
+	case models.StuckWorkKindExecutionStarted:
+		return pb.StuckWork_KIND_EXECUTION_STARTED
+	case models.StuckWorkKindWebhookPending:
+		return pb.StuckWork_KIND_WEBHOOK_PENDING
+	default:
+		return pb.StuckWork_KIND_UNKNOWN
+	}
+}
@@ -0,0 +1,97 @@
+package organizations
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const MaxWebhookSubscriptionDeliveriesLimit = 100
+
+func ListWebhookSubscriptionDeliveries(ctx context.Context, orgID string, subscriptionID string, limit uint32, before *timestamppb.Timestamp) (*pb.ListWebhookSubscriptionDeliveriesResponse, error) {
+	subscription, err := findWebhookSubscription(orgID, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit == 0 || limit > MaxWebhookSubscriptionDeliveriesLimit {
+		limit = MaxWebhookSubscriptionDeliveriesLimit
+	}
+
+	var beforeTime *time.Time
+	if before != nil {
+		t := before.AsTime()
+		beforeTime = &t
+	}
+
+	deliveries, err := models.ListOrganizationWebhookDeliveries(subscription.ID, int(limit), beforeTime)
+	if err != nil {
+		log.Errorf("error listing deliveries for webhook subscription %s: %v", subscription.ID, err)
+		return nil, status.Error(codes.Internal, "failed to list webhook subscription deliveries")
+	}
+
+	totalCount, err := models.CountOrganizationWebhookDeliveries(subscription.ID)
+	if err != nil {
+		log.Errorf("error counting deliveries for webhook subscription %s: %v", subscription.ID, err)
+		return nil, status.Error(codes.Internal, "failed to list webhook subscription deliveries")
+	}
+
+	serialized := make([]*pb.WebhookSubscriptionDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		serialized = append(serialized, serializeWebhookSubscriptionDelivery(&delivery))
+	}
+
+	response := &pb.ListWebhookSubscriptionDeliveriesResponse{
+		Deliveries:  serialized,
+		TotalCount:  uint32(totalCount),
+		HasNextPage: len(deliveries) >= int(limit) && int64(len(deliveries)) < totalCount,
+	}
+
+	if len(deliveries) > 0 {
+		response.LastTimestamp = timestamppb.New(*deliveries[len(deliveries)-1].CreatedAt)
+	}
+
+	return response, nil
+}
+
+func serializeWebhookSubscriptionDelivery(delivery *models.OrganizationWebhookDelivery) *pb.WebhookSubscriptionDelivery {
+	serialized := &pb.WebhookSubscriptionDelivery{
+		Id:        delivery.ID.String(),
+		EventType: delivery.EventType,
+		State:     delivery.State,
+		Attempts:  int32(delivery.Attempts),
+		CreatedAt: timestamppb.New(*delivery.CreatedAt),
+	}
+
+	if delivery.LastStatusCode != nil {
+		serialized.LastStatusCode = int32(*delivery.LastStatusCode)
+	}
+
+	if delivery.LastError != nil {
+		serialized.LastError = *delivery.LastError
+	}
+
+	if delivery.NextAttemptAt != nil {
+		serialized.NextAttemptAt = timestamppb.New(*delivery.NextAttemptAt)
+	}
+
+	if delivery.DeliveredAt != nil {
+		serialized.DeliveredAt = timestamppb.New(*delivery.DeliveredAt)
+	}
+
+	payload, err := structpb.NewStruct(delivery.Payload.Data())
+	if err != nil {
+		log.Errorf("error serializing payload for webhook subscription delivery %s: %v", delivery.ID, err)
+		return serialized
+	}
+
+	serialized.Payload = payload
+	return serialized
+}
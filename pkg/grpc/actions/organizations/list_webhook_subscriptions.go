@@ -0,0 +1,49 @@
+package organizations
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ListWebhookSubscriptions(ctx context.Context, orgID string) (*pb.ListWebhookSubscriptionsResponse, error) {
+	org, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization ID: %v", err)
+	}
+
+	subscriptions, err := models.ListOrganizationWebhookSubscriptions(org)
+	if err != nil {
+		log.Errorf("error listing webhook subscriptions for organization %s: %v", org, err)
+		return nil, status.Error(codes.Internal, "failed to list webhook subscriptions")
+	}
+
+	serialized := make([]*pb.WebhookSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		serialized = append(serialized, serializeWebhookSubscription(&subscription))
+	}
+
+	return &pb.ListWebhookSubscriptionsResponse{
+		Subscriptions: serialized,
+	}, nil
+}
+
+func serializeWebhookSubscription(subscription *models.OrganizationWebhookSubscription) *pb.WebhookSubscription {
+	eventTypes := []string{}
+	eventTypes = append(eventTypes, subscription.EventTypes...)
+
+	return &pb.WebhookSubscription{
+		Id:         subscription.ID.String(),
+		Url:        subscription.URL,
+		EventTypes: eventTypes,
+		Enabled:    subscription.Enabled,
+		CreatedAt:  timestamppb.New(*subscription.CreatedAt),
+		UpdatedAt:  timestamppb.New(*subscription.UpdatedAt),
+	}
+}
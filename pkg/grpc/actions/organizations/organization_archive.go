@@ -0,0 +1,101 @@
+package organizations
+
+import (
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+const OrganizationArchiveVersion = 1
+
+/*
+ * OrganizationArchive is the document produced by ExportOrganization,
+ * and consumed by ImportOrganization. IDs are only kept for
+ * the resources referenced by others, so the references
+ * can be remapped to the IDs of the imported resources.
+ */
+type OrganizationArchive struct {
+	Version      int                   `json:"version"`
+	ExportedAt   time.Time             `json:"exportedAt"`
+	Organization ArchivedOrganization  `json:"organization"`
+	Roles        []ArchivedRole        `json:"roles"`
+	Groups       []ArchivedGroup       `json:"groups"`
+	Integrations []ArchivedIntegration `json:"integrations"`
+	Secrets      []ArchivedSecret      `json:"secrets"`
+	Blueprints   []ArchivedBlueprint   `json:"blueprints"`
+	Canvases     []ArchivedCanvas      `json:"canvases"`
+
+	//
+	// Secret values, as a JSON object with the values of each secret,
+	// sealed with the public key given on export. Empty if not exported.
+	//
+	SecretValues []byte `json:"secretValues,omitempty"`
+}
+
+type ArchivedOrganization struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Only custom roles are exported, since default roles
+// are created with every organization.
+type ArchivedRole struct {
+	Name         string               `json:"name"`
+	DisplayName  string               `json:"displayName"`
+	Description  string               `json:"description"`
+	InheritsFrom string               `json:"inheritsFrom,omitempty"`
+	Permissions  []ArchivedPermission `json:"permissions"`
+}
+
+type ArchivedPermission struct {
+	Resource string `json:"resource"`
+	Action   string `json:"action"`
+}
+
+// Members are identified by email, and only the ones
+// already in the organization are added on import.
+type ArchivedGroup struct {
+	Name        string   `json:"name"`
+	DisplayName string   `json:"displayName"`
+	Description string   `json:"description"`
+	Role        string   `json:"role"`
+	Members     []string `json:"members"`
+}
+
+// Sensitive configuration fields are not exported.
+type ArchivedIntegration struct {
+	ID            string         `json:"id"`
+	AppName       string         `json:"appName"`
+	Name          string         `json:"name"`
+	Configuration map[string]any `json:"configuration"`
+}
+
+type ArchivedSecret struct {
+	Name     string   `json:"name"`
+	Provider string   `json:"provider"`
+	Keys     []string `json:"keys"`
+}
+
+type ArchivedBlueprint struct {
+	ID             string                          `json:"id"`
+	Name           string                          `json:"name"`
+	Description    string                          `json:"description"`
+	Icon           string                          `json:"icon"`
+	Color          string                          `json:"color"`
+	Nodes          []models.Node                   `json:"nodes"`
+	Edges          []models.Edge                   `json:"edges"`
+	Configuration  []configuration.Field           `json:"configuration"`
+	OutputChannels []models.BlueprintOutputChannel `json:"outputChannels"`
+}
+
+// Nodes only include the top-level nodes of the canvas, since
+// the internal nodes of blueprints are created again on import.
+type ArchivedCanvas struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	Nodes       []models.Node `json:"nodes"`
+	Edges       []models.Edge `json:"edges"`
+}
@@ -0,0 +1,72 @@
+package organizations
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func UpdateWebhookSubscription(ctx context.Context, orgID string, subscriptionID string, req *pb.UpdateWebhookSubscriptionRequest) (*pb.UpdateWebhookSubscriptionResponse, error) {
+	subscription, err := findWebhookSubscription(orgID, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Url != nil {
+		err = validateWebhookSubscriptionURL(*req.Url)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		subscription.URL = *req.Url
+	}
+
+	if req.UpdateEventTypes {
+		err = validateWebhookSubscriptionEventTypes(req.EventTypes)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		subscription.EventTypes = datatypes.NewJSONSlice(req.EventTypes)
+	}
+
+	if req.Enabled != nil {
+		subscription.Enabled = *req.Enabled
+	}
+
+	err = subscription.Update(database.Conn())
+	if err != nil {
+		log.Errorf("error updating webhook subscription %s: %v", subscription.ID, err)
+		return nil, status.Error(codes.Internal, "failed to update webhook subscription")
+	}
+
+	return &pb.UpdateWebhookSubscriptionResponse{
+		Subscription: serializeWebhookSubscription(subscription),
+	}, nil
+}
+
+func findWebhookSubscription(orgID string, subscriptionID string) (*models.OrganizationWebhookSubscription, error) {
+	org, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization ID: %v", err)
+	}
+
+	id, err := uuid.Parse(subscriptionID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid subscription ID: %v", err)
+	}
+
+	subscription, err := models.FindOrganizationWebhookSubscription(org, id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "webhook subscription not found")
+	}
+
+	return subscription, nil
+}
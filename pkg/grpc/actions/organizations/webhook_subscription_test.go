@@ -0,0 +1,122 @@
+package organizations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__WebhookSubscriptions(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+	orgID := r.Organization.ID.String()
+
+	t.Run("invalid url -> error", func(t *testing.T) {
+		_, err := CreateWebhookSubscription(ctx, r.Encryptor, orgID, "ftp://example.com", nil)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("unknown event type -> error", func(t *testing.T) {
+		_, err := CreateWebhookSubscription(ctx, r.Encryptor, orgID, "https://example.com/hook", []string{"execution.unknown"})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("create, update, list and delete subscription", func(t *testing.T) {
+		created, err := CreateWebhookSubscription(ctx, r.Encryptor, orgID, "https://example.com/hook", []string{models.OrganizationWebhookEventExecutionFailed})
+		require.NoError(t, err)
+		require.NotEmpty(t, created.Secret)
+		assert.Equal(t, "https://example.com/hook", created.Subscription.Url)
+		assert.Equal(t, []string{models.OrganizationWebhookEventExecutionFailed}, created.Subscription.EventTypes)
+		assert.True(t, created.Subscription.Enabled)
+
+		//
+		// The secret is stored encrypted.
+		//
+		subscription, err := models.FindOrganizationWebhookSubscription(r.Organization.ID, uuid.MustParse(created.Subscription.Id))
+		require.NoError(t, err)
+		secret, err := r.Encryptor.Decrypt(context.Background(), subscription.Secret, []byte(subscription.ID.String()))
+		require.NoError(t, err)
+		assert.Equal(t, created.Secret, string(secret))
+
+		enabled := false
+		updated, err := UpdateWebhookSubscription(ctx, orgID, created.Subscription.Id, &pb.UpdateWebhookSubscriptionRequest{
+			Enabled:          &enabled,
+			UpdateEventTypes: true,
+			EventTypes:       []string{},
+		})
+
+		require.NoError(t, err)
+		assert.False(t, updated.Subscription.Enabled)
+		assert.Empty(t, updated.Subscription.EventTypes)
+		assert.Equal(t, "https://example.com/hook", updated.Subscription.Url)
+
+		list, err := ListWebhookSubscriptions(ctx, orgID)
+		require.NoError(t, err)
+		require.Len(t, list.Subscriptions, 1)
+		assert.Equal(t, created.Subscription.Id, list.Subscriptions[0].Id)
+
+		deliveries, err := ListWebhookSubscriptionDeliveries(ctx, orgID, created.Subscription.Id, 0, nil)
+		require.NoError(t, err)
+		assert.Empty(t, deliveries.Deliveries)
+
+		_, err = DeleteWebhookSubscription(ctx, orgID, created.Subscription.Id)
+		require.NoError(t, err)
+
+		list, err = ListWebhookSubscriptions(ctx, orgID)
+		require.NoError(t, err)
+		assert.Empty(t, list.Subscriptions)
+	})
+
+	t.Run("subscription from another organization -> not found", func(t *testing.T) {
+		created, err := CreateWebhookSubscription(ctx, r.Encryptor, orgID, "https://example.com/hook", nil)
+		require.NoError(t, err)
+
+		_, err = DeleteWebhookSubscription(ctx, uuid.NewString(), created.Subscription.Id)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+}
+
+func Test__ValidateWebhookSubscriptionURL(t *testing.T) {
+	tests := []struct {
+		url   string
+		valid bool
+	}{
+		{url: "https://203.0.113.10/hook", valid: true},
+		{url: "", valid: false},
+		{url: "ftp://203.0.113.10/hook", valid: false},
+		{url: "http://localhost:8000/hook", valid: false},
+		{url: "http://app.localhost/hook", valid: false},
+		{url: "http://127.0.0.1/hook", valid: false},
+		{url: "http://[::1]/hook", valid: false},
+		{url: "http://10.1.2.3/hook", valid: false},
+		{url: "http://192.168.0.10/hook", valid: false},
+		{url: "http://169.254.169.254/latest/meta-data", valid: false},
+		{url: "http://0.0.0.0/hook", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			err := validateWebhookSubscriptionURL(tt.url)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -17,14 +17,16 @@ type CanvasService struct {
 	registry       *registry.Registry
 	encryptor      crypto.Encryptor
 	authService    authorization.Authorization
+	baseURL        string
 	webhookBaseURL string
 }
 
-func NewCanvasService(authService authorization.Authorization, registry *registry.Registry, encryptor crypto.Encryptor, webhookBaseURL string) *CanvasService {
+func NewCanvasService(authService authorization.Authorization, registry *registry.Registry, encryptor crypto.Encryptor, baseURL, webhookBaseURL string) *CanvasService {
 	return &CanvasService{
 		registry:       registry,
 		encryptor:      encryptor,
 		authService:    authService,
+		baseURL:        baseURL,
 		webhookBaseURL: webhookBaseURL,
 	}
 }
@@ -184,6 +186,22 @@ func (s *CanvasService) ListEventExecutions(ctx context.Context, req *pb.ListEve
 	return canvases.ListEventExecutions(ctx, s.registry, req.CanvasId, req.EventId)
 }
 
+func (s *CanvasService) DescribeExecution(ctx context.Context, req *pb.DescribeExecutionRequest) (*pb.DescribeExecutionResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	executionID, err := uuid.Parse(req.ExecutionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid execution_id")
+	}
+
+	return canvases.DescribeExecution(ctx, uuid.MustParse(organizationID), canvasID, executionID)
+}
+
 func (s *CanvasService) ListChildExecutions(ctx context.Context, req *pb.ListChildExecutionsRequest) (*pb.ListChildExecutionsResponse, error) {
 	canvasID, err := uuid.Parse(req.CanvasId)
 	if err != nil {
@@ -231,3 +249,86 @@ func (s *CanvasService) ResolveExecutionErrors(ctx context.Context, req *pb.Reso
 
 	return canvases.ResolveExecutionErrors(ctx, canvasID, executionIDs)
 }
+
+func (s *CanvasService) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	if req.NodeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "node_id is required")
+	}
+
+	return canvases.ListWebhookDeliveries(ctx, s.encryptor, uuid.MustParse(organizationID), canvasID, req.NodeId, req.Limit, req.Before)
+}
+
+func (s *CanvasService) ReplayWebhookDelivery(ctx context.Context, req *pb.ReplayWebhookDeliveryRequest) (*pb.ReplayWebhookDeliveryResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	if req.NodeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "node_id is required")
+	}
+
+	deliveryID, err := uuid.Parse(req.DeliveryId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid delivery_id")
+	}
+
+	return canvases.ReplayWebhookDelivery(
+		ctx,
+		s.encryptor,
+		s.registry,
+		uuid.MustParse(organizationID),
+		canvasID,
+		req.NodeId,
+		deliveryID,
+		s.baseURL,
+		s.webhookBaseURL,
+	)
+}
+
+func (s *CanvasService) GetCanvasMetrics(ctx context.Context, req *pb.GetCanvasMetricsRequest) (*pb.GetCanvasMetricsResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	return canvases.GetCanvasMetrics(ctx, uuid.MustParse(organizationID), canvasID, req.StartTime, req.EndTime, req.NodeIds)
+}
+
+func (s *CanvasService) WatchCanvas(req *pb.WatchCanvasRequest, stream pb.Canvases_WatchCanvasServer) error {
+	organizationID := stream.Context().Value(authorization.OrganizationContextKey).(string)
+
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	return canvases.WatchCanvas(stream.Context(), uuid.MustParse(organizationID), canvasID, req.NodeIds, req.Types, req.Cursor, stream)
+}
+
+func (s *CanvasService) GetExecutionLogs(req *pb.GetExecutionLogsRequest, stream pb.Canvases_GetExecutionLogsServer) error {
+	organizationID := stream.Context().Value(authorization.OrganizationContextKey).(string)
+
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	executionID, err := uuid.Parse(req.ExecutionId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid execution_id")
+	}
+
+	return canvases.GetExecutionLogs(stream.Context(), uuid.MustParse(organizationID), canvasID, executionID, req.Follow, req.AfterSequence, stream)
+}
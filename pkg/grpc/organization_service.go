@@ -5,6 +5,7 @@ import (
 
 	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/organizations"
+	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/pkg/oidc"
 	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
 	"github.com/superplanehq/superplane/pkg/registry"
@@ -20,6 +21,7 @@ type OrganizationService struct {
 	oidcProvider         oidc.Provider
 	baseURL              string
 	webhooksBaseURL      string
+	stuckWorkThresholds  models.StuckWorkThresholds
 }
 
 func NewOrganizationService(
@@ -28,6 +30,7 @@ func NewOrganizationService(
 	oidcProvider oidc.Provider,
 	baseURL string,
 	webhooksBaseURL string,
+	stuckWorkThresholds models.StuckWorkThresholds,
 ) *OrganizationService {
 	return &OrganizationService{
 		registry:             registry,
@@ -35,6 +38,7 @@ func NewOrganizationService(
 		baseURL:              baseURL,
 		webhooksBaseURL:      webhooksBaseURL,
 		authorizationService: authorizationService,
+		stuckWorkThresholds:  stuckWorkThresholds,
 	}
 }
 
@@ -152,6 +156,31 @@ func (s *OrganizationService) DeleteIntegration(ctx context.Context, req *pb.Del
 	return organizations.DeleteIntegration(ctx, orgID, req.IntegrationId)
 }
 
+func (s *OrganizationService) ListWebhookSubscriptions(ctx context.Context, req *pb.ListWebhookSubscriptionsRequest) (*pb.ListWebhookSubscriptionsResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.ListWebhookSubscriptions(ctx, orgID)
+}
+
+func (s *OrganizationService) CreateWebhookSubscription(ctx context.Context, req *pb.CreateWebhookSubscriptionRequest) (*pb.CreateWebhookSubscriptionResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.CreateWebhookSubscription(ctx, s.registry.Encryptor, orgID, req.Url, req.EventTypes)
+}
+
+func (s *OrganizationService) UpdateWebhookSubscription(ctx context.Context, req *pb.UpdateWebhookSubscriptionRequest) (*pb.UpdateWebhookSubscriptionResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.UpdateWebhookSubscription(ctx, orgID, req.SubscriptionId, req)
+}
+
+func (s *OrganizationService) DeleteWebhookSubscription(ctx context.Context, req *pb.DeleteWebhookSubscriptionRequest) (*pb.DeleteWebhookSubscriptionResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.DeleteWebhookSubscription(ctx, orgID, req.SubscriptionId)
+}
+
+func (s *OrganizationService) ListWebhookSubscriptionDeliveries(ctx context.Context, req *pb.ListWebhookSubscriptionDeliveriesRequest) (*pb.ListWebhookSubscriptionDeliveriesResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.ListWebhookSubscriptionDeliveries(ctx, orgID, req.SubscriptionId, req.Limit, req.Before)
+}
+
 func accountIDFromContext(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -165,3 +194,18 @@ func accountIDFromContext(ctx context.Context) (string, error) {
 
 	return accountMeta[0], nil
 }
+
+func (s *OrganizationService) ListStuckWork(ctx context.Context, req *pb.ListStuckWorkRequest) (*pb.ListStuckWorkResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.ListStuckWork(ctx, s.registry, orgID, s.stuckWorkThresholds)
+}
+
+func (s *OrganizationService) ExportOrganization(ctx context.Context, req *pb.ExportOrganizationRequest) (*pb.ExportOrganizationResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.ExportOrganization(ctx, s.authorizationService, s.registry, orgID, req.IncludeSecretValues, req.PublicKey)
+}
+
+func (s *OrganizationService) ImportOrganization(ctx context.Context, req *pb.ImportOrganizationRequest) (*pb.ImportOrganizationResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.ImportOrganization(ctx, s.authorizationService, s.registry, orgID, req.Archive, req.SecretValues)
+}
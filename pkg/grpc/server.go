@@ -1,8 +1,7 @@
 package grpc
 
 import (
-	"fmt"
-	"net"
+	"context"
 	"runtime/debug"
 	"time"
 
@@ -12,6 +11,7 @@ import (
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/pkg/oidc"
 	pbBlueprints "github.com/superplanehq/superplane/pkg/protos/blueprints"
 	pbCanvases "github.com/superplanehq/superplane/pkg/protos/canvases"
@@ -53,14 +53,7 @@ func sentryRecoveryHandler(p any) error {
 	return status.Errorf(codes.Internal, "internal server error")
 }
 
-func RunServer(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encryptor, authService authorization.Authorization, registry *registry.Registry, oidcProvider oidc.Provider, port int) {
-	endpoint := fmt.Sprintf("0.0.0.0:%d", port)
-	lis, err := net.Listen("tcp", endpoint)
-
-	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
-	}
-
+func NewServer(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encryptor, authService authorization.Authorization, registry *registry.Registry, oidcProvider oidc.Provider, stuckWorkThresholds models.StuckWorkThresholds) *grpc.Server {
 	//
 	// Set up error handler middlewares for the server.
 	//
@@ -76,6 +69,7 @@ func RunServer(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encry
 		),
 		grpc.ChainStreamInterceptor(
 			recovery.StreamServerInterceptor(opts...),
+			authorization.NewAuthorizationInterceptor(authService).StreamInterceptor(),
 		),
 	)
 
@@ -88,7 +82,7 @@ func RunServer(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encry
 	//
 	// Initialize services exposed by this server.
 	//
-	organizationService := NewOrganizationService(authService, registry, oidcProvider, baseURL, webhooksBaseURL)
+	organizationService := NewOrganizationService(authService, registry, oidcProvider, baseURL, webhooksBaseURL, stuckWorkThresholds)
 	organizationPb.RegisterOrganizationsServer(grpcServer, organizationService)
 
 	userService := NewUsersService(authService)
@@ -118,20 +112,29 @@ func RunServer(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encry
 	blueprintService := NewBlueprintService(registry)
 	pbBlueprints.RegisterBlueprintsServer(grpcServer, blueprintService)
 
-	canvasService := NewCanvasService(authService, registry, encryptor, webhooksBaseURL+basePath)
+	canvasService := NewCanvasService(authService, registry, encryptor, baseURL, webhooksBaseURL+basePath)
 	pbCanvases.RegisterCanvasesServer(grpcServer, canvasService)
 
 	integrationService := NewIntegrationService(encryptor, registry)
 	integrationpb.RegisterIntegrationsServer(grpcServer, integrationService)
 
 	reflection.Register(grpcServer)
+	return grpcServer
+}
 
-	//
-	// Start handling incoming requests
-	//
-	log.Infof("Starting GRPC on %s.", endpoint)
-	err = grpcServer.Serve(lis)
-	if err != nil {
-		panic(err)
+// GracefulStop stops the server from taking new requests and waits
+// for the ones in flight, until the context is done.
+// Requests still in flight by then are cancelled.
+func GracefulStop(ctx context.Context, server *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		server.Stop()
 	}
 }
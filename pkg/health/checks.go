@@ -0,0 +1,132 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/superplanehq/superplane/pkg/database"
+)
+
+func DatabaseCheck(ctx context.Context) error {
+	db, err := database.Conn().DB()
+	if err != nil {
+		return err
+	}
+
+	return db.PingContext(ctx)
+}
+
+// RabbitMQCheck keeps its connection open between probes,
+// and only dials again once it is closed, so probes do not churn connections.
+// A connection to a broker that goes away is closed by the AMQP heartbeats.
+func RabbitMQCheck(url string) CheckFunc {
+	return rabbitMQCheck(func(timeout time.Duration) (rabbitMQConnection, error) {
+		return amqp.DialConfig(url, amqp.Config{Dial: amqp.DefaultDial(timeout)})
+	})
+}
+
+type rabbitMQConnection interface {
+	IsClosed() bool
+}
+
+func rabbitMQCheck(dial func(timeout time.Duration) (rabbitMQConnection, error)) CheckFunc {
+	var mu sync.Mutex
+	var conn rabbitMQConnection
+
+	return func(ctx context.Context) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if conn != nil && !conn.IsClosed() {
+			return nil
+		}
+
+		timeout := CheckTimeout
+		if deadline, ok := ctx.Deadline(); ok {
+			timeout = time.Until(deadline)
+		}
+
+		c, err := dial(timeout)
+		if err != nil {
+			return err
+		}
+
+		conn = c
+		return nil
+	}
+}
+
+// MigrationsCheck verifies the database schema is clean, and not behind
+// the most recent migration in the given directory.
+func MigrationsCheck(dir string) CheckFunc {
+	return func(ctx context.Context) error {
+		var migration struct {
+			Version int64
+			Dirty   bool
+		}
+
+		err := database.Conn().
+			WithContext(ctx).
+			Raw("SELECT version, dirty FROM schema_migrations LIMIT 1").
+			Scan(&migration).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		if migration.Dirty {
+			return fmt.Errorf("migration %d is dirty", migration.Version)
+		}
+
+		latest, err := LatestMigration(dir)
+		if err != nil {
+			return fmt.Errorf("error finding latest migration: %w", err)
+		}
+
+		if migration.Version < latest {
+			return fmt.Errorf("database is at migration %d, expected %d", migration.Version, latest)
+		}
+
+		return nil
+	}
+}
+
+// LatestMigration returns the version of the most recent migration in a directory.
+func LatestMigration(dir string) (int64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, err
+	}
+
+	var latest int64
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry.Name(), ".up.sql") {
+			continue
+		}
+
+		prefix, _, found := strings.Cut(entry.Name(), "_")
+		if !found {
+			continue
+		}
+
+		version, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		latest = max(latest, version)
+	}
+
+	if latest == 0 {
+		return 0, fmt.Errorf("no migrations found in %s", dir)
+	}
+
+	return latest, nil
+}
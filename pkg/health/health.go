@@ -0,0 +1,147 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// How long a worker can go without ticking before the process is not ready.
+const WorkerHeartbeatTimeout = 5 * time.Minute
+
+// How long each readiness check can take.
+const CheckTimeout = 3 * time.Second
+
+type CheckFunc func(ctx context.Context) error
+
+/*
+ * Checker runs the readiness checks of the process:
+ * connectivity to its dependencies, and the heartbeats
+ * of the workers started in it.
+ */
+type Checker struct {
+	mu         sync.Mutex
+	checks     map[string]CheckFunc
+	heartbeats []*Heartbeat
+}
+
+func NewChecker() *Checker {
+	return &Checker{checks: map[string]CheckFunc{}}
+}
+
+func (c *Checker) AddCheck(name string, check CheckFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks[name] = check
+}
+
+// NewHeartbeat registers a worker that must beat at least every timeout.
+func (c *Checker) NewHeartbeat(name string, timeout time.Duration) *Heartbeat {
+	h := &Heartbeat{name: name, timeout: timeout}
+	h.last.Store(time.Now().UnixNano())
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.heartbeats = append(c.heartbeats, h)
+	return h
+}
+
+type Result struct {
+	Ready  bool              `json:"ready"`
+	Checks map[string]string `json:"checks"`
+}
+
+// Check runs all checks concurrently.
+func (c *Checker) Check(ctx context.Context) Result {
+	c.mu.Lock()
+	checks := make(map[string]CheckFunc, len(c.checks))
+	for name, check := range c.checks {
+		checks[name] = check
+	}
+	heartbeats := append([]*Heartbeat{}, c.heartbeats...)
+	c.mu.Unlock()
+
+	result := Result{Ready: true, Checks: map[string]string{}}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	record := func(name string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if err != nil {
+			result.Ready = false
+			result.Checks[name] = err.Error()
+			return
+		}
+
+		result.Checks[name] = "ok"
+	}
+
+	for name, check := range checks {
+		wg.Add(1)
+		go func(name string, check CheckFunc) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, CheckTimeout)
+			defer cancel()
+			record(name, check(checkCtx))
+		}(name, check)
+	}
+
+	wg.Wait()
+
+	now := time.Now()
+	for _, h := range heartbeats {
+		record("worker:"+h.name, h.check(now))
+	}
+
+	return result
+}
+
+// LiveHandler responds as long as the process is able to serve requests.
+func (c *Checker) LiveHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{"live":true}`))
+}
+
+// ReadyHandler responds with 503 if any of the checks fail.
+func (c *Checker) ReadyHandler(w http.ResponseWriter, r *http.Request) {
+	result := c.Check(r.Context())
+
+	w.Header().Set("Content-Type", "application/json")
+	if !result.Ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+type Heartbeat struct {
+	name    string
+	timeout time.Duration
+	last    atomic.Int64
+}
+
+// Beat records that the worker is still ticking.
+// A nil heartbeat does nothing, so workers can beat without being monitored.
+func (h *Heartbeat) Beat() {
+	if h == nil {
+		return
+	}
+
+	h.last.Store(time.Now().UnixNano())
+}
+
+func (h *Heartbeat) check(now time.Time) error {
+	last := time.Unix(0, h.last.Load())
+	if now.Sub(last) > h.timeout {
+		return fmt.Errorf("no tick since %s", last.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__Checker(t *testing.T) {
+	t.Run("ready when all checks pass", func(t *testing.T) {
+		checker := NewChecker()
+		checker.AddCheck("database", func(ctx context.Context) error { return nil })
+		checker.NewHeartbeat("EventRouter", time.Minute).Beat()
+
+		result := checker.Check(context.Background())
+		assert.True(t, result.Ready)
+		assert.Equal(t, map[string]string{"database": "ok", "worker:EventRouter": "ok"}, result.Checks)
+	})
+
+	t.Run("not ready when a check fails", func(t *testing.T) {
+		checker := NewChecker()
+		checker.AddCheck("database", func(ctx context.Context) error { return nil })
+		checker.AddCheck("rabbitmq", func(ctx context.Context) error { return errors.New("connection refused") })
+
+		result := checker.Check(context.Background())
+		assert.False(t, result.Ready)
+		assert.Equal(t, "ok", result.Checks["database"])
+		assert.Equal(t, "connection refused", result.Checks["rabbitmq"])
+	})
+
+	t.Run("not ready when a worker stops ticking", func(t *testing.T) {
+		checker := NewChecker()
+		checker.NewHeartbeat("NodeExecutor", time.Millisecond)
+		time.Sleep(5 * time.Millisecond)
+
+		result := checker.Check(context.Background())
+		assert.False(t, result.Ready)
+		assert.Contains(t, result.Checks["worker:NodeExecutor"], "no tick since")
+	})
+
+	t.Run("nil heartbeat can beat", func(t *testing.T) {
+		var h *Heartbeat
+		assert.NotPanics(t, h.Beat)
+	})
+}
+
+func Test__Handlers(t *testing.T) {
+	checker := NewChecker()
+	checker.AddCheck("database", func(ctx context.Context) error { return errors.New("down") })
+
+	t.Run("live even if checks fail", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		checker.LiveHandler(w, httptest.NewRequest(http.MethodGet, "/livez", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("not ready if checks fail", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		checker.ReadyHandler(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+		var result Result
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+		assert.False(t, result.Ready)
+		assert.Equal(t, "down", result.Checks["database"])
+	})
+}
+
+type fakeRabbitMQConnection struct {
+	closed bool
+}
+
+func (c *fakeRabbitMQConnection) IsClosed() bool {
+	return c.closed
+}
+
+func Test__RabbitMQCheck(t *testing.T) {
+	dials := 0
+	var conn *fakeRabbitMQConnection
+	var dialErr error
+	check := rabbitMQCheck(func(timeout time.Duration) (rabbitMQConnection, error) {
+		dials++
+		if dialErr != nil {
+			return nil, dialErr
+		}
+
+		conn = &fakeRabbitMQConnection{}
+		return conn, nil
+	})
+
+	t.Run("connection is reused between probes", func(t *testing.T) {
+		require.NoError(t, check(context.Background()))
+		require.NoError(t, check(context.Background()))
+		assert.Equal(t, 1, dials)
+	})
+
+	t.Run("closed connection -> dials again", func(t *testing.T) {
+		conn.closed = true
+		require.NoError(t, check(context.Background()))
+		assert.Equal(t, 2, dials)
+	})
+
+	t.Run("broker is down -> error", func(t *testing.T) {
+		conn.closed = true
+		dialErr = errors.New("connection refused")
+		require.EqualError(t, check(context.Background()), "connection refused")
+	})
+}
+
+func Test__LatestMigration(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{
+		"20250101000000_first.up.sql",
+		"20250101000000_first.down.sql",
+		"20250201000000_second.up.sql",
+		"20250301000000_third.down.sql",
+		"README.md",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte{}, 0600))
+	}
+
+	latest, err := LatestMigration(dir)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20250201000000), latest)
+
+	_, err = LatestMigration(t.TempDir())
+	assert.ErrorContains(t, err, "no migrations found")
+}
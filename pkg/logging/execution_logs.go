@@ -0,0 +1,140 @@
+package logging
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+const (
+	// Lines are written in batches: once this many are buffered,
+	// once this long passed since the last write, and when flushed.
+	executionLogsBatchSize     = 50
+	executionLogsFlushInterval = time.Second
+)
+
+/*
+ * WithExecutionLogs returns a logger that also stores the lines
+ * logged through it in the logs of the execution, so canvas users
+ * can see them without access to the server logs.
+ *
+ * Only the fields added after this point are stored with each line,
+ * since the ones already in the logger only identify the execution.
+ *
+ * Lines are buffered, so the returned ExecutionLogs
+ * must be flushed once the execution is done logging.
+ */
+func WithExecutionLogs(logger *log.Entry, execution *models.CanvasNodeExecution) (*log.Entry, *ExecutionLogs) {
+	base := logger.Logger
+	captured := &log.Logger{
+		Out:          base.Out,
+		Hooks:        make(log.LevelHooks),
+		Formatter:    base.Formatter,
+		ReportCaller: base.ReportCaller,
+		Level:        base.Level,
+		ExitFunc:     base.ExitFunc,
+	}
+
+	for level, hooks := range base.Hooks {
+		captured.Hooks[level] = append([]log.Hook{}, hooks...)
+	}
+
+	ignoredFields := make(map[string]bool, len(logger.Data))
+	for field := range logger.Data {
+		ignoredFields[field] = true
+	}
+
+	logs := &ExecutionLogs{
+		execution:     execution,
+		ignoredFields: ignoredFields,
+		logger:        logger,
+		lastWrite:     time.Now(),
+	}
+
+	captured.AddHook(logs)
+	return log.NewEntry(captured).WithFields(logger.Data), logs
+}
+
+// ExecutionLogs buffers the lines logged for an execution, and writes them in batches.
+// Once a write finds the execution at its limit of lines, nothing else is buffered.
+type ExecutionLogs struct {
+	execution     *models.CanvasNodeExecution
+	ignoredFields map[string]bool
+	logger        *log.Entry
+
+	mu        sync.Mutex
+	pending   []models.CanvasNodeExecutionLog
+	full      bool
+	lastWrite time.Time
+}
+
+func (l *ExecutionLogs) Levels() []log.Level {
+	return []log.Level{log.PanicLevel, log.FatalLevel, log.ErrorLevel, log.WarnLevel, log.InfoLevel}
+}
+
+func (l *ExecutionLogs) Fire(entry *log.Entry) error {
+	fields := map[string]string{}
+	for field, value := range entry.Data {
+		if l.ignoredFields[field] {
+			continue
+		}
+
+		fields[field] = fmt.Sprint(value)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.full {
+		return nil
+	}
+
+	l.pending = append(l.pending, models.NewCanvasNodeExecutionLog(l.execution, executionLogLevel(entry.Level), entry.Message, fields))
+	if len(l.pending) < executionLogsBatchSize && time.Since(l.lastWrite) < executionLogsFlushInterval {
+		return nil
+	}
+
+	return l.write()
+}
+
+// Flush writes the buffered lines.
+func (l *ExecutionLogs) Flush() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.write(); err != nil {
+		l.logger.Errorf("Error writing logs of execution %s: %v", l.execution.ID, err)
+	}
+}
+
+func (l *ExecutionLogs) write() error {
+	if len(l.pending) == 0 {
+		return nil
+	}
+
+	lines := l.pending
+	l.pending = nil
+	l.lastWrite = time.Now()
+
+	written, err := models.CreateCanvasNodeExecutionLogs(l.execution.ID, lines)
+	if err != nil {
+		return err
+	}
+
+	l.full = written < len(lines)
+	return nil
+}
+
+func executionLogLevel(level log.Level) string {
+	switch level {
+	case log.InfoLevel:
+		return models.CanvasNodeExecutionLogLevelInfo
+	case log.WarnLevel:
+		return models.CanvasNodeExecutionLogLevelWarning
+	default:
+		return models.CanvasNodeExecutionLogLevelError
+	}
+}
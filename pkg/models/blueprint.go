@@ -100,6 +100,21 @@ func FindBlueprintByName(name string, orgID uuid.UUID) (*Blueprint, error) {
 	return &blueprint, nil
 }
 
+func ListBlueprints(orgID uuid.UUID) ([]Blueprint, error) {
+	var blueprints []Blueprint
+	err := database.Conn().
+		Where("organization_id = ?", orgID).
+		Order("name ASC").
+		Find(&blueprints).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return blueprints, nil
+}
+
 func FindUnscopedBlueprint(id string) (*Blueprint, error) {
 	return FindUnscopedBlueprintInTransaction(database.Conn(), id)
 }
@@ -179,6 +179,33 @@ func FindCanvasWithoutOrgScopeInTransaction(tx *gorm.DB, id uuid.UUID) (*Canvas,
 	return &canvas, nil
 }
 
+// FindCanvasOrganizationIDs returns the organization of each canvas, by canvas ID.
+func FindCanvasOrganizationIDs(ids []uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	var rows []struct {
+		ID             uuid.UUID
+		OrganizationID uuid.UUID
+	}
+
+	err := database.Conn().
+		Unscoped().
+		Model(&Canvas{}).
+		Select("id, organization_id").
+		Where("id IN ?", ids).
+		Scan(&rows).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	organizations := make(map[uuid.UUID]uuid.UUID, len(rows))
+	for _, row := range rows {
+		organizations[row.ID] = row.OrganizationID
+	}
+
+	return organizations, nil
+}
+
 func FindUnscopedCanvas(id uuid.UUID) (*Canvas, error) {
 	return FindUnscopedCanvasInTransaction(database.Conn(), id)
 }
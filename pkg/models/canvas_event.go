@@ -25,6 +25,12 @@ type CanvasEvent struct {
 	ExecutionID *uuid.UUID
 	State       string
 	CreatedAt   *time.Time
+
+	//
+	// W3C trace context of the span started for a root event.
+	// Everything processed for the root event is traced under it.
+	//
+	Traceparent *string
 }
 
 func (e *CanvasEvent) TableName() string {
@@ -114,6 +120,28 @@ func ListCanvasEvents(canvasID uuid.UUID, nodeID string, limit int, before *time
 	return events, nil
 }
 
+// ListCanvasEventsCreatedSince returns the oldest events created after the given
+// time and ID, ordered by creation time and ID, to page through events created at the same time.
+func ListCanvasEventsCreatedSince(canvasID uuid.UUID, nodeIDs []string, since time.Time, sinceID uuid.UUID, limit int) ([]CanvasEvent, error) {
+	var events []CanvasEvent
+	query := database.Conn().
+		Where("workflow_id = ?", canvasID).
+		Where("(created_at, id) > (?, ?)", since, sinceID).
+		Order("created_at ASC, id ASC").
+		Limit(limit)
+
+	if len(nodeIDs) > 0 {
+		query = query.Where("node_id IN ?", nodeIDs)
+	}
+
+	err := query.Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
 func CountCanvasEvents(canvasID uuid.UUID, nodeID string) (int64, error) {
 	var count int64
 
@@ -237,3 +265,72 @@ func FindLastEventPerNode(canvasID uuid.UUID) ([]CanvasEvent, error) {
 
 	return events, nil
 }
+
+// FindFirstComponentEventForRootEvent finds the first event emitted by a node
+// using the given component, in the execution chain started by the root event.
+func FindFirstComponentEventForRootEvent(canvasID uuid.UUID, rootEventID uuid.UUID, componentName string) (*CanvasEvent, error) {
+	var events []CanvasEvent
+	err := database.Conn().
+		Raw(`
+			SELECT we.*
+			FROM workflow_events we
+			INNER JOIN workflow_node_executions wne
+				ON we.execution_id = wne.id
+			INNER JOIN workflow_nodes wn
+				ON wne.workflow_id = wn.workflow_id
+				AND wne.node_id = wn.node_id
+			WHERE we.workflow_id = ?
+			AND wne.root_event_id = ?
+			AND wn.ref->'component'->>'name' = ?
+			ORDER BY we.created_at ASC
+			LIMIT 1
+		`, canvasID, rootEventID, componentName).
+		Scan(&events).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(events) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &events[0], nil
+}
+
+// FindRootEventTraceparent returns the trace context stored on a root event.
+func FindRootEventTraceparent(rootEventID uuid.UUID) (*string, error) {
+	var traceparents []*string
+	err := database.Conn().
+		Model(&CanvasEvent{}).
+		Where("id = ?", rootEventID).
+		Limit(1).
+		Pluck("traceparent", &traceparents).
+		Error
+
+	if err != nil || len(traceparents) == 0 {
+		return nil, err
+	}
+
+	return traceparents[0], nil
+}
+
+// FindExecutionTraceparent returns the trace context stored
+// on the root event of an execution.
+func FindExecutionTraceparent(executionID uuid.UUID) (*string, error) {
+	var traceparents []*string
+	err := database.Conn().
+		Model(&CanvasEvent{}).
+		Joins("JOIN workflow_node_executions ON workflow_node_executions.root_event_id = workflow_events.id").
+		Where("workflow_node_executions.id = ?", executionID).
+		Limit(1).
+		Pluck("workflow_events.traceparent", &traceparents).
+		Error
+
+	if err != nil || len(traceparents) == 0 {
+		return nil, err
+	}
+
+	return traceparents[0], nil
+}
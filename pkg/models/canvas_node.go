@@ -26,6 +26,9 @@ const (
 	NodeTypeWidget    = "widget"
 )
 
+// Executions of this component are waiting for approval while started.
+const ApprovalComponentName = "approval"
+
 type CanvasNode struct {
 	WorkflowID        uuid.UUID `gorm:"primaryKey"`
 	NodeID            string    `gorm:"primaryKey"`
@@ -166,6 +169,29 @@ func FindCanvasNode(tx *gorm.DB, canvasID uuid.UUID, nodeID string) (*CanvasNode
 	return &node, nil
 }
 
+// findCanvasNodeWithOrganization finds a node along with the organization of its canvas.
+func findCanvasNodeWithOrganization(tx *gorm.DB, canvasID uuid.UUID, nodeID string) (*CanvasNode, uuid.UUID, error) {
+	var result struct {
+		CanvasNode     `gorm:"embedded"`
+		OrganizationID uuid.UUID
+	}
+
+	err := tx.
+		Model(&CanvasNode{}).
+		Select("workflow_nodes.*, workflows.organization_id").
+		Joins("JOIN workflows ON workflows.id = workflow_nodes.workflow_id").
+		Where("workflow_nodes.workflow_id = ?", canvasID).
+		Where("workflow_nodes.node_id = ?", nodeID).
+		Take(&result).
+		Error
+
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+
+	return &result.CanvasNode, result.OrganizationID, nil
+}
+
 func FindCanvasNodesByIDs(tx *gorm.DB, canvasID uuid.UUID, nodeIDs []string) ([]CanvasNode, error) {
 	var nodes []CanvasNode
 	err := tx.
@@ -269,6 +295,28 @@ func (c *CanvasNode) UpdateState(tx *gorm.DB, state string) error {
 		Error
 }
 
+// UpdateStateReason does not touch updated_at,
+// since it tracks changes to the state of the node.
+func (c *CanvasNode) UpdateStateReason(tx *gorm.DB, reason *string) error {
+	return tx.Model(c).UpdateColumn("state_reason", reason).Error
+}
+
+// ListCanvasNodesWithStateReason returns the nodes not in error state that have a state reason.
+func ListCanvasNodesWithStateReason() ([]CanvasNode, error) {
+	var nodes []CanvasNode
+	err := database.Conn().
+		Where("state <> ?", CanvasNodeStateError).
+		Where("state_reason IS NOT NULL").
+		Find(&nodes).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
 func (c *CanvasNode) FirstQueueItem(tx *gorm.DB) (*CanvasNodeQueueItem, error) {
 	var queueItem CanvasNodeQueueItem
 	err := tx.
@@ -351,6 +399,29 @@ func ListNodeQueueItems(workflowID uuid.UUID, nodeID string, limit int, beforeTi
 	return queueItems, nil
 }
 
+// ListNodeQueueItemsCreatedSince returns the oldest queue items created after the given
+// time and ID, ordered by creation time and ID, to page through items created at the same time.
+func ListNodeQueueItemsCreatedSince(workflowID uuid.UUID, nodeIDs []string, since time.Time, sinceID uuid.UUID, limit int) ([]CanvasNodeQueueItem, error) {
+	var queueItems []CanvasNodeQueueItem
+	query := database.Conn().
+		Preload("RootEvent").
+		Where("workflow_id = ?", workflowID).
+		Where("(created_at, id) > (?, ?)", since, sinceID).
+		Order("created_at ASC, id ASC").
+		Limit(limit)
+
+	if len(nodeIDs) > 0 {
+		query = query.Where("node_id IN ?", nodeIDs)
+	}
+
+	err := query.Find(&queueItems).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return queueItems, nil
+}
+
 func CountNodeQueueItems(workflowID uuid.UUID, nodeID string) (int64, error) {
 	var totalCount int64
 	countQuery := database.Conn().
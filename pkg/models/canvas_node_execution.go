@@ -1,12 +1,14 @@
 package models
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/telemetry/canvasmetrics"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -71,6 +73,14 @@ type CanvasNodeExecution struct {
 	ResultMessage string
 	CancelledBy   *uuid.UUID
 
+	//
+	// Timing fields, used for metrics.
+	// QueuedAt is when the input of the execution was queued.
+	//
+	QueuedAt   *time.Time
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+
 	//
 	// Components can store metadata about each execution here.
 	// This allows them to control the behavior of each execution.
@@ -117,6 +127,7 @@ func CreatePendingChildExecution(tx *gorm.DB, parent *CanvasNodeExecution, child
 		NodeID:              fmt.Sprintf("%s:%s", parent.NodeID, childNodeID),
 		State:               CanvasNodeExecutionStatePending,
 		Configuration:       datatypes.NewJSONType(config),
+		QueuedAt:            &now,
 		CreatedAt:           &now,
 		UpdatedAt:           &now,
 	}
@@ -171,6 +182,28 @@ func ListNodeExecutions(workflowID uuid.UUID, nodeID string, states []string, re
 	return executions, nil
 }
 
+// ListNodeExecutionsUpdatedSince returns the least recently updated executions updated after
+// the given time and ID, ordered by update time and ID, to page through executions updated at the same time.
+func ListNodeExecutionsUpdatedSince(workflowID uuid.UUID, nodeIDs []string, since time.Time, sinceID uuid.UUID, limit int) ([]CanvasNodeExecution, error) {
+	var executions []CanvasNodeExecution
+	query := database.Conn().
+		Where("workflow_id = ?", workflowID).
+		Where("(updated_at, id) > (?, ?)", since, sinceID).
+		Order("updated_at ASC, id ASC").
+		Limit(limit)
+
+	if len(nodeIDs) > 0 {
+		query = query.Where("node_id IN ?", nodeIDs)
+	}
+
+	err := query.Find(&executions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return executions, nil
+}
+
 func ListNodeExecutionsForRootEvents(rootEventIDs []uuid.UUID) ([]CanvasNodeExecution, error) {
 	if len(rootEventIDs) == 0 {
 		return []CanvasNodeExecution{}, nil
@@ -353,11 +386,9 @@ func (e *CanvasNodeExecution) GetParentExecutionID() string {
 	return e.ParentExecutionID.String()
 }
 
-func (e *CanvasNodeExecution) Start() error {
-	return e.StartInTransaction(database.Conn())
-}
-
-func (e *CanvasNodeExecution) StartInTransaction(tx *gorm.DB) error {
+// StartInTransaction starts the execution.
+// The canvas and node of the execution label its metrics.
+func (e *CanvasNodeExecution) StartInTransaction(tx *gorm.DB, canvas *Canvas, node *CanvasNode) error {
 	// Just a sanity check that we are not trying to start and already started execution.
 	if e.State != CanvasNodeExecutionStatePending {
 		return fmt.Errorf("cannot start execution %s in state %s", e.ID, e.State)
@@ -366,15 +397,29 @@ func (e *CanvasNodeExecution) StartInTransaction(tx *gorm.DB) error {
 	//
 	// Update the execution state to started.
 	//
-	return tx.Model(e).
-		Update("state", CanvasNodeExecutionStateStarted).
-		Update("updated_at", time.Now()).
-		Error
+	now := time.Now()
+	err := tx.Model(e).
+		Updates(map[string]interface{}{
+			"state":      CanvasNodeExecutionStateStarted,
+			"started_at": &now,
+			"updated_at": &now,
+		}).Error
+
+	if err != nil {
+		return err
+	}
+
+	e.StartedAt = &now
+	if e.QueuedAt != nil && canvasmetrics.Enabled() {
+		canvasmetrics.RecordQueueWait(tx.Statement.Context, e.metricLabels(canvas.OrganizationID, node), now.Sub(*e.QueuedAt))
+	}
+
+	return nil
 }
 
 func (e *CanvasNodeExecution) Pass(outputs map[string][]any) ([]CanvasEvent, error) {
 	var events []CanvasEvent
-	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+	err := TransactionWithMetrics(func(tx *gorm.DB) error {
 		var err error
 		events, err = e.PassInTransaction(tx, outputs)
 		if err != nil {
@@ -417,7 +462,7 @@ func (e *CanvasNodeExecution) PassInTransaction(tx *gorm.DB, channelOutputs map[
 	//
 	// Update the workflow node state to ready.
 	//
-	node, err := FindCanvasNode(tx, e.WorkflowID, e.NodeID)
+	node, organizationID, err := findCanvasNodeWithOrganization(tx, e.WorkflowID, e.NodeID)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, err
 	}
@@ -436,20 +481,22 @@ func (e *CanvasNodeExecution) PassInTransaction(tx *gorm.DB, channelOutputs map[
 	//
 	err = tx.Model(e).
 		Updates(map[string]interface{}{
-			"state":      CanvasNodeExecutionStateFinished,
-			"result":     CanvasNodeExecutionResultPassed,
-			"updated_at": &now,
+			"state":       CanvasNodeExecutionStateFinished,
+			"result":      CanvasNodeExecutionResultPassed,
+			"finished_at": &now,
+			"updated_at":  &now,
 		}).Error
 
 	if err != nil {
 		return nil, err
 	}
 
+	e.recordFinished(tx, organizationID, node, CanvasNodeExecutionResultPassed, CanvasNodeExecutionResultReasonOk, now)
 	return events, nil
 }
 
 func (e *CanvasNodeExecution) Fail(reason, message string) error {
-	return database.Conn().Transaction(func(tx *gorm.DB) error {
+	return TransactionWithMetrics(func(tx *gorm.DB) error {
 		return e.FailInTransaction(tx, reason, message)
 	})
 }
@@ -463,6 +510,7 @@ func (e *CanvasNodeExecution) FailInTransaction(tx *gorm.DB, reason, message str
 			"result":         CanvasNodeExecutionResultFailed,
 			"result_reason":  reason,
 			"result_message": message,
+			"finished_at":    &now,
 			"updated_at":     &now,
 		}).Error
 
@@ -473,7 +521,7 @@ func (e *CanvasNodeExecution) FailInTransaction(tx *gorm.DB, reason, message str
 	//
 	// Update the workflow node state to ready.
 	//
-	node, err := FindCanvasNode(tx, e.WorkflowID, e.NodeID)
+	node, organizationID, err := findCanvasNodeWithOrganization(tx, e.WorkflowID, e.NodeID)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return err
 	}
@@ -487,6 +535,8 @@ func (e *CanvasNodeExecution) FailInTransaction(tx *gorm.DB, reason, message str
 		}
 	}
 
+	e.recordFinished(tx, organizationID, node, CanvasNodeExecutionResultFailed, reason, now)
+
 	//
 	// Since an execution failure does not emit anything,
 	// we need to update the parent execution here too,
@@ -516,6 +566,7 @@ func (e *CanvasNodeExecution) CancelInTransaction(tx *gorm.DB, cancelledBy *uuid
 			"state":        CanvasNodeExecutionStateFinished,
 			"result":       CanvasNodeExecutionResultCancelled,
 			"cancelled_by": cancelledBy,
+			"finished_at":  &now,
 			"updated_at":   &now,
 		}).Error
 
@@ -523,7 +574,7 @@ func (e *CanvasNodeExecution) CancelInTransaction(tx *gorm.DB, cancelledBy *uuid
 		return err
 	}
 
-	node, err := FindCanvasNode(tx, e.WorkflowID, e.NodeID)
+	node, organizationID, err := findCanvasNodeWithOrganization(tx, e.WorkflowID, e.NodeID)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return err
 	}
@@ -537,9 +588,60 @@ func (e *CanvasNodeExecution) CancelInTransaction(tx *gorm.DB, cancelledBy *uuid
 		}
 	}
 
+	e.recordFinished(tx, organizationID, node, CanvasNodeExecutionResultCancelled, "", now)
+	return nil
+}
+
+// TransactionWithMetrics runs fn in a transaction, and records the metrics
+// of the executions changed in it only once the transaction commits.
+// Executions changed outside of a transaction record them right away.
+func TransactionWithMetrics(fn func(tx *gorm.DB) error) error {
+	ctx, pending := canvasmetrics.WithPending(context.Background())
+	err := database.Conn().WithContext(ctx).Transaction(fn)
+	if err != nil {
+		return err
+	}
+
+	pending.Record(context.Background())
 	return nil
 }
 
+func (e *CanvasNodeExecution) recordFinished(tx *gorm.DB, organizationID uuid.UUID, node *CanvasNode, result, resultReason string, now time.Time) {
+	if !canvasmetrics.Enabled() {
+		return
+	}
+
+	var duration *time.Duration
+	if e.StartedAt != nil {
+		d := now.Sub(*e.StartedAt)
+		duration = &d
+	}
+
+	labels := e.metricLabels(organizationID, node)
+	canvasmetrics.RecordExecutionFinished(tx.Statement.Context, labels, result, resultReason, duration)
+	if duration != nil && labels.Component == ApprovalComponentName {
+		canvasmetrics.RecordApprovalWait(tx.Statement.Context, labels, result, *duration)
+	}
+}
+
+// metricLabels returns the labels for the metrics of this execution,
+// from the organization and node already loaded by the caller.
+func (e *CanvasNodeExecution) metricLabels(organizationID uuid.UUID, node *CanvasNode) canvasmetrics.Labels {
+	labels := canvasmetrics.Labels{
+		CanvasID: e.WorkflowID.String(),
+	}
+
+	if organizationID != uuid.Nil {
+		labels.OrganizationID = organizationID.String()
+	}
+
+	if node != nil && node.Ref.Data().Component != nil {
+		labels.Component = node.Ref.Data().Component.Name
+	}
+
+	return labels
+}
+
 func (e *CanvasNodeExecution) GetInput(tx *gorm.DB) (any, error) {
 	event, err := FindCanvasEventInTransaction(tx, e.EventID)
 	if err != nil {
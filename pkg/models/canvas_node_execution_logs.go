@@ -0,0 +1,170 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+const (
+	CanvasNodeExecutionLogLevelInfo    = "info"
+	CanvasNodeExecutionLogLevelWarning = "warning"
+	CanvasNodeExecutionLogLevelError   = "error"
+
+	//
+	// Logs are kept for debugging executions, not as an output channel,
+	// so the number of lines and their size is bounded per execution.
+	//
+	MaxCanvasNodeExecutionLogLines         = 1000
+	MaxCanvasNodeExecutionLogMessageLength = 4096
+
+	// Namespace of the advisory locks taken to write the logs of an execution.
+	// The second key of each lock is a hash of the execution ID.
+	executionLogsLockNamespace = 0x5350_0003
+)
+
+//
+// CanvasNodeExecutionLog is a line logged while processing an execution.
+//
+// There is no foreign key to the execution, since lines are written
+// outside of the transaction that locks the execution while processing it,
+// so they are kept even if that transaction is rolled back.
+// They are deleted with the rest of the node resources when the canvas is deleted.
+//
+
+type CanvasNodeExecutionLog struct {
+	ID          int64 `gorm:"primaryKey;autoIncrement"`
+	WorkflowID  uuid.UUID
+	NodeID      string
+	ExecutionID uuid.UUID
+	Level       string
+	Message     string
+	Fields      datatypes.JSONType[map[string]string]
+	CreatedAt   *time.Time
+}
+
+func (l *CanvasNodeExecutionLog) TableName() string {
+	return "workflow_node_execution_logs"
+}
+
+func CountCanvasNodeExecutionLogs(executionID uuid.UUID) (int64, error) {
+	return CountCanvasNodeExecutionLogsInTransaction(database.Conn(), executionID)
+}
+
+func CountCanvasNodeExecutionLogsInTransaction(tx *gorm.DB, executionID uuid.UUID) (int64, error) {
+	var count int64
+	err := tx.
+		Model(&CanvasNodeExecutionLog{}).
+		Where("execution_id = ?", executionID).
+		Count(&count).
+		Error
+
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// NewCanvasNodeExecutionLog returns a line for the logs of an execution,
+// with its message bounded to MaxCanvasNodeExecutionLogMessageLength.
+func NewCanvasNodeExecutionLog(execution *CanvasNodeExecution, level, message string, fields map[string]string) CanvasNodeExecutionLog {
+	// Postgres does not accept NUL characters in text columns.
+	message = strings.ReplaceAll(message, "\x00", "")
+	if len(message) > MaxCanvasNodeExecutionLogMessageLength {
+		message = strings.ToValidUTF8(message[:MaxCanvasNodeExecutionLogMessageLength], "") + "... (truncated)"
+	}
+
+	if fields == nil {
+		fields = map[string]string{}
+	}
+
+	now := time.Now()
+	return CanvasNodeExecutionLog{
+		WorkflowID:  execution.WorkflowID,
+		NodeID:      execution.NodeID,
+		ExecutionID: execution.ID,
+		Level:       level,
+		Message:     message,
+		Fields:      datatypes.NewJSONType(fields),
+		CreatedAt:   &now,
+	}
+}
+
+// CreateCanvasNodeExecutionLogs appends lines to the logs of an execution,
+// and returns how many were written.
+// Once the execution has MaxCanvasNodeExecutionLogLines lines, nothing else
+// is written, and the last line records that the logs were truncated.
+func CreateCanvasNodeExecutionLogs(executionID uuid.UUID, lines []CanvasNodeExecutionLog) (int, error) {
+	if len(lines) == 0 {
+		return 0, nil
+	}
+
+	written := 0
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		//
+		// Lines of the same execution can be written from
+		// more than one process, so they are counted under a lock
+		// for the limit to hold across all of them.
+		//
+		err := tx.Exec("SELECT pg_advisory_xact_lock(?::int, hashtext(?))", executionLogsLockNamespace, executionID.String()).Error
+		if err != nil {
+			return err
+		}
+
+		count, err := CountCanvasNodeExecutionLogsInTransaction(tx, executionID)
+		if err != nil {
+			return err
+		}
+
+		available := MaxCanvasNodeExecutionLogLines - count
+		if available <= 0 {
+			return nil
+		}
+
+		if int64(len(lines)) >= available {
+			lines = lines[:available]
+			last := &lines[available-1]
+			last.Level = CanvasNodeExecutionLogLevelWarning
+			last.Message = fmt.Sprintf("Log limit of %d lines reached, further lines are not stored", MaxCanvasNodeExecutionLogLines)
+			last.Fields = datatypes.NewJSONType(map[string]string{})
+		}
+
+		err = tx.Create(&lines).Error
+		if err != nil {
+			return err
+		}
+
+		written = len(lines)
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return written, nil
+}
+
+// ListCanvasNodeExecutionLogs returns the lines of an execution after the given ID, oldest first.
+func ListCanvasNodeExecutionLogs(executionID uuid.UUID, afterID int64, limit int) ([]CanvasNodeExecutionLog, error) {
+	var lines []CanvasNodeExecutionLog
+	err := database.Conn().
+		Where("execution_id = ?", executionID).
+		Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&lines).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
@@ -0,0 +1,148 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+)
+
+const executionComponentColumn = "COALESCE(n.ref->'component'->>'name', '')"
+
+// Aggregated metrics for the executions finished in a time window.
+type ExecutionMetrics struct {
+	NodeID       string
+	Component    string
+	Executions   int64
+	Duration     ExecutionDurationStats `gorm:"embedded;embeddedPrefix:duration_"`
+	QueueWait    ExecutionDurationStats `gorm:"embedded;embeddedPrefix:queue_wait_"`
+	ApprovalWait ExecutionDurationStats `gorm:"embedded;embeddedPrefix:approval_wait_"`
+	Results      []ExecutionResultCount `gorm:"-"`
+}
+
+// Durations are in seconds, and nil if there are no executions to aggregate.
+type ExecutionDurationStats struct {
+	Count   int64
+	Average *float64
+	P50     *float64
+	P95     *float64
+	Max     *float64
+}
+
+type ExecutionResultCount struct {
+	NodeID       string
+	Result       string
+	ResultReason string
+	Count        int64
+}
+
+// ListNodeExecutionMetrics returns the metrics for each node of a canvas.
+// If node IDs are given, only those nodes are included.
+func ListNodeExecutionMetrics(canvasID uuid.UUID, nodeIDs []string, start, end time.Time) ([]ExecutionMetrics, error) {
+	var metrics []ExecutionMetrics
+	err := executionMetricsQuery(canvasID, nodeIDs, start, end).
+		Select("e.node_id, " + executionComponentColumn + " AS component, " + executionMetricsColumns()).
+		Group("e.node_id, " + executionComponentColumn).
+		Order("e.node_id ASC").
+		Scan(&metrics).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	var results []ExecutionResultCount
+	err = executionMetricsQuery(canvasID, nodeIDs, start, end).
+		Select("e.node_id, e.result, COALESCE(e.result_reason, '') AS result_reason, COUNT(*) AS count").
+		Group("e.node_id, e.result, COALESCE(e.result_reason, '')").
+		Scan(&results).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range metrics {
+		for _, result := range results {
+			if result.NodeID == metrics[i].NodeID {
+				metrics[i].Results = append(metrics[i].Results, result)
+			}
+		}
+	}
+
+	return metrics, nil
+}
+
+// FindCanvasExecutionMetrics returns the metrics for a whole canvas.
+// Executions of nodes inside blueprint nodes are not included,
+// since they are already accounted for in the blueprint node execution.
+func FindCanvasExecutionMetrics(canvasID uuid.UUID, nodeIDs []string, start, end time.Time) (*ExecutionMetrics, error) {
+	var metrics ExecutionMetrics
+	err := executionMetricsQuery(canvasID, nodeIDs, start, end).
+		Where("e.parent_execution_id IS NULL").
+		Select(executionMetricsColumns()).
+		Scan(&metrics).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = executionMetricsQuery(canvasID, nodeIDs, start, end).
+		Where("e.parent_execution_id IS NULL").
+		Select("e.result, COALESCE(e.result_reason, '') AS result_reason, COUNT(*) AS count").
+		Group("e.result, COALESCE(e.result_reason, '')").
+		Scan(&metrics.Results).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &metrics, nil
+}
+
+func executionMetricsQuery(canvasID uuid.UUID, nodeIDs []string, start, end time.Time) *gorm.DB {
+	query := database.Conn().
+		Table("workflow_node_executions AS e").
+		Joins("LEFT JOIN workflow_nodes AS n ON n.workflow_id = e.workflow_id AND n.node_id = e.node_id").
+		Where("e.workflow_id = ?", canvasID).
+		Where("e.state = ?", CanvasNodeExecutionStateFinished).
+		Where("e.finished_at >= ?", start).
+		Where("e.finished_at < ?", end)
+
+	if len(nodeIDs) > 0 {
+		query = query.Where("e.node_id IN ?", nodeIDs)
+	}
+
+	return query
+}
+
+func executionMetricsColumns() string {
+	return "COUNT(*) AS executions, " +
+		durationStatsColumns("duration", "EXTRACT(EPOCH FROM (e.finished_at - e.started_at))", "") + ", " +
+		durationStatsColumns("queue_wait", "EXTRACT(EPOCH FROM (e.started_at - e.queued_at))", "") + ", " +
+		durationStatsColumns(
+			"approval_wait",
+			"EXTRACT(EPOCH FROM (e.finished_at - e.started_at))",
+			fmt.Sprintf("%s = '%s'", executionComponentColumn, ApprovalComponentName),
+		)
+}
+
+// durationStatsColumns aggregates the non-null values of an expression in seconds.
+func durationStatsColumns(prefix, seconds, filter string) string {
+	if filter != "" {
+		filter = " FILTER (WHERE " + filter + ")"
+	}
+
+	return fmt.Sprintf(
+		"COUNT(%[2]s)%[3]s AS %[1]s_count, "+
+			"AVG(%[2]s)%[3]s AS %[1]s_average, "+
+			"percentile_cont(0.5) WITHIN GROUP (ORDER BY %[2]s)%[3]s AS %[1]s_p50, "+
+			"percentile_cont(0.95) WITHIN GROUP (ORDER BY %[2]s)%[3]s AS %[1]s_p95, "+
+			"MAX(%[2]s)%[3]s AS %[1]s_max",
+		prefix, seconds, filter,
+	)
+}
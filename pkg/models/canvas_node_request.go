@@ -80,6 +80,26 @@ func ListNodeRequests() ([]CanvasNodeRequest, error) {
 	return requests, nil
 }
 
+// NextNodeRequestRunAt returns when the next pending request
+// scheduled for the future should run, or nil if there is none.
+func NextNodeRequestRunAt() (*time.Time, error) {
+	var runAt *time.Time
+
+	err := database.Conn().
+		Model(&CanvasNodeRequest{}).
+		Select("MIN(run_at)").
+		Where("state = ?", NodeExecutionRequestStatePending).
+		Where("run_at > ?", time.Now()).
+		Scan(&runAt).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return runAt, nil
+}
+
 func FindPendingRequestForNode(tx *gorm.DB, workflowID uuid.UUID, nodeID string) (*CanvasNodeRequest, error) {
 	var request CanvasNodeRequest
 
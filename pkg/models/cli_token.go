@@ -0,0 +1,120 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+)
+
+const (
+	CLITokenTTL     = 30 * 24 * time.Hour
+	CLILoginCodeTTL = 5 * time.Minute
+)
+
+// CLIToken is a token created for the CLI through the browser login.
+// It starts as a login code, which the CLI exchanges for the token,
+// and gives access to the organization of the user until it expires
+// or is revoked.
+type CLIToken struct {
+	ID            uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID        uuid.UUID
+	Name          string
+	CodeHash      *string
+	CodeChallenge *string
+	CodeExpiresAt *time.Time
+	TokenHash     *string
+	ExpiresAt     time.Time
+	RevokedAt     *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (t *CLIToken) TableName() string {
+	return "cli_tokens"
+}
+
+func CreateCLILoginCode(userID uuid.UUID, name, codeHash, codeChallenge string) (*CLIToken, error) {
+	now := time.Now()
+	codeExpiresAt := now.Add(CLILoginCodeTTL)
+
+	token := &CLIToken{
+		UserID:        userID,
+		Name:          name,
+		CodeHash:      &codeHash,
+		CodeChallenge: &codeChallenge,
+		CodeExpiresAt: &codeExpiresAt,
+		ExpiresAt:     codeExpiresAt,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	err := database.Conn().Create(token).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func FindCLILoginCodeInTransaction(tx *gorm.DB, codeHash string) (*CLIToken, error) {
+	var token CLIToken
+
+	err := tx.
+		Where("code_hash = ?", codeHash).
+		Where("code_expires_at > ?", time.Now()).
+		Where("token_hash IS NULL").
+		Where("revoked_at IS NULL").
+		First(&token).
+		Error
+
+	return &token, err
+}
+
+// ExchangeInTransaction turns the login code into the token,
+// so the code can not be used again.
+func (t *CLIToken) ExchangeInTransaction(tx *gorm.DB, tokenHash string) error {
+	now := time.Now()
+	t.CodeHash = nil
+	t.CodeChallenge = nil
+	t.CodeExpiresAt = nil
+	t.TokenHash = &tokenHash
+	t.ExpiresAt = now.Add(CLITokenTTL)
+	t.UpdatedAt = now
+	return tx.Save(t).Error
+}
+
+func (t *CLIToken) Revoke() error {
+	now := time.Now()
+	t.RevokedAt = &now
+	t.UpdatedAt = now
+	return database.Conn().Save(t).Error
+}
+
+func FindActiveCLIToken(tokenHash string) (*CLIToken, error) {
+	var token CLIToken
+
+	err := database.Conn().
+		Where("token_hash = ?", tokenHash).
+		Where("expires_at > ?", time.Now()).
+		Where("revoked_at IS NULL").
+		First(&token).
+		Error
+
+	return &token, err
+}
+
+func FindActiveUserByCLITokenHash(tokenHash string) (*User, error) {
+	var user User
+
+	err := database.Conn().
+		Joins("JOIN cli_tokens ON cli_tokens.user_id = users.id").
+		Where("cli_tokens.token_hash = ?", tokenHash).
+		Where("cli_tokens.expires_at > ?", time.Now()).
+		Where("cli_tokens.revoked_at IS NULL").
+		First(&user).
+		Error
+
+	return &user, err
+}
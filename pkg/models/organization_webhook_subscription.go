@@ -0,0 +1,335 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	OrganizationWebhookEventExecutionStarted           = "execution.started"
+	OrganizationWebhookEventExecutionFinished          = "execution.finished"
+	OrganizationWebhookEventExecutionFailed            = "execution.failed"
+	OrganizationWebhookEventExecutionApprovalRequested = "execution.approval_requested"
+	OrganizationWebhookEventCanvasCreated              = "canvas.created"
+	OrganizationWebhookEventCanvasUpdated              = "canvas.updated"
+	OrganizationWebhookEventCanvasDeleted              = "canvas.deleted"
+
+	OrganizationWebhookDeliveryStatePending   = "pending"
+	OrganizationWebhookDeliveryStateDelivered = "delivered"
+	OrganizationWebhookDeliveryStateFailed    = "failed"
+
+	// Only the most recent completed deliveries for each subscription are kept
+	MaxOrganizationWebhookDeliveriesPerSubscription = 100
+)
+
+var OrganizationWebhookEventTypes = []string{
+	OrganizationWebhookEventExecutionStarted,
+	OrganizationWebhookEventExecutionFinished,
+	OrganizationWebhookEventExecutionFailed,
+	OrganizationWebhookEventExecutionApprovalRequested,
+	OrganizationWebhookEventCanvasCreated,
+	OrganizationWebhookEventCanvasUpdated,
+	OrganizationWebhookEventCanvasDeleted,
+}
+
+type OrganizationWebhookSubscription struct {
+	ID             uuid.UUID `gorm:"primary_key;default:uuid_generate_v4()"`
+	OrganizationID uuid.UUID
+	URL            string
+	Secret         []byte
+	EventTypes     datatypes.JSONSlice[string]
+	Enabled        bool
+	CreatedBy      *uuid.UUID
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+}
+
+func (s *OrganizationWebhookSubscription) TableName() string {
+	return "organization_webhook_subscriptions"
+}
+
+// Wants returns true if the subscription receives events of the given type.
+// Subscriptions without event types receive all of them.
+func (s *OrganizationWebhookSubscription) Wants(eventType string) bool {
+	return len(s.EventTypes) == 0 || slices.Contains(s.EventTypes, eventType)
+}
+
+type OrganizationWebhookDelivery struct {
+	ID             uuid.UUID `gorm:"primary_key;default:uuid_generate_v4()"`
+	SubscriptionID uuid.UUID
+	EventType      string
+
+	//
+	// Identifies the occurrence of the event,
+	// so the same event is never delivered twice.
+	//
+	DedupeKey string
+
+	Payload        datatypes.JSONType[map[string]any]
+	State          string
+	Attempts       int
+	NextAttemptAt  *time.Time
+	LastStatusCode *int
+	LastError      *string
+	DeliveredAt    *time.Time
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+}
+
+func (d *OrganizationWebhookDelivery) TableName() string {
+	return "organization_webhook_deliveries"
+}
+
+func CreateOrganizationWebhookSubscription(subscription *OrganizationWebhookSubscription) error {
+	return database.Conn().Create(subscription).Error
+}
+
+func FindOrganizationWebhookSubscription(orgID, id uuid.UUID) (*OrganizationWebhookSubscription, error) {
+	return FindOrganizationWebhookSubscriptionInTransaction(database.Conn(), orgID, id)
+}
+
+func FindOrganizationWebhookSubscriptionInTransaction(tx *gorm.DB, orgID, id uuid.UUID) (*OrganizationWebhookSubscription, error) {
+	var subscription OrganizationWebhookSubscription
+	err := tx.
+		Where("organization_id = ?", orgID).
+		Where("id = ?", id).
+		First(&subscription).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+func FindUnscopedOrganizationWebhookSubscriptionInTransaction(tx *gorm.DB, id uuid.UUID) (*OrganizationWebhookSubscription, error) {
+	var subscription OrganizationWebhookSubscription
+	err := tx.
+		Where("id = ?", id).
+		First(&subscription).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+func ListOrganizationWebhookSubscriptions(orgID uuid.UUID) ([]OrganizationWebhookSubscription, error) {
+	var subscriptions []OrganizationWebhookSubscription
+	err := database.Conn().
+		Where("organization_id = ?", orgID).
+		Order("created_at ASC").
+		Find(&subscriptions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func ListEnabledOrganizationWebhookSubscriptions(orgID uuid.UUID) ([]OrganizationWebhookSubscription, error) {
+	var subscriptions []OrganizationWebhookSubscription
+	err := database.Conn().
+		Where("organization_id = ?", orgID).
+		Where("enabled = ?", true).
+		Find(&subscriptions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func (s *OrganizationWebhookSubscription) Update(tx *gorm.DB) error {
+	now := time.Now()
+	s.UpdatedAt = &now
+	return tx.Save(s).Error
+}
+
+func (s *OrganizationWebhookSubscription) Delete() error {
+	return database.Conn().Delete(s).Error
+}
+
+// EnqueueOrganizationWebhookDelivery creates a pending delivery,
+// unless one for the same event already exists.
+func EnqueueOrganizationWebhookDelivery(subscriptionID uuid.UUID, eventType, dedupeKey string, payload map[string]any) error {
+	now := time.Now()
+	delivery := OrganizationWebhookDelivery{
+		SubscriptionID: subscriptionID,
+		EventType:      eventType,
+		DedupeKey:      dedupeKey,
+		Payload:        datatypes.NewJSONType(payload),
+		State:          OrganizationWebhookDeliveryStatePending,
+		NextAttemptAt:  &now,
+		CreatedAt:      &now,
+		UpdatedAt:      &now,
+	}
+
+	return database.Conn().
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&delivery).
+		Error
+}
+
+func ListPendingOrganizationWebhookDeliveries(limit int) ([]OrganizationWebhookDelivery, error) {
+	var deliveries []OrganizationWebhookDelivery
+	err := database.Conn().
+		Where("state = ?", OrganizationWebhookDeliveryStatePending).
+		Where("next_attempt_at <= ?", time.Now()).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&deliveries).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func FindOrganizationWebhookDelivery(id uuid.UUID) (*OrganizationWebhookDelivery, error) {
+	var delivery OrganizationWebhookDelivery
+	err := database.Conn().
+		Where("id = ?", id).
+		First(&delivery).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+// ClaimOrganizationWebhookDelivery claims a pending delivery that is due,
+// by moving its next attempt to claimedUntil, so it is not picked up again
+// while it is being sent. If the sender goes away, it is retried after that.
+func ClaimOrganizationWebhookDelivery(id uuid.UUID, claimedUntil time.Time) (*OrganizationWebhookDelivery, error) {
+	var deliveries []OrganizationWebhookDelivery
+	err := database.Conn().
+		Model(&deliveries).
+		Clauses(clause.Returning{}).
+		Where("id = ?", id).
+		Where("state = ?", OrganizationWebhookDeliveryStatePending).
+		Where("next_attempt_at <= ?", time.Now()).
+		Update("next_attempt_at", claimedUntil).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(deliveries) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &deliveries[0], nil
+}
+
+func ListOrganizationWebhookDeliveries(subscriptionID uuid.UUID, limit int, before *time.Time) ([]OrganizationWebhookDelivery, error) {
+	var deliveries []OrganizationWebhookDelivery
+	query := database.Conn().
+		Where("subscription_id = ?", subscriptionID).
+		Order("created_at DESC").
+		Limit(limit)
+
+	if before != nil {
+		query = query.Where("created_at < ?", before)
+	}
+
+	err := query.Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func CountOrganizationWebhookDeliveries(subscriptionID uuid.UUID) (int64, error) {
+	var count int64
+	err := database.Conn().
+		Model(&OrganizationWebhookDelivery{}).
+		Where("subscription_id = ?", subscriptionID).
+		Count(&count).
+		Error
+
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// Delivered marks the delivery as delivered,
+// and removes the oldest completed deliveries over the limit.
+func (d *OrganizationWebhookDelivery) Delivered(tx *gorm.DB, statusCode int) error {
+	now := time.Now()
+	d.State = OrganizationWebhookDeliveryStateDelivered
+	d.Attempts++
+	d.LastStatusCode = &statusCode
+	d.LastError = nil
+	d.NextAttemptAt = nil
+	d.DeliveredAt = &now
+	d.UpdatedAt = &now
+
+	err := tx.Save(d).Error
+	if err != nil {
+		return err
+	}
+
+	return d.prune(tx)
+}
+
+// Failed records a failed attempt. The delivery is retried at nextAttemptAt,
+// or marked as failed if there are no attempts left.
+func (d *OrganizationWebhookDelivery) Failed(tx *gorm.DB, statusCode *int, message string, nextAttemptAt *time.Time) error {
+	now := time.Now()
+	d.Attempts++
+	d.LastStatusCode = statusCode
+	d.LastError = &message
+	d.NextAttemptAt = nextAttemptAt
+	d.UpdatedAt = &now
+
+	if nextAttemptAt == nil {
+		d.State = OrganizationWebhookDeliveryStateFailed
+	}
+
+	err := tx.Save(d).Error
+	if err != nil {
+		return err
+	}
+
+	if d.State != OrganizationWebhookDeliveryStateFailed {
+		return nil
+	}
+
+	return d.prune(tx)
+}
+
+func (d *OrganizationWebhookDelivery) prune(tx *gorm.DB) error {
+	return tx.
+		Where("subscription_id = ?", d.SubscriptionID).
+		Where("state <> ?", OrganizationWebhookDeliveryStatePending).
+		Where(`id NOT IN (
+			SELECT id FROM organization_webhook_deliveries
+			WHERE subscription_id = ? AND state <> ?
+			ORDER BY created_at DESC
+			LIMIT ?
+		)`, d.SubscriptionID, OrganizationWebhookDeliveryStatePending, MaxOrganizationWebhookDeliveriesPerSubscription).
+		Delete(&OrganizationWebhookDelivery{}).
+		Error
+}
@@ -0,0 +1,194 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+)
+
+const (
+	// Node in processing state without any execution to finish.
+	StuckWorkKindNodeProcessing = "node-processing"
+
+	// Ready node whose queue items are not being picked up.
+	StuckWorkKindQueueItems = "queue-items"
+
+	// Started execution with no request scheduled, no child execution running,
+	// and nothing it is waiting for, like users acting on it or an external event.
+	StuckWorkKindExecutionStarted = "execution-started"
+
+	// Webhook still pending provisioning.
+	StuckWorkKindWebhookPending = "webhook-pending"
+)
+
+// How long each kind of work can stay in the same state before it is considered stuck.
+type StuckWorkThresholds struct {
+	Node      time.Duration
+	QueueItem time.Duration
+	Execution time.Duration
+	Webhook   time.Duration
+}
+
+var DefaultStuckWorkThresholds = StuckWorkThresholds{
+	Node:      10 * time.Minute,
+	QueueItem: 10 * time.Minute,
+	Execution: 24 * time.Hour,
+	Webhook:   30 * time.Minute,
+}
+
+type StuckWork struct {
+	Kind           string
+	OrganizationID uuid.UUID
+	WorkflowID     uuid.UUID
+	NodeID         string
+
+	//
+	// ID of the execution or webhook that is stuck,
+	// and the number of queue items for StuckWorkKindQueueItems.
+	//
+	ResourceID *uuid.UUID
+	Count      int64
+
+	// When the work last changed.
+	Since time.Time
+}
+
+// Reason describes the stuck work for the StateReason of its node.
+func (w *StuckWork) Reason(now time.Time) string {
+	age := now.Sub(w.Since).Round(time.Second)
+
+	switch w.Kind {
+	case StuckWorkKindNodeProcessing:
+		return fmt.Sprintf("Node has been processing for %s without any running execution", age)
+	case StuckWorkKindQueueItems:
+		return fmt.Sprintf("%d queue items have not been processed for %s", w.Count, age)
+	case StuckWorkKindExecutionStarted:
+		return fmt.Sprintf("Execution %s has not changed for %s and has nothing scheduled to finish it", w.ResourceID, age)
+	case StuckWorkKindWebhookPending:
+		return fmt.Sprintf("Webhook %s has been pending provisioning for %s", w.ResourceID, age)
+	default:
+		return fmt.Sprintf("Stuck for %s", age)
+	}
+}
+
+// FindStuckWork returns the work that has been in the same state for longer than the thresholds.
+// If an organization is given, only work for its canvases is returned.
+// Executions of the waiting components wait for users, so they are never stuck.
+func FindStuckWork(organizationID *uuid.UUID, thresholds StuckWorkThresholds, waitingComponents []string, now time.Time) ([]StuckWork, error) {
+	finders := []func(*uuid.UUID, StuckWorkThresholds, time.Time) ([]StuckWork, error){
+		findStuckProcessingNodes,
+		findStuckQueueItems,
+		func(organizationID *uuid.UUID, thresholds StuckWorkThresholds, now time.Time) ([]StuckWork, error) {
+			return findStuckExecutions(organizationID, thresholds, waitingComponents, now)
+		},
+		findStuckWebhooks,
+	}
+
+	stuck := []StuckWork{}
+	for _, find := range finders {
+		found, err := find(organizationID, thresholds, now)
+		if err != nil {
+			return nil, err
+		}
+
+		stuck = append(stuck, found...)
+	}
+
+	return stuck, nil
+}
+
+func stuckWorkQuery(organizationID *uuid.UUID) *gorm.DB {
+	query := database.Conn().
+		Table("workflow_nodes AS n").
+		Joins("JOIN workflows AS w ON w.id = n.workflow_id").
+		Where("n.deleted_at IS NULL").
+		Where("w.deleted_at IS NULL")
+
+	if organizationID != nil {
+		query = query.Where("w.organization_id = ?", *organizationID)
+	}
+
+	return query
+}
+
+func findStuckProcessingNodes(organizationID *uuid.UUID, thresholds StuckWorkThresholds, now time.Time) ([]StuckWork, error) {
+	var stuck []StuckWork
+	err := stuckWorkQuery(organizationID).
+		Select("? AS kind, w.organization_id, n.workflow_id, n.node_id, n.updated_at AS since", StuckWorkKindNodeProcessing).
+		Where("n.state = ?", CanvasNodeStateProcessing).
+		Where("n.updated_at < ?", now.Add(-thresholds.Node)).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM workflow_node_executions e
+			WHERE e.workflow_id = n.workflow_id AND e.node_id = n.node_id AND e.state <> ?
+		)`, CanvasNodeExecutionStateFinished).
+		Scan(&stuck).
+		Error
+
+	return stuck, err
+}
+
+func findStuckQueueItems(organizationID *uuid.UUID, thresholds StuckWorkThresholds, now time.Time) ([]StuckWork, error) {
+	var stuck []StuckWork
+	err := stuckWorkQuery(organizationID).
+		Joins("JOIN workflow_node_queue_items AS q ON q.workflow_id = n.workflow_id AND q.node_id = n.node_id").
+		Select("? AS kind, w.organization_id, n.workflow_id, n.node_id, COUNT(*) AS count, MIN(q.created_at) AS since", StuckWorkKindQueueItems).
+		Where("n.state = ?", CanvasNodeStateReady).
+		Where("n.type IN ?", []string{NodeTypeComponent, NodeTypeBlueprint}).
+		Group("w.organization_id, n.workflow_id, n.node_id").
+		Having("MIN(q.created_at) < ?", now.Add(-thresholds.QueueItem)).
+		Scan(&stuck).
+		Error
+
+	return stuck, err
+}
+
+func findStuckExecutions(organizationID *uuid.UUID, thresholds StuckWorkThresholds, waitingComponents []string, now time.Time) ([]StuckWork, error) {
+	query := stuckWorkQuery(organizationID)
+	if len(waitingComponents) > 0 {
+		query = query.Where("COALESCE(n.ref->'component'->>'name', '') NOT IN ?", waitingComponents)
+	}
+
+	var stuck []StuckWork
+	err := query.
+		Joins("JOIN workflow_node_executions AS e ON e.workflow_id = n.workflow_id AND e.node_id = n.node_id").
+		Select("? AS kind, w.organization_id, n.workflow_id, n.node_id, e.id AS resource_id, e.updated_at AS since", StuckWorkKindExecutionStarted).
+		Where("e.state = ?", CanvasNodeExecutionStateStarted).
+		Where("e.updated_at < ?", now.Add(-thresholds.Execution)).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM workflow_node_requests r
+			WHERE r.execution_id = e.id AND r.state = ?
+		)`, NodeExecutionRequestStatePending).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM workflow_node_executions c
+			WHERE c.parent_execution_id = e.id AND c.state <> ?
+		)`, CanvasNodeExecutionStateFinished).
+		//
+		// Executions with keys are looked up by them when
+		// the external event they are waiting for arrives.
+		//
+		Where(`NOT EXISTS (
+			SELECT 1 FROM workflow_node_execution_kvs kv
+			WHERE kv.execution_id = e.id
+		)`).
+		Scan(&stuck).
+		Error
+
+	return stuck, err
+}
+
+func findStuckWebhooks(organizationID *uuid.UUID, thresholds StuckWorkThresholds, now time.Time) ([]StuckWork, error) {
+	var stuck []StuckWork
+	err := stuckWorkQuery(organizationID).
+		Joins("JOIN webhooks AS wh ON wh.id = n.webhook_id").
+		Select("? AS kind, w.organization_id, n.workflow_id, n.node_id, wh.id AS resource_id, wh.updated_at AS since", StuckWorkKindWebhookPending).
+		Where("wh.deleted_at IS NULL").
+		Where("wh.state = ?", WebhookStatePending).
+		Where("wh.updated_at < ?", now.Add(-thresholds.Webhook)).
+		Scan(&stuck).
+		Error
+
+	return stuck, err
+}
@@ -0,0 +1,116 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+const (
+	// Deliveries store up to 16k of the request body
+	MaxWebhookDeliveryBodySize = 16 * 1024
+
+	// Only the most recent deliveries for each webhook are kept
+	MaxWebhookDeliveriesPerWebhook = 100
+)
+
+type WebhookDelivery struct {
+	ID            uuid.UUID `gorm:"primary_key;default:uuid_generate_v4()"`
+	WebhookID     uuid.UUID
+	ReplayOf      *uuid.UUID
+	Method        string
+	RemoteIP      string
+	Query         string
+	Headers       []byte
+	Body          []byte
+	BodyTruncated bool
+	StatusCode    int
+	Error         *string
+	Nodes         datatypes.JSONSlice[WebhookDeliveryNode]
+	EventIDs      datatypes.JSONSlice[string]
+	CreatedAt     *time.Time
+}
+
+// WebhookDeliveryNode is a node a webhook delivery was routed to.
+type WebhookDeliveryNode struct {
+	CanvasID   string `json:"canvasId"`
+	NodeID     string `json:"nodeId"`
+	StatusCode int    `json:"statusCode"`
+	Error      string `json:"error,omitempty"`
+}
+
+func (d *WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// CreateWebhookDeliveryInTransaction records a delivery,
+// and removes the oldest deliveries for the webhook over the limit.
+func CreateWebhookDeliveryInTransaction(tx *gorm.DB, delivery *WebhookDelivery) error {
+	err := tx.Create(delivery).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.
+		Where("webhook_id = ?", delivery.WebhookID).
+		Where(`id NOT IN (
+			SELECT id FROM webhook_deliveries
+			WHERE webhook_id = ?
+			ORDER BY created_at DESC
+			LIMIT ?
+		)`, delivery.WebhookID, MaxWebhookDeliveriesPerWebhook).
+		Delete(&WebhookDelivery{}).
+		Error
+}
+
+func FindWebhookDelivery(webhookID uuid.UUID, id uuid.UUID) (*WebhookDelivery, error) {
+	var delivery WebhookDelivery
+	err := database.Conn().
+		Where("webhook_id = ?", webhookID).
+		Where("id = ?", id).
+		First(&delivery).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+func ListWebhookDeliveries(webhookID uuid.UUID, limit int, before *time.Time) ([]WebhookDelivery, error) {
+	var deliveries []WebhookDelivery
+	query := database.Conn().
+		Where("webhook_id = ?", webhookID).
+		Order("created_at DESC").
+		Limit(limit)
+
+	if before != nil {
+		query = query.Where("created_at < ?", before)
+	}
+
+	err := query.Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func CountWebhookDeliveries(webhookID uuid.UUID) (int64, error) {
+	var count int64
+	err := database.Conn().
+		Model(&WebhookDelivery{}).
+		Where("webhook_id = ?", webhookID).
+		Count(&count).
+		Error
+
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,18 @@
+package models
+
+import "github.com/superplanehq/superplane/pkg/database"
+
+// Channels notified by database triggers when rows become
+// ready to be processed by the workers.
+const (
+	NotificationChannelPendingEvents     = "superplane_pending_events"
+	NotificationChannelReadyNodes        = "superplane_ready_nodes"
+	NotificationChannelPendingExecutions = "superplane_pending_executions"
+	NotificationChannelPendingRequests   = "superplane_pending_requests"
+)
+
+// NotifyWorkers wakes up the workers listening on a channel,
+// for when work became ready without a trigger noticing it.
+func NotifyWorkers(channel string) error {
+	return database.Conn().Exec("SELECT pg_notify(?, '')", channel).Error
+}
@@ -1,6 +1,7 @@
 package oidc
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -17,3 +18,25 @@ type PublicJWK struct {
 	N   string `json:"n"`
 	E   string `json:"e"`
 }
+
+// Claims included in the tokens issued for component executions.
+const (
+	ClaimOrganizationID = "organization_id"
+	ClaimCanvasID       = "canvas_id"
+	ClaimNodeID         = "node_id"
+	ClaimExecutionID    = "execution_id"
+	ClaimRootEventID    = "root_event_id"
+)
+
+// Default and maximum durations for tokens issued for component executions.
+const (
+	DefaultExecutionTokenDuration = 5 * time.Minute
+	MaxExecutionTokenDuration     = time.Hour
+)
+
+// ExecutionSubject returns the subject used for tokens issued for a component execution.
+// External systems can use it (or a prefix of it) in their trust policies,
+// e.g. "org:<org>:canvas:<canvas>:*" to trust any execution in a canvas.
+func ExecutionSubject(organizationID, canvasID, nodeID, executionID string) string {
+	return fmt.Sprintf("org:%s:canvas:%s:node:%s:execution:%s", organizationID, canvasID, nodeID, executionID)
+}
@@ -272,6 +272,142 @@ func (a *CanvasNodeAPIService) CanvasesEmitNodeEventExecute(r ApiCanvasesEmitNod
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiCanvasesGetExecutionLogsRequest struct {
+	ctx           context.Context
+	ApiService    *CanvasNodeAPIService
+	canvasId      string
+	executionId   string
+	follow        *bool
+	afterSequence *string
+}
+
+// Keep streaming new lines until the execution finishes.
+func (r ApiCanvasesGetExecutionLogsRequest) Follow(follow bool) ApiCanvasesGetExecutionLogsRequest {
+	r.follow = &follow
+	return r
+}
+
+// Only lines after this sequence number are streamed. Used to resume the stream after a disconnect.
+func (r ApiCanvasesGetExecutionLogsRequest) AfterSequence(afterSequence string) ApiCanvasesGetExecutionLogsRequest {
+	r.afterSequence = &afterSequence
+	return r
+}
+
+func (r ApiCanvasesGetExecutionLogsRequest) Execute() (*StreamResultOfCanvasesGetExecutionLogsResponse, *http.Response, error) {
+	return r.ApiService.CanvasesGetExecutionLogsExecute(r)
+}
+
+/*
+CanvasesGetExecutionLogs Get execution logs
+
+Streams the logs of an execution as newline-delimited JSON, optionally following new lines until the execution finishes.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@param executionId
+	@return ApiCanvasesGetExecutionLogsRequest
+*/
+func (a *CanvasNodeAPIService) CanvasesGetExecutionLogs(ctx context.Context, canvasId string, executionId string) ApiCanvasesGetExecutionLogsRequest {
+	return ApiCanvasesGetExecutionLogsRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		canvasId:    canvasId,
+		executionId: executionId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return StreamResultOfCanvasesGetExecutionLogsResponse
+func (a *CanvasNodeAPIService) CanvasesGetExecutionLogsExecute(r ApiCanvasesGetExecutionLogsRequest) (*StreamResultOfCanvasesGetExecutionLogsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *StreamResultOfCanvasesGetExecutionLogsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasNodeAPIService.CanvasesGetExecutionLogs")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/executions/{executionId}/logs"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"executionId"+"}", url.PathEscape(parameterValueToString(r.executionId, "executionId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.follow != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "follow", r.follow, "", "")
+	}
+	if r.afterSequence != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "afterSequence", r.afterSequence, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiCanvasesInvokeNodeTriggerActionRequest struct {
 	ctx        context.Context
 	ApiService *CanvasNodeAPIService
@@ -839,6 +975,271 @@ func (a *CanvasNodeAPIService) CanvasesListNodeQueueItemsExecute(r ApiCanvasesLi
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiCanvasesListWebhookDeliveriesRequest struct {
+	ctx        context.Context
+	ApiService *CanvasNodeAPIService
+	canvasId   string
+	nodeId     string
+	limit      *int64
+	before     *time.Time
+}
+
+func (r ApiCanvasesListWebhookDeliveriesRequest) Limit(limit int64) ApiCanvasesListWebhookDeliveriesRequest {
+	r.limit = &limit
+	return r
+}
+
+func (r ApiCanvasesListWebhookDeliveriesRequest) Before(before time.Time) ApiCanvasesListWebhookDeliveriesRequest {
+	r.before = &before
+	return r
+}
+
+func (r ApiCanvasesListWebhookDeliveriesRequest) Execute() (*CanvasesListWebhookDeliveriesResponse, *http.Response, error) {
+	return r.ApiService.CanvasesListWebhookDeliveriesExecute(r)
+}
+
+/*
+CanvasesListWebhookDeliveries List webhook deliveries
+
+Returns the most recent requests received by the webhook of a canvas node
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@param nodeId
+	@return ApiCanvasesListWebhookDeliveriesRequest
+*/
+func (a *CanvasNodeAPIService) CanvasesListWebhookDeliveries(ctx context.Context, canvasId string, nodeId string) ApiCanvasesListWebhookDeliveriesRequest {
+	return ApiCanvasesListWebhookDeliveriesRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+		nodeId:     nodeId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesListWebhookDeliveriesResponse
+func (a *CanvasNodeAPIService) CanvasesListWebhookDeliveriesExecute(r ApiCanvasesListWebhookDeliveriesRequest) (*CanvasesListWebhookDeliveriesResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesListWebhookDeliveriesResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasNodeAPIService.CanvasesListWebhookDeliveries")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/nodes/{nodeId}/webhook-deliveries"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"nodeId"+"}", url.PathEscape(parameterValueToString(r.nodeId, "nodeId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.limit != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", r.limit, "", "")
+	}
+	if r.before != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "before", r.before, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesReplayWebhookDeliveryRequest struct {
+	ctx        context.Context
+	ApiService *CanvasNodeAPIService
+	canvasId   string
+	nodeId     string
+	deliveryId string
+	body       *map[string]interface{}
+}
+
+func (r ApiCanvasesReplayWebhookDeliveryRequest) Body(body map[string]interface{}) ApiCanvasesReplayWebhookDeliveryRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiCanvasesReplayWebhookDeliveryRequest) Execute() (*CanvasesReplayWebhookDeliveryResponse, *http.Response, error) {
+	return r.ApiService.CanvasesReplayWebhookDeliveryExecute(r)
+}
+
+/*
+CanvasesReplayWebhookDelivery Replay webhook delivery
+
+Handles a previously received webhook request again with the current node configuration
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@param nodeId
+	@param deliveryId
+	@return ApiCanvasesReplayWebhookDeliveryRequest
+*/
+func (a *CanvasNodeAPIService) CanvasesReplayWebhookDelivery(ctx context.Context, canvasId string, nodeId string, deliveryId string) ApiCanvasesReplayWebhookDeliveryRequest {
+	return ApiCanvasesReplayWebhookDeliveryRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+		nodeId:     nodeId,
+		deliveryId: deliveryId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesReplayWebhookDeliveryResponse
+func (a *CanvasNodeAPIService) CanvasesReplayWebhookDeliveryExecute(r ApiCanvasesReplayWebhookDeliveryRequest) (*CanvasesReplayWebhookDeliveryResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesReplayWebhookDeliveryResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasNodeAPIService.CanvasesReplayWebhookDelivery")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/nodes/{nodeId}/webhook-deliveries/{deliveryId}/replay"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"nodeId"+"}", url.PathEscape(parameterValueToString(r.nodeId, "nodeId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"deliveryId"+"}", url.PathEscape(parameterValueToString(r.deliveryId, "deliveryId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiCanvasesUpdateNodePauseRequest struct {
 	ctx        context.Context
 	ApiService *CanvasNodeAPIService
@@ -427,6 +427,129 @@ func (a *OrganizationAPIService) OrganizationsCreateInvitationExecute(r ApiOrgan
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiOrganizationsCreateWebhookSubscriptionRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+	body       *OrganizationsCreateWebhookSubscriptionBody
+}
+
+func (r ApiOrganizationsCreateWebhookSubscriptionRequest) Body(body OrganizationsCreateWebhookSubscriptionBody) ApiOrganizationsCreateWebhookSubscriptionRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiOrganizationsCreateWebhookSubscriptionRequest) Execute() (*OrganizationsCreateWebhookSubscriptionResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsCreateWebhookSubscriptionExecute(r)
+}
+
+/*
+OrganizationsCreateWebhookSubscription Create webhook subscription
+
+Creates a webhook that receives activity from the organization. The signing secret is only returned here.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsCreateWebhookSubscriptionRequest
+*/
+func (a *OrganizationAPIService) OrganizationsCreateWebhookSubscription(ctx context.Context, id string) ApiOrganizationsCreateWebhookSubscriptionRequest {
+	return ApiOrganizationsCreateWebhookSubscriptionRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsCreateWebhookSubscriptionResponse
+func (a *OrganizationAPIService) OrganizationsCreateWebhookSubscriptionExecute(r ApiOrganizationsCreateWebhookSubscriptionRequest) (*OrganizationsCreateWebhookSubscriptionResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsCreateWebhookSubscriptionResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsCreateWebhookSubscription")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/webhook-subscriptions"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiOrganizationsDeleteIntegrationRequest struct {
 	ctx           context.Context
 	ApiService    *OrganizationAPIService
@@ -655,6 +778,122 @@ func (a *OrganizationAPIService) OrganizationsDeleteOrganizationExecute(r ApiOrg
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiOrganizationsDeleteWebhookSubscriptionRequest struct {
+	ctx            context.Context
+	ApiService     *OrganizationAPIService
+	id             string
+	subscriptionId string
+}
+
+func (r ApiOrganizationsDeleteWebhookSubscriptionRequest) Execute() (map[string]interface{}, *http.Response, error) {
+	return r.ApiService.OrganizationsDeleteWebhookSubscriptionExecute(r)
+}
+
+/*
+OrganizationsDeleteWebhookSubscription Delete webhook subscription
+
+Deletes a webhook subscription and its delivery log
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@param subscriptionId
+	@return ApiOrganizationsDeleteWebhookSubscriptionRequest
+*/
+func (a *OrganizationAPIService) OrganizationsDeleteWebhookSubscription(ctx context.Context, id string, subscriptionId string) ApiOrganizationsDeleteWebhookSubscriptionRequest {
+	return ApiOrganizationsDeleteWebhookSubscriptionRequest{
+		ApiService:     a,
+		ctx:            ctx,
+		id:             id,
+		subscriptionId: subscriptionId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return map[string]interface{}
+func (a *OrganizationAPIService) OrganizationsDeleteWebhookSubscriptionExecute(r ApiOrganizationsDeleteWebhookSubscriptionRequest) (map[string]interface{}, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodDelete
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue map[string]interface{}
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsDeleteWebhookSubscription")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/webhook-subscriptions/{subscriptionId}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"subscriptionId"+"}", url.PathEscape(parameterValueToString(r.subscriptionId, "subscriptionId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiOrganizationsDescribeIntegrationRequest struct {
 	ctx           context.Context
 	ApiService    *OrganizationAPIService
@@ -674,10 +913,602 @@ Returns details of a specific integration in an organization
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
 	@param integrationId
-	@return ApiOrganizationsDescribeIntegrationRequest
+	@return ApiOrganizationsDescribeIntegrationRequest
+*/
+func (a *OrganizationAPIService) OrganizationsDescribeIntegration(ctx context.Context, id string, integrationId string) ApiOrganizationsDescribeIntegrationRequest {
+	return ApiOrganizationsDescribeIntegrationRequest{
+		ApiService:    a,
+		ctx:           ctx,
+		id:            id,
+		integrationId: integrationId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsDescribeIntegrationResponse
+func (a *OrganizationAPIService) OrganizationsDescribeIntegrationExecute(r ApiOrganizationsDescribeIntegrationRequest) (*OrganizationsDescribeIntegrationResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsDescribeIntegrationResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsDescribeIntegration")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/integrations/{integrationId}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"integrationId"+"}", url.PathEscape(parameterValueToString(r.integrationId, "integrationId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsDescribeOrganizationRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+}
+
+func (r ApiOrganizationsDescribeOrganizationRequest) Execute() (*OrganizationsDescribeOrganizationResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsDescribeOrganizationExecute(r)
+}
+
+/*
+OrganizationsDescribeOrganization Get organization details
+
+Returns the details of a specific organization (can be referenced by ID or name)
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsDescribeOrganizationRequest
+*/
+func (a *OrganizationAPIService) OrganizationsDescribeOrganization(ctx context.Context, id string) ApiOrganizationsDescribeOrganizationRequest {
+	return ApiOrganizationsDescribeOrganizationRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsDescribeOrganizationResponse
+func (a *OrganizationAPIService) OrganizationsDescribeOrganizationExecute(r ApiOrganizationsDescribeOrganizationRequest) (*OrganizationsDescribeOrganizationResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsDescribeOrganizationResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsDescribeOrganization")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsExportOrganizationRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+	body       *OrganizationsExportOrganizationBody
+}
+
+func (r ApiOrganizationsExportOrganizationRequest) Body(body OrganizationsExportOrganizationBody) ApiOrganizationsExportOrganizationRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiOrganizationsExportOrganizationRequest) Execute() (*OrganizationsExportOrganizationResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsExportOrganizationExecute(r)
+}
+
+/*
+OrganizationsExportOrganization Export an organization
+
+Exports the canvases, blueprints, roles, groups, integrations and secrets of an organization to a portable archive
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsExportOrganizationRequest
+*/
+func (a *OrganizationAPIService) OrganizationsExportOrganization(ctx context.Context, id string) ApiOrganizationsExportOrganizationRequest {
+	return ApiOrganizationsExportOrganizationRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsExportOrganizationResponse
+func (a *OrganizationAPIService) OrganizationsExportOrganizationExecute(r ApiOrganizationsExportOrganizationRequest) (*OrganizationsExportOrganizationResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsExportOrganizationResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsExportOrganization")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/export"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsGetInviteLinkRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+}
+
+func (r ApiOrganizationsGetInviteLinkRequest) Execute() (*OrganizationsGetInviteLinkResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsGetInviteLinkExecute(r)
+}
+
+/*
+OrganizationsGetInviteLink Get an organization invite link
+
+Returns the invite link for an organization
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsGetInviteLinkRequest
+*/
+func (a *OrganizationAPIService) OrganizationsGetInviteLink(ctx context.Context, id string) ApiOrganizationsGetInviteLinkRequest {
+	return ApiOrganizationsGetInviteLinkRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsGetInviteLinkResponse
+func (a *OrganizationAPIService) OrganizationsGetInviteLinkExecute(r ApiOrganizationsGetInviteLinkRequest) (*OrganizationsGetInviteLinkResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsGetInviteLinkResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsGetInviteLink")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/invite-link"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsImportOrganizationRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+	body       *OrganizationsImportOrganizationBody
+}
+
+func (r ApiOrganizationsImportOrganizationRequest) Body(body OrganizationsImportOrganizationBody) ApiOrganizationsImportOrganizationRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiOrganizationsImportOrganizationRequest) Execute() (*OrganizationsImportOrganizationResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsImportOrganizationExecute(r)
+}
+
+/*
+OrganizationsImportOrganization Import an organization
+
+Recreates the resources of an organization archive in this organization
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsImportOrganizationRequest
+*/
+func (a *OrganizationAPIService) OrganizationsImportOrganization(ctx context.Context, id string) ApiOrganizationsImportOrganizationRequest {
+	return ApiOrganizationsImportOrganizationRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsImportOrganizationResponse
+func (a *OrganizationAPIService) OrganizationsImportOrganizationExecute(r ApiOrganizationsImportOrganizationRequest) (*OrganizationsImportOrganizationResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsImportOrganizationResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsImportOrganization")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/import"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsListIntegrationResourcesRequest struct {
+	ctx           context.Context
+	ApiService    *OrganizationAPIService
+	id            string
+	integrationId string
+	parameters    *string
+}
+
+func (r ApiOrganizationsListIntegrationResourcesRequest) Parameters(parameters string) ApiOrganizationsListIntegrationResourcesRequest {
+	r.parameters = &parameters
+	return r
+}
+
+func (r ApiOrganizationsListIntegrationResourcesRequest) Execute() (*OrganizationsListIntegrationResourcesResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsListIntegrationResourcesExecute(r)
+}
+
+/*
+OrganizationsListIntegrationResources List integration resources
+
+Lists resources for an integration
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@param integrationId
+	@return ApiOrganizationsListIntegrationResourcesRequest
 */
-func (a *OrganizationAPIService) OrganizationsDescribeIntegration(ctx context.Context, id string, integrationId string) ApiOrganizationsDescribeIntegrationRequest {
-	return ApiOrganizationsDescribeIntegrationRequest{
+func (a *OrganizationAPIService) OrganizationsListIntegrationResources(ctx context.Context, id string, integrationId string) ApiOrganizationsListIntegrationResourcesRequest {
+	return ApiOrganizationsListIntegrationResourcesRequest{
 		ApiService:    a,
 		ctx:           ctx,
 		id:            id,
@@ -687,21 +1518,21 @@ func (a *OrganizationAPIService) OrganizationsDescribeIntegration(ctx context.Co
 
 // Execute executes the request
 //
-//	@return OrganizationsDescribeIntegrationResponse
-func (a *OrganizationAPIService) OrganizationsDescribeIntegrationExecute(r ApiOrganizationsDescribeIntegrationRequest) (*OrganizationsDescribeIntegrationResponse, *http.Response, error) {
+//	@return OrganizationsListIntegrationResourcesResponse
+func (a *OrganizationAPIService) OrganizationsListIntegrationResourcesExecute(r ApiOrganizationsListIntegrationResourcesRequest) (*OrganizationsListIntegrationResourcesResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsDescribeIntegrationResponse
+		localVarReturnValue *OrganizationsListIntegrationResourcesResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsDescribeIntegration")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListIntegrationResources")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/integrations/{integrationId}"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/integrations/{integrationId}/resources"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"integrationId"+"}", url.PathEscape(parameterValueToString(r.integrationId, "integrationId")), -1)
 
@@ -709,6 +1540,9 @@ func (a *OrganizationAPIService) OrganizationsDescribeIntegrationExecute(r ApiOr
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.parameters != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "parameters", r.parameters, "", "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -771,27 +1605,27 @@ func (a *OrganizationAPIService) OrganizationsDescribeIntegrationExecute(r ApiOr
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsDescribeOrganizationRequest struct {
+type ApiOrganizationsListIntegrationsRequest struct {
 	ctx        context.Context
 	ApiService *OrganizationAPIService
 	id         string
 }
 
-func (r ApiOrganizationsDescribeOrganizationRequest) Execute() (*OrganizationsDescribeOrganizationResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsDescribeOrganizationExecute(r)
+func (r ApiOrganizationsListIntegrationsRequest) Execute() (*SuperplaneOrganizationsListIntegrationsResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsListIntegrationsExecute(r)
 }
 
 /*
-OrganizationsDescribeOrganization Get organization details
+OrganizationsListIntegrations List integrations in an organization
 
-Returns the details of a specific organization (can be referenced by ID or name)
+Returns a list of integrations in an organization
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@return ApiOrganizationsDescribeOrganizationRequest
+	@return ApiOrganizationsListIntegrationsRequest
 */
-func (a *OrganizationAPIService) OrganizationsDescribeOrganization(ctx context.Context, id string) ApiOrganizationsDescribeOrganizationRequest {
-	return ApiOrganizationsDescribeOrganizationRequest{
+func (a *OrganizationAPIService) OrganizationsListIntegrations(ctx context.Context, id string) ApiOrganizationsListIntegrationsRequest {
+	return ApiOrganizationsListIntegrationsRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
@@ -800,21 +1634,21 @@ func (a *OrganizationAPIService) OrganizationsDescribeOrganization(ctx context.C
 
 // Execute executes the request
 //
-//	@return OrganizationsDescribeOrganizationResponse
-func (a *OrganizationAPIService) OrganizationsDescribeOrganizationExecute(r ApiOrganizationsDescribeOrganizationRequest) (*OrganizationsDescribeOrganizationResponse, *http.Response, error) {
+//	@return SuperplaneOrganizationsListIntegrationsResponse
+func (a *OrganizationAPIService) OrganizationsListIntegrationsExecute(r ApiOrganizationsListIntegrationsRequest) (*SuperplaneOrganizationsListIntegrationsResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsDescribeOrganizationResponse
+		localVarReturnValue *SuperplaneOrganizationsListIntegrationsResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsDescribeOrganization")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListIntegrations")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/integrations"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -883,27 +1717,27 @@ func (a *OrganizationAPIService) OrganizationsDescribeOrganizationExecute(r ApiO
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsGetInviteLinkRequest struct {
+type ApiOrganizationsListInvitationsRequest struct {
 	ctx        context.Context
 	ApiService *OrganizationAPIService
 	id         string
 }
 
-func (r ApiOrganizationsGetInviteLinkRequest) Execute() (*OrganizationsGetInviteLinkResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsGetInviteLinkExecute(r)
+func (r ApiOrganizationsListInvitationsRequest) Execute() (*OrganizationsListInvitationsResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsListInvitationsExecute(r)
 }
 
 /*
-OrganizationsGetInviteLink Get an organization invite link
+OrganizationsListInvitations List organization invitations
 
-Returns the invite link for an organization
+Returns pending invitations for an organization
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@return ApiOrganizationsGetInviteLinkRequest
+	@return ApiOrganizationsListInvitationsRequest
 */
-func (a *OrganizationAPIService) OrganizationsGetInviteLink(ctx context.Context, id string) ApiOrganizationsGetInviteLinkRequest {
-	return ApiOrganizationsGetInviteLinkRequest{
+func (a *OrganizationAPIService) OrganizationsListInvitations(ctx context.Context, id string) ApiOrganizationsListInvitationsRequest {
+	return ApiOrganizationsListInvitationsRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
@@ -912,21 +1746,21 @@ func (a *OrganizationAPIService) OrganizationsGetInviteLink(ctx context.Context,
 
 // Execute executes the request
 //
-//	@return OrganizationsGetInviteLinkResponse
-func (a *OrganizationAPIService) OrganizationsGetInviteLinkExecute(r ApiOrganizationsGetInviteLinkRequest) (*OrganizationsGetInviteLinkResponse, *http.Response, error) {
+//	@return OrganizationsListInvitationsResponse
+func (a *OrganizationAPIService) OrganizationsListInvitationsExecute(r ApiOrganizationsListInvitationsRequest) (*OrganizationsListInvitationsResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsGetInviteLinkResponse
+		localVarReturnValue *OrganizationsListInvitationsResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsGetInviteLink")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListInvitations")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/invite-link"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/invitations"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -995,69 +1829,56 @@ func (a *OrganizationAPIService) OrganizationsGetInviteLinkExecute(r ApiOrganiza
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsListIntegrationResourcesRequest struct {
-	ctx           context.Context
-	ApiService    *OrganizationAPIService
-	id            string
-	integrationId string
-	parameters    *string
-}
-
-func (r ApiOrganizationsListIntegrationResourcesRequest) Parameters(parameters string) ApiOrganizationsListIntegrationResourcesRequest {
-	r.parameters = &parameters
-	return r
+type ApiOrganizationsListStuckWorkRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
 }
 
-func (r ApiOrganizationsListIntegrationResourcesRequest) Execute() (*OrganizationsListIntegrationResourcesResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsListIntegrationResourcesExecute(r)
+func (r ApiOrganizationsListStuckWorkRequest) Execute() (*OrganizationsListStuckWorkResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsListStuckWorkExecute(r)
 }
 
 /*
-OrganizationsListIntegrationResources List integration resources
+OrganizationsListStuckWork List stuck work
 
-Lists resources for an integration
+Lists nodes, queue items, executions and webhooks that have not progressed for longer than expected
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@param integrationId
-	@return ApiOrganizationsListIntegrationResourcesRequest
+	@return ApiOrganizationsListStuckWorkRequest
 */
-func (a *OrganizationAPIService) OrganizationsListIntegrationResources(ctx context.Context, id string, integrationId string) ApiOrganizationsListIntegrationResourcesRequest {
-	return ApiOrganizationsListIntegrationResourcesRequest{
-		ApiService:    a,
-		ctx:           ctx,
-		id:            id,
-		integrationId: integrationId,
+func (a *OrganizationAPIService) OrganizationsListStuckWork(ctx context.Context, id string) ApiOrganizationsListStuckWorkRequest {
+	return ApiOrganizationsListStuckWorkRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
 	}
 }
 
 // Execute executes the request
 //
-//	@return OrganizationsListIntegrationResourcesResponse
-func (a *OrganizationAPIService) OrganizationsListIntegrationResourcesExecute(r ApiOrganizationsListIntegrationResourcesRequest) (*OrganizationsListIntegrationResourcesResponse, *http.Response, error) {
+//	@return OrganizationsListStuckWorkResponse
+func (a *OrganizationAPIService) OrganizationsListStuckWorkExecute(r ApiOrganizationsListStuckWorkRequest) (*OrganizationsListStuckWorkResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsListIntegrationResourcesResponse
+		localVarReturnValue *OrganizationsListStuckWorkResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListIntegrationResources")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListStuckWork")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/integrations/{integrationId}/resources"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/stuck-work"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
-	localVarPath = strings.Replace(localVarPath, "{"+"integrationId"+"}", url.PathEscape(parameterValueToString(r.integrationId, "integrationId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.parameters != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "parameters", r.parameters, "", "")
-	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -1120,56 +1941,78 @@ func (a *OrganizationAPIService) OrganizationsListIntegrationResourcesExecute(r
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsListIntegrationsRequest struct {
-	ctx        context.Context
-	ApiService *OrganizationAPIService
-	id         string
+type ApiOrganizationsListWebhookSubscriptionDeliveriesRequest struct {
+	ctx            context.Context
+	ApiService     *OrganizationAPIService
+	id             string
+	subscriptionId string
+	limit          *int64
+	before         *time.Time
 }
 
-func (r ApiOrganizationsListIntegrationsRequest) Execute() (*SuperplaneOrganizationsListIntegrationsResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsListIntegrationsExecute(r)
+func (r ApiOrganizationsListWebhookSubscriptionDeliveriesRequest) Limit(limit int64) ApiOrganizationsListWebhookSubscriptionDeliveriesRequest {
+	r.limit = &limit
+	return r
+}
+
+func (r ApiOrganizationsListWebhookSubscriptionDeliveriesRequest) Before(before time.Time) ApiOrganizationsListWebhookSubscriptionDeliveriesRequest {
+	r.before = &before
+	return r
+}
+
+func (r ApiOrganizationsListWebhookSubscriptionDeliveriesRequest) Execute() (*OrganizationsListWebhookSubscriptionDeliveriesResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsListWebhookSubscriptionDeliveriesExecute(r)
 }
 
 /*
-OrganizationsListIntegrations List integrations in an organization
+OrganizationsListWebhookSubscriptionDeliveries List webhook subscription deliveries
 
-Returns a list of integrations in an organization
+Lists the most recent deliveries for a webhook subscription
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@return ApiOrganizationsListIntegrationsRequest
+	@param subscriptionId
+	@return ApiOrganizationsListWebhookSubscriptionDeliveriesRequest
 */
-func (a *OrganizationAPIService) OrganizationsListIntegrations(ctx context.Context, id string) ApiOrganizationsListIntegrationsRequest {
-	return ApiOrganizationsListIntegrationsRequest{
-		ApiService: a,
-		ctx:        ctx,
-		id:         id,
+func (a *OrganizationAPIService) OrganizationsListWebhookSubscriptionDeliveries(ctx context.Context, id string, subscriptionId string) ApiOrganizationsListWebhookSubscriptionDeliveriesRequest {
+	return ApiOrganizationsListWebhookSubscriptionDeliveriesRequest{
+		ApiService:     a,
+		ctx:            ctx,
+		id:             id,
+		subscriptionId: subscriptionId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return SuperplaneOrganizationsListIntegrationsResponse
-func (a *OrganizationAPIService) OrganizationsListIntegrationsExecute(r ApiOrganizationsListIntegrationsRequest) (*SuperplaneOrganizationsListIntegrationsResponse, *http.Response, error) {
+//	@return OrganizationsListWebhookSubscriptionDeliveriesResponse
+func (a *OrganizationAPIService) OrganizationsListWebhookSubscriptionDeliveriesExecute(r ApiOrganizationsListWebhookSubscriptionDeliveriesRequest) (*OrganizationsListWebhookSubscriptionDeliveriesResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *SuperplaneOrganizationsListIntegrationsResponse
+		localVarReturnValue *OrganizationsListWebhookSubscriptionDeliveriesResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListIntegrations")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListWebhookSubscriptionDeliveries")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/integrations"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/webhook-subscriptions/{subscriptionId}/deliveries"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"subscriptionId"+"}", url.PathEscape(parameterValueToString(r.subscriptionId, "subscriptionId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.limit != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", r.limit, "", "")
+	}
+	if r.before != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "before", r.before, "", "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -1232,27 +2075,27 @@ func (a *OrganizationAPIService) OrganizationsListIntegrationsExecute(r ApiOrgan
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsListInvitationsRequest struct {
+type ApiOrganizationsListWebhookSubscriptionsRequest struct {
 	ctx        context.Context
 	ApiService *OrganizationAPIService
 	id         string
 }
 
-func (r ApiOrganizationsListInvitationsRequest) Execute() (*OrganizationsListInvitationsResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsListInvitationsExecute(r)
+func (r ApiOrganizationsListWebhookSubscriptionsRequest) Execute() (*OrganizationsListWebhookSubscriptionsResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsListWebhookSubscriptionsExecute(r)
 }
 
 /*
-OrganizationsListInvitations List organization invitations
+OrganizationsListWebhookSubscriptions List webhook subscriptions
 
-Returns pending invitations for an organization
+Lists the webhooks that receive activity from the organization
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@return ApiOrganizationsListInvitationsRequest
+	@return ApiOrganizationsListWebhookSubscriptionsRequest
 */
-func (a *OrganizationAPIService) OrganizationsListInvitations(ctx context.Context, id string) ApiOrganizationsListInvitationsRequest {
-	return ApiOrganizationsListInvitationsRequest{
+func (a *OrganizationAPIService) OrganizationsListWebhookSubscriptions(ctx context.Context, id string) ApiOrganizationsListWebhookSubscriptionsRequest {
+	return ApiOrganizationsListWebhookSubscriptionsRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
@@ -1261,21 +2104,21 @@ func (a *OrganizationAPIService) OrganizationsListInvitations(ctx context.Contex
 
 // Execute executes the request
 //
-//	@return OrganizationsListInvitationsResponse
-func (a *OrganizationAPIService) OrganizationsListInvitationsExecute(r ApiOrganizationsListInvitationsRequest) (*OrganizationsListInvitationsResponse, *http.Response, error) {
+//	@return OrganizationsListWebhookSubscriptionsResponse
+func (a *OrganizationAPIService) OrganizationsListWebhookSubscriptionsExecute(r ApiOrganizationsListWebhookSubscriptionsRequest) (*OrganizationsListWebhookSubscriptionsResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsListInvitationsResponse
+		localVarReturnValue *OrganizationsListWebhookSubscriptionsResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListInvitations")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListWebhookSubscriptions")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/invitations"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/webhook-subscriptions"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -2060,3 +2903,130 @@ func (a *OrganizationAPIService) OrganizationsUpdateOrganizationExecute(r ApiOrg
 
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
+
+type ApiOrganizationsUpdateWebhookSubscriptionRequest struct {
+	ctx            context.Context
+	ApiService     *OrganizationAPIService
+	id             string
+	subscriptionId string
+	body           *OrganizationsUpdateWebhookSubscriptionBody
+}
+
+func (r ApiOrganizationsUpdateWebhookSubscriptionRequest) Body(body OrganizationsUpdateWebhookSubscriptionBody) ApiOrganizationsUpdateWebhookSubscriptionRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiOrganizationsUpdateWebhookSubscriptionRequest) Execute() (*OrganizationsUpdateWebhookSubscriptionResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsUpdateWebhookSubscriptionExecute(r)
+}
+
+/*
+OrganizationsUpdateWebhookSubscription Update webhook subscription
+
+Updates the URL, event types or state of a webhook subscription
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@param subscriptionId
+	@return ApiOrganizationsUpdateWebhookSubscriptionRequest
+*/
+func (a *OrganizationAPIService) OrganizationsUpdateWebhookSubscription(ctx context.Context, id string, subscriptionId string) ApiOrganizationsUpdateWebhookSubscriptionRequest {
+	return ApiOrganizationsUpdateWebhookSubscriptionRequest{
+		ApiService:     a,
+		ctx:            ctx,
+		id:             id,
+		subscriptionId: subscriptionId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsUpdateWebhookSubscriptionResponse
+func (a *OrganizationAPIService) OrganizationsUpdateWebhookSubscriptionExecute(r ApiOrganizationsUpdateWebhookSubscriptionRequest) (*OrganizationsUpdateWebhookSubscriptionResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPatch
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsUpdateWebhookSubscriptionResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsUpdateWebhookSubscription")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/webhook-subscriptions/{subscriptionId}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"subscriptionId"+"}", url.PathEscape(parameterValueToString(r.subscriptionId, "subscriptionId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
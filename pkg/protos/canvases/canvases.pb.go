@@ -75,7 +75,7 @@ func (x CanvasNodeExecution_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_State.Descriptor instead.
 func (CanvasNodeExecution_State) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{26, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{28, 0}
 }
 
 type CanvasNodeExecution_Result int32
@@ -127,7 +127,7 @@ func (x CanvasNodeExecution_Result) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_Result.Descriptor instead.
 func (CanvasNodeExecution_Result) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{26, 1}
+	return file_canvases_proto_rawDescGZIP(), []int{28, 1}
 }
 
 type CanvasNodeExecution_ResultReason int32
@@ -176,7 +176,163 @@ func (x CanvasNodeExecution_ResultReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_ResultReason.Descriptor instead.
 func (CanvasNodeExecution_ResultReason) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{26, 2}
+	return file_canvases_proto_rawDescGZIP(), []int{28, 2}
+}
+
+type WatchCanvasResponse_Type int32
+
+const (
+	WatchCanvasResponse_TYPE_UNKNOWN                 WatchCanvasResponse_Type = 0
+	WatchCanvasResponse_TYPE_EVENT_CREATED           WatchCanvasResponse_Type = 1
+	WatchCanvasResponse_TYPE_EXECUTION_STATE_CHANGED WatchCanvasResponse_Type = 2
+	WatchCanvasResponse_TYPE_QUEUE_ITEM_CREATED      WatchCanvasResponse_Type = 3
+)
+
+// Enum value maps for WatchCanvasResponse_Type.
+var (
+	WatchCanvasResponse_Type_name = map[int32]string{
+		0: "TYPE_UNKNOWN",
+		1: "TYPE_EVENT_CREATED",
+		2: "TYPE_EXECUTION_STATE_CHANGED",
+		3: "TYPE_QUEUE_ITEM_CREATED",
+	}
+	WatchCanvasResponse_Type_value = map[string]int32{
+		"TYPE_UNKNOWN":                 0,
+		"TYPE_EVENT_CREATED":           1,
+		"TYPE_EXECUTION_STATE_CHANGED": 2,
+		"TYPE_QUEUE_ITEM_CREATED":      3,
+	}
+)
+
+func (x WatchCanvasResponse_Type) Enum() *WatchCanvasResponse_Type {
+	p := new(WatchCanvasResponse_Type)
+	*p = x
+	return p
+}
+
+func (x WatchCanvasResponse_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchCanvasResponse_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[3].Descriptor()
+}
+
+func (WatchCanvasResponse_Type) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[3]
+}
+
+func (x WatchCanvasResponse_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchCanvasResponse_Type.Descriptor instead.
+func (WatchCanvasResponse_Type) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{50, 0}
+}
+
+type ExecutionLogLine_Level int32
+
+const (
+	ExecutionLogLine_LEVEL_UNKNOWN ExecutionLogLine_Level = 0
+	ExecutionLogLine_LEVEL_INFO    ExecutionLogLine_Level = 1
+	ExecutionLogLine_LEVEL_WARNING ExecutionLogLine_Level = 2
+	ExecutionLogLine_LEVEL_ERROR   ExecutionLogLine_Level = 3
+)
+
+// Enum value maps for ExecutionLogLine_Level.
+var (
+	ExecutionLogLine_Level_name = map[int32]string{
+		0: "LEVEL_UNKNOWN",
+		1: "LEVEL_INFO",
+		2: "LEVEL_WARNING",
+		3: "LEVEL_ERROR",
+	}
+	ExecutionLogLine_Level_value = map[string]int32{
+		"LEVEL_UNKNOWN": 0,
+		"LEVEL_INFO":    1,
+		"LEVEL_WARNING": 2,
+		"LEVEL_ERROR":   3,
+	}
+)
+
+func (x ExecutionLogLine_Level) Enum() *ExecutionLogLine_Level {
+	p := new(ExecutionLogLine_Level)
+	*p = x
+	return p
+}
+
+func (x ExecutionLogLine_Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExecutionLogLine_Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[4].Descriptor()
+}
+
+func (ExecutionLogLine_Level) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[4]
+}
+
+func (x ExecutionLogLine_Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExecutionLogLine_Level.Descriptor instead.
+func (ExecutionLogLine_Level) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{57, 0}
+}
+
+type CanvasChangedMessage_Action int32
+
+const (
+	CanvasChangedMessage_ACTION_UNKNOWN CanvasChangedMessage_Action = 0
+	CanvasChangedMessage_ACTION_CREATED CanvasChangedMessage_Action = 1
+	CanvasChangedMessage_ACTION_UPDATED CanvasChangedMessage_Action = 2
+	CanvasChangedMessage_ACTION_DELETED CanvasChangedMessage_Action = 3
+)
+
+// Enum value maps for CanvasChangedMessage_Action.
+var (
+	CanvasChangedMessage_Action_name = map[int32]string{
+		0: "ACTION_UNKNOWN",
+		1: "ACTION_CREATED",
+		2: "ACTION_UPDATED",
+		3: "ACTION_DELETED",
+	}
+	CanvasChangedMessage_Action_value = map[string]int32{
+		"ACTION_UNKNOWN": 0,
+		"ACTION_CREATED": 1,
+		"ACTION_UPDATED": 2,
+		"ACTION_DELETED": 3,
+	}
+)
+
+func (x CanvasChangedMessage_Action) Enum() *CanvasChangedMessage_Action {
+	p := new(CanvasChangedMessage_Action)
+	*p = x
+	return p
+}
+
+func (x CanvasChangedMessage_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CanvasChangedMessage_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[5].Descriptor()
+}
+
+func (CanvasChangedMessage_Action) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[5]
+}
+
+func (x CanvasChangedMessage_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CanvasChangedMessage_Action.Descriptor instead.
+func (CanvasChangedMessage_Action) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{61, 0}
 }
 
 type ListCanvasesRequest struct {
@@ -1467,6 +1623,102 @@ func (x *ListNodeExecutionsResponse) GetLastTimestamp() *timestamp.Timestamp {
 	return nil
 }
 
+type DescribeExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeExecutionRequest) Reset() {
+	*x = DescribeExecutionRequest{}
+	mi := &file_canvases_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeExecutionRequest) ProtoMessage() {}
+
+func (x *DescribeExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeExecutionRequest.ProtoReflect.Descriptor instead.
+func (*DescribeExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DescribeExecutionRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *DescribeExecutionRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+type DescribeExecutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Execution     *CanvasNodeExecution   `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeExecutionResponse) Reset() {
+	*x = DescribeExecutionResponse{}
+	mi := &file_canvases_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeExecutionResponse) ProtoMessage() {}
+
+func (x *DescribeExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeExecutionResponse.ProtoReflect.Descriptor instead.
+func (*DescribeExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DescribeExecutionResponse) GetExecution() *CanvasNodeExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
 type ListChildExecutionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
@@ -1477,7 +1729,7 @@ type ListChildExecutionsRequest struct {
 
 func (x *ListChildExecutionsRequest) Reset() {
 	*x = ListChildExecutionsRequest{}
-	mi := &file_canvases_proto_msgTypes[24]
+	mi := &file_canvases_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1489,7 +1741,7 @@ func (x *ListChildExecutionsRequest) String() string {
 func (*ListChildExecutionsRequest) ProtoMessage() {}
 
 func (x *ListChildExecutionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[24]
+	mi := &file_canvases_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1502,7 +1754,7 @@ func (x *ListChildExecutionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListChildExecutionsRequest.ProtoReflect.Descriptor instead.
 func (*ListChildExecutionsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{24}
+	return file_canvases_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ListChildExecutionsRequest) GetCanvasId() string {
@@ -1528,7 +1780,7 @@ type ListChildExecutionsResponse struct {
 
 func (x *ListChildExecutionsResponse) Reset() {
 	*x = ListChildExecutionsResponse{}
-	mi := &file_canvases_proto_msgTypes[25]
+	mi := &file_canvases_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1540,7 +1792,7 @@ func (x *ListChildExecutionsResponse) String() string {
 func (*ListChildExecutionsResponse) ProtoMessage() {}
 
 func (x *ListChildExecutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[25]
+	mi := &file_canvases_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1553,7 +1805,7 @@ func (x *ListChildExecutionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListChildExecutionsResponse.ProtoReflect.Descriptor instead.
 func (*ListChildExecutionsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{25}
+	return file_canvases_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ListChildExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
@@ -1589,7 +1841,7 @@ type CanvasNodeExecution struct {
 
 func (x *CanvasNodeExecution) Reset() {
 	*x = CanvasNodeExecution{}
-	mi := &file_canvases_proto_msgTypes[26]
+	mi := &file_canvases_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1601,7 +1853,7 @@ func (x *CanvasNodeExecution) String() string {
 func (*CanvasNodeExecution) ProtoMessage() {}
 
 func (x *CanvasNodeExecution) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[26]
+	mi := &file_canvases_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1614,7 +1866,7 @@ func (x *CanvasNodeExecution) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasNodeExecution.ProtoReflect.Descriptor instead.
 func (*CanvasNodeExecution) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{26}
+	return file_canvases_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CanvasNodeExecution) GetId() string {
@@ -1757,7 +2009,7 @@ type CanvasNodeQueueItem struct {
 
 func (x *CanvasNodeQueueItem) Reset() {
 	*x = CanvasNodeQueueItem{}
-	mi := &file_canvases_proto_msgTypes[27]
+	mi := &file_canvases_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1769,7 +2021,7 @@ func (x *CanvasNodeQueueItem) String() string {
 func (*CanvasNodeQueueItem) ProtoMessage() {}
 
 func (x *CanvasNodeQueueItem) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[27]
+	mi := &file_canvases_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1782,7 +2034,7 @@ func (x *CanvasNodeQueueItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasNodeQueueItem.ProtoReflect.Descriptor instead.
 func (*CanvasNodeQueueItem) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{27}
+	return file_canvases_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *CanvasNodeQueueItem) GetId() string {
@@ -1839,7 +2091,7 @@ type InvokeNodeExecutionActionRequest struct {
 
 func (x *InvokeNodeExecutionActionRequest) Reset() {
 	*x = InvokeNodeExecutionActionRequest{}
-	mi := &file_canvases_proto_msgTypes[28]
+	mi := &file_canvases_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1851,7 +2103,7 @@ func (x *InvokeNodeExecutionActionRequest) String() string {
 func (*InvokeNodeExecutionActionRequest) ProtoMessage() {}
 
 func (x *InvokeNodeExecutionActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[28]
+	mi := &file_canvases_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1864,7 +2116,7 @@ func (x *InvokeNodeExecutionActionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvokeNodeExecutionActionRequest.ProtoReflect.Descriptor instead.
 func (*InvokeNodeExecutionActionRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{28}
+	return file_canvases_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *InvokeNodeExecutionActionRequest) GetCanvasId() string {
@@ -1903,7 +2155,7 @@ type InvokeNodeExecutionActionResponse struct {
 
 func (x *InvokeNodeExecutionActionResponse) Reset() {
 	*x = InvokeNodeExecutionActionResponse{}
-	mi := &file_canvases_proto_msgTypes[29]
+	mi := &file_canvases_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1915,7 +2167,7 @@ func (x *InvokeNodeExecutionActionResponse) String() string {
 func (*InvokeNodeExecutionActionResponse) ProtoMessage() {}
 
 func (x *InvokeNodeExecutionActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[29]
+	mi := &file_canvases_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1928,7 +2180,7 @@ func (x *InvokeNodeExecutionActionResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use InvokeNodeExecutionActionResponse.ProtoReflect.Descriptor instead.
 func (*InvokeNodeExecutionActionResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{29}
+	return file_canvases_proto_rawDescGZIP(), []int{31}
 }
 
 type InvokeNodeTriggerActionRequest struct {
@@ -1943,7 +2195,7 @@ type InvokeNodeTriggerActionRequest struct {
 
 func (x *InvokeNodeTriggerActionRequest) Reset() {
 	*x = InvokeNodeTriggerActionRequest{}
-	mi := &file_canvases_proto_msgTypes[30]
+	mi := &file_canvases_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1955,7 +2207,7 @@ func (x *InvokeNodeTriggerActionRequest) String() string {
 func (*InvokeNodeTriggerActionRequest) ProtoMessage() {}
 
 func (x *InvokeNodeTriggerActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[30]
+	mi := &file_canvases_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1968,7 +2220,7 @@ func (x *InvokeNodeTriggerActionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvokeNodeTriggerActionRequest.ProtoReflect.Descriptor instead.
 func (*InvokeNodeTriggerActionRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{30}
+	return file_canvases_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *InvokeNodeTriggerActionRequest) GetCanvasId() string {
@@ -2008,7 +2260,7 @@ type InvokeNodeTriggerActionResponse struct {
 
 func (x *InvokeNodeTriggerActionResponse) Reset() {
 	*x = InvokeNodeTriggerActionResponse{}
-	mi := &file_canvases_proto_msgTypes[31]
+	mi := &file_canvases_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2020,7 +2272,7 @@ func (x *InvokeNodeTriggerActionResponse) String() string {
 func (*InvokeNodeTriggerActionResponse) ProtoMessage() {}
 
 func (x *InvokeNodeTriggerActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[31]
+	mi := &file_canvases_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2033,7 +2285,7 @@ func (x *InvokeNodeTriggerActionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvokeNodeTriggerActionResponse.ProtoReflect.Descriptor instead.
 func (*InvokeNodeTriggerActionResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{31}
+	return file_canvases_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *InvokeNodeTriggerActionResponse) GetResult() *_struct.Struct {
@@ -2054,7 +2306,7 @@ type ListCanvasEventsRequest struct {
 
 func (x *ListCanvasEventsRequest) Reset() {
 	*x = ListCanvasEventsRequest{}
-	mi := &file_canvases_proto_msgTypes[32]
+	mi := &file_canvases_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2066,7 +2318,7 @@ func (x *ListCanvasEventsRequest) String() string {
 func (*ListCanvasEventsRequest) ProtoMessage() {}
 
 func (x *ListCanvasEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[32]
+	mi := &file_canvases_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2079,7 +2331,7 @@ func (x *ListCanvasEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCanvasEventsRequest.ProtoReflect.Descriptor instead.
 func (*ListCanvasEventsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{32}
+	return file_canvases_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ListCanvasEventsRequest) GetCanvasId() string {
@@ -2115,7 +2367,7 @@ type ListCanvasEventsResponse struct {
 
 func (x *ListCanvasEventsResponse) Reset() {
 	*x = ListCanvasEventsResponse{}
-	mi := &file_canvases_proto_msgTypes[33]
+	mi := &file_canvases_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2127,7 +2379,7 @@ func (x *ListCanvasEventsResponse) String() string {
 func (*ListCanvasEventsResponse) ProtoMessage() {}
 
 func (x *ListCanvasEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[33]
+	mi := &file_canvases_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2140,7 +2392,7 @@ func (x *ListCanvasEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCanvasEventsResponse.ProtoReflect.Descriptor instead.
 func (*ListCanvasEventsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{33}
+	return file_canvases_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ListCanvasEventsResponse) GetEvents() []*CanvasEventWithExecutions {
@@ -2186,7 +2438,7 @@ type CanvasEvent struct {
 
 func (x *CanvasEvent) Reset() {
 	*x = CanvasEvent{}
-	mi := &file_canvases_proto_msgTypes[34]
+	mi := &file_canvases_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2198,7 +2450,7 @@ func (x *CanvasEvent) String() string {
 func (*CanvasEvent) ProtoMessage() {}
 
 func (x *CanvasEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[34]
+	mi := &file_canvases_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2211,7 +2463,7 @@ func (x *CanvasEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasEvent.ProtoReflect.Descriptor instead.
 func (*CanvasEvent) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{34}
+	return file_canvases_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *CanvasEvent) GetId() string {
@@ -2279,7 +2531,7 @@ type CanvasEventWithExecutions struct {
 
 func (x *CanvasEventWithExecutions) Reset() {
 	*x = CanvasEventWithExecutions{}
-	mi := &file_canvases_proto_msgTypes[35]
+	mi := &file_canvases_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2291,7 +2543,7 @@ func (x *CanvasEventWithExecutions) String() string {
 func (*CanvasEventWithExecutions) ProtoMessage() {}
 
 func (x *CanvasEventWithExecutions) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[35]
+	mi := &file_canvases_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2304,7 +2556,7 @@ func (x *CanvasEventWithExecutions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasEventWithExecutions.ProtoReflect.Descriptor instead.
 func (*CanvasEventWithExecutions) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{35}
+	return file_canvases_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *CanvasEventWithExecutions) GetId() string {
@@ -2373,7 +2625,7 @@ type ListEventExecutionsRequest struct {
 
 func (x *ListEventExecutionsRequest) Reset() {
 	*x = ListEventExecutionsRequest{}
-	mi := &file_canvases_proto_msgTypes[36]
+	mi := &file_canvases_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2385,7 +2637,7 @@ func (x *ListEventExecutionsRequest) String() string {
 func (*ListEventExecutionsRequest) ProtoMessage() {}
 
 func (x *ListEventExecutionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[36]
+	mi := &file_canvases_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2398,7 +2650,7 @@ func (x *ListEventExecutionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventExecutionsRequest.ProtoReflect.Descriptor instead.
 func (*ListEventExecutionsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{36}
+	return file_canvases_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ListEventExecutionsRequest) GetCanvasId() string {
@@ -2424,7 +2676,7 @@ type ListEventExecutionsResponse struct {
 
 func (x *ListEventExecutionsResponse) Reset() {
 	*x = ListEventExecutionsResponse{}
-	mi := &file_canvases_proto_msgTypes[37]
+	mi := &file_canvases_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2436,7 +2688,7 @@ func (x *ListEventExecutionsResponse) String() string {
 func (*ListEventExecutionsResponse) ProtoMessage() {}
 
 func (x *ListEventExecutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[37]
+	mi := &file_canvases_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2449,7 +2701,7 @@ func (x *ListEventExecutionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventExecutionsResponse.ProtoReflect.Descriptor instead.
 func (*ListEventExecutionsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{37}
+	return file_canvases_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ListEventExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
@@ -2469,7 +2721,7 @@ type CancelExecutionRequest struct {
 
 func (x *CancelExecutionRequest) Reset() {
 	*x = CancelExecutionRequest{}
-	mi := &file_canvases_proto_msgTypes[38]
+	mi := &file_canvases_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2481,7 +2733,7 @@ func (x *CancelExecutionRequest) String() string {
 func (*CancelExecutionRequest) ProtoMessage() {}
 
 func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[38]
+	mi := &file_canvases_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2494,7 +2746,7 @@ func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelExecutionRequest.ProtoReflect.Descriptor instead.
 func (*CancelExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{38}
+	return file_canvases_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *CancelExecutionRequest) GetCanvasId() string {
@@ -2519,7 +2771,7 @@ type CancelExecutionResponse struct {
 
 func (x *CancelExecutionResponse) Reset() {
 	*x = CancelExecutionResponse{}
-	mi := &file_canvases_proto_msgTypes[39]
+	mi := &file_canvases_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2531,7 +2783,7 @@ func (x *CancelExecutionResponse) String() string {
 func (*CancelExecutionResponse) ProtoMessage() {}
 
 func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[39]
+	mi := &file_canvases_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2544,7 +2796,7 @@ func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelExecutionResponse.ProtoReflect.Descriptor instead.
 func (*CancelExecutionResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{39}
+	return file_canvases_proto_rawDescGZIP(), []int{41}
 }
 
 type ResolveExecutionErrorsRequest struct {
@@ -2557,7 +2809,7 @@ type ResolveExecutionErrorsRequest struct {
 
 func (x *ResolveExecutionErrorsRequest) Reset() {
 	*x = ResolveExecutionErrorsRequest{}
-	mi := &file_canvases_proto_msgTypes[40]
+	mi := &file_canvases_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2569,7 +2821,7 @@ func (x *ResolveExecutionErrorsRequest) String() string {
 func (*ResolveExecutionErrorsRequest) ProtoMessage() {}
 
 func (x *ResolveExecutionErrorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[40]
+	mi := &file_canvases_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2582,7 +2834,7 @@ func (x *ResolveExecutionErrorsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveExecutionErrorsRequest.ProtoReflect.Descriptor instead.
 func (*ResolveExecutionErrorsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{40}
+	return file_canvases_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ResolveExecutionErrorsRequest) GetCanvasId() string {
@@ -2607,7 +2859,7 @@ type ResolveExecutionErrorsResponse struct {
 
 func (x *ResolveExecutionErrorsResponse) Reset() {
 	*x = ResolveExecutionErrorsResponse{}
-	mi := &file_canvases_proto_msgTypes[41]
+	mi := &file_canvases_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2619,7 +2871,7 @@ func (x *ResolveExecutionErrorsResponse) String() string {
 func (*ResolveExecutionErrorsResponse) ProtoMessage() {}
 
 func (x *ResolveExecutionErrorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[41]
+	mi := &file_canvases_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2632,10 +2884,1050 @@ func (x *ResolveExecutionErrorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveExecutionErrorsResponse.ProtoReflect.Descriptor instead.
 func (*ResolveExecutionErrorsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{41}
+	return file_canvases_proto_rawDescGZIP(), []int{43}
 }
 
-type CanvasNodeEventMessage struct {
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Before        *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_canvases_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetBefore() *timestamp.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	HasNextPage   bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	LastTimestamp *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_canvases_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *ListWebhookDeliveriesResponse) GetLastTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return nil
+}
+
+type ReplayWebhookDeliveryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	DeliveryId    string                 `protobuf:"bytes,3,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayWebhookDeliveryRequest) Reset() {
+	*x = ReplayWebhookDeliveryRequest{}
+	mi := &file_canvases_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayWebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayWebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *ReplayWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReplayWebhookDeliveryRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ReplayWebhookDeliveryRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ReplayWebhookDeliveryRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+type ReplayWebhookDeliveryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayWebhookDeliveryResponse) Reset() {
+	*x = ReplayWebhookDeliveryResponse{}
+	mi := &file_canvases_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayWebhookDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayWebhookDeliveryResponse) ProtoMessage() {}
+
+func (x *ReplayWebhookDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayWebhookDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReplayWebhookDeliveryResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+type WebhookDelivery struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Id            string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReplayOf      string                        `protobuf:"bytes,2,opt,name=replay_of,json=replayOf,proto3" json:"replay_of,omitempty"`
+	Method        string                        `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	RemoteIp      string                        `protobuf:"bytes,4,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	Query         string                        `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`
+	Headers       map[string]string             `protobuf:"bytes,6,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Body          string                        `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	BodyTruncated bool                          `protobuf:"varint,8,opt,name=body_truncated,json=bodyTruncated,proto3" json:"body_truncated,omitempty"`
+	StatusCode    int32                         `protobuf:"varint,9,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error         string                        `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	Nodes         []*WebhookDelivery_RoutedNode `protobuf:"bytes,11,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	EventIds      []string                      `protobuf:"bytes,12,rep,name=event_ids,json=eventIds,proto3" json:"event_ids,omitempty"`
+	CreatedAt     *timestamp.Timestamp          `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_canvases_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetReplayOf() string {
+	if x != nil {
+		return x.ReplayOf
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetBodyTruncated() bool {
+	if x != nil {
+		return x.BodyTruncated
+	}
+	return false
+}
+
+func (x *WebhookDelivery) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetNodes() []*WebhookDelivery_RoutedNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetEventIds() []string {
+	if x != nil {
+		return x.EventIds
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type WatchCanvasRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	// Only stream activity for these nodes.
+	NodeIds []string `protobuf:"bytes,2,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	// Only stream these types of messages.
+	Types []WatchCanvasResponse_Type `protobuf:"varint,3,rep,packed,name=types,proto3,enum=Superplane.Canvases.WatchCanvasResponse_Type" json:"types,omitempty"`
+	// Cursor from a previously received message.
+	// If empty, only activity from now on is streamed.
+	Cursor        string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchCanvasRequest) Reset() {
+	*x = WatchCanvasRequest{}
+	mi := &file_canvases_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchCanvasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCanvasRequest) ProtoMessage() {}
+
+func (x *WatchCanvasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCanvasRequest.ProtoReflect.Descriptor instead.
+func (*WatchCanvasRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *WatchCanvasRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *WatchCanvasRequest) GetNodeIds() []string {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+func (x *WatchCanvasRequest) GetTypes() []WatchCanvasResponse_Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *WatchCanvasRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type WatchCanvasResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Type          WatchCanvasResponse_Type `protobuf:"varint,1,opt,name=type,proto3,enum=Superplane.Canvases.WatchCanvasResponse_Type" json:"type,omitempty"`
+	Cursor        string                   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Timestamp     *timestamp.Timestamp     `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Event         *CanvasEvent             `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+	Execution     *CanvasNodeExecution     `protobuf:"bytes,5,opt,name=execution,proto3" json:"execution,omitempty"`
+	QueueItem     *CanvasNodeQueueItem     `protobuf:"bytes,6,opt,name=queue_item,json=queueItem,proto3" json:"queue_item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchCanvasResponse) Reset() {
+	*x = WatchCanvasResponse{}
+	mi := &file_canvases_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchCanvasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCanvasResponse) ProtoMessage() {}
+
+func (x *WatchCanvasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCanvasResponse.ProtoReflect.Descriptor instead.
+func (*WatchCanvasResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *WatchCanvasResponse) GetType() WatchCanvasResponse_Type {
+	if x != nil {
+		return x.Type
+	}
+	return WatchCanvasResponse_TYPE_UNKNOWN
+}
+
+func (x *WatchCanvasResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *WatchCanvasResponse) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *WatchCanvasResponse) GetEvent() *CanvasEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *WatchCanvasResponse) GetExecution() *CanvasNodeExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *WatchCanvasResponse) GetQueueItem() *CanvasNodeQueueItem {
+	if x != nil {
+		return x.QueueItem
+	}
+	return nil
+}
+
+type GetCanvasMetricsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	// Only executions finished in this window are included.
+	// Defaults to the last 24 hours.
+	StartTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Only include these nodes.
+	NodeIds       []string `protobuf:"bytes,4,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCanvasMetricsRequest) Reset() {
+	*x = GetCanvasMetricsRequest{}
+	mi := &file_canvases_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCanvasMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCanvasMetricsRequest) ProtoMessage() {}
+
+func (x *GetCanvasMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCanvasMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetCanvasMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetCanvasMetricsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *GetCanvasMetricsRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetCanvasMetricsRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetCanvasMetricsRequest) GetNodeIds() []string {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+type GetCanvasMetricsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartTime *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Metrics for the whole canvas.
+	// Executions of nodes inside blueprint nodes are not included,
+	// since they are already part of the blueprint node execution.
+	Canvas        *ExecutionMetrics   `protobuf:"bytes,3,opt,name=canvas,proto3" json:"canvas,omitempty"`
+	Nodes         []*ExecutionMetrics `protobuf:"bytes,4,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCanvasMetricsResponse) Reset() {
+	*x = GetCanvasMetricsResponse{}
+	mi := &file_canvases_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCanvasMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCanvasMetricsResponse) ProtoMessage() {}
+
+func (x *GetCanvasMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCanvasMetricsResponse.ProtoReflect.Descriptor instead.
+func (*GetCanvasMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetCanvasMetricsResponse) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetCanvasMetricsResponse) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetCanvasMetricsResponse) GetCanvas() *ExecutionMetrics {
+	if x != nil {
+		return x.Canvas
+	}
+	return nil
+}
+
+func (x *GetCanvasMetricsResponse) GetNodes() []*ExecutionMetrics {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type ExecutionMetrics struct {
+	state      protoimpl.MessageState          `protogen:"open.v1"`
+	NodeId     string                          `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Component  string                          `protobuf:"bytes,2,opt,name=component,proto3" json:"component,omitempty"`
+	Executions uint32                          `protobuf:"varint,3,opt,name=executions,proto3" json:"executions,omitempty"`
+	Results    []*ExecutionMetrics_ResultCount `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	// From the start to the end of each execution.
+	Duration *DurationStats `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	// From the moment the input of each execution was queued until it started.
+	QueueWait *DurationStats `protobuf:"bytes,6,opt,name=queue_wait,json=queueWait,proto3" json:"queue_wait,omitempty"`
+	// Duration of approval executions.
+	ApprovalWait  *DurationStats `protobuf:"bytes,7,opt,name=approval_wait,json=approvalWait,proto3" json:"approval_wait,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionMetrics) Reset() {
+	*x = ExecutionMetrics{}
+	mi := &file_canvases_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionMetrics) ProtoMessage() {}
+
+func (x *ExecutionMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionMetrics.ProtoReflect.Descriptor instead.
+func (*ExecutionMetrics) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ExecutionMetrics) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ExecutionMetrics) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *ExecutionMetrics) GetExecutions() uint32 {
+	if x != nil {
+		return x.Executions
+	}
+	return 0
+}
+
+func (x *ExecutionMetrics) GetResults() []*ExecutionMetrics_ResultCount {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ExecutionMetrics) GetDuration() *DurationStats {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *ExecutionMetrics) GetQueueWait() *DurationStats {
+	if x != nil {
+		return x.QueueWait
+	}
+	return nil
+}
+
+func (x *ExecutionMetrics) GetApprovalWait() *DurationStats {
+	if x != nil {
+		return x.ApprovalWait
+	}
+	return nil
+}
+
+type DurationStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Count          uint32                 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	AverageSeconds float64                `protobuf:"fixed64,2,opt,name=average_seconds,json=averageSeconds,proto3" json:"average_seconds,omitempty"`
+	P50Seconds     float64                `protobuf:"fixed64,3,opt,name=p50_seconds,json=p50Seconds,proto3" json:"p50_seconds,omitempty"`
+	P95Seconds     float64                `protobuf:"fixed64,4,opt,name=p95_seconds,json=p95Seconds,proto3" json:"p95_seconds,omitempty"`
+	MaxSeconds     float64                `protobuf:"fixed64,5,opt,name=max_seconds,json=maxSeconds,proto3" json:"max_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DurationStats) Reset() {
+	*x = DurationStats{}
+	mi := &file_canvases_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DurationStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DurationStats) ProtoMessage() {}
+
+func (x *DurationStats) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DurationStats.ProtoReflect.Descriptor instead.
+func (*DurationStats) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DurationStats) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DurationStats) GetAverageSeconds() float64 {
+	if x != nil {
+		return x.AverageSeconds
+	}
+	return 0
+}
+
+func (x *DurationStats) GetP50Seconds() float64 {
+	if x != nil {
+		return x.P50Seconds
+	}
+	return 0
+}
+
+func (x *DurationStats) GetP95Seconds() float64 {
+	if x != nil {
+		return x.P95Seconds
+	}
+	return 0
+}
+
+func (x *DurationStats) GetMaxSeconds() float64 {
+	if x != nil {
+		return x.MaxSeconds
+	}
+	return 0
+}
+
+type GetExecutionLogsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId    string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	// Keep streaming new lines until the execution finishes.
+	Follow bool `protobuf:"varint,3,opt,name=follow,proto3" json:"follow,omitempty"`
+	// Only lines after this sequence number are streamed.
+	// Used to resume the stream after a disconnect.
+	AfterSequence uint64 `protobuf:"varint,4,opt,name=after_sequence,json=afterSequence,proto3" json:"after_sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExecutionLogsRequest) Reset() {
+	*x = GetExecutionLogsRequest{}
+	mi := &file_canvases_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExecutionLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionLogsRequest) ProtoMessage() {}
+
+func (x *GetExecutionLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetExecutionLogsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetExecutionLogsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *GetExecutionLogsRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *GetExecutionLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *GetExecutionLogsRequest) GetAfterSequence() uint64 {
+	if x != nil {
+		return x.AfterSequence
+	}
+	return 0
+}
+
+type GetExecutionLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lines         []*ExecutionLogLine    `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExecutionLogsResponse) Reset() {
+	*x = GetExecutionLogsResponse{}
+	mi := &file_canvases_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExecutionLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionLogsResponse) ProtoMessage() {}
+
+func (x *GetExecutionLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetExecutionLogsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetExecutionLogsResponse) GetLines() []*ExecutionLogLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type ExecutionLogLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sequence      uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Level         ExecutionLogLine_Level `protobuf:"varint,2,opt,name=level,proto3,enum=Superplane.Canvases.ExecutionLogLine_Level" json:"level,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Fields        map[string]string      `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionLogLine) Reset() {
+	*x = ExecutionLogLine{}
+	mi := &file_canvases_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionLogLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionLogLine) ProtoMessage() {}
+
+func (x *ExecutionLogLine) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionLogLine.ProtoReflect.Descriptor instead.
+func (*ExecutionLogLine) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ExecutionLogLine) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ExecutionLogLine) GetLevel() ExecutionLogLine_Level {
+	if x != nil {
+		return x.Level
+	}
+	return ExecutionLogLine_LEVEL_UNKNOWN
+}
+
+func (x *ExecutionLogLine) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExecutionLogLine) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ExecutionLogLine) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type CanvasNodeEventMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
@@ -2647,7 +3939,7 @@ type CanvasNodeEventMessage struct {
 
 func (x *CanvasNodeEventMessage) Reset() {
 	*x = CanvasNodeEventMessage{}
-	mi := &file_canvases_proto_msgTypes[42]
+	mi := &file_canvases_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2659,7 +3951,7 @@ func (x *CanvasNodeEventMessage) String() string {
 func (*CanvasNodeEventMessage) ProtoMessage() {}
 
 func (x *CanvasNodeEventMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[42]
+	mi := &file_canvases_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2672,7 +3964,7 @@ func (x *CanvasNodeEventMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasNodeEventMessage.ProtoReflect.Descriptor instead.
 func (*CanvasNodeEventMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{42}
+	return file_canvases_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *CanvasNodeEventMessage) GetId() string {
@@ -2704,18 +3996,21 @@ func (x *CanvasNodeEventMessage) GetTimestamp() *timestamp.Timestamp {
 }
 
 type CanvasNodeExecutionMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId  string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId    string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Timestamp *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The state the execution moved to, when the message is published for a state change.
+	// The execution may already be in a later state when the message is consumed.
+	State         string `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CanvasNodeExecutionMessage) Reset() {
 	*x = CanvasNodeExecutionMessage{}
-	mi := &file_canvases_proto_msgTypes[43]
+	mi := &file_canvases_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2727,7 +4022,7 @@ func (x *CanvasNodeExecutionMessage) String() string {
 func (*CanvasNodeExecutionMessage) ProtoMessage() {}
 
 func (x *CanvasNodeExecutionMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[43]
+	mi := &file_canvases_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2740,7 +4035,7 @@ func (x *CanvasNodeExecutionMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasNodeExecutionMessage.ProtoReflect.Descriptor instead.
 func (*CanvasNodeExecutionMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{43}
+	return file_canvases_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *CanvasNodeExecutionMessage) GetId() string {
@@ -2771,6 +4066,13 @@ func (x *CanvasNodeExecutionMessage) GetTimestamp() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *CanvasNodeExecutionMessage) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
 type CanvasNodeQueueItemMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2783,7 +4085,7 @@ type CanvasNodeQueueItemMessage struct {
 
 func (x *CanvasNodeQueueItemMessage) Reset() {
 	*x = CanvasNodeQueueItemMessage{}
-	mi := &file_canvases_proto_msgTypes[44]
+	mi := &file_canvases_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2795,7 +4097,7 @@ func (x *CanvasNodeQueueItemMessage) String() string {
 func (*CanvasNodeQueueItemMessage) ProtoMessage() {}
 
 func (x *CanvasNodeQueueItemMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[44]
+	mi := &file_canvases_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2808,7 +4110,7 @@ func (x *CanvasNodeQueueItemMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasNodeQueueItemMessage.ProtoReflect.Descriptor instead.
 func (*CanvasNodeQueueItemMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{44}
+	return file_canvases_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *CanvasNodeQueueItemMessage) GetId() string {
@@ -2839,6 +4141,74 @@ func (x *CanvasNodeQueueItemMessage) GetTimestamp() *timestamp.Timestamp {
 	return nil
 }
 
+type CanvasChangedMessage struct {
+	state          protoimpl.MessageState      `protogen:"open.v1"`
+	CanvasId       string                      `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	OrganizationId string                      `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Action         CanvasChangedMessage_Action `protobuf:"varint,3,opt,name=action,proto3,enum=Superplane.Canvases.CanvasChangedMessage_Action" json:"action,omitempty"`
+	Timestamp      *timestamp.Timestamp        `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CanvasChangedMessage) Reset() {
+	*x = CanvasChangedMessage{}
+	mi := &file_canvases_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasChangedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasChangedMessage) ProtoMessage() {}
+
+func (x *CanvasChangedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasChangedMessage.ProtoReflect.Descriptor instead.
+func (*CanvasChangedMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CanvasChangedMessage) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasChangedMessage) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CanvasChangedMessage) GetAction() CanvasChangedMessage_Action {
+	if x != nil {
+		return x.Action
+	}
+	return CanvasChangedMessage_ACTION_UNKNOWN
+}
+
+func (x *CanvasChangedMessage) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
 type Canvas_Metadata struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2855,7 +4225,7 @@ type Canvas_Metadata struct {
 
 func (x *Canvas_Metadata) Reset() {
 	*x = Canvas_Metadata{}
-	mi := &file_canvases_proto_msgTypes[45]
+	mi := &file_canvases_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2867,7 +4237,7 @@ func (x *Canvas_Metadata) String() string {
 func (*Canvas_Metadata) ProtoMessage() {}
 
 func (x *Canvas_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[45]
+	mi := &file_canvases_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2949,7 +4319,7 @@ type Canvas_Spec struct {
 
 func (x *Canvas_Spec) Reset() {
 	*x = Canvas_Spec{}
-	mi := &file_canvases_proto_msgTypes[46]
+	mi := &file_canvases_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2961,7 +4331,7 @@ func (x *Canvas_Spec) String() string {
 func (*Canvas_Spec) ProtoMessage() {}
 
 func (x *Canvas_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[46]
+	mi := &file_canvases_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3002,7 +4372,7 @@ type Canvas_Status struct {
 
 func (x *Canvas_Status) Reset() {
 	*x = Canvas_Status{}
-	mi := &file_canvases_proto_msgTypes[47]
+	mi := &file_canvases_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3014,7 +4384,7 @@ func (x *Canvas_Status) String() string {
 func (*Canvas_Status) ProtoMessage() {}
 
 func (x *Canvas_Status) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[47]
+	mi := &file_canvases_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3051,6 +4421,134 @@ func (x *Canvas_Status) GetLastEvents() []*CanvasEvent {
 	return nil
 }
 
+type WebhookDelivery_RoutedNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	StatusCode    int32                  `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery_RoutedNode) Reset() {
+	*x = WebhookDelivery_RoutedNode{}
+	mi := &file_canvases_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery_RoutedNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery_RoutedNode) ProtoMessage() {}
+
+func (x *WebhookDelivery_RoutedNode) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery_RoutedNode.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery_RoutedNode) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{48, 0}
+}
+
+func (x *WebhookDelivery_RoutedNode) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery_RoutedNode) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery_RoutedNode) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery_RoutedNode) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ExecutionMetrics_ResultCount struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Result        CanvasNodeExecution_Result       `protobuf:"varint,1,opt,name=result,proto3,enum=Superplane.Canvases.CanvasNodeExecution_Result" json:"result,omitempty"`
+	ResultReason  CanvasNodeExecution_ResultReason `protobuf:"varint,2,opt,name=result_reason,json=resultReason,proto3,enum=Superplane.Canvases.CanvasNodeExecution_ResultReason" json:"result_reason,omitempty"`
+	Count         uint32                           `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionMetrics_ResultCount) Reset() {
+	*x = ExecutionMetrics_ResultCount{}
+	mi := &file_canvases_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionMetrics_ResultCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionMetrics_ResultCount) ProtoMessage() {}
+
+func (x *ExecutionMetrics_ResultCount) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionMetrics_ResultCount.ProtoReflect.Descriptor instead.
+func (*ExecutionMetrics_ResultCount) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{53, 0}
+}
+
+func (x *ExecutionMetrics_ResultCount) GetResult() CanvasNodeExecution_Result {
+	if x != nil {
+		return x.Result
+	}
+	return CanvasNodeExecution_RESULT_UNKNOWN
+}
+
+func (x *ExecutionMetrics_ResultCount) GetResultReason() CanvasNodeExecution_ResultReason {
+	if x != nil {
+		return x.ResultReason
+	}
+	return CanvasNodeExecution_RESULT_REASON_OK
+}
+
+func (x *ExecutionMetrics_ResultCount) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 var File_canvases_proto protoreflect.FileDescriptor
 
 const file_canvases_proto_rawDesc = "" +
@@ -3158,7 +4656,12 @@ const file_canvases_proto_rawDesc = "" +
 	"\vtotal_count\x18\x02 \x01(\rR\n" +
 	"totalCount\x12\"\n" +
 	"\rhas_next_page\x18\x03 \x01(\bR\vhasNextPage\x12A\n" +
-	"\x0elast_timestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\"\\\n" +
+	"\x0elast_timestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\"Z\n" +
+	"\x18DescribeExecutionRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\"c\n" +
+	"\x19DescribeExecutionResponse\x12F\n" +
+	"\texecution\x18\x01 \x01(\v2(.Superplane.Canvases.CanvasNodeExecutionR\texecution\"\\\n" +
 	"\x1aListChildExecutionsRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
 	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\"g\n" +
@@ -3279,22 +4782,156 @@ const file_canvases_proto_rawDesc = "" +
 	"\x1dResolveExecutionErrorsRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12#\n" +
 	"\rexecution_ids\x18\x02 \x03(\tR\fexecutionIds\" \n" +
-	"\x1eResolveExecutionErrorsResponse\"\x98\x01\n" +
+	"\x1eResolveExecutionErrorsResponse\"\x9e\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\x122\n" +
+	"\x06before\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\"\xed\x01\n" +
+	"\x1dListWebhookDeliveriesResponse\x12D\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2$.Superplane.Canvases.WebhookDeliveryR\n" +
+	"deliveries\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\rR\n" +
+	"totalCount\x12\"\n" +
+	"\rhas_next_page\x18\x03 \x01(\bR\vhasNextPage\x12A\n" +
+	"\x0elast_timestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\"u\n" +
+	"\x1cReplayWebhookDeliveryRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x1f\n" +
+	"\vdelivery_id\x18\x03 \x01(\tR\n" +
+	"deliveryId\"a\n" +
+	"\x1dReplayWebhookDeliveryResponse\x12@\n" +
+	"\bdelivery\x18\x01 \x01(\v2$.Superplane.Canvases.WebhookDeliveryR\bdelivery\"\x9e\x05\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\treplay_of\x18\x02 \x01(\tR\breplayOf\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x1b\n" +
+	"\tremote_ip\x18\x04 \x01(\tR\bremoteIp\x12\x14\n" +
+	"\x05query\x18\x05 \x01(\tR\x05query\x12K\n" +
+	"\aheaders\x18\x06 \x03(\v21.Superplane.Canvases.WebhookDelivery.HeadersEntryR\aheaders\x12\x12\n" +
+	"\x04body\x18\a \x01(\tR\x04body\x12%\n" +
+	"\x0ebody_truncated\x18\b \x01(\bR\rbodyTruncated\x12\x1f\n" +
+	"\vstatus_code\x18\t \x01(\x05R\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12E\n" +
+	"\x05nodes\x18\v \x03(\v2/.Superplane.Canvases.WebhookDelivery.RoutedNodeR\x05nodes\x12\x1b\n" +
+	"\tevent_ids\x18\f \x03(\tR\beventIds\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1ay\n" +
+	"\n" +
+	"RoutedNode\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x1f\n" +
+	"\vstatus_code\x18\x03 \x01(\x05R\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa9\x01\n" +
+	"\x12WatchCanvasRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x19\n" +
+	"\bnode_ids\x18\x02 \x03(\tR\anodeIds\x12C\n" +
+	"\x05types\x18\x03 \x03(\x0e2-.Superplane.Canvases.WatchCanvasResponse.TypeR\x05types\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"\xe4\x03\n" +
+	"\x13WatchCanvasResponse\x12A\n" +
+	"\x04type\x18\x01 \x01(\x0e2-.Superplane.Canvases.WatchCanvasResponse.TypeR\x04type\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x126\n" +
+	"\x05event\x18\x04 \x01(\v2 .Superplane.Canvases.CanvasEventR\x05event\x12F\n" +
+	"\texecution\x18\x05 \x01(\v2(.Superplane.Canvases.CanvasNodeExecutionR\texecution\x12G\n" +
+	"\n" +
+	"queue_item\x18\x06 \x01(\v2(.Superplane.Canvases.CanvasNodeQueueItemR\tqueueItem\"o\n" +
+	"\x04Type\x12\x10\n" +
+	"\fTYPE_UNKNOWN\x10\x00\x12\x16\n" +
+	"\x12TYPE_EVENT_CREATED\x10\x01\x12 \n" +
+	"\x1cTYPE_EXECUTION_STATE_CHANGED\x10\x02\x12\x1b\n" +
+	"\x17TYPE_QUEUE_ITEM_CREATED\x10\x03\"\xc3\x01\n" +
+	"\x17GetCanvasMetricsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x19\n" +
+	"\bnode_ids\x18\x04 \x03(\tR\anodeIds\"\x88\x02\n" +
+	"\x18GetCanvasMetricsResponse\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12=\n" +
+	"\x06canvas\x18\x03 \x01(\v2%.Superplane.Canvases.ExecutionMetricsR\x06canvas\x12;\n" +
+	"\x05nodes\x18\x04 \x03(\v2%.Superplane.Canvases.ExecutionMetricsR\x05nodes\"\xcd\x04\n" +
+	"\x10ExecutionMetrics\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1c\n" +
+	"\tcomponent\x18\x02 \x01(\tR\tcomponent\x12\x1e\n" +
+	"\n" +
+	"executions\x18\x03 \x01(\rR\n" +
+	"executions\x12K\n" +
+	"\aresults\x18\x04 \x03(\v21.Superplane.Canvases.ExecutionMetrics.ResultCountR\aresults\x12>\n" +
+	"\bduration\x18\x05 \x01(\v2\".Superplane.Canvases.DurationStatsR\bduration\x12A\n" +
+	"\n" +
+	"queue_wait\x18\x06 \x01(\v2\".Superplane.Canvases.DurationStatsR\tqueueWait\x12G\n" +
+	"\rapproval_wait\x18\a \x01(\v2\".Superplane.Canvases.DurationStatsR\fapprovalWait\x1a\xc8\x01\n" +
+	"\vResultCount\x12G\n" +
+	"\x06result\x18\x01 \x01(\x0e2/.Superplane.Canvases.CanvasNodeExecution.ResultR\x06result\x12Z\n" +
+	"\rresult_reason\x18\x02 \x01(\x0e25.Superplane.Canvases.CanvasNodeExecution.ResultReasonR\fresultReason\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\rR\x05count\"\xb1\x01\n" +
+	"\rDurationStats\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\rR\x05count\x12'\n" +
+	"\x0faverage_seconds\x18\x02 \x01(\x01R\x0eaverageSeconds\x12\x1f\n" +
+	"\vp50_seconds\x18\x03 \x01(\x01R\n" +
+	"p50Seconds\x12\x1f\n" +
+	"\vp95_seconds\x18\x04 \x01(\x01R\n" +
+	"p95Seconds\x12\x1f\n" +
+	"\vmax_seconds\x18\x05 \x01(\x01R\n" +
+	"maxSeconds\"\x98\x01\n" +
+	"\x17GetExecutionLogsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\x12\x16\n" +
+	"\x06follow\x18\x03 \x01(\bR\x06follow\x12%\n" +
+	"\x0eafter_sequence\x18\x04 \x01(\x04R\rafterSequence\"W\n" +
+	"\x18GetExecutionLogsResponse\x12;\n" +
+	"\x05lines\x18\x01 \x03(\v2%.Superplane.Canvases.ExecutionLogLineR\x05lines\"\x9b\x03\n" +
+	"\x10ExecutionLogLine\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12A\n" +
+	"\x05level\x18\x02 \x01(\x0e2+.Superplane.Canvases.ExecutionLogLine.LevelR\x05level\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12I\n" +
+	"\x06fields\x18\x04 \x03(\v21.Superplane.Canvases.ExecutionLogLine.FieldsEntryR\x06fields\x128\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"N\n" +
+	"\x05Level\x12\x11\n" +
+	"\rLEVEL_UNKNOWN\x10\x00\x12\x0e\n" +
+	"\n" +
+	"LEVEL_INFO\x10\x01\x12\x11\n" +
+	"\rLEVEL_WARNING\x10\x02\x12\x0f\n" +
+	"\vLEVEL_ERROR\x10\x03\"\x98\x01\n" +
 	"\x16CanvasNodeEventMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
 	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x128\n" +
-	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x9c\x01\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xb2\x01\n" +
 	"\x1aCanvasNodeExecutionMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
 	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x128\n" +
-	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x9c\x01\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n" +
+	"\x05state\x18\x05 \x01(\tR\x05state\"\x9c\x01\n" +
 	"\x1aCanvasNodeQueueItemMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
 	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x128\n" +
-	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\xb7$\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xba\x02\n" +
+	"\x14CanvasChangedMessage\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12H\n" +
+	"\x06action\x18\x03 \x01(\x0e20.Superplane.Canvases.CanvasChangedMessage.ActionR\x06action\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"X\n" +
+	"\x06Action\x12\x12\n" +
+	"\x0eACTION_UNKNOWN\x10\x00\x12\x12\n" +
+	"\x0eACTION_CREATED\x10\x01\x12\x12\n" +
+	"\x0eACTION_UPDATED\x10\x02\x12\x12\n" +
+	"\x0eACTION_DELETED\x10\x032\xf82\n" +
 	"\bCanvases\x12\xb7\x01\n" +
 	"\fListCanvases\x12(.Superplane.Canvases.ListCanvasesRequest\x1a).Superplane.Canvases.ListCanvasesResponse\"R\x92A7\n" +
 	"\x06Canvas\x12\rList canvases\x1a\x1eReturns a list of all canvases\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/canvases\x12\xb0\x01\n" +
@@ -3328,7 +4965,9 @@ const file_canvases_proto_rawDesc = "" +
 	"\x13CanvasNodeExecution\x12\x17Invoke execution action\x1a2Invokes a custom action on a canvas node execution\x82\xd3\xe4\x93\x02Q:\x01*\"L/api/v1/canvases/{canvas_id}/executions/{execution_id}/actions/{action_name}\x12\xaf\x02\n" +
 	"\x17InvokeNodeTriggerAction\x123.Superplane.Canvases.InvokeNodeTriggerActionRequest\x1a4.Superplane.Canvases.InvokeNodeTriggerActionResponse\"\xa8\x01\x92AU\n" +
 	"\n" +
-	"CanvasNode\x12\x15Invoke trigger action\x1a0Invokes a custom action on a canvas node trigger\x82\xd3\xe4\x93\x02J:\x01*\"E/api/v1/canvases/{canvas_id}/triggers/{node_id}/actions/{action_name}\x12\xad\x02\n" +
+	"CanvasNode\x12\x15Invoke trigger action\x1a0Invokes a custom action on a canvas node trigger\x82\xd3\xe4\x93\x02J:\x01*\"E/api/v1/canvases/{canvas_id}/triggers/{node_id}/actions/{action_name}\x12\x92\x02\n" +
+	"\x11DescribeExecution\x12-.Superplane.Canvases.DescribeExecutionRequest\x1a..Superplane.Canvases.DescribeExecutionResponse\"\x9d\x01\x92A\\\n" +
+	"\x13CanvasNodeExecution\x12\x15Describe an execution\x1a.Returns the details of a canvas node execution\x82\xd3\xe4\x93\x028\x126/api/v1/canvases/{canvas_id}/executions/{execution_id}\x12\xad\x02\n" +
 	"\x13ListChildExecutions\x12/.Superplane.Canvases.ListChildExecutionsRequest\x1a0.Superplane.Canvases.ListChildExecutionsResponse\"\xb2\x01\x92Ae\n" +
 	"\x13CanvasNodeExecution\x12&List child executions for an execution\x1a&List child executions for an execution\x82\xd3\xe4\x93\x02D:\x01*\"?/api/v1/canvases/{canvas_id}/executions/{execution_id}/children\x12\x8a\x02\n" +
 	"\x0fCancelExecution\x12+.Superplane.Canvases.CancelExecutionRequest\x1a,.Superplane.Canvases.CancelExecutionResponse\"\x9b\x01\x92AP\n" +
@@ -3338,7 +4977,20 @@ const file_canvases_proto_rawDesc = "" +
 	"\x10ListCanvasEvents\x12,.Superplane.Canvases.ListCanvasEventsRequest\x1a-.Superplane.Canvases.ListCanvasEventsResponse\"\x94\x01\x92Af\n" +
 	"\vCanvasEvent\x12\x12List canvas events\x1aCReturns a list of root events that triggered executions in a canvas\x82\xd3\xe4\x93\x02%\x12#/api/v1/canvases/{canvas_id}/events\x12\xa4\x02\n" +
 	"\x13ListEventExecutions\x12/.Superplane.Canvases.ListEventExecutionsRequest\x1a0.Superplane.Canvases.ListEventExecutionsResponse\"\xa9\x01\x92Ae\n" +
-	"\vCanvasEvent\x12\x15List event executions\x1a?Returns a list of all node executions triggered by a root event\x82\xd3\xe4\x93\x02;\x129/api/v1/canvases/{canvas_id}/events/{event_id}/executionsB\xc8\x01\x92A\x8c\x01\x12b\n" +
+	"\vCanvasEvent\x12\x15List event executions\x1a?Returns a list of all node executions triggered by a root event\x82\xd3\xe4\x93\x02;\x129/api/v1/canvases/{canvas_id}/events/{event_id}/executions\x12\xbb\x02\n" +
+	"\x15ListWebhookDeliveries\x121.Superplane.Canvases.ListWebhookDeliveriesRequest\x1a2.Superplane.Canvases.ListWebhookDeliveriesResponse\"\xba\x01\x92Ap\n" +
+	"\n" +
+	"CanvasNode\x12\x17List webhook deliveries\x1aIReturns the most recent requests received by the webhook of a canvas node\x82\xd3\xe4\x93\x02A\x12?/api/v1/canvases/{canvas_id}/nodes/{node_id}/webhook-deliveries\x12\xe1\x02\n" +
+	"\x15ReplayWebhookDelivery\x121.Superplane.Canvases.ReplayWebhookDeliveryRequest\x1a2.Superplane.Canvases.ReplayWebhookDeliveryResponse\"\xe0\x01\x92A~\n" +
+	"\n" +
+	"CanvasNode\x12\x17Replay webhook delivery\x1aWHandles a previously received webhook request again with the current node configuration\x82\xd3\xe4\x93\x02Y:\x01*\"T/api/v1/canvases/{canvas_id}/nodes/{node_id}/webhook-deliveries/{delivery_id}/replay\x12\x9f\x02\n" +
+	"\x10GetCanvasMetrics\x12,.Superplane.Canvases.GetCanvasMetricsRequest\x1a-.Superplane.Canvases.GetCanvasMetricsResponse\"\xad\x01\x92A~\n" +
+	"\x06Canvas\x12\x12Get canvas metrics\x1a`Returns execution counts, durations and wait times for a canvas and its nodes over a time window\x82\xd3\xe4\x93\x02&\x12$/api/v1/canvases/{canvas_id}/metrics\x12\x8d\x02\n" +
+	"\vWatchCanvas\x12'.Superplane.Canvases.WatchCanvasRequest\x1a(.Superplane.Canvases.WatchCanvasResponse\"\xa8\x01\x92A{\n" +
+	"\x06Canvas\x12\fWatch canvas\x1acStreams canvas activity as newline-delimited JSON. Resume from a previous message using its cursor.\x82\xd3\xe4\x93\x02$\x12\"/api/v1/canvases/{canvas_id}/watch0\x01\x12\xd5\x02\n" +
+	"\x10GetExecutionLogs\x12,.Superplane.Canvases.GetExecutionLogsRequest\x1a-.Superplane.Canvases.GetExecutionLogsResponse\"\xe1\x01\x92A\x9a\x01\n" +
+	"\n" +
+	"CanvasNode\x12\x12Get execution logs\x1axStreams the logs of an execution as newline-delimited JSON, optionally following new lines until the execution finishes.\x82\xd3\xe4\x93\x02=\x12;/api/v1/canvases/{canvas_id}/executions/{execution_id}/logs0\x01B\xc8\x01\x92A\x8c\x01\x12b\n" +
 	"\x17Superplane Canvases API\x12\x1bAPI for Superplane canvases\"%\n" +
 	"\vAPI Support\x1a\x16support@superplane.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ6github.com/superplanehq/superplane/pkg/protos/canvasesb\x06proto3"
 
@@ -3354,168 +5006,236 @@ func file_canvases_proto_rawDescGZIP() []byte {
 	return file_canvases_proto_rawDescData
 }
 
-var file_canvases_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_canvases_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
+var file_canvases_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_canvases_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
 var file_canvases_proto_goTypes = []any{
 	(CanvasNodeExecution_State)(0),            // 0: Superplane.Canvases.CanvasNodeExecution.State
 	(CanvasNodeExecution_Result)(0),           // 1: Superplane.Canvases.CanvasNodeExecution.Result
 	(CanvasNodeExecution_ResultReason)(0),     // 2: Superplane.Canvases.CanvasNodeExecution.ResultReason
-	(*ListCanvasesRequest)(nil),               // 3: Superplane.Canvases.ListCanvasesRequest
-	(*ListCanvasesResponse)(nil),              // 4: Superplane.Canvases.ListCanvasesResponse
-	(*DescribeCanvasRequest)(nil),             // 5: Superplane.Canvases.DescribeCanvasRequest
-	(*DescribeCanvasResponse)(nil),            // 6: Superplane.Canvases.DescribeCanvasResponse
-	(*CreateCanvasRequest)(nil),               // 7: Superplane.Canvases.CreateCanvasRequest
-	(*CreateCanvasResponse)(nil),              // 8: Superplane.Canvases.CreateCanvasResponse
-	(*UpdateCanvasRequest)(nil),               // 9: Superplane.Canvases.UpdateCanvasRequest
-	(*UpdateCanvasResponse)(nil),              // 10: Superplane.Canvases.UpdateCanvasResponse
-	(*DeleteCanvasRequest)(nil),               // 11: Superplane.Canvases.DeleteCanvasRequest
-	(*DeleteCanvasResponse)(nil),              // 12: Superplane.Canvases.DeleteCanvasResponse
-	(*UserRef)(nil),                           // 13: Superplane.Canvases.UserRef
-	(*Canvas)(nil),                            // 14: Superplane.Canvases.Canvas
-	(*ListNodeEventsRequest)(nil),             // 15: Superplane.Canvases.ListNodeEventsRequest
-	(*ListNodeEventsResponse)(nil),            // 16: Superplane.Canvases.ListNodeEventsResponse
-	(*EmitNodeEventRequest)(nil),              // 17: Superplane.Canvases.EmitNodeEventRequest
-	(*EmitNodeEventResponse)(nil),             // 18: Superplane.Canvases.EmitNodeEventResponse
-	(*ListNodeQueueItemsRequest)(nil),         // 19: Superplane.Canvases.ListNodeQueueItemsRequest
-	(*ListNodeQueueItemsResponse)(nil),        // 20: Superplane.Canvases.ListNodeQueueItemsResponse
-	(*DeleteNodeQueueItemRequest)(nil),        // 21: Superplane.Canvases.DeleteNodeQueueItemRequest
-	(*DeleteNodeQueueItemResponse)(nil),       // 22: Superplane.Canvases.DeleteNodeQueueItemResponse
-	(*UpdateNodePauseRequest)(nil),            // 23: Superplane.Canvases.UpdateNodePauseRequest
-	(*UpdateNodePauseResponse)(nil),           // 24: Superplane.Canvases.UpdateNodePauseResponse
-	(*ListNodeExecutionsRequest)(nil),         // 25: Superplane.Canvases.ListNodeExecutionsRequest
-	(*ListNodeExecutionsResponse)(nil),        // 26: Superplane.Canvases.ListNodeExecutionsResponse
-	(*ListChildExecutionsRequest)(nil),        // 27: Superplane.Canvases.ListChildExecutionsRequest
-	(*ListChildExecutionsResponse)(nil),       // 28: Superplane.Canvases.ListChildExecutionsResponse
-	(*CanvasNodeExecution)(nil),               // 29: Superplane.Canvases.CanvasNodeExecution
-	(*CanvasNodeQueueItem)(nil),               // 30: Superplane.Canvases.CanvasNodeQueueItem
-	(*InvokeNodeExecutionActionRequest)(nil),  // 31: Superplane.Canvases.InvokeNodeExecutionActionRequest
-	(*InvokeNodeExecutionActionResponse)(nil), // 32: Superplane.Canvases.InvokeNodeExecutionActionResponse
-	(*InvokeNodeTriggerActionRequest)(nil),    // 33: Superplane.Canvases.InvokeNodeTriggerActionRequest
-	(*InvokeNodeTriggerActionResponse)(nil),   // 34: Superplane.Canvases.InvokeNodeTriggerActionResponse
-	(*ListCanvasEventsRequest)(nil),           // 35: Superplane.Canvases.ListCanvasEventsRequest
-	(*ListCanvasEventsResponse)(nil),          // 36: Superplane.Canvases.ListCanvasEventsResponse
-	(*CanvasEvent)(nil),                       // 37: Superplane.Canvases.CanvasEvent
-	(*CanvasEventWithExecutions)(nil),         // 38: Superplane.Canvases.CanvasEventWithExecutions
-	(*ListEventExecutionsRequest)(nil),        // 39: Superplane.Canvases.ListEventExecutionsRequest
-	(*ListEventExecutionsResponse)(nil),       // 40: Superplane.Canvases.ListEventExecutionsResponse
-	(*CancelExecutionRequest)(nil),            // 41: Superplane.Canvases.CancelExecutionRequest
-	(*CancelExecutionResponse)(nil),           // 42: Superplane.Canvases.CancelExecutionResponse
-	(*ResolveExecutionErrorsRequest)(nil),     // 43: Superplane.Canvases.ResolveExecutionErrorsRequest
-	(*ResolveExecutionErrorsResponse)(nil),    // 44: Superplane.Canvases.ResolveExecutionErrorsResponse
-	(*CanvasNodeEventMessage)(nil),            // 45: Superplane.Canvases.CanvasNodeEventMessage
-	(*CanvasNodeExecutionMessage)(nil),        // 46: Superplane.Canvases.CanvasNodeExecutionMessage
-	(*CanvasNodeQueueItemMessage)(nil),        // 47: Superplane.Canvases.CanvasNodeQueueItemMessage
-	(*Canvas_Metadata)(nil),                   // 48: Superplane.Canvases.Canvas.Metadata
-	(*Canvas_Spec)(nil),                       // 49: Superplane.Canvases.Canvas.Spec
-	(*Canvas_Status)(nil),                     // 50: Superplane.Canvases.Canvas.Status
-	(*timestamp.Timestamp)(nil),               // 51: google.protobuf.Timestamp
-	(*_struct.Struct)(nil),                    // 52: google.protobuf.Struct
-	(*components.Node)(nil),                   // 53: Superplane.Components.Node
-	(*components.Edge)(nil),                   // 54: Superplane.Components.Edge
+	(WatchCanvasResponse_Type)(0),             // 3: Superplane.Canvases.WatchCanvasResponse.Type
+	(ExecutionLogLine_Level)(0),               // 4: Superplane.Canvases.ExecutionLogLine.Level
+	(CanvasChangedMessage_Action)(0),          // 5: Superplane.Canvases.CanvasChangedMessage.Action
+	(*ListCanvasesRequest)(nil),               // 6: Superplane.Canvases.ListCanvasesRequest
+	(*ListCanvasesResponse)(nil),              // 7: Superplane.Canvases.ListCanvasesResponse
+	(*DescribeCanvasRequest)(nil),             // 8: Superplane.Canvases.DescribeCanvasRequest
+	(*DescribeCanvasResponse)(nil),            // 9: Superplane.Canvases.DescribeCanvasResponse
+	(*CreateCanvasRequest)(nil),               // 10: Superplane.Canvases.CreateCanvasRequest
+	(*CreateCanvasResponse)(nil),              // 11: Superplane.Canvases.CreateCanvasResponse
+	(*UpdateCanvasRequest)(nil),               // 12: Superplane.Canvases.UpdateCanvasRequest
+	(*UpdateCanvasResponse)(nil),              // 13: Superplane.Canvases.UpdateCanvasResponse
+	(*DeleteCanvasRequest)(nil),               // 14: Superplane.Canvases.DeleteCanvasRequest
+	(*DeleteCanvasResponse)(nil),              // 15: Superplane.Canvases.DeleteCanvasResponse
+	(*UserRef)(nil),                           // 16: Superplane.Canvases.UserRef
+	(*Canvas)(nil),                            // 17: Superplane.Canvases.Canvas
+	(*ListNodeEventsRequest)(nil),             // 18: Superplane.Canvases.ListNodeEventsRequest
+	(*ListNodeEventsResponse)(nil),            // 19: Superplane.Canvases.ListNodeEventsResponse
+	(*EmitNodeEventRequest)(nil),              // 20: Superplane.Canvases.EmitNodeEventRequest
+	(*EmitNodeEventResponse)(nil),             // 21: Superplane.Canvases.EmitNodeEventResponse
+	(*ListNodeQueueItemsRequest)(nil),         // 22: Superplane.Canvases.ListNodeQueueItemsRequest
+	(*ListNodeQueueItemsResponse)(nil),        // 23: Superplane.Canvases.ListNodeQueueItemsResponse
+	(*DeleteNodeQueueItemRequest)(nil),        // 24: Superplane.Canvases.DeleteNodeQueueItemRequest
+	(*DeleteNodeQueueItemResponse)(nil),       // 25: Superplane.Canvases.DeleteNodeQueueItemResponse
+	(*UpdateNodePauseRequest)(nil),            // 26: Superplane.Canvases.UpdateNodePauseRequest
+	(*UpdateNodePauseResponse)(nil),           // 27: Superplane.Canvases.UpdateNodePauseResponse
+	(*ListNodeExecutionsRequest)(nil),         // 28: Superplane.Canvases.ListNodeExecutionsRequest
+	(*ListNodeExecutionsResponse)(nil),        // 29: Superplane.Canvases.ListNodeExecutionsResponse
+	(*DescribeExecutionRequest)(nil),          // 30: Superplane.Canvases.DescribeExecutionRequest
+	(*DescribeExecutionResponse)(nil),         // 31: Superplane.Canvases.DescribeExecutionResponse
+	(*ListChildExecutionsRequest)(nil),        // 32: Superplane.Canvases.ListChildExecutionsRequest
+	(*ListChildExecutionsResponse)(nil),       // 33: Superplane.Canvases.ListChildExecutionsResponse
+	(*CanvasNodeExecution)(nil),               // 34: Superplane.Canvases.CanvasNodeExecution
+	(*CanvasNodeQueueItem)(nil),               // 35: Superplane.Canvases.CanvasNodeQueueItem
+	(*InvokeNodeExecutionActionRequest)(nil),  // 36: Superplane.Canvases.InvokeNodeExecutionActionRequest
+	(*InvokeNodeExecutionActionResponse)(nil), // 37: Superplane.Canvases.InvokeNodeExecutionActionResponse
+	(*InvokeNodeTriggerActionRequest)(nil),    // 38: Superplane.Canvases.InvokeNodeTriggerActionRequest
+	(*InvokeNodeTriggerActionResponse)(nil),   // 39: Superplane.Canvases.InvokeNodeTriggerActionResponse
+	(*ListCanvasEventsRequest)(nil),           // 40: Superplane.Canvases.ListCanvasEventsRequest
+	(*ListCanvasEventsResponse)(nil),          // 41: Superplane.Canvases.ListCanvasEventsResponse
+	(*CanvasEvent)(nil),                       // 42: Superplane.Canvases.CanvasEvent
+	(*CanvasEventWithExecutions)(nil),         // 43: Superplane.Canvases.CanvasEventWithExecutions
+	(*ListEventExecutionsRequest)(nil),        // 44: Superplane.Canvases.ListEventExecutionsRequest
+	(*ListEventExecutionsResponse)(nil),       // 45: Superplane.Canvases.ListEventExecutionsResponse
+	(*CancelExecutionRequest)(nil),            // 46: Superplane.Canvases.CancelExecutionRequest
+	(*CancelExecutionResponse)(nil),           // 47: Superplane.Canvases.CancelExecutionResponse
+	(*ResolveExecutionErrorsRequest)(nil),     // 48: Superplane.Canvases.ResolveExecutionErrorsRequest
+	(*ResolveExecutionErrorsResponse)(nil),    // 49: Superplane.Canvases.ResolveExecutionErrorsResponse
+	(*ListWebhookDeliveriesRequest)(nil),      // 50: Superplane.Canvases.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),     // 51: Superplane.Canvases.ListWebhookDeliveriesResponse
+	(*ReplayWebhookDeliveryRequest)(nil),      // 52: Superplane.Canvases.ReplayWebhookDeliveryRequest
+	(*ReplayWebhookDeliveryResponse)(nil),     // 53: Superplane.Canvases.ReplayWebhookDeliveryResponse
+	(*WebhookDelivery)(nil),                   // 54: Superplane.Canvases.WebhookDelivery
+	(*WatchCanvasRequest)(nil),                // 55: Superplane.Canvases.WatchCanvasRequest
+	(*WatchCanvasResponse)(nil),               // 56: Superplane.Canvases.WatchCanvasResponse
+	(*GetCanvasMetricsRequest)(nil),           // 57: Superplane.Canvases.GetCanvasMetricsRequest
+	(*GetCanvasMetricsResponse)(nil),          // 58: Superplane.Canvases.GetCanvasMetricsResponse
+	(*ExecutionMetrics)(nil),                  // 59: Superplane.Canvases.ExecutionMetrics
+	(*DurationStats)(nil),                     // 60: Superplane.Canvases.DurationStats
+	(*GetExecutionLogsRequest)(nil),           // 61: Superplane.Canvases.GetExecutionLogsRequest
+	(*GetExecutionLogsResponse)(nil),          // 62: Superplane.Canvases.GetExecutionLogsResponse
+	(*ExecutionLogLine)(nil),                  // 63: Superplane.Canvases.ExecutionLogLine
+	(*CanvasNodeEventMessage)(nil),            // 64: Superplane.Canvases.CanvasNodeEventMessage
+	(*CanvasNodeExecutionMessage)(nil),        // 65: Superplane.Canvases.CanvasNodeExecutionMessage
+	(*CanvasNodeQueueItemMessage)(nil),        // 66: Superplane.Canvases.CanvasNodeQueueItemMessage
+	(*CanvasChangedMessage)(nil),              // 67: Superplane.Canvases.CanvasChangedMessage
+	(*Canvas_Metadata)(nil),                   // 68: Superplane.Canvases.Canvas.Metadata
+	(*Canvas_Spec)(nil),                       // 69: Superplane.Canvases.Canvas.Spec
+	(*Canvas_Status)(nil),                     // 70: Superplane.Canvases.Canvas.Status
+	(*WebhookDelivery_RoutedNode)(nil),        // 71: Superplane.Canvases.WebhookDelivery.RoutedNode
+	nil,                                       // 72: Superplane.Canvases.WebhookDelivery.HeadersEntry
+	(*ExecutionMetrics_ResultCount)(nil),      // 73: Superplane.Canvases.ExecutionMetrics.ResultCount
+	nil,                                       // 74: Superplane.Canvases.ExecutionLogLine.FieldsEntry
+	(*timestamp.Timestamp)(nil),               // 75: google.protobuf.Timestamp
+	(*_struct.Struct)(nil),                    // 76: google.protobuf.Struct
+	(*components.Node)(nil),                   // 77: Superplane.Components.Node
+	(*components.Edge)(nil),                   // 78: Superplane.Components.Edge
 }
 var file_canvases_proto_depIdxs = []int32{
-	14, // 0: Superplane.Canvases.ListCanvasesResponse.canvases:type_name -> Superplane.Canvases.Canvas
-	14, // 1: Superplane.Canvases.DescribeCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	14, // 2: Superplane.Canvases.CreateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
-	14, // 3: Superplane.Canvases.CreateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	14, // 4: Superplane.Canvases.UpdateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
-	14, // 5: Superplane.Canvases.UpdateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	48, // 6: Superplane.Canvases.Canvas.metadata:type_name -> Superplane.Canvases.Canvas.Metadata
-	49, // 7: Superplane.Canvases.Canvas.spec:type_name -> Superplane.Canvases.Canvas.Spec
-	50, // 8: Superplane.Canvases.Canvas.status:type_name -> Superplane.Canvases.Canvas.Status
-	51, // 9: Superplane.Canvases.ListNodeEventsRequest.before:type_name -> google.protobuf.Timestamp
-	37, // 10: Superplane.Canvases.ListNodeEventsResponse.events:type_name -> Superplane.Canvases.CanvasEvent
-	51, // 11: Superplane.Canvases.ListNodeEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	52, // 12: Superplane.Canvases.EmitNodeEventRequest.data:type_name -> google.protobuf.Struct
-	51, // 13: Superplane.Canvases.ListNodeQueueItemsRequest.before:type_name -> google.protobuf.Timestamp
-	30, // 14: Superplane.Canvases.ListNodeQueueItemsResponse.items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
-	51, // 15: Superplane.Canvases.ListNodeQueueItemsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	53, // 16: Superplane.Canvases.UpdateNodePauseResponse.node:type_name -> Superplane.Components.Node
-	0,  // 17: Superplane.Canvases.ListNodeExecutionsRequest.states:type_name -> Superplane.Canvases.CanvasNodeExecution.State
-	1,  // 18: Superplane.Canvases.ListNodeExecutionsRequest.results:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
-	51, // 19: Superplane.Canvases.ListNodeExecutionsRequest.before:type_name -> google.protobuf.Timestamp
-	29, // 20: Superplane.Canvases.ListNodeExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	51, // 21: Superplane.Canvases.ListNodeExecutionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	29, // 22: Superplane.Canvases.ListChildExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	0,  // 23: Superplane.Canvases.CanvasNodeExecution.state:type_name -> Superplane.Canvases.CanvasNodeExecution.State
-	1,  // 24: Superplane.Canvases.CanvasNodeExecution.result:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
-	2,  // 25: Superplane.Canvases.CanvasNodeExecution.result_reason:type_name -> Superplane.Canvases.CanvasNodeExecution.ResultReason
-	52, // 26: Superplane.Canvases.CanvasNodeExecution.input:type_name -> google.protobuf.Struct
-	52, // 27: Superplane.Canvases.CanvasNodeExecution.outputs:type_name -> google.protobuf.Struct
-	51, // 28: Superplane.Canvases.CanvasNodeExecution.created_at:type_name -> google.protobuf.Timestamp
-	51, // 29: Superplane.Canvases.CanvasNodeExecution.updated_at:type_name -> google.protobuf.Timestamp
-	52, // 30: Superplane.Canvases.CanvasNodeExecution.metadata:type_name -> google.protobuf.Struct
-	52, // 31: Superplane.Canvases.CanvasNodeExecution.configuration:type_name -> google.protobuf.Struct
-	29, // 32: Superplane.Canvases.CanvasNodeExecution.child_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	37, // 33: Superplane.Canvases.CanvasNodeExecution.root_event:type_name -> Superplane.Canvases.CanvasEvent
-	13, // 34: Superplane.Canvases.CanvasNodeExecution.cancelled_by:type_name -> Superplane.Canvases.UserRef
-	52, // 35: Superplane.Canvases.CanvasNodeQueueItem.input:type_name -> google.protobuf.Struct
-	37, // 36: Superplane.Canvases.CanvasNodeQueueItem.root_event:type_name -> Superplane.Canvases.CanvasEvent
-	51, // 37: Superplane.Canvases.CanvasNodeQueueItem.created_at:type_name -> google.protobuf.Timestamp
-	52, // 38: Superplane.Canvases.InvokeNodeExecutionActionRequest.parameters:type_name -> google.protobuf.Struct
-	52, // 39: Superplane.Canvases.InvokeNodeTriggerActionRequest.parameters:type_name -> google.protobuf.Struct
-	52, // 40: Superplane.Canvases.InvokeNodeTriggerActionResponse.result:type_name -> google.protobuf.Struct
-	51, // 41: Superplane.Canvases.ListCanvasEventsRequest.before:type_name -> google.protobuf.Timestamp
-	38, // 42: Superplane.Canvases.ListCanvasEventsResponse.events:type_name -> Superplane.Canvases.CanvasEventWithExecutions
-	51, // 43: Superplane.Canvases.ListCanvasEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	52, // 44: Superplane.Canvases.CanvasEvent.data:type_name -> google.protobuf.Struct
-	51, // 45: Superplane.Canvases.CanvasEvent.created_at:type_name -> google.protobuf.Timestamp
-	52, // 46: Superplane.Canvases.CanvasEventWithExecutions.data:type_name -> google.protobuf.Struct
-	51, // 47: Superplane.Canvases.CanvasEventWithExecutions.created_at:type_name -> google.protobuf.Timestamp
-	29, // 48: Superplane.Canvases.CanvasEventWithExecutions.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	29, // 49: Superplane.Canvases.ListEventExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	51, // 50: Superplane.Canvases.CanvasNodeEventMessage.timestamp:type_name -> google.protobuf.Timestamp
-	51, // 51: Superplane.Canvases.CanvasNodeExecutionMessage.timestamp:type_name -> google.protobuf.Timestamp
-	51, // 52: Superplane.Canvases.CanvasNodeQueueItemMessage.timestamp:type_name -> google.protobuf.Timestamp
-	51, // 53: Superplane.Canvases.Canvas.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	51, // 54: Superplane.Canvases.Canvas.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 55: Superplane.Canvases.Canvas.Metadata.created_by:type_name -> Superplane.Canvases.UserRef
-	53, // 56: Superplane.Canvases.Canvas.Spec.nodes:type_name -> Superplane.Components.Node
-	54, // 57: Superplane.Canvases.Canvas.Spec.edges:type_name -> Superplane.Components.Edge
-	29, // 58: Superplane.Canvases.Canvas.Status.last_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	30, // 59: Superplane.Canvases.Canvas.Status.next_queue_items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
-	37, // 60: Superplane.Canvases.Canvas.Status.last_events:type_name -> Superplane.Canvases.CanvasEvent
-	3,  // 61: Superplane.Canvases.Canvases.ListCanvases:input_type -> Superplane.Canvases.ListCanvasesRequest
-	7,  // 62: Superplane.Canvases.Canvases.CreateCanvas:input_type -> Superplane.Canvases.CreateCanvasRequest
-	5,  // 63: Superplane.Canvases.Canvases.DescribeCanvas:input_type -> Superplane.Canvases.DescribeCanvasRequest
-	9,  // 64: Superplane.Canvases.Canvases.UpdateCanvas:input_type -> Superplane.Canvases.UpdateCanvasRequest
-	11, // 65: Superplane.Canvases.Canvases.DeleteCanvas:input_type -> Superplane.Canvases.DeleteCanvasRequest
-	19, // 66: Superplane.Canvases.Canvases.ListNodeQueueItems:input_type -> Superplane.Canvases.ListNodeQueueItemsRequest
-	21, // 67: Superplane.Canvases.Canvases.DeleteNodeQueueItem:input_type -> Superplane.Canvases.DeleteNodeQueueItemRequest
-	23, // 68: Superplane.Canvases.Canvases.UpdateNodePause:input_type -> Superplane.Canvases.UpdateNodePauseRequest
-	25, // 69: Superplane.Canvases.Canvases.ListNodeExecutions:input_type -> Superplane.Canvases.ListNodeExecutionsRequest
-	15, // 70: Superplane.Canvases.Canvases.ListNodeEvents:input_type -> Superplane.Canvases.ListNodeEventsRequest
-	17, // 71: Superplane.Canvases.Canvases.EmitNodeEvent:input_type -> Superplane.Canvases.EmitNodeEventRequest
-	31, // 72: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:input_type -> Superplane.Canvases.InvokeNodeExecutionActionRequest
-	33, // 73: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:input_type -> Superplane.Canvases.InvokeNodeTriggerActionRequest
-	27, // 74: Superplane.Canvases.Canvases.ListChildExecutions:input_type -> Superplane.Canvases.ListChildExecutionsRequest
-	41, // 75: Superplane.Canvases.Canvases.CancelExecution:input_type -> Superplane.Canvases.CancelExecutionRequest
-	43, // 76: Superplane.Canvases.Canvases.ResolveExecutionErrors:input_type -> Superplane.Canvases.ResolveExecutionErrorsRequest
-	35, // 77: Superplane.Canvases.Canvases.ListCanvasEvents:input_type -> Superplane.Canvases.ListCanvasEventsRequest
-	39, // 78: Superplane.Canvases.Canvases.ListEventExecutions:input_type -> Superplane.Canvases.ListEventExecutionsRequest
-	4,  // 79: Superplane.Canvases.Canvases.ListCanvases:output_type -> Superplane.Canvases.ListCanvasesResponse
-	8,  // 80: Superplane.Canvases.Canvases.CreateCanvas:output_type -> Superplane.Canvases.CreateCanvasResponse
-	6,  // 81: Superplane.Canvases.Canvases.DescribeCanvas:output_type -> Superplane.Canvases.DescribeCanvasResponse
-	10, // 82: Superplane.Canvases.Canvases.UpdateCanvas:output_type -> Superplane.Canvases.UpdateCanvasResponse
-	12, // 83: Superplane.Canvases.Canvases.DeleteCanvas:output_type -> Superplane.Canvases.DeleteCanvasResponse
-	20, // 84: Superplane.Canvases.Canvases.ListNodeQueueItems:output_type -> Superplane.Canvases.ListNodeQueueItemsResponse
-	22, // 85: Superplane.Canvases.Canvases.DeleteNodeQueueItem:output_type -> Superplane.Canvases.DeleteNodeQueueItemResponse
-	24, // 86: Superplane.Canvases.Canvases.UpdateNodePause:output_type -> Superplane.Canvases.UpdateNodePauseResponse
-	26, // 87: Superplane.Canvases.Canvases.ListNodeExecutions:output_type -> Superplane.Canvases.ListNodeExecutionsResponse
-	16, // 88: Superplane.Canvases.Canvases.ListNodeEvents:output_type -> Superplane.Canvases.ListNodeEventsResponse
-	18, // 89: Superplane.Canvases.Canvases.EmitNodeEvent:output_type -> Superplane.Canvases.EmitNodeEventResponse
-	32, // 90: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:output_type -> Superplane.Canvases.InvokeNodeExecutionActionResponse
-	34, // 91: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:output_type -> Superplane.Canvases.InvokeNodeTriggerActionResponse
-	28, // 92: Superplane.Canvases.Canvases.ListChildExecutions:output_type -> Superplane.Canvases.ListChildExecutionsResponse
-	42, // 93: Superplane.Canvases.Canvases.CancelExecution:output_type -> Superplane.Canvases.CancelExecutionResponse
-	44, // 94: Superplane.Canvases.Canvases.ResolveExecutionErrors:output_type -> Superplane.Canvases.ResolveExecutionErrorsResponse
-	36, // 95: Superplane.Canvases.Canvases.ListCanvasEvents:output_type -> Superplane.Canvases.ListCanvasEventsResponse
-	40, // 96: Superplane.Canvases.Canvases.ListEventExecutions:output_type -> Superplane.Canvases.ListEventExecutionsResponse
-	79, // [79:97] is the sub-list for method output_type
-	61, // [61:79] is the sub-list for method input_type
-	61, // [61:61] is the sub-list for extension type_name
-	61, // [61:61] is the sub-list for extension extendee
-	0,  // [0:61] is the sub-list for field type_name
+	17,  // 0: Superplane.Canvases.ListCanvasesResponse.canvases:type_name -> Superplane.Canvases.Canvas
+	17,  // 1: Superplane.Canvases.DescribeCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	17,  // 2: Superplane.Canvases.CreateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
+	17,  // 3: Superplane.Canvases.CreateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	17,  // 4: Superplane.Canvases.UpdateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
+	17,  // 5: Superplane.Canvases.UpdateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	68,  // 6: Superplane.Canvases.Canvas.metadata:type_name -> Superplane.Canvases.Canvas.Metadata
+	69,  // 7: Superplane.Canvases.Canvas.spec:type_name -> Superplane.Canvases.Canvas.Spec
+	70,  // 8: Superplane.Canvases.Canvas.status:type_name -> Superplane.Canvases.Canvas.Status
+	75,  // 9: Superplane.Canvases.ListNodeEventsRequest.before:type_name -> google.protobuf.Timestamp
+	42,  // 10: Superplane.Canvases.ListNodeEventsResponse.events:type_name -> Superplane.Canvases.CanvasEvent
+	75,  // 11: Superplane.Canvases.ListNodeEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	76,  // 12: Superplane.Canvases.EmitNodeEventRequest.data:type_name -> google.protobuf.Struct
+	75,  // 13: Superplane.Canvases.ListNodeQueueItemsRequest.before:type_name -> google.protobuf.Timestamp
+	35,  // 14: Superplane.Canvases.ListNodeQueueItemsResponse.items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
+	75,  // 15: Superplane.Canvases.ListNodeQueueItemsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	77,  // 16: Superplane.Canvases.UpdateNodePauseResponse.node:type_name -> Superplane.Components.Node
+	0,   // 17: Superplane.Canvases.ListNodeExecutionsRequest.states:type_name -> Superplane.Canvases.CanvasNodeExecution.State
+	1,   // 18: Superplane.Canvases.ListNodeExecutionsRequest.results:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
+	75,  // 19: Superplane.Canvases.ListNodeExecutionsRequest.before:type_name -> google.protobuf.Timestamp
+	34,  // 20: Superplane.Canvases.ListNodeExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	75,  // 21: Superplane.Canvases.ListNodeExecutionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	34,  // 22: Superplane.Canvases.DescribeExecutionResponse.execution:type_name -> Superplane.Canvases.CanvasNodeExecution
+	34,  // 23: Superplane.Canvases.ListChildExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	0,   // 24: Superplane.Canvases.CanvasNodeExecution.state:type_name -> Superplane.Canvases.CanvasNodeExecution.State
+	1,   // 25: Superplane.Canvases.CanvasNodeExecution.result:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
+	2,   // 26: Superplane.Canvases.CanvasNodeExecution.result_reason:type_name -> Superplane.Canvases.CanvasNodeExecution.ResultReason
+	76,  // 27: Superplane.Canvases.CanvasNodeExecution.input:type_name -> google.protobuf.Struct
+	76,  // 28: Superplane.Canvases.CanvasNodeExecution.outputs:type_name -> google.protobuf.Struct
+	75,  // 29: Superplane.Canvases.CanvasNodeExecution.created_at:type_name -> google.protobuf.Timestamp
+	75,  // 30: Superplane.Canvases.CanvasNodeExecution.updated_at:type_name -> google.protobuf.Timestamp
+	76,  // 31: Superplane.Canvases.CanvasNodeExecution.metadata:type_name -> google.protobuf.Struct
+	76,  // 32: Superplane.Canvases.CanvasNodeExecution.configuration:type_name -> google.protobuf.Struct
+	34,  // 33: Superplane.Canvases.CanvasNodeExecution.child_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	42,  // 34: Superplane.Canvases.CanvasNodeExecution.root_event:type_name -> Superplane.Canvases.CanvasEvent
+	16,  // 35: Superplane.Canvases.CanvasNodeExecution.cancelled_by:type_name -> Superplane.Canvases.UserRef
+	76,  // 36: Superplane.Canvases.CanvasNodeQueueItem.input:type_name -> google.protobuf.Struct
+	42,  // 37: Superplane.Canvases.CanvasNodeQueueItem.root_event:type_name -> Superplane.Canvases.CanvasEvent
+	75,  // 38: Superplane.Canvases.CanvasNodeQueueItem.created_at:type_name -> google.protobuf.Timestamp
+	76,  // 39: Superplane.Canvases.InvokeNodeExecutionActionRequest.parameters:type_name -> google.protobuf.Struct
+	76,  // 40: Superplane.Canvases.InvokeNodeTriggerActionRequest.parameters:type_name -> google.protobuf.Struct
+	76,  // 41: Superplane.Canvases.InvokeNodeTriggerActionResponse.result:type_name -> google.protobuf.Struct
+	75,  // 42: Superplane.Canvases.ListCanvasEventsRequest.before:type_name -> google.protobuf.Timestamp
+	43,  // 43: Superplane.Canvases.ListCanvasEventsResponse.events:type_name -> Superplane.Canvases.CanvasEventWithExecutions
+	75,  // 44: Superplane.Canvases.ListCanvasEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	76,  // 45: Superplane.Canvases.CanvasEvent.data:type_name -> google.protobuf.Struct
+	75,  // 46: Superplane.Canvases.CanvasEvent.created_at:type_name -> google.protobuf.Timestamp
+	76,  // 47: Superplane.Canvases.CanvasEventWithExecutions.data:type_name -> google.protobuf.Struct
+	75,  // 48: Superplane.Canvases.CanvasEventWithExecutions.created_at:type_name -> google.protobuf.Timestamp
+	34,  // 49: Superplane.Canvases.CanvasEventWithExecutions.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	34,  // 50: Superplane.Canvases.ListEventExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	75,  // 51: Superplane.Canvases.ListWebhookDeliveriesRequest.before:type_name -> google.protobuf.Timestamp
+	54,  // 52: Superplane.Canvases.ListWebhookDeliveriesResponse.deliveries:type_name -> Superplane.Canvases.WebhookDelivery
+	75,  // 53: Superplane.Canvases.ListWebhookDeliveriesResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	54,  // 54: Superplane.Canvases.ReplayWebhookDeliveryResponse.delivery:type_name -> Superplane.Canvases.WebhookDelivery
+	72,  // 55: Superplane.Canvases.WebhookDelivery.headers:type_name -> Superplane.Canvases.WebhookDelivery.HeadersEntry
+	71,  // 56: Superplane.Canvases.WebhookDelivery.nodes:type_name -> Superplane.Canvases.WebhookDelivery.RoutedNode
+	75,  // 57: Superplane.Canvases.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	3,   // 58: Superplane.Canvases.WatchCanvasRequest.types:type_name -> Superplane.Canvases.WatchCanvasResponse.Type
+	3,   // 59: Superplane.Canvases.WatchCanvasResponse.type:type_name -> Superplane.Canvases.WatchCanvasResponse.Type
+	75,  // 60: Superplane.Canvases.WatchCanvasResponse.timestamp:type_name -> google.protobuf.Timestamp
+	42,  // 61: Superplane.Canvases.WatchCanvasResponse.event:type_name -> Superplane.Canvases.CanvasEvent
+	34,  // 62: Superplane.Canvases.WatchCanvasResponse.execution:type_name -> Superplane.Canvases.CanvasNodeExecution
+	35,  // 63: Superplane.Canvases.WatchCanvasResponse.queue_item:type_name -> Superplane.Canvases.CanvasNodeQueueItem
+	75,  // 64: Superplane.Canvases.GetCanvasMetricsRequest.start_time:type_name -> google.protobuf.Timestamp
+	75,  // 65: Superplane.Canvases.GetCanvasMetricsRequest.end_time:type_name -> google.protobuf.Timestamp
+	75,  // 66: Superplane.Canvases.GetCanvasMetricsResponse.start_time:type_name -> google.protobuf.Timestamp
+	75,  // 67: Superplane.Canvases.GetCanvasMetricsResponse.end_time:type_name -> google.protobuf.Timestamp
+	59,  // 68: Superplane.Canvases.GetCanvasMetricsResponse.canvas:type_name -> Superplane.Canvases.ExecutionMetrics
+	59,  // 69: Superplane.Canvases.GetCanvasMetricsResponse.nodes:type_name -> Superplane.Canvases.ExecutionMetrics
+	73,  // 70: Superplane.Canvases.ExecutionMetrics.results:type_name -> Superplane.Canvases.ExecutionMetrics.ResultCount
+	60,  // 71: Superplane.Canvases.ExecutionMetrics.duration:type_name -> Superplane.Canvases.DurationStats
+	60,  // 72: Superplane.Canvases.ExecutionMetrics.queue_wait:type_name -> Superplane.Canvases.DurationStats
+	60,  // 73: Superplane.Canvases.ExecutionMetrics.approval_wait:type_name -> Superplane.Canvases.DurationStats
+	63,  // 74: Superplane.Canvases.GetExecutionLogsResponse.lines:type_name -> Superplane.Canvases.ExecutionLogLine
+	4,   // 75: Superplane.Canvases.ExecutionLogLine.level:type_name -> Superplane.Canvases.ExecutionLogLine.Level
+	74,  // 76: Superplane.Canvases.ExecutionLogLine.fields:type_name -> Superplane.Canvases.ExecutionLogLine.FieldsEntry
+	75,  // 77: Superplane.Canvases.ExecutionLogLine.timestamp:type_name -> google.protobuf.Timestamp
+	75,  // 78: Superplane.Canvases.CanvasNodeEventMessage.timestamp:type_name -> google.protobuf.Timestamp
+	75,  // 79: Superplane.Canvases.CanvasNodeExecutionMessage.timestamp:type_name -> google.protobuf.Timestamp
+	75,  // 80: Superplane.Canvases.CanvasNodeQueueItemMessage.timestamp:type_name -> google.protobuf.Timestamp
+	5,   // 81: Superplane.Canvases.CanvasChangedMessage.action:type_name -> Superplane.Canvases.CanvasChangedMessage.Action
+	75,  // 82: Superplane.Canvases.CanvasChangedMessage.timestamp:type_name -> google.protobuf.Timestamp
+	75,  // 83: Superplane.Canvases.Canvas.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	75,  // 84: Superplane.Canvases.Canvas.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	16,  // 85: Superplane.Canvases.Canvas.Metadata.created_by:type_name -> Superplane.Canvases.UserRef
+	77,  // 86: Superplane.Canvases.Canvas.Spec.nodes:type_name -> Superplane.Components.Node
+	78,  // 87: Superplane.Canvases.Canvas.Spec.edges:type_name -> Superplane.Components.Edge
+	34,  // 88: Superplane.Canvases.Canvas.Status.last_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	35,  // 89: Superplane.Canvases.Canvas.Status.next_queue_items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
+	42,  // 90: Superplane.Canvases.Canvas.Status.last_events:type_name -> Superplane.Canvases.CanvasEvent
+	1,   // 91: Superplane.Canvases.ExecutionMetrics.ResultCount.result:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
+	2,   // 92: Superplane.Canvases.ExecutionMetrics.ResultCount.result_reason:type_name -> Superplane.Canvases.CanvasNodeExecution.ResultReason
+	6,   // 93: Superplane.Canvases.Canvases.ListCanvases:input_type -> Superplane.Canvases.ListCanvasesRequest
+	10,  // 94: Superplane.Canvases.Canvases.CreateCanvas:input_type -> Superplane.Canvases.CreateCanvasRequest
+	8,   // 95: Superplane.Canvases.Canvases.DescribeCanvas:input_type -> Superplane.Canvases.DescribeCanvasRequest
+	12,  // 96: Superplane.Canvases.Canvases.UpdateCanvas:input_type -> Superplane.Canvases.UpdateCanvasRequest
+	14,  // 97: Superplane.Canvases.Canvases.DeleteCanvas:input_type -> Superplane.Canvases.DeleteCanvasRequest
+	22,  // 98: Superplane.Canvases.Canvases.ListNodeQueueItems:input_type -> Superplane.Canvases.ListNodeQueueItemsRequest
+	24,  // 99: Superplane.Canvases.Canvases.DeleteNodeQueueItem:input_type -> Superplane.Canvases.DeleteNodeQueueItemRequest
+	26,  // 100: Superplane.Canvases.Canvases.UpdateNodePause:input_type -> Superplane.Canvases.UpdateNodePauseRequest
+	28,  // 101: Superplane.Canvases.Canvases.ListNodeExecutions:input_type -> Superplane.Canvases.ListNodeExecutionsRequest
+	18,  // 102: Superplane.Canvases.Canvases.ListNodeEvents:input_type -> Superplane.Canvases.ListNodeEventsRequest
+	20,  // 103: Superplane.Canvases.Canvases.EmitNodeEvent:input_type -> Superplane.Canvases.EmitNodeEventRequest
+	36,  // 104: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:input_type -> Superplane.Canvases.InvokeNodeExecutionActionRequest
+	38,  // 105: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:input_type -> Superplane.Canvases.InvokeNodeTriggerActionRequest
+	30,  // 106: Superplane.Canvases.Canvases.DescribeExecution:input_type -> Superplane.Canvases.DescribeExecutionRequest
+	32,  // 107: Superplane.Canvases.Canvases.ListChildExecutions:input_type -> Superplane.Canvases.ListChildExecutionsRequest
+	46,  // 108: Superplane.Canvases.Canvases.CancelExecution:input_type -> Superplane.Canvases.CancelExecutionRequest
+	48,  // 109: Superplane.Canvases.Canvases.ResolveExecutionErrors:input_type -> Superplane.Canvases.ResolveExecutionErrorsRequest
+	40,  // 110: Superplane.Canvases.Canvases.ListCanvasEvents:input_type -> Superplane.Canvases.ListCanvasEventsRequest
+	44,  // 111: Superplane.Canvases.Canvases.ListEventExecutions:input_type -> Superplane.Canvases.ListEventExecutionsRequest
+	50,  // 112: Superplane.Canvases.Canvases.ListWebhookDeliveries:input_type -> Superplane.Canvases.ListWebhookDeliveriesRequest
+	52,  // 113: Superplane.Canvases.Canvases.ReplayWebhookDelivery:input_type -> Superplane.Canvases.ReplayWebhookDeliveryRequest
+	57,  // 114: Superplane.Canvases.Canvases.GetCanvasMetrics:input_type -> Superplane.Canvases.GetCanvasMetricsRequest
+	55,  // 115: Superplane.Canvases.Canvases.WatchCanvas:input_type -> Superplane.Canvases.WatchCanvasRequest
+	61,  // 116: Superplane.Canvases.Canvases.GetExecutionLogs:input_type -> Superplane.Canvases.GetExecutionLogsRequest
+	7,   // 117: Superplane.Canvases.Canvases.ListCanvases:output_type -> Superplane.Canvases.ListCanvasesResponse
+	11,  // 118: Superplane.Canvases.Canvases.CreateCanvas:output_type -> Superplane.Canvases.CreateCanvasResponse
+	9,   // 119: Superplane.Canvases.Canvases.DescribeCanvas:output_type -> Superplane.Canvases.DescribeCanvasResponse
+	13,  // 120: Superplane.Canvases.Canvases.UpdateCanvas:output_type -> Superplane.Canvases.UpdateCanvasResponse
+	15,  // 121: Superplane.Canvases.Canvases.DeleteCanvas:output_type -> Superplane.Canvases.DeleteCanvasResponse
+	23,  // 122: Superplane.Canvases.Canvases.ListNodeQueueItems:output_type -> Superplane.Canvases.ListNodeQueueItemsResponse
+	25,  // 123: Superplane.Canvases.Canvases.DeleteNodeQueueItem:output_type -> Superplane.Canvases.DeleteNodeQueueItemResponse
+	27,  // 124: Superplane.Canvases.Canvases.UpdateNodePause:output_type -> Superplane.Canvases.UpdateNodePauseResponse
+	29,  // 125: Superplane.Canvases.Canvases.ListNodeExecutions:output_type -> Superplane.Canvases.ListNodeExecutionsResponse
+	19,  // 126: Superplane.Canvases.Canvases.ListNodeEvents:output_type -> Superplane.Canvases.ListNodeEventsResponse
+	21,  // 127: Superplane.Canvases.Canvases.EmitNodeEvent:output_type -> Superplane.Canvases.EmitNodeEventResponse
+	37,  // 128: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:output_type -> Superplane.Canvases.InvokeNodeExecutionActionResponse
+	39,  // 129: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:output_type -> Superplane.Canvases.InvokeNodeTriggerActionResponse
+	31,  // 130: Superplane.Canvases.Canvases.DescribeExecution:output_type -> Superplane.Canvases.DescribeExecutionResponse
+	33,  // 131: Superplane.Canvases.Canvases.ListChildExecutions:output_type -> Superplane.Canvases.ListChildExecutionsResponse
+	47,  // 132: Superplane.Canvases.Canvases.CancelExecution:output_type -> Superplane.Canvases.CancelExecutionResponse
+	49,  // 133: Superplane.Canvases.Canvases.ResolveExecutionErrors:output_type -> Superplane.Canvases.ResolveExecutionErrorsResponse
+	41,  // 134: Superplane.Canvases.Canvases.ListCanvasEvents:output_type -> Superplane.Canvases.ListCanvasEventsResponse
+	45,  // 135: Superplane.Canvases.Canvases.ListEventExecutions:output_type -> Superplane.Canvases.ListEventExecutionsResponse
+	51,  // 136: Superplane.Canvases.Canvases.ListWebhookDeliveries:output_type -> Superplane.Canvases.ListWebhookDeliveriesResponse
+	53,  // 137: Superplane.Canvases.Canvases.ReplayWebhookDelivery:output_type -> Superplane.Canvases.ReplayWebhookDeliveryResponse
+	58,  // 138: Superplane.Canvases.Canvases.GetCanvasMetrics:output_type -> Superplane.Canvases.GetCanvasMetricsResponse
+	56,  // 139: Superplane.Canvases.Canvases.WatchCanvas:output_type -> Superplane.Canvases.WatchCanvasResponse
+	62,  // 140: Superplane.Canvases.Canvases.GetExecutionLogs:output_type -> Superplane.Canvases.GetExecutionLogsResponse
+	117, // [117:141] is the sub-list for method output_type
+	93,  // [93:117] is the sub-list for method input_type
+	93,  // [93:93] is the sub-list for extension type_name
+	93,  // [93:93] is the sub-list for extension extendee
+	0,   // [0:93] is the sub-list for field type_name
 }
 
 func init() { file_canvases_proto_init() }
@@ -3528,8 +5248,8 @@ func file_canvases_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_canvases_proto_rawDesc), len(file_canvases_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   48,
+			NumEnums:      6,
+			NumMessages:   69,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -32,11 +32,17 @@ const (
 	Canvases_EmitNodeEvent_FullMethodName             = "/Superplane.Canvases.Canvases/EmitNodeEvent"
 	Canvases_InvokeNodeExecutionAction_FullMethodName = "/Superplane.Canvases.Canvases/InvokeNodeExecutionAction"
 	Canvases_InvokeNodeTriggerAction_FullMethodName   = "/Superplane.Canvases.Canvases/InvokeNodeTriggerAction"
+	Canvases_DescribeExecution_FullMethodName         = "/Superplane.Canvases.Canvases/DescribeExecution"
 	Canvases_ListChildExecutions_FullMethodName       = "/Superplane.Canvases.Canvases/ListChildExecutions"
 	Canvases_CancelExecution_FullMethodName           = "/Superplane.Canvases.Canvases/CancelExecution"
 	Canvases_ResolveExecutionErrors_FullMethodName    = "/Superplane.Canvases.Canvases/ResolveExecutionErrors"
 	Canvases_ListCanvasEvents_FullMethodName          = "/Superplane.Canvases.Canvases/ListCanvasEvents"
 	Canvases_ListEventExecutions_FullMethodName       = "/Superplane.Canvases.Canvases/ListEventExecutions"
+	Canvases_ListWebhookDeliveries_FullMethodName     = "/Superplane.Canvases.Canvases/ListWebhookDeliveries"
+	Canvases_ReplayWebhookDelivery_FullMethodName     = "/Superplane.Canvases.Canvases/ReplayWebhookDelivery"
+	Canvases_GetCanvasMetrics_FullMethodName          = "/Superplane.Canvases.Canvases/GetCanvasMetrics"
+	Canvases_WatchCanvas_FullMethodName               = "/Superplane.Canvases.Canvases/WatchCanvas"
+	Canvases_GetExecutionLogs_FullMethodName          = "/Superplane.Canvases.Canvases/GetExecutionLogs"
 )
 
 // CanvasesClient is the client API for Canvases service.
@@ -56,11 +62,24 @@ type CanvasesClient interface {
 	EmitNodeEvent(ctx context.Context, in *EmitNodeEventRequest, opts ...grpc.CallOption) (*EmitNodeEventResponse, error)
 	InvokeNodeExecutionAction(ctx context.Context, in *InvokeNodeExecutionActionRequest, opts ...grpc.CallOption) (*InvokeNodeExecutionActionResponse, error)
 	InvokeNodeTriggerAction(ctx context.Context, in *InvokeNodeTriggerActionRequest, opts ...grpc.CallOption) (*InvokeNodeTriggerActionResponse, error)
+	DescribeExecution(ctx context.Context, in *DescribeExecutionRequest, opts ...grpc.CallOption) (*DescribeExecutionResponse, error)
 	ListChildExecutions(ctx context.Context, in *ListChildExecutionsRequest, opts ...grpc.CallOption) (*ListChildExecutionsResponse, error)
 	CancelExecution(ctx context.Context, in *CancelExecutionRequest, opts ...grpc.CallOption) (*CancelExecutionResponse, error)
 	ResolveExecutionErrors(ctx context.Context, in *ResolveExecutionErrorsRequest, opts ...grpc.CallOption) (*ResolveExecutionErrorsResponse, error)
 	ListCanvasEvents(ctx context.Context, in *ListCanvasEventsRequest, opts ...grpc.CallOption) (*ListCanvasEventsResponse, error)
 	ListEventExecutions(ctx context.Context, in *ListEventExecutionsRequest, opts ...grpc.CallOption) (*ListEventExecutionsResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	ReplayWebhookDelivery(ctx context.Context, in *ReplayWebhookDeliveryRequest, opts ...grpc.CallOption) (*ReplayWebhookDeliveryResponse, error)
+	GetCanvasMetrics(ctx context.Context, in *GetCanvasMetricsRequest, opts ...grpc.CallOption) (*GetCanvasMetricsResponse, error)
+	// Streams the activity on a canvas: events created,
+	// execution state changes and queue items created.
+	// Changes are delivered at least once, and each message includes
+	// a cursor that can be used to resume the stream after a disconnect.
+	WatchCanvas(ctx context.Context, in *WatchCanvasRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchCanvasResponse], error)
+	// Streams the lines logged while processing an execution.
+	// Without follow, the stream ends after the lines logged so far.
+	// With follow, it ends once the execution is finished.
+	GetExecutionLogs(ctx context.Context, in *GetExecutionLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetExecutionLogsResponse], error)
 }
 
 type canvasesClient struct {
@@ -201,6 +220,16 @@ func (c *canvasesClient) InvokeNodeTriggerAction(ctx context.Context, in *Invoke
 	return out, nil
 }
 
+func (c *canvasesClient) DescribeExecution(ctx context.Context, in *DescribeExecutionRequest, opts ...grpc.CallOption) (*DescribeExecutionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DescribeExecutionResponse)
+	err := c.cc.Invoke(ctx, Canvases_DescribeExecution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *canvasesClient) ListChildExecutions(ctx context.Context, in *ListChildExecutionsRequest, opts ...grpc.CallOption) (*ListChildExecutionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListChildExecutionsResponse)
@@ -251,6 +280,74 @@ func (c *canvasesClient) ListEventExecutions(ctx context.Context, in *ListEventE
 	return out, nil
 }
 
+func (c *canvasesClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, Canvases_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) ReplayWebhookDelivery(ctx context.Context, in *ReplayWebhookDeliveryRequest, opts ...grpc.CallOption) (*ReplayWebhookDeliveryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayWebhookDeliveryResponse)
+	err := c.cc.Invoke(ctx, Canvases_ReplayWebhookDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) GetCanvasMetrics(ctx context.Context, in *GetCanvasMetricsRequest, opts ...grpc.CallOption) (*GetCanvasMetricsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCanvasMetricsResponse)
+	err := c.cc.Invoke(ctx, Canvases_GetCanvasMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) WatchCanvas(ctx context.Context, in *WatchCanvasRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchCanvasResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Canvases_ServiceDesc.Streams[0], Canvases_WatchCanvas_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchCanvasRequest, WatchCanvasResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Canvases_WatchCanvasClient = grpc.ServerStreamingClient[WatchCanvasResponse]
+
+func (c *canvasesClient) GetExecutionLogs(ctx context.Context, in *GetExecutionLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetExecutionLogsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Canvases_ServiceDesc.Streams[1], Canvases_GetExecutionLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetExecutionLogsRequest, GetExecutionLogsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Canvases_GetExecutionLogsClient = grpc.ServerStreamingClient[GetExecutionLogsResponse]
+
 // CanvasesServer is the server API for Canvases service.
 // All implementations should embed UnimplementedCanvasesServer
 // for forward compatibility.
@@ -268,11 +365,24 @@ type CanvasesServer interface {
 	EmitNodeEvent(context.Context, *EmitNodeEventRequest) (*EmitNodeEventResponse, error)
 	InvokeNodeExecutionAction(context.Context, *InvokeNodeExecutionActionRequest) (*InvokeNodeExecutionActionResponse, error)
 	InvokeNodeTriggerAction(context.Context, *InvokeNodeTriggerActionRequest) (*InvokeNodeTriggerActionResponse, error)
+	DescribeExecution(context.Context, *DescribeExecutionRequest) (*DescribeExecutionResponse, error)
 	ListChildExecutions(context.Context, *ListChildExecutionsRequest) (*ListChildExecutionsResponse, error)
 	CancelExecution(context.Context, *CancelExecutionRequest) (*CancelExecutionResponse, error)
 	ResolveExecutionErrors(context.Context, *ResolveExecutionErrorsRequest) (*ResolveExecutionErrorsResponse, error)
 	ListCanvasEvents(context.Context, *ListCanvasEventsRequest) (*ListCanvasEventsResponse, error)
 	ListEventExecutions(context.Context, *ListEventExecutionsRequest) (*ListEventExecutionsResponse, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	ReplayWebhookDelivery(context.Context, *ReplayWebhookDeliveryRequest) (*ReplayWebhookDeliveryResponse, error)
+	GetCanvasMetrics(context.Context, *GetCanvasMetricsRequest) (*GetCanvasMetricsResponse, error)
+	// Streams the activity on a canvas: events created,
+	// execution state changes and queue items created.
+	// Changes are delivered at least once, and each message includes
+	// a cursor that can be used to resume the stream after a disconnect.
+	WatchCanvas(*WatchCanvasRequest, grpc.ServerStreamingServer[WatchCanvasResponse]) error
+	// Streams the lines logged while processing an execution.
+	// Without follow, the stream ends after the lines logged so far.
+	// With follow, it ends once the execution is finished.
+	GetExecutionLogs(*GetExecutionLogsRequest, grpc.ServerStreamingServer[GetExecutionLogsResponse]) error
 }
 
 // UnimplementedCanvasesServer should be embedded to have
@@ -321,6 +431,9 @@ func (UnimplementedCanvasesServer) InvokeNodeExecutionAction(context.Context, *I
 func (UnimplementedCanvasesServer) InvokeNodeTriggerAction(context.Context, *InvokeNodeTriggerActionRequest) (*InvokeNodeTriggerActionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InvokeNodeTriggerAction not implemented")
 }
+func (UnimplementedCanvasesServer) DescribeExecution(context.Context, *DescribeExecutionRequest) (*DescribeExecutionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DescribeExecution not implemented")
+}
 func (UnimplementedCanvasesServer) ListChildExecutions(context.Context, *ListChildExecutionsRequest) (*ListChildExecutionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListChildExecutions not implemented")
 }
@@ -336,6 +449,21 @@ func (UnimplementedCanvasesServer) ListCanvasEvents(context.Context, *ListCanvas
 func (UnimplementedCanvasesServer) ListEventExecutions(context.Context, *ListEventExecutionsRequest) (*ListEventExecutionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListEventExecutions not implemented")
 }
+func (UnimplementedCanvasesServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedCanvasesServer) ReplayWebhookDelivery(context.Context, *ReplayWebhookDeliveryRequest) (*ReplayWebhookDeliveryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplayWebhookDelivery not implemented")
+}
+func (UnimplementedCanvasesServer) GetCanvasMetrics(context.Context, *GetCanvasMetricsRequest) (*GetCanvasMetricsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCanvasMetrics not implemented")
+}
+func (UnimplementedCanvasesServer) WatchCanvas(*WatchCanvasRequest, grpc.ServerStreamingServer[WatchCanvasResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchCanvas not implemented")
+}
+func (UnimplementedCanvasesServer) GetExecutionLogs(*GetExecutionLogsRequest, grpc.ServerStreamingServer[GetExecutionLogsResponse]) error {
+	return status.Error(codes.Unimplemented, "method GetExecutionLogs not implemented")
+}
 func (UnimplementedCanvasesServer) testEmbeddedByValue() {}
 
 // UnsafeCanvasesServer may be embedded to opt out of forward compatibility for this service.
@@ -590,6 +718,24 @@ func _Canvases_InvokeNodeTriggerAction_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_DescribeExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).DescribeExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_DescribeExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).DescribeExecution(ctx, req.(*DescribeExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Canvases_ListChildExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListChildExecutionsRequest)
 	if err := dec(in); err != nil {
@@ -680,6 +826,82 @@ func _Canvases_ListEventExecutions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_ReplayWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ReplayWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ReplayWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ReplayWebhookDelivery(ctx, req.(*ReplayWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_GetCanvasMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCanvasMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).GetCanvasMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_GetCanvasMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).GetCanvasMetrics(ctx, req.(*GetCanvasMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_WatchCanvas_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCanvasRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CanvasesServer).WatchCanvas(m, &grpc.GenericServerStream[WatchCanvasRequest, WatchCanvasResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Canvases_WatchCanvasServer = grpc.ServerStreamingServer[WatchCanvasResponse]
+
+func _Canvases_GetExecutionLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetExecutionLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CanvasesServer).GetExecutionLogs(m, &grpc.GenericServerStream[GetExecutionLogsRequest, GetExecutionLogsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Canvases_GetExecutionLogsServer = grpc.ServerStreamingServer[GetExecutionLogsResponse]
+
 // Canvases_ServiceDesc is the grpc.ServiceDesc for Canvases service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -739,6 +961,10 @@ var Canvases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InvokeNodeTriggerAction",
 			Handler:    _Canvases_InvokeNodeTriggerAction_Handler,
 		},
+		{
+			MethodName: "DescribeExecution",
+			Handler:    _Canvases_DescribeExecution_Handler,
+		},
 		{
 			MethodName: "ListChildExecutions",
 			Handler:    _Canvases_ListChildExecutions_Handler,
@@ -759,7 +985,30 @@ var Canvases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListEventExecutions",
 			Handler:    _Canvases_ListEventExecutions_Handler,
 		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _Canvases_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "ReplayWebhookDelivery",
+			Handler:    _Canvases_ReplayWebhookDelivery_Handler,
+		},
+		{
+			MethodName: "GetCanvasMetrics",
+			Handler:    _Canvases_GetCanvasMetrics_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCanvas",
+			Handler:       _Canvases_WatchCanvas_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetExecutionLogs",
+			Handler:       _Canvases_GetExecutionLogs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "canvases.proto",
 }
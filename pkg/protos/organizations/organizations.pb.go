@@ -25,6 +25,122 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type StuckWork_Kind int32
+
+const (
+	StuckWork_KIND_UNKNOWN           StuckWork_Kind = 0
+	StuckWork_KIND_NODE_PROCESSING   StuckWork_Kind = 1
+	StuckWork_KIND_QUEUE_ITEMS       StuckWork_Kind = 2
+	StuckWork_KIND_EXECUTION_STARTED StuckWork_Kind = 3
+	StuckWork_KIND_WEBHOOK_PENDING   StuckWork_Kind = 4
+)
+
+// Enum value maps for StuckWork_Kind.
+var (
+	StuckWork_Kind_name = map[int32]string{
+		0: "KIND_UNKNOWN",
+		1: "KIND_NODE_PROCESSING",
+		2: "KIND_QUEUE_ITEMS",
+		3: "KIND_EXECUTION_STARTED",
+		4: "KIND_WEBHOOK_PENDING",
+	}
+	StuckWork_Kind_value = map[string]int32{
+		"KIND_UNKNOWN":           0,
+		"KIND_NODE_PROCESSING":   1,
+		"KIND_QUEUE_ITEMS":       2,
+		"KIND_EXECUTION_STARTED": 3,
+		"KIND_WEBHOOK_PENDING":   4,
+	}
+)
+
+func (x StuckWork_Kind) Enum() *StuckWork_Kind {
+	p := new(StuckWork_Kind)
+	*p = x
+	return p
+}
+
+func (x StuckWork_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StuckWork_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_organizations_proto_enumTypes[0].Descriptor()
+}
+
+func (StuckWork_Kind) Type() protoreflect.EnumType {
+	return &file_organizations_proto_enumTypes[0]
+}
+
+func (x StuckWork_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StuckWork_Kind.Descriptor instead.
+func (StuckWork_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{54, 0}
+}
+
+type ImportedResource_Kind int32
+
+const (
+	ImportedResource_KIND_UNKNOWN     ImportedResource_Kind = 0
+	ImportedResource_KIND_ROLE        ImportedResource_Kind = 1
+	ImportedResource_KIND_GROUP       ImportedResource_Kind = 2
+	ImportedResource_KIND_INTEGRATION ImportedResource_Kind = 3
+	ImportedResource_KIND_SECRET      ImportedResource_Kind = 4
+	ImportedResource_KIND_BLUEPRINT   ImportedResource_Kind = 5
+	ImportedResource_KIND_CANVAS      ImportedResource_Kind = 6
+)
+
+// Enum value maps for ImportedResource_Kind.
+var (
+	ImportedResource_Kind_name = map[int32]string{
+		0: "KIND_UNKNOWN",
+		1: "KIND_ROLE",
+		2: "KIND_GROUP",
+		3: "KIND_INTEGRATION",
+		4: "KIND_SECRET",
+		5: "KIND_BLUEPRINT",
+		6: "KIND_CANVAS",
+	}
+	ImportedResource_Kind_value = map[string]int32{
+		"KIND_UNKNOWN":     0,
+		"KIND_ROLE":        1,
+		"KIND_GROUP":       2,
+		"KIND_INTEGRATION": 3,
+		"KIND_SECRET":      4,
+		"KIND_BLUEPRINT":   5,
+		"KIND_CANVAS":      6,
+	}
+)
+
+func (x ImportedResource_Kind) Enum() *ImportedResource_Kind {
+	p := new(ImportedResource_Kind)
+	*p = x
+	return p
+}
+
+func (x ImportedResource_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportedResource_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_organizations_proto_enumTypes[1].Descriptor()
+}
+
+func (ImportedResource_Kind) Type() protoreflect.EnumType {
+	return &file_organizations_proto_enumTypes[1]
+}
+
+func (x ImportedResource_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportedResource_Kind.Descriptor instead.
+func (ImportedResource_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{61, 0}
+}
+
 type Organization struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Metadata      *Organization_Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
@@ -1845,30 +1961,34 @@ func (x *Integration) GetStatus() *Integration_Status {
 	return nil
 }
 
-type BrowserAction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
-	FormFields    map[string]string      `protobuf:"bytes,3,rep,name=form_fields,json=formFields,proto3" json:"form_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+type WebhookSubscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url   string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Types of events sent to the webhook.
+	// If empty, all event types are sent.
+	EventTypes    []string             `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Enabled       bool                 `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt     *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamp.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BrowserAction) Reset() {
-	*x = BrowserAction{}
+func (x *WebhookSubscription) Reset() {
+	*x = WebhookSubscription{}
 	mi := &file_organizations_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BrowserAction) String() string {
+func (x *WebhookSubscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BrowserAction) ProtoMessage() {}
+func (*WebhookSubscription) ProtoMessage() {}
 
-func (x *BrowserAction) ProtoReflect() protoreflect.Message {
+func (x *WebhookSubscription) ProtoReflect() protoreflect.Message {
 	mi := &file_organizations_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1880,62 +2000,83 @@ func (x *BrowserAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BrowserAction.ProtoReflect.Descriptor instead.
-func (*BrowserAction) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebhookSubscription.ProtoReflect.Descriptor instead.
+func (*WebhookSubscription) Descriptor() ([]byte, []int) {
 	return file_organizations_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *BrowserAction) GetUrl() string {
+func (x *WebhookSubscription) GetId() string {
 	if x != nil {
-		return x.Url
+		return x.Id
 	}
 	return ""
 }
 
-func (x *BrowserAction) GetMethod() string {
+func (x *WebhookSubscription) GetUrl() string {
 	if x != nil {
-		return x.Method
+		return x.Url
 	}
 	return ""
 }
 
-func (x *BrowserAction) GetFormFields() map[string]string {
+func (x *WebhookSubscription) GetEventTypes() []string {
 	if x != nil {
-		return x.FormFields
+		return x.EventTypes
 	}
 	return nil
 }
 
-func (x *BrowserAction) GetDescription() string {
+func (x *WebhookSubscription) GetEnabled() bool {
 	if x != nil {
-		return x.Description
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-// Event messages for organization lifecycle events
-type OrganizationCreated struct {
+func (x *WebhookSubscription) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookSubscription) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type WebhookSubscriptionDelivery struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Timestamp      *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventType      string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	State          string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Attempts       int32                  `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastStatusCode int32                  `protobuf:"varint,5,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	LastError      string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Payload        *_struct.Struct        `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	NextAttemptAt  *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	DeliveredAt    *timestamp.Timestamp   `protobuf:"bytes,9,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	CreatedAt      *timestamp.Timestamp   `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *OrganizationCreated) Reset() {
-	*x = OrganizationCreated{}
+func (x *WebhookSubscriptionDelivery) Reset() {
+	*x = WebhookSubscriptionDelivery{}
 	mi := &file_organizations_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrganizationCreated) String() string {
+func (x *WebhookSubscriptionDelivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrganizationCreated) ProtoMessage() {}
+func (*WebhookSubscriptionDelivery) ProtoMessage() {}
 
-func (x *OrganizationCreated) ProtoReflect() protoreflect.Message {
+func (x *WebhookSubscriptionDelivery) ProtoReflect() protoreflect.Message {
 	mi := &file_organizations_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1947,100 +2088,103 @@ func (x *OrganizationCreated) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrganizationCreated.ProtoReflect.Descriptor instead.
-func (*OrganizationCreated) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebhookSubscriptionDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookSubscriptionDelivery) Descriptor() ([]byte, []int) {
 	return file_organizations_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *OrganizationCreated) GetOrganizationId() string {
+func (x *WebhookSubscriptionDelivery) GetId() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *OrganizationCreated) GetTimestamp() *timestamp.Timestamp {
+func (x *WebhookSubscriptionDelivery) GetEventType() string {
 	if x != nil {
-		return x.Timestamp
+		return x.EventType
 	}
-	return nil
+	return ""
 }
 
-type OrganizationUpdated struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Timestamp      *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *WebhookSubscriptionDelivery) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
 }
 
-func (x *OrganizationUpdated) Reset() {
-	*x = OrganizationUpdated{}
-	mi := &file_organizations_proto_msgTypes[39]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *WebhookSubscriptionDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
 }
 
-func (x *OrganizationUpdated) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WebhookSubscriptionDelivery) GetLastStatusCode() int32 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
 }
 
-func (*OrganizationUpdated) ProtoMessage() {}
+func (x *WebhookSubscriptionDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
 
-func (x *OrganizationUpdated) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[39]
+func (x *WebhookSubscriptionDelivery) GetPayload() *_struct.Struct {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Payload
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use OrganizationUpdated.ProtoReflect.Descriptor instead.
-func (*OrganizationUpdated) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{39}
+func (x *WebhookSubscriptionDelivery) GetNextAttemptAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
 }
 
-func (x *OrganizationUpdated) GetOrganizationId() string {
+func (x *WebhookSubscriptionDelivery) GetDeliveredAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.OrganizationId
+		return x.DeliveredAt
 	}
-	return ""
+	return nil
 }
 
-func (x *OrganizationUpdated) GetTimestamp() *timestamp.Timestamp {
+func (x *WebhookSubscriptionDelivery) GetCreatedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Timestamp
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type OrganizationDeleted struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Timestamp      *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ListWebhookSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrganizationDeleted) Reset() {
-	*x = OrganizationDeleted{}
-	mi := &file_organizations_proto_msgTypes[40]
+func (x *ListWebhookSubscriptionsRequest) Reset() {
+	*x = ListWebhookSubscriptionsRequest{}
+	mi := &file_organizations_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrganizationDeleted) String() string {
+func (x *ListWebhookSubscriptionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrganizationDeleted) ProtoMessage() {}
+func (*ListWebhookSubscriptionsRequest) ProtoMessage() {}
 
-func (x *OrganizationDeleted) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[40]
+func (x *ListWebhookSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2051,48 +2195,40 @@ func (x *OrganizationDeleted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrganizationDeleted.ProtoReflect.Descriptor instead.
-func (*OrganizationDeleted) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ListWebhookSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *OrganizationDeleted) GetOrganizationId() string {
+func (x *ListWebhookSubscriptionsRequest) GetId() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *OrganizationDeleted) GetTimestamp() *timestamp.Timestamp {
-	if x != nil {
-		return x.Timestamp
-	}
-	return nil
-}
-
-type InvitationCreated struct {
+type ListWebhookSubscriptionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Subscriptions []*WebhookSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InvitationCreated) Reset() {
-	*x = InvitationCreated{}
-	mi := &file_organizations_proto_msgTypes[41]
+func (x *ListWebhookSubscriptionsResponse) Reset() {
+	*x = ListWebhookSubscriptionsResponse{}
+	mi := &file_organizations_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InvitationCreated) String() string {
+func (x *ListWebhookSubscriptionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvitationCreated) ProtoMessage() {}
+func (*ListWebhookSubscriptionsResponse) ProtoMessage() {}
 
-func (x *InvitationCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[41]
+func (x *ListWebhookSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2103,51 +2239,42 @@ func (x *InvitationCreated) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvitationCreated.ProtoReflect.Descriptor instead.
-func (*InvitationCreated) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{41}
-}
-
-func (x *InvitationCreated) GetInvitationId() string {
-	if x != nil {
-		return x.InvitationId
-	}
-	return ""
+// Deprecated: Use ListWebhookSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *InvitationCreated) GetTimestamp() *timestamp.Timestamp {
+func (x *ListWebhookSubscriptionsResponse) GetSubscriptions() []*WebhookSubscription {
 	if x != nil {
-		return x.Timestamp
+		return x.Subscriptions
 	}
 	return nil
 }
 
-type Organization_Metadata struct {
+type CreateWebhookSubscriptionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Organization_Metadata) Reset() {
-	*x = Organization_Metadata{}
-	mi := &file_organizations_proto_msgTypes[42]
+func (x *CreateWebhookSubscriptionRequest) Reset() {
+	*x = CreateWebhookSubscriptionRequest{}
+	mi := &file_organizations_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Organization_Metadata) String() string {
+func (x *CreateWebhookSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Organization_Metadata) ProtoMessage() {}
+func (*CreateWebhookSubscriptionRequest) ProtoMessage() {}
 
-func (x *Organization_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[42]
+func (x *CreateWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2158,71 +2285,1345 @@ func (x *Organization_Metadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Organization_Metadata.ProtoReflect.Descriptor instead.
-func (*Organization_Metadata) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use CreateWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *Organization_Metadata) GetId() string {
+func (x *CreateWebhookSubscriptionRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Organization_Metadata) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Organization_Metadata) GetDescription() string {
+func (x *CreateWebhookSubscriptionRequest) GetUrl() string {
 	if x != nil {
-		return x.Description
+		return x.Url
 	}
 	return ""
 }
 
-func (x *Organization_Metadata) GetCreatedAt() *timestamp.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *Organization_Metadata) GetUpdatedAt() *timestamp.Timestamp {
+func (x *CreateWebhookSubscriptionRequest) GetEventTypes() []string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.EventTypes
 	}
 	return nil
 }
 
-type Integration_Metadata struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+type CreateWebhookSubscriptionResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Subscription *WebhookSubscription   `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// Secret used to sign the requests sent to the webhook.
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Integration_Metadata) Reset() {
-	*x = Integration_Metadata{}
-	mi := &file_organizations_proto_msgTypes[44]
+func (x *CreateWebhookSubscriptionResponse) Reset() {
+	*x = CreateWebhookSubscriptionResponse{}
+	mi := &file_organizations_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Integration_Metadata) String() string {
+func (x *CreateWebhookSubscriptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Integration_Metadata) ProtoMessage() {}
+func (*CreateWebhookSubscriptionResponse) ProtoMessage() {}
 
-func (x *Integration_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[44]
+func (x *CreateWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateWebhookSubscriptionResponse) GetSubscription() *WebhookSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *CreateWebhookSubscriptionResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type UpdateWebhookSubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscriptionId string                 `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Url            *string                `protobuf:"bytes,3,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	EventTypes     []string               `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Enabled        *bool                  `protobuf:"varint,5,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	// Set to replace the event types, including with an empty list.
+	UpdateEventTypes bool `protobuf:"varint,6,opt,name=update_event_types,json=updateEventTypes,proto3" json:"update_event_types,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookSubscriptionRequest) Reset() {
+	*x = UpdateWebhookSubscriptionRequest{}
+	mi := &file_organizations_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdateWebhookSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateWebhookSubscriptionRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *UpdateWebhookSubscriptionRequest) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *UpdateWebhookSubscriptionRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *UpdateWebhookSubscriptionRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *UpdateWebhookSubscriptionRequest) GetUpdateEventTypes() bool {
+	if x != nil {
+		return x.UpdateEventTypes
+	}
+	return false
+}
+
+type UpdateWebhookSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *WebhookSubscription   `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookSubscriptionResponse) Reset() {
+	*x = UpdateWebhookSubscriptionResponse{}
+	mi := &file_organizations_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *UpdateWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateWebhookSubscriptionResponse) GetSubscription() *WebhookSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type DeleteWebhookSubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscriptionId string                 `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionRequest) Reset() {
+	*x = DeleteWebhookSubscriptionRequest{}
+	mi := &file_organizations_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeleteWebhookSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteWebhookSubscriptionRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+type DeleteWebhookSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionResponse) Reset() {
+	*x = DeleteWebhookSubscriptionResponse{}
+	mi := &file_organizations_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{46}
+}
+
+type ListWebhookSubscriptionDeliveriesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscriptionId string                 `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Limit          uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Before         *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionDeliveriesRequest) Reset() {
+	*x = ListWebhookSubscriptionDeliveriesRequest{}
+	mi := &file_organizations_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListWebhookSubscriptionDeliveriesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListWebhookSubscriptionDeliveriesRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *ListWebhookSubscriptionDeliveriesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListWebhookSubscriptionDeliveriesRequest) GetBefore() *timestamp.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+type ListWebhookSubscriptionDeliveriesResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Deliveries    []*WebhookSubscriptionDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	TotalCount    uint32                         `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	HasNextPage   bool                           `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	LastTimestamp *timestamp.Timestamp           `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionDeliveriesResponse) Reset() {
+	*x = ListWebhookSubscriptionDeliveriesResponse{}
+	mi := &file_organizations_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListWebhookSubscriptionDeliveriesResponse) GetDeliveries() []*WebhookSubscriptionDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListWebhookSubscriptionDeliveriesResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListWebhookSubscriptionDeliveriesResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *ListWebhookSubscriptionDeliveriesResponse) GetLastTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return nil
+}
+
+type BrowserAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	FormFields    map[string]string      `protobuf:"bytes,3,rep,name=form_fields,json=formFields,proto3" json:"form_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrowserAction) Reset() {
+	*x = BrowserAction{}
+	mi := &file_organizations_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrowserAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrowserAction) ProtoMessage() {}
+
+func (x *BrowserAction) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrowserAction.ProtoReflect.Descriptor instead.
+func (*BrowserAction) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BrowserAction) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *BrowserAction) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *BrowserAction) GetFormFields() map[string]string {
+	if x != nil {
+		return x.FormFields
+	}
+	return nil
+}
+
+func (x *BrowserAction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Event messages for organization lifecycle events
+type OrganizationCreated struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Timestamp      *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrganizationCreated) Reset() {
+	*x = OrganizationCreated{}
+	mi := &file_organizations_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationCreated) ProtoMessage() {}
+
+func (x *OrganizationCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationCreated.ProtoReflect.Descriptor instead.
+func (*OrganizationCreated) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *OrganizationCreated) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *OrganizationCreated) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type OrganizationUpdated struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Timestamp      *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrganizationUpdated) Reset() {
+	*x = OrganizationUpdated{}
+	mi := &file_organizations_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationUpdated) ProtoMessage() {}
+
+func (x *OrganizationUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationUpdated.ProtoReflect.Descriptor instead.
+func (*OrganizationUpdated) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *OrganizationUpdated) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *OrganizationUpdated) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type OrganizationDeleted struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Timestamp      *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrganizationDeleted) Reset() {
+	*x = OrganizationDeleted{}
+	mi := &file_organizations_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationDeleted) ProtoMessage() {}
+
+func (x *OrganizationDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationDeleted.ProtoReflect.Descriptor instead.
+func (*OrganizationDeleted) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *OrganizationDeleted) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *OrganizationDeleted) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type InvitationCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvitationCreated) Reset() {
+	*x = InvitationCreated{}
+	mi := &file_organizations_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvitationCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitationCreated) ProtoMessage() {}
+
+func (x *InvitationCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitationCreated.ProtoReflect.Descriptor instead.
+func (*InvitationCreated) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *InvitationCreated) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *InvitationCreated) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type StuckWork struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Kind     StuckWork_Kind         `protobuf:"varint,1,opt,name=kind,proto3,enum=Superplane.Organizations.StuckWork_Kind" json:"kind,omitempty"`
+	CanvasId string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId   string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// ID of the stuck execution or webhook.
+	// Empty for the other kinds.
+	ResourceId string `protobuf:"bytes,4,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// Number of queue items waiting, for KIND_QUEUE_ITEMS.
+	Count         int64                `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Since         *timestamp.Timestamp `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`
+	Reason        string               `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StuckWork) Reset() {
+	*x = StuckWork{}
+	mi := &file_organizations_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StuckWork) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StuckWork) ProtoMessage() {}
+
+func (x *StuckWork) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StuckWork.ProtoReflect.Descriptor instead.
+func (*StuckWork) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *StuckWork) GetKind() StuckWork_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return StuckWork_KIND_UNKNOWN
+}
+
+func (x *StuckWork) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *StuckWork) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *StuckWork) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *StuckWork) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StuckWork) GetSince() *timestamp.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *StuckWork) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListStuckWorkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStuckWorkRequest) Reset() {
+	*x = ListStuckWorkRequest{}
+	mi := &file_organizations_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStuckWorkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStuckWorkRequest) ProtoMessage() {}
+
+func (x *ListStuckWorkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStuckWorkRequest.ProtoReflect.Descriptor instead.
+func (*ListStuckWorkRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListStuckWorkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListStuckWorkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*StuckWork           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStuckWorkResponse) Reset() {
+	*x = ListStuckWorkResponse{}
+	mi := &file_organizations_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStuckWorkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStuckWorkResponse) ProtoMessage() {}
+
+func (x *ListStuckWorkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStuckWorkResponse.ProtoReflect.Descriptor instead.
+func (*ListStuckWorkResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListStuckWorkResponse) GetItems() []*StuckWork {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ExportOrganizationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Secret values are only exported if requested, by users allowed
+	// to read secrets, and are encrypted to this PEM-encoded RSA public key.
+	IncludeSecretValues bool   `protobuf:"varint,2,opt,name=include_secret_values,json=includeSecretValues,proto3" json:"include_secret_values,omitempty"`
+	PublicKey           string `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ExportOrganizationRequest) Reset() {
+	*x = ExportOrganizationRequest{}
+	mi := &file_organizations_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOrganizationRequest) ProtoMessage() {}
+
+func (x *ExportOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*ExportOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ExportOrganizationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExportOrganizationRequest) GetIncludeSecretValues() bool {
+	if x != nil {
+		return x.IncludeSecretValues
+	}
+	return false
+}
+
+func (x *ExportOrganizationRequest) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+type ExportOrganizationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// JSON document with the exported resources.
+	Archive       []byte `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportOrganizationResponse) Reset() {
+	*x = ExportOrganizationResponse{}
+	mi := &file_organizations_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOrganizationResponse) ProtoMessage() {}
+
+func (x *ExportOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*ExportOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ExportOrganizationResponse) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+type ImportOrganizationRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Archive []byte                 `protobuf:"bytes,2,opt,name=archive,proto3" json:"archive,omitempty"`
+	// Values of the secrets, by secret name, decrypted by the client
+	// from the secret values of the archive. Secrets without values
+	// are created with their keys, but empty values.
+	SecretValues  map[string]*SecretValues `protobuf:"bytes,3,rep,name=secret_values,json=secretValues,proto3" json:"secret_values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOrganizationRequest) Reset() {
+	*x = ImportOrganizationRequest{}
+	mi := &file_organizations_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOrganizationRequest) ProtoMessage() {}
+
+func (x *ImportOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*ImportOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ImportOrganizationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportOrganizationRequest) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *ImportOrganizationRequest) GetSecretValues() map[string]*SecretValues {
+	if x != nil {
+		return x.SecretValues
+	}
+	return nil
+}
+
+type SecretValues struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          map[string]string      `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretValues) Reset() {
+	*x = SecretValues{}
+	mi := &file_organizations_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretValues) ProtoMessage() {}
+
+func (x *SecretValues) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretValues.ProtoReflect.Descriptor instead.
+func (*SecretValues) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SecretValues) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ImportedResource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  ImportedResource_Kind  `protobuf:"varint,1,opt,name=kind,proto3,enum=Superplane.Organizations.ImportedResource_Kind" json:"kind,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// IDs in the archive and in this organization.
+	// Empty for resources identified only by name.
+	ArchiveId string `protobuf:"bytes,3,opt,name=archive_id,json=archiveId,proto3" json:"archive_id,omitempty"`
+	Id        string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	// Resources with the same name already present
+	// are not changed, and the existing one is used.
+	Skipped       bool `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportedResource) Reset() {
+	*x = ImportedResource{}
+	mi := &file_organizations_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportedResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedResource) ProtoMessage() {}
+
+func (x *ImportedResource) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedResource.ProtoReflect.Descriptor instead.
+func (*ImportedResource) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ImportedResource) GetKind() ImportedResource_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return ImportedResource_KIND_UNKNOWN
+}
+
+func (x *ImportedResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportedResource) GetArchiveId() string {
+	if x != nil {
+		return x.ArchiveId
+	}
+	return ""
+}
+
+func (x *ImportedResource) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportedResource) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+type ImportOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resources     []*ImportedResource    `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	Warnings      []string               `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOrganizationResponse) Reset() {
+	*x = ImportOrganizationResponse{}
+	mi := &file_organizations_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOrganizationResponse) ProtoMessage() {}
+
+func (x *ImportOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*ImportOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ImportOrganizationResponse) GetResources() []*ImportedResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ImportOrganizationResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type Organization_Metadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Organization_Metadata) Reset() {
+	*x = Organization_Metadata{}
+	mi := &file_organizations_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Organization_Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization_Metadata) ProtoMessage() {}
+
+func (x *Organization_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization_Metadata.ProtoReflect.Descriptor instead.
+func (*Organization_Metadata) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Organization_Metadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Organization_Metadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Organization_Metadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Organization_Metadata) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Organization_Metadata) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Integration_Metadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Integration_Metadata) Reset() {
+	*x = Integration_Metadata{}
+	mi := &file_organizations_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Integration_Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Integration_Metadata) ProtoMessage() {}
+
+func (x *Integration_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2276,7 +3677,7 @@ type Integration_Spec struct {
 
 func (x *Integration_Spec) Reset() {
 	*x = Integration_Spec{}
-	mi := &file_organizations_proto_msgTypes[45]
+	mi := &file_organizations_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2288,7 +3689,7 @@ func (x *Integration_Spec) String() string {
 func (*Integration_Spec) ProtoMessage() {}
 
 func (x *Integration_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[45]
+	mi := &file_organizations_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2331,7 +3732,7 @@ type Integration_Status struct {
 
 func (x *Integration_Status) Reset() {
 	*x = Integration_Status{}
-	mi := &file_organizations_proto_msgTypes[46]
+	mi := &file_organizations_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2343,7 +3744,7 @@ func (x *Integration_Status) String() string {
 func (*Integration_Status) ProtoMessage() {}
 
 func (x *Integration_Status) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[46]
+	mi := &file_organizations_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2406,7 +3807,7 @@ type Integration_NodeRef struct {
 
 func (x *Integration_NodeRef) Reset() {
 	*x = Integration_NodeRef{}
-	mi := &file_organizations_proto_msgTypes[47]
+	mi := &file_organizations_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2418,7 +3819,7 @@ func (x *Integration_NodeRef) String() string {
 func (*Integration_NodeRef) ProtoMessage() {}
 
 func (x *Integration_NodeRef) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[47]
+	mi := &file_organizations_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2609,7 +4010,74 @@ const file_organizations_proto_rawDesc = "" +
 	"\vcanvas_name\x18\x02 \x01(\tR\n" +
 	"canvasName\x12\x17\n" +
 	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x12\x1b\n" +
-	"\tnode_name\x18\x04 \x01(\tR\bnodeName\"\xf4\x01\n" +
+	"\tnode_name\x18\x04 \x01(\tR\bnodeName\"\xe8\x01\n" +
+	"\x13WebhookSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb8\x03\n" +
+	"\x1bWebhookSubscriptionDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x1a\n" +
+	"\battempts\x18\x04 \x01(\x05R\battempts\x12(\n" +
+	"\x10last_status_code\x18\x05 \x01(\x05R\x0elastStatusCode\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x121\n" +
+	"\apayload\x18\a \x01(\v2\x17.google.protobuf.StructR\apayload\x12B\n" +
+	"\x0fnext_attempt_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12=\n" +
+	"\fdelivered_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"1\n" +
+	"\x1fListWebhookSubscriptionsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"w\n" +
+	" ListWebhookSubscriptionsResponse\x12S\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2-.Superplane.Organizations.WebhookSubscriptionR\rsubscriptions\"e\n" +
+	" CreateWebhookSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\"\x8e\x01\n" +
+	"!CreateWebhookSubscriptionResponse\x12Q\n" +
+	"\fsubscription\x18\x01 \x01(\v2-.Superplane.Organizations.WebhookSubscriptionR\fsubscription\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"\xf4\x01\n" +
+	" UpdateWebhookSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\x12\x15\n" +
+	"\x03url\x18\x03 \x01(\tH\x00R\x03url\x88\x01\x01\x12\x1f\n" +
+	"\vevent_types\x18\x04 \x03(\tR\n" +
+	"eventTypes\x12\x1d\n" +
+	"\aenabled\x18\x05 \x01(\bH\x01R\aenabled\x88\x01\x01\x12,\n" +
+	"\x12update_event_types\x18\x06 \x01(\bR\x10updateEventTypesB\x06\n" +
+	"\x04_urlB\n" +
+	"\n" +
+	"\b_enabled\"v\n" +
+	"!UpdateWebhookSubscriptionResponse\x12Q\n" +
+	"\fsubscription\x18\x01 \x01(\v2-.Superplane.Organizations.WebhookSubscriptionR\fsubscription\"[\n" +
+	" DeleteWebhookSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\"#\n" +
+	"!DeleteWebhookSubscriptionResponse\"\xad\x01\n" +
+	"(ListWebhookSubscriptionDeliveriesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\x122\n" +
+	"\x06before\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\"\x8a\x02\n" +
+	")ListWebhookSubscriptionDeliveriesResponse\x12U\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v25.Superplane.Organizations.WebhookSubscriptionDeliveryR\n" +
+	"deliveries\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\rR\n" +
+	"totalCount\x12\"\n" +
+	"\rhas_next_page\x18\x03 \x01(\bR\vhasNextPage\x12A\n" +
+	"\x0elast_timestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\"\xf4\x01\n" +
 	"\rBrowserAction\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
 	"\x06method\x18\x02 \x01(\tR\x06method\x12X\n" +
@@ -2630,7 +4098,64 @@ const file_organizations_proto_rawDesc = "" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"r\n" +
 	"\x11InvitationCreated\x12#\n" +
 	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\x128\n" +
-	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\xbe%\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x80\x03\n" +
+	"\tStuckWork\x12<\n" +
+	"\x04kind\x18\x01 \x01(\x0e2(.Superplane.Organizations.StuckWork.KindR\x04kind\x12\x1b\n" +
+	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x12\x1f\n" +
+	"\vresource_id\x18\x04 \x01(\tR\n" +
+	"resourceId\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\x03R\x05count\x120\n" +
+	"\x05since\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\"~\n" +
+	"\x04Kind\x12\x10\n" +
+	"\fKIND_UNKNOWN\x10\x00\x12\x18\n" +
+	"\x14KIND_NODE_PROCESSING\x10\x01\x12\x14\n" +
+	"\x10KIND_QUEUE_ITEMS\x10\x02\x12\x1a\n" +
+	"\x16KIND_EXECUTION_STARTED\x10\x03\x12\x18\n" +
+	"\x14KIND_WEBHOOK_PENDING\x10\x04\"&\n" +
+	"\x14ListStuckWorkRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"R\n" +
+	"\x15ListStuckWorkResponse\x129\n" +
+	"\x05items\x18\x01 \x03(\v2#.Superplane.Organizations.StuckWorkR\x05items\"~\n" +
+	"\x19ExportOrganizationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
+	"\x15include_secret_values\x18\x02 \x01(\bR\x13includeSecretValues\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x03 \x01(\tR\tpublicKey\"6\n" +
+	"\x1aExportOrganizationResponse\x12\x18\n" +
+	"\aarchive\x18\x01 \x01(\fR\aarchive\"\x9a\x02\n" +
+	"\x19ImportOrganizationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aarchive\x18\x02 \x01(\fR\aarchive\x12j\n" +
+	"\rsecret_values\x18\x03 \x03(\v2E.Superplane.Organizations.ImportOrganizationRequest.SecretValuesEntryR\fsecretValues\x1ag\n" +
+	"\x11SecretValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12<\n" +
+	"\x05value\x18\x02 \x01(\v2&.Superplane.Organizations.SecretValuesR\x05value:\x028\x01\"\x8d\x01\n" +
+	"\fSecretValues\x12D\n" +
+	"\x04data\x18\x01 \x03(\v20.Superplane.Organizations.SecretValues.DataEntryR\x04data\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xba\x02\n" +
+	"\x10ImportedResource\x12C\n" +
+	"\x04kind\x18\x01 \x01(\x0e2/.Superplane.Organizations.ImportedResource.KindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"archive_id\x18\x03 \x01(\tR\tarchiveId\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\tR\x02id\x12\x18\n" +
+	"\askipped\x18\x05 \x01(\bR\askipped\"\x83\x01\n" +
+	"\x04Kind\x12\x10\n" +
+	"\fKIND_UNKNOWN\x10\x00\x12\r\n" +
+	"\tKIND_ROLE\x10\x01\x12\x0e\n" +
+	"\n" +
+	"KIND_GROUP\x10\x02\x12\x14\n" +
+	"\x10KIND_INTEGRATION\x10\x03\x12\x0f\n" +
+	"\vKIND_SECRET\x10\x04\x12\x12\n" +
+	"\x0eKIND_BLUEPRINT\x10\x05\x12\x0f\n" +
+	"\vKIND_CANVAS\x10\x06\"\x82\x01\n" +
+	"\x1aImportOrganizationResponse\x12H\n" +
+	"\tresources\x18\x01 \x03(\v2*.Superplane.Organizations.ImportedResourceR\tresources\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings2\x9e:\n" +
 	"\rOrganizations\x12\xa7\x02\n" +
 	"\x14DescribeOrganization\x125.Superplane.Organizations.DescribeOrganizationRequest\x1a6.Superplane.Organizations.DescribeOrganizationResponse\"\x9f\x01\x92Az\n" +
 	"\fOrganization\x12\x18Get organization details\x1aPReturns the details of a specific organization (can be referenced by ID or name)\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/organizations/{id}\x12\x96\x02\n" +
@@ -2666,7 +4191,23 @@ const file_organizations_proto_rawDesc = "" +
 	"\x11UpdateIntegration\x122.Superplane.Organizations.UpdateIntegrationRequest\x1a3.Superplane.Organizations.UpdateIntegrationResponse\"\xa3\x01\x92A]\n" +
 	"\fOrganization\x12\x12Update integration\x1a9Updates the configuration for an organization integration\x82\xd3\xe4\x93\x02=:\x01*28/api/v1/organizations/{id}/integrations/{integration_id}\x12\x9e\x02\n" +
 	"\x11DeleteIntegration\x122.Superplane.Organizations.DeleteIntegrationRequest\x1a3.Superplane.Organizations.DeleteIntegrationResponse\"\x9f\x01\x92A\\\n" +
-	"\fOrganization\x12\x1fDelete organization integration\x1a+Deletes an integration from an organization\x82\xd3\xe4\x93\x02:*8/api/v1/organizations/{id}/integrations/{integration_id}B\xf0\x01\x92A\xaf\x01\x12\x84\x01\n" +
+	"\fOrganization\x12\x1fDelete organization integration\x1a+Deletes an integration from an organization\x82\xd3\xe4\x93\x02:*8/api/v1/organizations/{id}/integrations/{integration_id}\x12\xb9\x02\n" +
+	"\x18ListWebhookSubscriptions\x129.Superplane.Organizations.ListWebhookSubscriptionsRequest\x1a:.Superplane.Organizations.ListWebhookSubscriptionsResponse\"\xa5\x01\x92Aj\n" +
+	"\fOrganization\x12\x1aList webhook subscriptions\x1a>Lists the webhooks that receive activity from the organization\x82\xd3\xe4\x93\x022\x120/api/v1/organizations/{id}/webhook-subscriptions\x12\xec\x02\n" +
+	"\x19CreateWebhookSubscription\x12:.Superplane.Organizations.CreateWebhookSubscriptionRequest\x1a;.Superplane.Organizations.CreateWebhookSubscriptionResponse\"\xd5\x01\x92A\x96\x01\n" +
+	"\fOrganization\x12\x1bCreate webhook subscription\x1aiCreates a webhook that receives activity from the organization. The signing secret is only returned here.\x82\xd3\xe4\x93\x025:\x01*\"0/api/v1/organizations/{id}/webhook-subscriptions\x12\xd3\x02\n" +
+	"\x19UpdateWebhookSubscription\x12:.Superplane.Organizations.UpdateWebhookSubscriptionRequest\x1a;.Superplane.Organizations.UpdateWebhookSubscriptionResponse\"\xbc\x01\x92Al\n" +
+	"\fOrganization\x12\x1bUpdate webhook subscription\x1a?Updates the URL, event types or state of a webhook subscription\x82\xd3\xe4\x93\x02G:\x01*2B/api/v1/organizations/{id}/webhook-subscriptions/{subscription_id}\x12\xc4\x02\n" +
+	"\x19DeleteWebhookSubscription\x12:.Superplane.Organizations.DeleteWebhookSubscriptionRequest\x1a;.Superplane.Organizations.DeleteWebhookSubscriptionResponse\"\xad\x01\x92A`\n" +
+	"\fOrganization\x12\x1bDelete webhook subscription\x1a3Deletes a webhook subscription and its delivery log\x82\xd3\xe4\x93\x02D*B/api/v1/organizations/{id}/webhook-subscriptions/{subscription_id}\x12\xf8\x02\n" +
+	"!ListWebhookSubscriptionDeliveries\x12B.Superplane.Organizations.ListWebhookSubscriptionDeliveriesRequest\x1aC.Superplane.Organizations.ListWebhookSubscriptionDeliveriesResponse\"\xc9\x01\x92Aq\n" +
+	"\fOrganization\x12$List webhook subscription deliveries\x1a;Lists the most recent deliveries for a webhook subscription\x82\xd3\xe4\x93\x02O\x12M/api/v1/organizations/{id}/webhook-subscriptions/{subscription_id}/deliveries\x12\xa8\x02\n" +
+	"\rListStuckWork\x12..Superplane.Organizations.ListStuckWorkRequest\x1a/.Superplane.Organizations.ListStuckWorkResponse\"\xb5\x01\x92A\x84\x01\n" +
+	"\fOrganization\x12\x0fList stuck work\x1acLists nodes, queue items, executions and webhooks that have not progressed for longer than expected\x82\xd3\xe4\x93\x02'\x12%/api/v1/organizations/{id}/stuck-work\x12\xcc\x02\n" +
+	"\x12ExportOrganization\x123.Superplane.Organizations.ExportOrganizationRequest\x1a4.Superplane.Organizations.ExportOrganizationResponse\"\xca\x01\x92A\x9a\x01\n" +
+	"\fOrganization\x12\x16Export an organization\x1arExports the canvases, blueprints, roles, groups, integrations and secrets of an organization to a portable archive\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/organizations/{id}/export\x12\xa0\x02\n" +
+	"\x12ImportOrganization\x123.Superplane.Organizations.ImportOrganizationRequest\x1a4.Superplane.Organizations.ImportOrganizationResponse\"\x9e\x01\x92Ao\n" +
+	"\fOrganization\x12\x16Import an organization\x1aGRecreates the resources of an organization archive in this organization\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/organizations/{id}/importB\xf0\x01\x92A\xaf\x01\x12\x84\x01\n" +
 	"\x1cSuperplane Organizations API\x128API for managing organizations in the Superplane service\"%\n" +
 	"\vAPI Support\x1a\x16support@superplane.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ;github.com/superplanehq/superplane/pkg/protos/organizationsb\x06proto3"
 
@@ -2682,136 +4223,198 @@ func file_organizations_proto_rawDescGZIP() []byte {
 	return file_organizations_proto_rawDescData
 }
 
-var file_organizations_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
+var file_organizations_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_organizations_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
 var file_organizations_proto_goTypes = []any{
-	(*Organization)(nil),                     // 0: Superplane.Organizations.Organization
-	(*DescribeOrganizationRequest)(nil),      // 1: Superplane.Organizations.DescribeOrganizationRequest
-	(*DescribeOrganizationResponse)(nil),     // 2: Superplane.Organizations.DescribeOrganizationResponse
-	(*UpdateOrganizationRequest)(nil),        // 3: Superplane.Organizations.UpdateOrganizationRequest
-	(*UpdateOrganizationResponse)(nil),       // 4: Superplane.Organizations.UpdateOrganizationResponse
-	(*DeleteOrganizationRequest)(nil),        // 5: Superplane.Organizations.DeleteOrganizationRequest
-	(*DeleteOrganizationResponse)(nil),       // 6: Superplane.Organizations.DeleteOrganizationResponse
-	(*Invitation)(nil),                       // 7: Superplane.Organizations.Invitation
-	(*InviteLink)(nil),                       // 8: Superplane.Organizations.InviteLink
-	(*CreateInvitationRequest)(nil),          // 9: Superplane.Organizations.CreateInvitationRequest
-	(*CreateInvitationResponse)(nil),         // 10: Superplane.Organizations.CreateInvitationResponse
-	(*ListInvitationsRequest)(nil),           // 11: Superplane.Organizations.ListInvitationsRequest
-	(*ListInvitationsResponse)(nil),          // 12: Superplane.Organizations.ListInvitationsResponse
-	(*RemoveInvitationRequest)(nil),          // 13: Superplane.Organizations.RemoveInvitationRequest
-	(*RemoveInvitationResponse)(nil),         // 14: Superplane.Organizations.RemoveInvitationResponse
-	(*GetInviteLinkRequest)(nil),             // 15: Superplane.Organizations.GetInviteLinkRequest
-	(*GetInviteLinkResponse)(nil),            // 16: Superplane.Organizations.GetInviteLinkResponse
-	(*UpdateInviteLinkRequest)(nil),          // 17: Superplane.Organizations.UpdateInviteLinkRequest
-	(*UpdateInviteLinkResponse)(nil),         // 18: Superplane.Organizations.UpdateInviteLinkResponse
-	(*ResetInviteLinkRequest)(nil),           // 19: Superplane.Organizations.ResetInviteLinkRequest
-	(*ResetInviteLinkResponse)(nil),          // 20: Superplane.Organizations.ResetInviteLinkResponse
-	(*RemoveUserRequest)(nil),                // 21: Superplane.Organizations.RemoveUserRequest
-	(*RemoveUserResponse)(nil),               // 22: Superplane.Organizations.RemoveUserResponse
-	(*ListIntegrationsRequest)(nil),          // 23: Superplane.Organizations.ListIntegrationsRequest
-	(*ListIntegrationsResponse)(nil),         // 24: Superplane.Organizations.ListIntegrationsResponse
-	(*CreateIntegrationRequest)(nil),         // 25: Superplane.Organizations.CreateIntegrationRequest
-	(*CreateIntegrationResponse)(nil),        // 26: Superplane.Organizations.CreateIntegrationResponse
-	(*DescribeIntegrationRequest)(nil),       // 27: Superplane.Organizations.DescribeIntegrationRequest
-	(*DescribeIntegrationResponse)(nil),      // 28: Superplane.Organizations.DescribeIntegrationResponse
-	(*ListIntegrationResourcesRequest)(nil),  // 29: Superplane.Organizations.ListIntegrationResourcesRequest
-	(*ListIntegrationResourcesResponse)(nil), // 30: Superplane.Organizations.ListIntegrationResourcesResponse
-	(*IntegrationResourceRef)(nil),           // 31: Superplane.Organizations.IntegrationResourceRef
-	(*UpdateIntegrationRequest)(nil),         // 32: Superplane.Organizations.UpdateIntegrationRequest
-	(*UpdateIntegrationResponse)(nil),        // 33: Superplane.Organizations.UpdateIntegrationResponse
-	(*DeleteIntegrationRequest)(nil),         // 34: Superplane.Organizations.DeleteIntegrationRequest
-	(*DeleteIntegrationResponse)(nil),        // 35: Superplane.Organizations.DeleteIntegrationResponse
-	(*Integration)(nil),                      // 36: Superplane.Organizations.Integration
-	(*BrowserAction)(nil),                    // 37: Superplane.Organizations.BrowserAction
-	(*OrganizationCreated)(nil),              // 38: Superplane.Organizations.OrganizationCreated
-	(*OrganizationUpdated)(nil),              // 39: Superplane.Organizations.OrganizationUpdated
-	(*OrganizationDeleted)(nil),              // 40: Superplane.Organizations.OrganizationDeleted
-	(*InvitationCreated)(nil),                // 41: Superplane.Organizations.InvitationCreated
-	(*Organization_Metadata)(nil),            // 42: Superplane.Organizations.Organization.Metadata
-	nil,                                      // 43: Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
-	(*Integration_Metadata)(nil),             // 44: Superplane.Organizations.Integration.Metadata
-	(*Integration_Spec)(nil),                 // 45: Superplane.Organizations.Integration.Spec
-	(*Integration_Status)(nil),               // 46: Superplane.Organizations.Integration.Status
-	(*Integration_NodeRef)(nil),              // 47: Superplane.Organizations.Integration.NodeRef
-	nil,                                      // 48: Superplane.Organizations.BrowserAction.FormFieldsEntry
-	(*timestamp.Timestamp)(nil),              // 49: google.protobuf.Timestamp
-	(*_struct.Struct)(nil),                   // 50: google.protobuf.Struct
+	(StuckWork_Kind)(0),                               // 0: Superplane.Organizations.StuckWork.Kind
+	(ImportedResource_Kind)(0),                        // 1: Superplane.Organizations.ImportedResource.Kind
+	(*Organization)(nil),                              // 2: Superplane.Organizations.Organization
+	(*DescribeOrganizationRequest)(nil),               // 3: Superplane.Organizations.DescribeOrganizationRequest
+	(*DescribeOrganizationResponse)(nil),              // 4: Superplane.Organizations.DescribeOrganizationResponse
+	(*UpdateOrganizationRequest)(nil),                 // 5: Superplane.Organizations.UpdateOrganizationRequest
+	(*UpdateOrganizationResponse)(nil),                // 6: Superplane.Organizations.UpdateOrganizationResponse
+	(*DeleteOrganizationRequest)(nil),                 // 7: Superplane.Organizations.DeleteOrganizationRequest
+	(*DeleteOrganizationResponse)(nil),                // 8: Superplane.Organizations.DeleteOrganizationResponse
+	(*Invitation)(nil),                                // 9: Superplane.Organizations.Invitation
+	(*InviteLink)(nil),                                // 10: Superplane.Organizations.InviteLink
+	(*CreateInvitationRequest)(nil),                   // 11: Superplane.Organizations.CreateInvitationRequest
+	(*CreateInvitationResponse)(nil),                  // 12: Superplane.Organizations.CreateInvitationResponse
+	(*ListInvitationsRequest)(nil),                    // 13: Superplane.Organizations.ListInvitationsRequest
+	(*ListInvitationsResponse)(nil),                   // 14: Superplane.Organizations.ListInvitationsResponse
+	(*RemoveInvitationRequest)(nil),                   // 15: Superplane.Organizations.RemoveInvitationRequest
+	(*RemoveInvitationResponse)(nil),                  // 16: Superplane.Organizations.RemoveInvitationResponse
+	(*GetInviteLinkRequest)(nil),                      // 17: Superplane.Organizations.GetInviteLinkRequest
+	(*GetInviteLinkResponse)(nil),                     // 18: Superplane.Organizations.GetInviteLinkResponse
+	(*UpdateInviteLinkRequest)(nil),                   // 19: Superplane.Organizations.UpdateInviteLinkRequest
+	(*UpdateInviteLinkResponse)(nil),                  // 20: Superplane.Organizations.UpdateInviteLinkResponse
+	(*ResetInviteLinkRequest)(nil),                    // 21: Superplane.Organizations.ResetInviteLinkRequest
+	(*ResetInviteLinkResponse)(nil),                   // 22: Superplane.Organizations.ResetInviteLinkResponse
+	(*RemoveUserRequest)(nil),                         // 23: Superplane.Organizations.RemoveUserRequest
+	(*RemoveUserResponse)(nil),                        // 24: Superplane.Organizations.RemoveUserResponse
+	(*ListIntegrationsRequest)(nil),                   // 25: Superplane.Organizations.ListIntegrationsRequest
+	(*ListIntegrationsResponse)(nil),                  // 26: Superplane.Organizations.ListIntegrationsResponse
+	(*CreateIntegrationRequest)(nil),                  // 27: Superplane.Organizations.CreateIntegrationRequest
+	(*CreateIntegrationResponse)(nil),                 // 28: Superplane.Organizations.CreateIntegrationResponse
+	(*DescribeIntegrationRequest)(nil),                // 29: Superplane.Organizations.DescribeIntegrationRequest
+	(*DescribeIntegrationResponse)(nil),               // 30: Superplane.Organizations.DescribeIntegrationResponse
+	(*ListIntegrationResourcesRequest)(nil),           // 31: Superplane.Organizations.ListIntegrationResourcesRequest
+	(*ListIntegrationResourcesResponse)(nil),          // 32: Superplane.Organizations.ListIntegrationResourcesResponse
+	(*IntegrationResourceRef)(nil),                    // 33: Superplane.Organizations.IntegrationResourceRef
+	(*UpdateIntegrationRequest)(nil),                  // 34: Superplane.Organizations.UpdateIntegrationRequest
+	(*UpdateIntegrationResponse)(nil),                 // 35: Superplane.Organizations.UpdateIntegrationResponse
+	(*DeleteIntegrationRequest)(nil),                  // 36: Superplane.Organizations.DeleteIntegrationRequest
+	(*DeleteIntegrationResponse)(nil),                 // 37: Superplane.Organizations.DeleteIntegrationResponse
+	(*Integration)(nil),                               // 38: Superplane.Organizations.Integration
+	(*WebhookSubscription)(nil),                       // 39: Superplane.Organizations.WebhookSubscription
+	(*WebhookSubscriptionDelivery)(nil),               // 40: Superplane.Organizations.WebhookSubscriptionDelivery
+	(*ListWebhookSubscriptionsRequest)(nil),           // 41: Superplane.Organizations.ListWebhookSubscriptionsRequest
+	(*ListWebhookSubscriptionsResponse)(nil),          // 42: Superplane.Organizations.ListWebhookSubscriptionsResponse
+	(*CreateWebhookSubscriptionRequest)(nil),          // 43: Superplane.Organizations.CreateWebhookSubscriptionRequest
+	(*CreateWebhookSubscriptionResponse)(nil),         // 44: Superplane.Organizations.CreateWebhookSubscriptionResponse
+	(*UpdateWebhookSubscriptionRequest)(nil),          // 45: Superplane.Organizations.UpdateWebhookSubscriptionRequest
+	(*UpdateWebhookSubscriptionResponse)(nil),         // 46: Superplane.Organizations.UpdateWebhookSubscriptionResponse
+	(*DeleteWebhookSubscriptionRequest)(nil),          // 47: Superplane.Organizations.DeleteWebhookSubscriptionRequest
+	(*DeleteWebhookSubscriptionResponse)(nil),         // 48: Superplane.Organizations.DeleteWebhookSubscriptionResponse
+	(*ListWebhookSubscriptionDeliveriesRequest)(nil),  // 49: Superplane.Organizations.ListWebhookSubscriptionDeliveriesRequest
+	(*ListWebhookSubscriptionDeliveriesResponse)(nil), // 50: Superplane.Organizations.ListWebhookSubscriptionDeliveriesResponse
+	(*BrowserAction)(nil),                             // 51: Superplane.Organizations.BrowserAction
+	(*OrganizationCreated)(nil),                       // 52: Superplane.Organizations.OrganizationCreated
+	(*OrganizationUpdated)(nil),                       // 53: Superplane.Organizations.OrganizationUpdated
+	(*OrganizationDeleted)(nil),                       // 54: Superplane.Organizations.OrganizationDeleted
+	(*InvitationCreated)(nil),                         // 55: Superplane.Organizations.InvitationCreated
+	(*StuckWork)(nil),                                 // 56: Superplane.Organizations.StuckWork
+	(*ListStuckWorkRequest)(nil),                      // 57: Superplane.Organizations.ListStuckWorkRequest
+	(*ListStuckWorkResponse)(nil),                     // 58: Superplane.Organizations.ListStuckWorkResponse
+	(*ExportOrganizationRequest)(nil),                 // 59: Superplane.Organizations.ExportOrganizationRequest
+	(*ExportOrganizationResponse)(nil),                // 60: Superplane.Organizations.ExportOrganizationResponse
+	(*ImportOrganizationRequest)(nil),                 // 61: Superplane.Organizations.ImportOrganizationRequest
+	(*SecretValues)(nil),                              // 62: Superplane.Organizations.SecretValues
+	(*ImportedResource)(nil),                          // 63: Superplane.Organizations.ImportedResource
+	(*ImportOrganizationResponse)(nil),                // 64: Superplane.Organizations.ImportOrganizationResponse
+	(*Organization_Metadata)(nil),                     // 65: Superplane.Organizations.Organization.Metadata
+	nil,                                               // 66: Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
+	(*Integration_Metadata)(nil),                      // 67: Superplane.Organizations.Integration.Metadata
+	(*Integration_Spec)(nil),                          // 68: Superplane.Organizations.Integration.Spec
+	(*Integration_Status)(nil),                        // 69: Superplane.Organizations.Integration.Status
+	(*Integration_NodeRef)(nil),                       // 70: Superplane.Organizations.Integration.NodeRef
+	nil,                                               // 71: Superplane.Organizations.BrowserAction.FormFieldsEntry
+	nil,                                               // 72: Superplane.Organizations.ImportOrganizationRequest.SecretValuesEntry
+	nil,                                               // 73: Superplane.Organizations.SecretValues.DataEntry
+	(*timestamp.Timestamp)(nil),                       // 74: google.protobuf.Timestamp
+	(*_struct.Struct)(nil),                            // 75: google.protobuf.Struct
 }
 var file_organizations_proto_depIdxs = []int32{
-	42, // 0: Superplane.Organizations.Organization.metadata:type_name -> Superplane.Organizations.Organization.Metadata
-	0,  // 1: Superplane.Organizations.DescribeOrganizationResponse.organization:type_name -> Superplane.Organizations.Organization
-	0,  // 2: Superplane.Organizations.UpdateOrganizationRequest.organization:type_name -> Superplane.Organizations.Organization
-	0,  // 3: Superplane.Organizations.UpdateOrganizationResponse.organization:type_name -> Superplane.Organizations.Organization
-	49, // 4: Superplane.Organizations.Invitation.created_at:type_name -> google.protobuf.Timestamp
-	49, // 5: Superplane.Organizations.InviteLink.created_at:type_name -> google.protobuf.Timestamp
-	49, // 6: Superplane.Organizations.InviteLink.updated_at:type_name -> google.protobuf.Timestamp
-	7,  // 7: Superplane.Organizations.CreateInvitationResponse.invitation:type_name -> Superplane.Organizations.Invitation
-	7,  // 8: Superplane.Organizations.ListInvitationsResponse.invitations:type_name -> Superplane.Organizations.Invitation
-	8,  // 9: Superplane.Organizations.GetInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
-	8,  // 10: Superplane.Organizations.UpdateInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
-	8,  // 11: Superplane.Organizations.ResetInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
-	36, // 12: Superplane.Organizations.ListIntegrationsResponse.integrations:type_name -> Superplane.Organizations.Integration
-	50, // 13: Superplane.Organizations.CreateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
-	36, // 14: Superplane.Organizations.CreateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
-	36, // 15: Superplane.Organizations.DescribeIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
-	43, // 16: Superplane.Organizations.ListIntegrationResourcesRequest.parameters:type_name -> Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
-	31, // 17: Superplane.Organizations.ListIntegrationResourcesResponse.resources:type_name -> Superplane.Organizations.IntegrationResourceRef
-	50, // 18: Superplane.Organizations.UpdateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
-	36, // 19: Superplane.Organizations.UpdateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
-	44, // 20: Superplane.Organizations.Integration.metadata:type_name -> Superplane.Organizations.Integration.Metadata
-	45, // 21: Superplane.Organizations.Integration.spec:type_name -> Superplane.Organizations.Integration.Spec
-	46, // 22: Superplane.Organizations.Integration.status:type_name -> Superplane.Organizations.Integration.Status
-	48, // 23: Superplane.Organizations.BrowserAction.form_fields:type_name -> Superplane.Organizations.BrowserAction.FormFieldsEntry
-	49, // 24: Superplane.Organizations.OrganizationCreated.timestamp:type_name -> google.protobuf.Timestamp
-	49, // 25: Superplane.Organizations.OrganizationUpdated.timestamp:type_name -> google.protobuf.Timestamp
-	49, // 26: Superplane.Organizations.OrganizationDeleted.timestamp:type_name -> google.protobuf.Timestamp
-	49, // 27: Superplane.Organizations.InvitationCreated.timestamp:type_name -> google.protobuf.Timestamp
-	49, // 28: Superplane.Organizations.Organization.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	49, // 29: Superplane.Organizations.Organization.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	49, // 30: Superplane.Organizations.Integration.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	49, // 31: Superplane.Organizations.Integration.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	50, // 32: Superplane.Organizations.Integration.Spec.configuration:type_name -> google.protobuf.Struct
-	50, // 33: Superplane.Organizations.Integration.Status.metadata:type_name -> google.protobuf.Struct
-	37, // 34: Superplane.Organizations.Integration.Status.browser_action:type_name -> Superplane.Organizations.BrowserAction
-	47, // 35: Superplane.Organizations.Integration.Status.used_in:type_name -> Superplane.Organizations.Integration.NodeRef
-	1,  // 36: Superplane.Organizations.Organizations.DescribeOrganization:input_type -> Superplane.Organizations.DescribeOrganizationRequest
-	3,  // 37: Superplane.Organizations.Organizations.UpdateOrganization:input_type -> Superplane.Organizations.UpdateOrganizationRequest
-	5,  // 38: Superplane.Organizations.Organizations.DeleteOrganization:input_type -> Superplane.Organizations.DeleteOrganizationRequest
-	21, // 39: Superplane.Organizations.Organizations.RemoveUser:input_type -> Superplane.Organizations.RemoveUserRequest
-	9,  // 40: Superplane.Organizations.Organizations.CreateInvitation:input_type -> Superplane.Organizations.CreateInvitationRequest
-	11, // 41: Superplane.Organizations.Organizations.ListInvitations:input_type -> Superplane.Organizations.ListInvitationsRequest
-	13, // 42: Superplane.Organizations.Organizations.RemoveInvitation:input_type -> Superplane.Organizations.RemoveInvitationRequest
-	15, // 43: Superplane.Organizations.Organizations.GetInviteLink:input_type -> Superplane.Organizations.GetInviteLinkRequest
-	17, // 44: Superplane.Organizations.Organizations.UpdateInviteLink:input_type -> Superplane.Organizations.UpdateInviteLinkRequest
-	19, // 45: Superplane.Organizations.Organizations.ResetInviteLink:input_type -> Superplane.Organizations.ResetInviteLinkRequest
-	8,  // 46: Superplane.Organizations.Organizations.AcceptInviteLink:input_type -> Superplane.Organizations.InviteLink
-	23, // 47: Superplane.Organizations.Organizations.ListIntegrations:input_type -> Superplane.Organizations.ListIntegrationsRequest
-	27, // 48: Superplane.Organizations.Organizations.DescribeIntegration:input_type -> Superplane.Organizations.DescribeIntegrationRequest
-	29, // 49: Superplane.Organizations.Organizations.ListIntegrationResources:input_type -> Superplane.Organizations.ListIntegrationResourcesRequest
-	25, // 50: Superplane.Organizations.Organizations.CreateIntegration:input_type -> Superplane.Organizations.CreateIntegrationRequest
-	32, // 51: Superplane.Organizations.Organizations.UpdateIntegration:input_type -> Superplane.Organizations.UpdateIntegrationRequest
-	34, // 52: Superplane.Organizations.Organizations.DeleteIntegration:input_type -> Superplane.Organizations.DeleteIntegrationRequest
-	2,  // 53: Superplane.Organizations.Organizations.DescribeOrganization:output_type -> Superplane.Organizations.DescribeOrganizationResponse
-	4,  // 54: Superplane.Organizations.Organizations.UpdateOrganization:output_type -> Superplane.Organizations.UpdateOrganizationResponse
-	6,  // 55: Superplane.Organizations.Organizations.DeleteOrganization:output_type -> Superplane.Organizations.DeleteOrganizationResponse
-	22, // 56: Superplane.Organizations.Organizations.RemoveUser:output_type -> Superplane.Organizations.RemoveUserResponse
-	10, // 57: Superplane.Organizations.Organizations.CreateInvitation:output_type -> Superplane.Organizations.CreateInvitationResponse
-	12, // 58: Superplane.Organizations.Organizations.ListInvitations:output_type -> Superplane.Organizations.ListInvitationsResponse
-	14, // 59: Superplane.Organizations.Organizations.RemoveInvitation:output_type -> Superplane.Organizations.RemoveInvitationResponse
-	16, // 60: Superplane.Organizations.Organizations.GetInviteLink:output_type -> Superplane.Organizations.GetInviteLinkResponse
-	18, // 61: Superplane.Organizations.Organizations.UpdateInviteLink:output_type -> Superplane.Organizations.UpdateInviteLinkResponse
-	20, // 62: Superplane.Organizations.Organizations.ResetInviteLink:output_type -> Superplane.Organizations.ResetInviteLinkResponse
-	50, // 63: Superplane.Organizations.Organizations.AcceptInviteLink:output_type -> google.protobuf.Struct
-	24, // 64: Superplane.Organizations.Organizations.ListIntegrations:output_type -> Superplane.Organizations.ListIntegrationsResponse
-	28, // 65: Superplane.Organizations.Organizations.DescribeIntegration:output_type -> Superplane.Organizations.DescribeIntegrationResponse
-	30, // 66: Superplane.Organizations.Organizations.ListIntegrationResources:output_type -> Superplane.Organizations.ListIntegrationResourcesResponse
-	26, // 67: Superplane.Organizations.Organizations.CreateIntegration:output_type -> Superplane.Organizations.CreateIntegrationResponse
-	33, // 68: Superplane.Organizations.Organizations.UpdateIntegration:output_type -> Superplane.Organizations.UpdateIntegrationResponse
-	35, // 69: Superplane.Organizations.Organizations.DeleteIntegration:output_type -> Superplane.Organizations.DeleteIntegrationResponse
-	53, // [53:70] is the sub-list for method output_type
-	36, // [36:53] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	65, // 0: Superplane.Organizations.Organization.metadata:type_name -> Superplane.Organizations.Organization.Metadata
+	2,  // 1: Superplane.Organizations.DescribeOrganizationResponse.organization:type_name -> Superplane.Organizations.Organization
+	2,  // 2: Superplane.Organizations.UpdateOrganizationRequest.organization:type_name -> Superplane.Organizations.Organization
+	2,  // 3: Superplane.Organizations.UpdateOrganizationResponse.organization:type_name -> Superplane.Organizations.Organization
+	74, // 4: Superplane.Organizations.Invitation.created_at:type_name -> google.protobuf.Timestamp
+	74, // 5: Superplane.Organizations.InviteLink.created_at:type_name -> google.protobuf.Timestamp
+	74, // 6: Superplane.Organizations.InviteLink.updated_at:type_name -> google.protobuf.Timestamp
+	9,  // 7: Superplane.Organizations.CreateInvitationResponse.invitation:type_name -> Superplane.Organizations.Invitation
+	9,  // 8: Superplane.Organizations.ListInvitationsResponse.invitations:type_name -> Superplane.Organizations.Invitation
+	10, // 9: Superplane.Organizations.GetInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
+	10, // 10: Superplane.Organizations.UpdateInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
+	10, // 11: Superplane.Organizations.ResetInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
+	38, // 12: Superplane.Organizations.ListIntegrationsResponse.integrations:type_name -> Superplane.Organizations.Integration
+	75, // 13: Superplane.Organizations.CreateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
+	38, // 14: Superplane.Organizations.CreateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
+	38, // 15: Superplane.Organizations.DescribeIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
+	66, // 16: Superplane.Organizations.ListIntegrationResourcesRequest.parameters:type_name -> Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
+	33, // 17: Superplane.Organizations.ListIntegrationResourcesResponse.resources:type_name -> Superplane.Organizations.IntegrationResourceRef
+	75, // 18: Superplane.Organizations.UpdateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
+	38, // 19: Superplane.Organizations.UpdateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
+	67, // 20: Superplane.Organizations.Integration.metadata:type_name -> Superplane.Organizations.Integration.Metadata
+	68, // 21: Superplane.Organizations.Integration.spec:type_name -> Superplane.Organizations.Integration.Spec
+	69, // 22: Superplane.Organizations.Integration.status:type_name -> Superplane.Organizations.Integration.Status
+	74, // 23: Superplane.Organizations.WebhookSubscription.created_at:type_name -> google.protobuf.Timestamp
+	74, // 24: Superplane.Organizations.WebhookSubscription.updated_at:type_name -> google.protobuf.Timestamp
+	75, // 25: Superplane.Organizations.WebhookSubscriptionDelivery.payload:type_name -> google.protobuf.Struct
+	74, // 26: Superplane.Organizations.WebhookSubscriptionDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	74, // 27: Superplane.Organizations.WebhookSubscriptionDelivery.delivered_at:type_name -> google.protobuf.Timestamp
+	74, // 28: Superplane.Organizations.WebhookSubscriptionDelivery.created_at:type_name -> google.protobuf.Timestamp
+	39, // 29: Superplane.Organizations.ListWebhookSubscriptionsResponse.subscriptions:type_name -> Superplane.Organizations.WebhookSubscription
+	39, // 30: Superplane.Organizations.CreateWebhookSubscriptionResponse.subscription:type_name -> Superplane.Organizations.WebhookSubscription
+	39, // 31: Superplane.Organizations.UpdateWebhookSubscriptionResponse.subscription:type_name -> Superplane.Organizations.WebhookSubscription
+	74, // 32: Superplane.Organizations.ListWebhookSubscriptionDeliveriesRequest.before:type_name -> google.protobuf.Timestamp
+	40, // 33: Superplane.Organizations.ListWebhookSubscriptionDeliveriesResponse.deliveries:type_name -> Superplane.Organizations.WebhookSubscriptionDelivery
+	74, // 34: Superplane.Organizations.ListWebhookSubscriptionDeliveriesResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	71, // 35: Superplane.Organizations.BrowserAction.form_fields:type_name -> Superplane.Organizations.BrowserAction.FormFieldsEntry
+	74, // 36: Superplane.Organizations.OrganizationCreated.timestamp:type_name -> google.protobuf.Timestamp
+	74, // 37: Superplane.Organizations.OrganizationUpdated.timestamp:type_name -> google.protobuf.Timestamp
+	74, // 38: Superplane.Organizations.OrganizationDeleted.timestamp:type_name -> google.protobuf.Timestamp
+	74, // 39: Superplane.Organizations.InvitationCreated.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 40: Superplane.Organizations.StuckWork.kind:type_name -> Superplane.Organizations.StuckWork.Kind
+	74, // 41: Superplane.Organizations.StuckWork.since:type_name -> google.protobuf.Timestamp
+	56, // 42: Superplane.Organizations.ListStuckWorkResponse.items:type_name -> Superplane.Organizations.StuckWork
+	72, // 43: Superplane.Organizations.ImportOrganizationRequest.secret_values:type_name -> Superplane.Organizations.ImportOrganizationRequest.SecretValuesEntry
+	73, // 44: Superplane.Organizations.SecretValues.data:type_name -> Superplane.Organizations.SecretValues.DataEntry
+	1,  // 45: Superplane.Organizations.ImportedResource.kind:type_name -> Superplane.Organizations.ImportedResource.Kind
+	63, // 46: Superplane.Organizations.ImportOrganizationResponse.resources:type_name -> Superplane.Organizations.ImportedResource
+	74, // 47: Superplane.Organizations.Organization.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	74, // 48: Superplane.Organizations.Organization.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	74, // 49: Superplane.Organizations.Integration.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	74, // 50: Superplane.Organizations.Integration.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	75, // 51: Superplane.Organizations.Integration.Spec.configuration:type_name -> google.protobuf.Struct
+	75, // 52: Superplane.Organizations.Integration.Status.metadata:type_name -> google.protobuf.Struct
+	51, // 53: Superplane.Organizations.Integration.Status.browser_action:type_name -> Superplane.Organizations.BrowserAction
+	70, // 54: Superplane.Organizations.Integration.Status.used_in:type_name -> Superplane.Organizations.Integration.NodeRef
+	62, // 55: Superplane.Organizations.ImportOrganizationRequest.SecretValuesEntry.value:type_name -> Superplane.Organizations.SecretValues
+	3,  // 56: Superplane.Organizations.Organizations.DescribeOrganization:input_type -> Superplane.Organizations.DescribeOrganizationRequest
+	5,  // 57: Superplane.Organizations.Organizations.UpdateOrganization:input_type -> Superplane.Organizations.UpdateOrganizationRequest
+	7,  // 58: Superplane.Organizations.Organizations.DeleteOrganization:input_type -> Superplane.Organizations.DeleteOrganizationRequest
+	23, // 59: Superplane.Organizations.Organizations.RemoveUser:input_type -> Superplane.Organizations.RemoveUserRequest
+	11, // 60: Superplane.Organizations.Organizations.CreateInvitation:input_type -> Superplane.Organizations.CreateInvitationRequest
+	13, // 61: Superplane.Organizations.Organizations.ListInvitations:input_type -> Superplane.Organizations.ListInvitationsRequest
+	15, // 62: Superplane.Organizations.Organizations.RemoveInvitation:input_type -> Superplane.Organizations.RemoveInvitationRequest
+	17, // 63: Superplane.Organizations.Organizations.GetInviteLink:input_type -> Superplane.Organizations.GetInviteLinkRequest
+	19, // 64: Superplane.Organizations.Organizations.UpdateInviteLink:input_type -> Superplane.Organizations.UpdateInviteLinkRequest
+	21, // 65: Superplane.Organizations.Organizations.ResetInviteLink:input_type -> Superplane.Organizations.ResetInviteLinkRequest
+	10, // 66: Superplane.Organizations.Organizations.AcceptInviteLink:input_type -> Superplane.Organizations.InviteLink
+	25, // 67: Superplane.Organizations.Organizations.ListIntegrations:input_type -> Superplane.Organizations.ListIntegrationsRequest
+	29, // 68: Superplane.Organizations.Organizations.DescribeIntegration:input_type -> Superplane.Organizations.DescribeIntegrationRequest
+	31, // 69: Superplane.Organizations.Organizations.ListIntegrationResources:input_type -> Superplane.Organizations.ListIntegrationResourcesRequest
+	27, // 70: Superplane.Organizations.Organizations.CreateIntegration:input_type -> Superplane.Organizations.CreateIntegrationRequest
+	34, // 71: Superplane.Organizations.Organizations.UpdateIntegration:input_type -> Superplane.Organizations.UpdateIntegrationRequest
+	36, // 72: Superplane.Organizations.Organizations.DeleteIntegration:input_type -> Superplane.Organizations.DeleteIntegrationRequest
+	41, // 73: Superplane.Organizations.Organizations.ListWebhookSubscriptions:input_type -> Superplane.Organizations.ListWebhookSubscriptionsRequest
+	43, // 74: Superplane.Organizations.Organizations.CreateWebhookSubscription:input_type -> Superplane.Organizations.CreateWebhookSubscriptionRequest
+	45, // 75: Superplane.Organizations.Organizations.UpdateWebhookSubscription:input_type -> Superplane.Organizations.UpdateWebhookSubscriptionRequest
+	47, // 76: Superplane.Organizations.Organizations.DeleteWebhookSubscription:input_type -> Superplane.Organizations.DeleteWebhookSubscriptionRequest
+	49, // 77: Superplane.Organizations.Organizations.ListWebhookSubscriptionDeliveries:input_type -> Superplane.Organizations.ListWebhookSubscriptionDeliveriesRequest
+	57, // 78: Superplane.Organizations.Organizations.ListStuckWork:input_type -> Superplane.Organizations.ListStuckWorkRequest
+	59, // 79: Superplane.Organizations.Organizations.ExportOrganization:input_type -> Superplane.Organizations.ExportOrganizationRequest
+	61, // 80: Superplane.Organizations.Organizations.ImportOrganization:input_type -> Superplane.Organizations.ImportOrganizationRequest
+	4,  // 81: Superplane.Organizations.Organizations.DescribeOrganization:output_type -> Superplane.Organizations.DescribeOrganizationResponse
+	6,  // 82: Superplane.Organizations.Organizations.UpdateOrganization:output_type -> Superplane.Organizations.UpdateOrganizationResponse
+	8,  // 83: Superplane.Organizations.Organizations.DeleteOrganization:output_type -> Superplane.Organizations.DeleteOrganizationResponse
+	24, // 84: Superplane.Organizations.Organizations.RemoveUser:output_type -> Superplane.Organizations.RemoveUserResponse
+	12, // 85: Superplane.Organizations.Organizations.CreateInvitation:output_type -> Superplane.Organizations.CreateInvitationResponse
+	14, // 86: Superplane.Organizations.Organizations.ListInvitations:output_type -> Superplane.Organizations.ListInvitationsResponse
+	16, // 87: Superplane.Organizations.Organizations.RemoveInvitation:output_type -> Superplane.Organizations.RemoveInvitationResponse
+	18, // 88: Superplane.Organizations.Organizations.GetInviteLink:output_type -> Superplane.Organizations.GetInviteLinkResponse
+	20, // 89: Superplane.Organizations.Organizations.UpdateInviteLink:output_type -> Superplane.Organizations.UpdateInviteLinkResponse
+	22, // 90: Superplane.Organizations.Organizations.ResetInviteLink:output_type -> Superplane.Organizations.ResetInviteLinkResponse
+	75, // 91: Superplane.Organizations.Organizations.AcceptInviteLink:output_type -> google.protobuf.Struct
+	26, // 92: Superplane.Organizations.Organizations.ListIntegrations:output_type -> Superplane.Organizations.ListIntegrationsResponse
+	30, // 93: Superplane.Organizations.Organizations.DescribeIntegration:output_type -> Superplane.Organizations.DescribeIntegrationResponse
+	32, // 94: Superplane.Organizations.Organizations.ListIntegrationResources:output_type -> Superplane.Organizations.ListIntegrationResourcesResponse
+	28, // 95: Superplane.Organizations.Organizations.CreateIntegration:output_type -> Superplane.Organizations.CreateIntegrationResponse
+	35, // 96: Superplane.Organizations.Organizations.UpdateIntegration:output_type -> Superplane.Organizations.UpdateIntegrationResponse
+	37, // 97: Superplane.Organizations.Organizations.DeleteIntegration:output_type -> Superplane.Organizations.DeleteIntegrationResponse
+	42, // 98: Superplane.Organizations.Organizations.ListWebhookSubscriptions:output_type -> Superplane.Organizations.ListWebhookSubscriptionsResponse
+	44, // 99: Superplane.Organizations.Organizations.CreateWebhookSubscription:output_type -> Superplane.Organizations.CreateWebhookSubscriptionResponse
+	46, // 100: Superplane.Organizations.Organizations.UpdateWebhookSubscription:output_type -> Superplane.Organizations.UpdateWebhookSubscriptionResponse
+	48, // 101: Superplane.Organizations.Organizations.DeleteWebhookSubscription:output_type -> Superplane.Organizations.DeleteWebhookSubscriptionResponse
+	50, // 102: Superplane.Organizations.Organizations.ListWebhookSubscriptionDeliveries:output_type -> Superplane.Organizations.ListWebhookSubscriptionDeliveriesResponse
+	58, // 103: Superplane.Organizations.Organizations.ListStuckWork:output_type -> Superplane.Organizations.ListStuckWorkResponse
+	60, // 104: Superplane.Organizations.Organizations.ExportOrganization:output_type -> Superplane.Organizations.ExportOrganizationResponse
+	64, // 105: Superplane.Organizations.Organizations.ImportOrganization:output_type -> Superplane.Organizations.ImportOrganizationResponse
+	81, // [81:106] is the sub-list for method output_type
+	56, // [56:81] is the sub-list for method input_type
+	56, // [56:56] is the sub-list for extension type_name
+	56, // [56:56] is the sub-list for extension extendee
+	0,  // [0:56] is the sub-list for field type_name
 }
 
 func init() { file_organizations_proto_init() }
@@ -2819,18 +4422,20 @@ func file_organizations_proto_init() {
 	if File_organizations_proto != nil {
 		return
 	}
+	file_organizations_proto_msgTypes[43].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_organizations_proto_rawDesc), len(file_organizations_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   49,
+			NumEnums:      2,
+			NumMessages:   72,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_organizations_proto_goTypes,
 		DependencyIndexes: file_organizations_proto_depIdxs,
+		EnumInfos:         file_organizations_proto_enumTypes,
 		MessageInfos:      file_organizations_proto_msgTypes,
 	}.Build()
 	File_organizations_proto = out.File
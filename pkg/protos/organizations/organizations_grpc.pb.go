@@ -20,23 +20,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Organizations_DescribeOrganization_FullMethodName     = "/Superplane.Organizations.Organizations/DescribeOrganization"
-	Organizations_UpdateOrganization_FullMethodName       = "/Superplane.Organizations.Organizations/UpdateOrganization"
-	Organizations_DeleteOrganization_FullMethodName       = "/Superplane.Organizations.Organizations/DeleteOrganization"
-	Organizations_RemoveUser_FullMethodName               = "/Superplane.Organizations.Organizations/RemoveUser"
-	Organizations_CreateInvitation_FullMethodName         = "/Superplane.Organizations.Organizations/CreateInvitation"
-	Organizations_ListInvitations_FullMethodName          = "/Superplane.Organizations.Organizations/ListInvitations"
-	Organizations_RemoveInvitation_FullMethodName         = "/Superplane.Organizations.Organizations/RemoveInvitation"
-	Organizations_GetInviteLink_FullMethodName            = "/Superplane.Organizations.Organizations/GetInviteLink"
-	Organizations_UpdateInviteLink_FullMethodName         = "/Superplane.Organizations.Organizations/UpdateInviteLink"
-	Organizations_ResetInviteLink_FullMethodName          = "/Superplane.Organizations.Organizations/ResetInviteLink"
-	Organizations_AcceptInviteLink_FullMethodName         = "/Superplane.Organizations.Organizations/AcceptInviteLink"
-	Organizations_ListIntegrations_FullMethodName         = "/Superplane.Organizations.Organizations/ListIntegrations"
-	Organizations_DescribeIntegration_FullMethodName      = "/Superplane.Organizations.Organizations/DescribeIntegration"
-	Organizations_ListIntegrationResources_FullMethodName = "/Superplane.Organizations.Organizations/ListIntegrationResources"
-	Organizations_CreateIntegration_FullMethodName        = "/Superplane.Organizations.Organizations/CreateIntegration"
-	Organizations_UpdateIntegration_FullMethodName        = "/Superplane.Organizations.Organizations/UpdateIntegration"
-	Organizations_DeleteIntegration_FullMethodName        = "/Superplane.Organizations.Organizations/DeleteIntegration"
+	Organizations_DescribeOrganization_FullMethodName              = "/Superplane.Organizations.Organizations/DescribeOrganization"
+	Organizations_UpdateOrganization_FullMethodName                = "/Superplane.Organizations.Organizations/UpdateOrganization"
+	Organizations_DeleteOrganization_FullMethodName                = "/Superplane.Organizations.Organizations/DeleteOrganization"
+	Organizations_RemoveUser_FullMethodName                        = "/Superplane.Organizations.Organizations/RemoveUser"
+	Organizations_CreateInvitation_FullMethodName                  = "/Superplane.Organizations.Organizations/CreateInvitation"
+	Organizations_ListInvitations_FullMethodName                   = "/Superplane.Organizations.Organizations/ListInvitations"
+	Organizations_RemoveInvitation_FullMethodName                  = "/Superplane.Organizations.Organizations/RemoveInvitation"
+	Organizations_GetInviteLink_FullMethodName                     = "/Superplane.Organizations.Organizations/GetInviteLink"
+	Organizations_UpdateInviteLink_FullMethodName                  = "/Superplane.Organizations.Organizations/UpdateInviteLink"
+	Organizations_ResetInviteLink_FullMethodName                   = "/Superplane.Organizations.Organizations/ResetInviteLink"
+	Organizations_AcceptInviteLink_FullMethodName                  = "/Superplane.Organizations.Organizations/AcceptInviteLink"
+	Organizations_ListIntegrations_FullMethodName                  = "/Superplane.Organizations.Organizations/ListIntegrations"
+	Organizations_DescribeIntegration_FullMethodName               = "/Superplane.Organizations.Organizations/DescribeIntegration"
+	Organizations_ListIntegrationResources_FullMethodName          = "/Superplane.Organizations.Organizations/ListIntegrationResources"
+	Organizations_CreateIntegration_FullMethodName                 = "/Superplane.Organizations.Organizations/CreateIntegration"
+	Organizations_UpdateIntegration_FullMethodName                 = "/Superplane.Organizations.Organizations/UpdateIntegration"
+	Organizations_DeleteIntegration_FullMethodName                 = "/Superplane.Organizations.Organizations/DeleteIntegration"
+	Organizations_ListWebhookSubscriptions_FullMethodName          = "/Superplane.Organizations.Organizations/ListWebhookSubscriptions"
+	Organizations_CreateWebhookSubscription_FullMethodName         = "/Superplane.Organizations.Organizations/CreateWebhookSubscription"
+	Organizations_UpdateWebhookSubscription_FullMethodName         = "/Superplane.Organizations.Organizations/UpdateWebhookSubscription"
+	Organizations_DeleteWebhookSubscription_FullMethodName         = "/Superplane.Organizations.Organizations/DeleteWebhookSubscription"
+	Organizations_ListWebhookSubscriptionDeliveries_FullMethodName = "/Superplane.Organizations.Organizations/ListWebhookSubscriptionDeliveries"
+	Organizations_ListStuckWork_FullMethodName                     = "/Superplane.Organizations.Organizations/ListStuckWork"
+	Organizations_ExportOrganization_FullMethodName                = "/Superplane.Organizations.Organizations/ExportOrganization"
+	Organizations_ImportOrganization_FullMethodName                = "/Superplane.Organizations.Organizations/ImportOrganization"
 )
 
 // OrganizationsClient is the client API for Organizations service.
@@ -60,6 +68,14 @@ type OrganizationsClient interface {
 	CreateIntegration(ctx context.Context, in *CreateIntegrationRequest, opts ...grpc.CallOption) (*CreateIntegrationResponse, error)
 	UpdateIntegration(ctx context.Context, in *UpdateIntegrationRequest, opts ...grpc.CallOption) (*UpdateIntegrationResponse, error)
 	DeleteIntegration(ctx context.Context, in *DeleteIntegrationRequest, opts ...grpc.CallOption) (*DeleteIntegrationResponse, error)
+	ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error)
+	CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error)
+	UpdateWebhookSubscription(ctx context.Context, in *UpdateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*UpdateWebhookSubscriptionResponse, error)
+	DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error)
+	ListWebhookSubscriptionDeliveries(ctx context.Context, in *ListWebhookSubscriptionDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionDeliveriesResponse, error)
+	ListStuckWork(ctx context.Context, in *ListStuckWorkRequest, opts ...grpc.CallOption) (*ListStuckWorkResponse, error)
+	ExportOrganization(ctx context.Context, in *ExportOrganizationRequest, opts ...grpc.CallOption) (*ExportOrganizationResponse, error)
+	ImportOrganization(ctx context.Context, in *ImportOrganizationRequest, opts ...grpc.CallOption) (*ImportOrganizationResponse, error)
 }
 
 type organizationsClient struct {
@@ -240,6 +256,86 @@ func (c *organizationsClient) DeleteIntegration(ctx context.Context, in *DeleteI
 	return out, nil
 }
 
+func (c *organizationsClient) ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, Organizations_ListWebhookSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, Organizations_CreateWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) UpdateWebhookSubscription(ctx context.Context, in *UpdateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*UpdateWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, Organizations_UpdateWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, Organizations_DeleteWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) ListWebhookSubscriptionDeliveries(ctx context.Context, in *ListWebhookSubscriptionDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookSubscriptionDeliveriesResponse)
+	err := c.cc.Invoke(ctx, Organizations_ListWebhookSubscriptionDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) ListStuckWork(ctx context.Context, in *ListStuckWorkRequest, opts ...grpc.CallOption) (*ListStuckWorkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStuckWorkResponse)
+	err := c.cc.Invoke(ctx, Organizations_ListStuckWork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) ExportOrganization(ctx context.Context, in *ExportOrganizationRequest, opts ...grpc.CallOption) (*ExportOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportOrganizationResponse)
+	err := c.cc.Invoke(ctx, Organizations_ExportOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) ImportOrganization(ctx context.Context, in *ImportOrganizationRequest, opts ...grpc.CallOption) (*ImportOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportOrganizationResponse)
+	err := c.cc.Invoke(ctx, Organizations_ImportOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrganizationsServer is the server API for Organizations service.
 // All implementations should embed UnimplementedOrganizationsServer
 // for forward compatibility.
@@ -261,6 +357,14 @@ type OrganizationsServer interface {
 	CreateIntegration(context.Context, *CreateIntegrationRequest) (*CreateIntegrationResponse, error)
 	UpdateIntegration(context.Context, *UpdateIntegrationRequest) (*UpdateIntegrationResponse, error)
 	DeleteIntegration(context.Context, *DeleteIntegrationRequest) (*DeleteIntegrationResponse, error)
+	ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error)
+	CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error)
+	UpdateWebhookSubscription(context.Context, *UpdateWebhookSubscriptionRequest) (*UpdateWebhookSubscriptionResponse, error)
+	DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error)
+	ListWebhookSubscriptionDeliveries(context.Context, *ListWebhookSubscriptionDeliveriesRequest) (*ListWebhookSubscriptionDeliveriesResponse, error)
+	ListStuckWork(context.Context, *ListStuckWorkRequest) (*ListStuckWorkResponse, error)
+	ExportOrganization(context.Context, *ExportOrganizationRequest) (*ExportOrganizationResponse, error)
+	ImportOrganization(context.Context, *ImportOrganizationRequest) (*ImportOrganizationResponse, error)
 }
 
 // UnimplementedOrganizationsServer should be embedded to have
@@ -321,6 +425,30 @@ func (UnimplementedOrganizationsServer) UpdateIntegration(context.Context, *Upda
 func (UnimplementedOrganizationsServer) DeleteIntegration(context.Context, *DeleteIntegrationRequest) (*DeleteIntegrationResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteIntegration not implemented")
 }
+func (UnimplementedOrganizationsServer) ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWebhookSubscriptions not implemented")
+}
+func (UnimplementedOrganizationsServer) CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateWebhookSubscription not implemented")
+}
+func (UnimplementedOrganizationsServer) UpdateWebhookSubscription(context.Context, *UpdateWebhookSubscriptionRequest) (*UpdateWebhookSubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateWebhookSubscription not implemented")
+}
+func (UnimplementedOrganizationsServer) DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteWebhookSubscription not implemented")
+}
+func (UnimplementedOrganizationsServer) ListWebhookSubscriptionDeliveries(context.Context, *ListWebhookSubscriptionDeliveriesRequest) (*ListWebhookSubscriptionDeliveriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWebhookSubscriptionDeliveries not implemented")
+}
+func (UnimplementedOrganizationsServer) ListStuckWork(context.Context, *ListStuckWorkRequest) (*ListStuckWorkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListStuckWork not implemented")
+}
+func (UnimplementedOrganizationsServer) ExportOrganization(context.Context, *ExportOrganizationRequest) (*ExportOrganizationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportOrganization not implemented")
+}
+func (UnimplementedOrganizationsServer) ImportOrganization(context.Context, *ImportOrganizationRequest) (*ImportOrganizationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportOrganization not implemented")
+}
 func (UnimplementedOrganizationsServer) testEmbeddedByValue() {}
 
 // UnsafeOrganizationsServer may be embedded to opt out of forward compatibility for this service.
@@ -647,6 +775,150 @@ func _Organizations_DeleteIntegration_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Organizations_ListWebhookSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).ListWebhookSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_ListWebhookSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).ListWebhookSubscriptions(ctx, req.(*ListWebhookSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_CreateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).CreateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_CreateWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).CreateWebhookSubscription(ctx, req.(*CreateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_UpdateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).UpdateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_UpdateWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).UpdateWebhookSubscription(ctx, req.(*UpdateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_DeleteWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).DeleteWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_DeleteWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).DeleteWebhookSubscription(ctx, req.(*DeleteWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_ListWebhookSubscriptionDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookSubscriptionDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).ListWebhookSubscriptionDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_ListWebhookSubscriptionDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).ListWebhookSubscriptionDeliveries(ctx, req.(*ListWebhookSubscriptionDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_ListStuckWork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStuckWorkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).ListStuckWork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_ListStuckWork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).ListStuckWork(ctx, req.(*ListStuckWorkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_ExportOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).ExportOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_ExportOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).ExportOrganization(ctx, req.(*ExportOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_ImportOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).ImportOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_ImportOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).ImportOrganization(ctx, req.(*ImportOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Organizations_ServiceDesc is the grpc.ServiceDesc for Organizations service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -722,6 +994,38 @@ var Organizations_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteIntegration",
 			Handler:    _Organizations_DeleteIntegration_Handler,
 		},
+		{
+			MethodName: "ListWebhookSubscriptions",
+			Handler:    _Organizations_ListWebhookSubscriptions_Handler,
+		},
+		{
+			MethodName: "CreateWebhookSubscription",
+			Handler:    _Organizations_CreateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "UpdateWebhookSubscription",
+			Handler:    _Organizations_UpdateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookSubscription",
+			Handler:    _Organizations_DeleteWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookSubscriptionDeliveries",
+			Handler:    _Organizations_ListWebhookSubscriptionDeliveries_Handler,
+		},
+		{
+			MethodName: "ListStuckWork",
+			Handler:    _Organizations_ListStuckWork_Handler,
+		},
+		{
+			MethodName: "ExportOrganization",
+			Handler:    _Organizations_ExportOrganization_Handler,
+		},
+		{
+			MethodName: "ImportOrganization",
+			Handler:    _Organizations_ImportOrganization_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "organizations.proto",
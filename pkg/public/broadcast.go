@@ -0,0 +1,41 @@
+package public
+
+import "sync"
+
+/*
+ * broadcast wakes up all the goroutines waiting on it at once,
+ * unlike a channel, where each value wakes up a single receiver.
+ */
+type broadcast struct {
+	mu   sync.Mutex
+	next chan struct{}
+}
+
+func newBroadcast() *broadcast {
+	return &broadcast{next: make(chan struct{})}
+}
+
+// Wait returns a channel closed on the next wakeup.
+func (b *broadcast) Wait() <-chan struct{} {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.next
+}
+
+// Wake wakes up everyone waiting.
+func (b *broadcast) Wake() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	close(b.next)
+	b.next = make(chan struct{})
+}
+
+// Forward wakes up everyone waiting whenever the channel
+// receives a value, until the channel is closed.
+func (b *broadcast) Forward(wakeups <-chan struct{}) {
+	for range wakeups {
+		b.Wake()
+	}
+}
@@ -0,0 +1,47 @@
+package public
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test__Broadcast(t *testing.T) {
+	b := newBroadcast()
+	first := b.Wait()
+	second := b.Wait()
+
+	wakeups := make(chan struct{})
+	go b.Forward(wakeups)
+	wakeups <- struct{}{}
+
+	//
+	// Everyone waiting is woken up by the same wakeup,
+	// and waiting again waits for the next one.
+	//
+	for _, ch := range []<-chan struct{}{first, second} {
+		select {
+		case <-ch:
+		case <-time.After(time.Second):
+			t.Fatal("not woken up")
+		}
+	}
+
+	select {
+	case <-b.Wait():
+		t.Fatal("woken up without a new wakeup")
+	default:
+	}
+
+	close(wakeups)
+}
+
+func Test__BroadcastWait(t *testing.T) {
+	b := newBroadcast()
+	ch := b.Wait()
+	assert.Equal(t, ch, b.Wait())
+
+	b.Wake()
+	assert.NotEqual(t, ch, b.Wait())
+}
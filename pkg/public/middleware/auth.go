@@ -191,7 +191,16 @@ func authenticateUserByToken(r *http.Request) (*models.User, error) {
 	}
 
 	hashedToken := crypto.HashToken(headerParts[1])
-	return models.FindActiveUserByTokenHash(hashedToken)
+	user, err := models.FindActiveUserByTokenHash(hashedToken)
+	if err == nil {
+		return user, nil
+	}
+
+	//
+	// Not a user API token, but it might be a token
+	// created for the CLI through the browser login.
+	//
+	return models.FindActiveUserByCLITokenHash(hashedToken)
 }
 
 func authenticateUserByCookie(jwtSigner *jwt.Signer, r *http.Request) (*models.User, error) {
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
@@ -21,6 +23,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/authentication"
 	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/components/respond"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc"
@@ -50,8 +53,10 @@ import (
 	"github.com/superplanehq/superplane/pkg/public/ws"
 	"github.com/superplanehq/superplane/pkg/web"
 	"github.com/superplanehq/superplane/pkg/web/assets"
+	"github.com/superplanehq/superplane/pkg/webhooks"
 	grpcLib "google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"gorm.io/gorm"
 )
 
 const (
@@ -60,6 +65,14 @@ const (
 
 	// The size of the stage execution outputs can be up to 4k
 	MaxExecutionOutputsSize = 4 * 1024
+
+	// How often synchronous webhooks check for a response
+	// when not woken up by notifications
+	WebhookResponsePollInterval = time.Second
+
+	// How often synchronous webhooks woken up by notifications
+	// check for a response, in case a notification is lost
+	WebhookResponseFallbackPollInterval = 5 * time.Second
 )
 
 type Server struct {
@@ -78,6 +91,14 @@ type Server struct {
 	wsHub                 *ws.Hub
 	authHandler           *authentication.Handler
 	isDev                 bool
+	trustProxyHeaders     bool
+	trustedProxyHops      int
+	webhookDispatcher     *webhooks.Dispatcher
+
+	// Wakes up the synchronous webhooks waiting
+	// for a response when canvas events are created.
+	webhookResponses            *broadcast
+	webhookResponsePollInterval time.Duration
 }
 
 // WebsocketHub returns the websocket hub for this server
@@ -113,11 +134,14 @@ func NewServer(
 		wsHub:                 ws.NewHub(),
 		authHandler:           authHandler,
 		isDev:                 appEnv == "development",
+		trustProxyHeaders:     os.Getenv("TRUST_PROXY_HEADERS") == "yes",
+		trustedProxyHops:      trustedProxyHops(),
 		timeoutHandlerTimeout: 15 * time.Second,
 		encryptor:             encryptor,
 		jwt:                   jwtSigner,
 		oidcProvider:          oidcProvider,
 		registry:              registry,
+		webhookDispatcher:     webhooks.NewDispatcher(encryptor, registry, baseURL, baseURL+basePath),
 		authService:           authorizationService,
 		upgrader: &websocket.Upgrader{
 			CheckOrigin: func(r *http.Request) bool {
@@ -131,6 +155,8 @@ func NewServer(
 	}
 
 	server.timeoutHandlerTimeout = 15 * time.Second
+	server.webhookResponses = newBroadcast()
+	server.webhookResponsePollInterval = WebhookResponsePollInterval
 	server.InitRouter(middlewares...)
 	return server, nil
 }
@@ -245,6 +271,13 @@ func (s *Server) RegisterGRPCGateway(grpcServerAddr string) error {
 	accountAuthMiddleware := middleware.AccountAuthMiddleware(s.jwt)
 	protectedAccountGRPCHandler := accountAuthMiddleware(s.grpcGatewayAccountHandler(grpcGatewayMux))
 
+	//
+	// Watch streams and followed execution logs stay open
+	// for longer than the server write timeout.
+	//
+	s.Router.Handle("/api/v1/canvases/{canvasId}/watch", withoutWriteDeadline(protectedGRPCHandler)).Methods("GET")
+	s.Router.Handle("/api/v1/canvases/{canvasId}/executions/{executionId}/logs", withoutWriteDeadline(protectedGRPCHandler)).Methods("GET")
+
 	s.Router.PathPrefix("/api/v1/users").Handler(protectedGRPCHandler)
 	s.Router.PathPrefix("/api/v1/groups").Handler(protectedGRPCHandler)
 	s.Router.PathPrefix("/api/v1/roles").Handler(protectedGRPCHandler)
@@ -263,6 +296,17 @@ func (s *Server) RegisterGRPCGateway(grpcServerAddr string) error {
 	return nil
 }
 
+func withoutWriteDeadline(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := http.NewResponseController(w).SetWriteDeadline(time.Time{})
+		if err != nil {
+			log.Warnf("Error removing write deadline for %s: %v", r.URL.Path, err)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
 func headersMatcher(key string) (string, bool) {
 	switch key {
 	case "X-User-Id", "X-Organization-Id", "X-Account-Id":
@@ -369,6 +413,11 @@ func (s *Server) RegisterWebRoutes(webBasePath string) {
 
 func (s *Server) InitRouter(additionalMiddlewares ...mux.MiddlewareFunc) {
 	r := mux.NewRouter().StrictSlash(true)
+	//
+	// Requests are not recorded as spans, but the trace context
+	// of incoming requests is still extracted, so the events
+	// emitted by webhooks continue the trace of the sender.
+	//
 	r.Use(otelmux.Middleware(
 		"superplane-public-api",
 		otelmux.WithTracerProvider(nooptrace.NewTracerProvider()),
@@ -407,10 +456,12 @@ func (s *Server) InitRouter(additionalMiddlewares ...mux.MiddlewareFunc) {
 	//
 	// Webhook endpoints for triggers
 	//
+	// Methods and content types accepted are configured per trigger,
+	// so they are validated when the request is handled.
+	//
 	publicRoute.
 		HandleFunc(s.BasePath+"/webhooks/{webhookID}", s.HandleWebhook).
-		HeadersRegexp("Content-Type", `^application/json(?:;\s*charset=utf-8)?$`).
-		Methods("POST")
+		Methods("GET", "POST", "PUT", "PATCH", "DELETE")
 
 	//
 	// HTTP endpoints for app installations
@@ -440,6 +491,7 @@ type oidcDiscoveryResponse struct {
 	IDTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported"`
 	SubjectTypesSupported            []string `json:"subject_types_supported"`
 	ResponseTypesSupported           []string `json:"response_types_supported"`
+	ClaimsSupported                  []string `json:"claims_supported"`
 }
 
 type jwksResponse struct {
@@ -454,6 +506,14 @@ func (s *Server) handleOIDCConfiguration(w http.ResponseWriter, _ *http.Request)
 		IDTokenSigningAlgValuesSupported: []string{"RS256"},
 		SubjectTypesSupported:            []string{"public"},
 		ResponseTypesSupported:           []string{"id_token"},
+		ClaimsSupported: []string{
+			"iss", "sub", "aud", "exp", "iat", "nbf",
+			oidc.ClaimOrganizationID,
+			oidc.ClaimCanvasID,
+			oidc.ClaimNodeID,
+			oidc.ClaimExecutionID,
+			oidc.ClaimRootEventID,
+		},
 	}
 	respondJSON(w, response)
 }
@@ -716,6 +776,16 @@ func (s *Server) Serve(host string, port int) error {
 	return s.httpServer.ListenAndServe()
 }
 
+// Shutdown stops the server from taking new requests,
+// and waits for the ones in flight until the context is done.
+func (s *Server) Shutdown(ctx context.Context) error {
+	if s.httpServer == nil {
+		return nil
+	}
+
+	return s.httpServer.Shutdown(ctx)
+}
+
 func (s *Server) Close() {
 	if err := s.httpServer.Close(); err != nil {
 		log.Errorf("Error closing server: %v", err)
@@ -731,7 +801,7 @@ func (s *Server) HandleWebhook(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err = models.FindWebhook(webhookID)
+	webhook, err := models.FindWebhook(webhookID)
 	if err != nil {
 		http.Error(w, "webhook not found", http.StatusNotFound)
 		return
@@ -762,114 +832,197 @@ func (s *Server) HandleWebhook(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	for _, node := range nodes {
-		code, err := s.executeWebhookNode(r.Context(), body, r.Header, node)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("error handling webhook: %v", err), code)
+	result := s.webhookDispatcher.Dispatch(r.Context(), webhook, nodes, webhooks.Request{
+		Body:     body,
+		Headers:  r.Header,
+		Method:   r.Method,
+		Query:    r.URL.Query(),
+		RemoteIP: s.clientIP(r),
+	}, nil)
+
+	if result.Err != nil {
+		http.Error(w, fmt.Sprintf("error handling webhook: %v", result.Err), result.StatusCode)
+		return
+	}
+
+	if result.Response.WaitTimeout > 0 && len(result.Events) > 0 {
+		s.awaitWebhookResponse(r.Context(), w, result.Events[0], result.Response.WaitTimeout)
+		return
+	}
+
+	writeWebhookResponse(w, result.Response)
+}
+
+// WakeWebhookResponsesOn makes synchronous webhooks check for a response
+// whenever the channel receives a value, and only poll on the slower
+// fallback interval otherwise. The channel should receive a value
+// whenever canvas events are created.
+func (s *Server) WakeWebhookResponsesOn(wakeups <-chan struct{}) {
+	s.webhookResponsePollInterval = WebhookResponseFallbackPollInterval
+	go s.webhookResponses.Forward(wakeups)
+}
+
+// awaitWebhookResponse holds the caller until a respond component
+// in the execution chain started by the event emits, or the timeout is hit.
+// On timeout, the caller receives a 202 with a URL to follow the executions.
+func (s *Server) awaitWebhookResponse(ctx context.Context, w http.ResponseWriter, event models.CanvasEvent, timeout time.Duration) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	ticker := time.NewTicker(s.webhookResponsePollInterval)
+	defer ticker.Stop()
+
+	for {
+		//
+		// Waiting for the wakeup starts before looking for the response,
+		// so events created while looking for it are not missed.
+		//
+		wakeup := s.webhookResponses.Wait()
+		responseEvent, err := models.FindFirstComponentEventForRootEvent(event.WorkflowID, event.ID, respond.ComponentName)
+		if err == nil {
+			writeWebhookRespondEvent(w, responseEvent)
 			return
 		}
-	}
 
-	w.WriteHeader(http.StatusOK)
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Errorf("error finding webhook response for event %s: %v", event.ID, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusAccepted)
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"eventId": event.ID.String(),
+				"statusUrl": fmt.Sprintf(
+					"%s/api/v1/canvases/%s/events/%s/executions",
+					s.BaseURL,
+					event.WorkflowID,
+					event.ID,
+				),
+			})
+			return
+		case <-wakeup:
+		case <-ticker.C:
+		}
+	}
 }
 
-func (s *Server) executeWebhookNode(ctx context.Context, body []byte, headers http.Header, node models.CanvasNode) (int, error) {
-	if node.Type == models.NodeTypeTrigger {
-		return s.executeTriggerNode(ctx, body, headers, node)
+func writeWebhookRespondEvent(w http.ResponseWriter, event *models.CanvasEvent) {
+	var payload struct {
+		Data struct {
+			Status  int               `json:"status"`
+			Headers map[string]string `json:"headers"`
+			Body    any               `json:"body"`
+		} `json:"data"`
 	}
 
-	return s.executeComponentNode(ctx, body, headers, node)
-}
+	data, err := json.Marshal(event.Data.Data())
+	if err == nil {
+		err = json.Unmarshal(data, &payload)
+	}
 
-func (s *Server) executeTriggerNode(ctx context.Context, body []byte, headers http.Header, node models.CanvasNode) (int, error) {
-	ref := node.Ref.Data()
-	trigger, err := s.registry.GetTrigger(ref.Trigger.Name)
 	if err != nil {
-		return http.StatusInternalServerError, fmt.Errorf("trigger not found: %w", err)
+		log.Errorf("error decoding webhook response from event %s: %v", event.ID, err)
+		http.Error(w, "error decoding webhook response", http.StatusInternalServerError)
+		return
 	}
 
-	logger := logging.ForNode(node)
-	tx := database.Conn()
-	var integrationCtx core.IntegrationContext
-	if node.AppInstallationID != nil {
-		integration, integrationErr := models.FindUnscopedIntegrationInTransaction(tx, *node.AppInstallationID)
-		if integrationErr != nil {
-			return http.StatusInternalServerError, integrationErr
+	for name, value := range payload.Data.Headers {
+		w.Header().Set(name, value)
+	}
+
+	status := payload.Data.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	switch body := payload.Data.Body.(type) {
+	case nil:
+		w.WriteHeader(status)
+	case string:
+		if w.Header().Get("Content-Type") == "" {
+			w.Header().Set("Content-Type", "text/plain")
 		}
 
-		logger = logging.WithIntegration(logger, *integration)
-		integrationCtx = contexts.NewIntegrationContext(tx, &node, integration, s.encryptor, s.registry)
-	}
-
-	return trigger.HandleWebhook(core.WebhookRequestContext{
-		Body:          body,
-		Headers:       headers,
-		WorkflowID:    node.WorkflowID.String(),
-		NodeID:        node.NodeID,
-		Configuration: node.Configuration.Data(),
-		Metadata:      contexts.NewNodeMetadataContext(tx, &node),
-		Logger:        logger,
-		HTTP:          s.registry.HTTPContext(),
-		Webhook:       contexts.NewNodeWebhookContext(ctx, tx, s.encryptor, &node, s.BaseURL+s.BasePath),
-		Events:        contexts.NewEventContext(tx, &node),
-		Integration:   integrationCtx,
-	})
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	default:
+		if w.Header().Get("Content-Type") == "" {
+			w.Header().Set("Content-Type", "application/json")
+		}
+
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	}
 }
 
-func (s *Server) executeComponentNode(ctx context.Context, body []byte, headers http.Header, node models.CanvasNode) (int, error) {
-	ref := node.Ref.Data()
-	component, err := s.registry.GetComponent(ref.Component.Name)
-	if err != nil {
-		return http.StatusInternalServerError, fmt.Errorf("component not found: %w", err)
+func writeWebhookResponse(w http.ResponseWriter, response *core.WebhookResponse) {
+	if response.StatusCode == 0 {
+		w.WriteHeader(http.StatusOK)
+		return
 	}
 
-	logger := logging.ForNode(node)
-	tx := database.Conn()
-	var integrationCtx core.IntegrationContext
-	if node.AppInstallationID != nil {
-		integration, integrationErr := models.FindUnscopedIntegrationInTransaction(tx, *node.AppInstallationID)
-		if integrationErr != nil {
-			return http.StatusInternalServerError, integrationErr
+	if response.ContentType != "" && len(response.Body) > 0 {
+		w.Header().Set("Content-Type", response.ContentType)
+	}
+
+	w.WriteHeader(response.StatusCode)
+	if len(response.Body) > 0 {
+		_, _ = w.Write(response.Body)
+	}
+}
+
+// clientIP returns the IP address of the caller.
+// X-Forwarded-For is only used when running behind a trusted proxy.
+func (s *Server) clientIP(r *http.Request) string {
+	if s.trustProxyHeaders {
+		if ip := forwardedClientIP(r.Header.Values("X-Forwarded-For"), s.trustedProxyHops); ip != "" {
+			return ip
 		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
 
-		logger = logging.WithIntegration(logger, *integration)
-		integrationCtx = contexts.NewIntegrationContext(tx, &node, integration, s.encryptor, s.registry)
-	}
-
-	return component.HandleWebhook(core.WebhookRequestContext{
-		Body:          body,
-		Headers:       headers,
-		WorkflowID:    node.WorkflowID.String(),
-		NodeID:        node.NodeID,
-		Configuration: node.Configuration.Data(),
-		Metadata:      contexts.NewNodeMetadataContext(tx, &node),
-		Logger:        logger,
-		HTTP:          s.registry.HTTPContext(),
-		Webhook:       contexts.NewNodeWebhookContext(ctx, tx, s.encryptor, &node, s.BaseURL+s.BasePath),
-		Events:        contexts.NewEventContext(tx, &node),
-		Integration:   integrationCtx,
-		FindExecutionByKV: func(key string, value string) (*core.ExecutionContext, error) {
-			execution, err := models.FirstNodeExecutionByKVInTransaction(tx, node.WorkflowID, node.NodeID, key, value)
-			if err != nil {
-				return nil, err
+/*
+ * forwardedClientIP returns the caller address added to X-Forwarded-For
+ * by the outermost of the trusted proxies. Each proxy appends the address
+ * it received the request from, so entries on the left of the ones
+ * added by the trusted proxies are sent by the caller, and can be spoofed.
+ */
+func forwardedClientIP(values []string, hops int) string {
+	entries := []string{}
+	for _, value := range values {
+		for _, entry := range strings.Split(value, ",") {
+			if entry = strings.TrimSpace(entry); entry != "" {
+				entries = append(entries, entry)
 			}
+		}
+	}
 
-			return &core.ExecutionContext{
-				ID:             execution.ID,
-				WorkflowID:     execution.WorkflowID.String(),
-				NodeID:         execution.NodeID,
-				BaseURL:        s.BaseURL,
-				Configuration:  execution.Configuration.Data(),
-				HTTP:           s.registry.HTTPContext(),
-				Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
-				NodeMetadata:   contexts.NewNodeMetadataContext(tx, &node),
-				ExecutionState: contexts.NewExecutionStateContext(tx, execution),
-				Requests:       contexts.NewExecutionRequestContext(tx, execution),
-				Logger:         logging.ForExecution(execution, nil),
-				Notifications:  contexts.NewNotificationContext(tx, uuid.Nil, execution.WorkflowID),
-			}, nil
-		},
-	})
+	if len(entries) == 0 {
+		return ""
+	}
+
+	return entries[max(len(entries)-max(hops, 1), 0)]
+}
+
+// trustedProxyHops returns the number of trusted proxies in front of the server.
+func trustedProxyHops() int {
+	hops, err := strconv.Atoi(os.Getenv("TRUSTED_PROXY_HOPS"))
+	if err != nil || hops < 1 {
+		return 1
+	}
+
+	return hops
 }
 
 func (s *Server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
@@ -20,6 +20,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/pkg/registry"
 	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
@@ -388,3 +389,74 @@ func Test__CreateOrganization(t *testing.T) {
 		assert.Contains(t, response.Body.String(), "Organization name already in use")
 	})
 }
+
+func Test__WriteWebhookRespondEvent(t *testing.T) {
+	newEvent := func(data map[string]any) *models.CanvasEvent {
+		return &models.CanvasEvent{
+			Data: datatypes.NewJSONType[any](map[string]any{
+				"type": "webhook.response",
+				"data": data,
+			}),
+		}
+	}
+
+	t.Run("object body is returned as JSON", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		writeWebhookRespondEvent(response, newEvent(map[string]any{
+			"status":  201,
+			"headers": map[string]any{"X-Request-Id": "123"},
+			"body":    map[string]any{"ok": true},
+		}))
+
+		assert.Equal(t, http.StatusCreated, response.Code)
+		assert.Equal(t, "application/json", response.Header().Get("Content-Type"))
+		assert.Equal(t, "123", response.Header().Get("X-Request-Id"))
+		assert.JSONEq(t, `{"ok":true}`, response.Body.String())
+	})
+
+	t.Run("string body is returned as text", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		writeWebhookRespondEvent(response, newEvent(map[string]any{
+			"status": 200,
+			"body":   "done",
+		}))
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, "text/plain", response.Header().Get("Content-Type"))
+		assert.Equal(t, "done", response.Body.String())
+	})
+}
+
+func Test__ClientIP(t *testing.T) {
+	newRequest := func(forwardedFor ...string) *http.Request {
+		request := httptest.NewRequest(http.MethodPost, "/webhooks/123", nil)
+		request.RemoteAddr = "10.0.0.1:5000"
+		for _, value := range forwardedFor {
+			request.Header.Add("X-Forwarded-For", value)
+		}
+
+		return request
+	}
+
+	t.Run("proxy headers not trusted -> remote address", func(t *testing.T) {
+		server := &Server{}
+		assert.Equal(t, "10.0.0.1", server.clientIP(newRequest("1.2.3.4")))
+	})
+
+	t.Run("entry added by the trusted proxy is used", func(t *testing.T) {
+		server := &Server{trustProxyHeaders: true, trustedProxyHops: 1}
+		assert.Equal(t, "5.6.7.8", server.clientIP(newRequest("1.2.3.4, 5.6.7.8")))
+		assert.Equal(t, "5.6.7.8", server.clientIP(newRequest("1.2.3.4", "5.6.7.8")))
+	})
+
+	t.Run("entry added by the outermost of multiple trusted proxies is used", func(t *testing.T) {
+		server := &Server{trustProxyHeaders: true, trustedProxyHops: 2}
+		assert.Equal(t, "5.6.7.8", server.clientIP(newRequest("1.2.3.4, 5.6.7.8, 172.16.0.1")))
+		assert.Equal(t, "5.6.7.8", server.clientIP(newRequest("5.6.7.8")))
+	})
+
+	t.Run("no forwarded header -> remote address", func(t *testing.T) {
+		server := &Server{trustProxyHeaders: true, trustedProxyHops: 1}
+		assert.Equal(t, "10.0.0.1", server.clientIP(newRequest()))
+	})
+}
@@ -0,0 +1,40 @@
+// Package builtin imports the components, triggers, integrations and widgets
+// shipped with SuperPlane, registering them via init().
+// The server and the CLI both import it, so they register the same ones.
+package builtin
+
+import (
+	_ "github.com/superplanehq/superplane/pkg/components/approval"
+	_ "github.com/superplanehq/superplane/pkg/components/filter"
+	_ "github.com/superplanehq/superplane/pkg/components/http"
+	_ "github.com/superplanehq/superplane/pkg/components/if"
+	_ "github.com/superplanehq/superplane/pkg/components/merge"
+	_ "github.com/superplanehq/superplane/pkg/components/noop"
+	_ "github.com/superplanehq/superplane/pkg/components/respond"
+	_ "github.com/superplanehq/superplane/pkg/components/ssh"
+	_ "github.com/superplanehq/superplane/pkg/components/timegate"
+	_ "github.com/superplanehq/superplane/pkg/components/wait"
+	_ "github.com/superplanehq/superplane/pkg/integrations/aws"
+	_ "github.com/superplanehq/superplane/pkg/integrations/claude"
+	_ "github.com/superplanehq/superplane/pkg/integrations/cloudflare"
+	_ "github.com/superplanehq/superplane/pkg/integrations/dash0"
+	_ "github.com/superplanehq/superplane/pkg/integrations/datadog"
+	_ "github.com/superplanehq/superplane/pkg/integrations/daytona"
+	_ "github.com/superplanehq/superplane/pkg/integrations/discord"
+	_ "github.com/superplanehq/superplane/pkg/integrations/dockerhub"
+	_ "github.com/superplanehq/superplane/pkg/integrations/github"
+	_ "github.com/superplanehq/superplane/pkg/integrations/gitlab"
+	_ "github.com/superplanehq/superplane/pkg/integrations/jira"
+	_ "github.com/superplanehq/superplane/pkg/integrations/openai"
+	_ "github.com/superplanehq/superplane/pkg/integrations/pagerduty"
+	_ "github.com/superplanehq/superplane/pkg/integrations/render"
+	_ "github.com/superplanehq/superplane/pkg/integrations/rootly"
+	_ "github.com/superplanehq/superplane/pkg/integrations/semaphore"
+	_ "github.com/superplanehq/superplane/pkg/integrations/sendgrid"
+	_ "github.com/superplanehq/superplane/pkg/integrations/slack"
+	_ "github.com/superplanehq/superplane/pkg/integrations/smtp"
+	_ "github.com/superplanehq/superplane/pkg/triggers/schedule"
+	_ "github.com/superplanehq/superplane/pkg/triggers/start"
+	_ "github.com/superplanehq/superplane/pkg/triggers/webhook"
+	_ "github.com/superplanehq/superplane/pkg/widgets/annotation"
+)
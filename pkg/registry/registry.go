@@ -167,6 +167,28 @@ func (r *Registry) ListComponents() []core.Component {
 	return components
 }
 
+// ListComponentsWaitingForUsers returns the names of the components with actions
+// users can call on their executions. Executions of these components
+// can stay started for as long as it takes users to act on them.
+func (r *Registry) ListComponentsWaitingForUsers() []string {
+	components := r.ListComponents()
+	for _, integration := range r.ListIntegrations() {
+		components = append(components, integration.Components()...)
+	}
+
+	names := []string{}
+	for _, component := range components {
+		for _, action := range component.Actions() {
+			if action.UserAccessible {
+				names = append(names, component.Name())
+				break
+			}
+		}
+	}
+
+	return names
+}
+
 func (r *Registry) GetComponent(name string) (core.Component, error) {
 	parts := strings.SplitN(name, ".", 2)
 	if len(parts) > 2 {
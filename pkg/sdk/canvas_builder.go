@@ -0,0 +1,272 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const (
+	DefaultChannel = "default"
+
+	// Distance between nodes placed by the builder.
+	layoutColumnWidth = 400
+	layoutRowHeight   = 200
+)
+
+/*
+ * CanvasBuilder builds a canvas with a fluent API:
+ *
+ *	canvas, err := sdk.NewCanvas("deploy").
+ *		AddTrigger("start", "Start", sdk.StartTrigger{}).
+ *		AddComponent("call", "Call API", sdk.HTTPComponent{Method: "POST", URL: "https://example.com"}).
+ *		Connect("start", "call").
+ *		Build()
+ *
+ * Errors are collected as the canvas is built,
+ * and returned together by Build.
+ */
+type CanvasBuilder struct {
+	name        string
+	description string
+	nodes       []openapi_client.ComponentsNode
+	edges       []openapi_client.ComponentsEdge
+	errors      []error
+}
+
+// NodeOption changes a node added to the canvas.
+type NodeOption func(*openapi_client.ComponentsNode)
+
+// At places the node at the given position. Nodes without a position
+// are placed in columns, following the edges from the triggers.
+func At(x, y int) NodeOption {
+	return func(node *openapi_client.ComponentsNode) {
+		node.Position = &openapi_client.ComponentsPosition{
+			X: Ptr(int32(x)),
+			Y: Ptr(int32(y)),
+		}
+	}
+}
+
+// WithIntegration sets the integration used by a component or trigger of an integration.
+func WithIntegration(integrationID string) NodeOption {
+	return func(node *openapi_client.ComponentsNode) {
+		node.Integration = &openapi_client.ComponentsIntegrationRef{Id: &integrationID}
+	}
+}
+
+// Paused adds the node paused, so it queues the events it receives.
+func Paused() NodeOption {
+	return func(node *openapi_client.ComponentsNode) {
+		node.Paused = Ptr(true)
+	}
+}
+
+func NewCanvas(name string) *CanvasBuilder {
+	return &CanvasBuilder{name: name}
+}
+
+func (b *CanvasBuilder) Description(description string) *CanvasBuilder {
+	b.description = description
+	return b
+}
+
+func (b *CanvasBuilder) AddTrigger(id, name string, trigger TriggerConfiguration, options ...NodeOption) *CanvasBuilder {
+	if trigger == nil {
+		b.errors = append(b.errors, fmt.Errorf("node %s: trigger configuration is required", id))
+		return b
+	}
+
+	configuration, err := configurationMap(trigger)
+	if err != nil {
+		b.errors = append(b.errors, fmt.Errorf("node %s: %w", id, err))
+	}
+
+	node := newNode(id, name, openapi_client.COMPONENTSNODETYPE_TYPE_TRIGGER, configuration)
+	node.Trigger = &openapi_client.NodeTriggerRef{Name: Ptr(trigger.TriggerName())}
+	return b.addNode(node, options)
+}
+
+func (b *CanvasBuilder) AddComponent(id, name string, component ComponentConfiguration, options ...NodeOption) *CanvasBuilder {
+	if component == nil {
+		b.errors = append(b.errors, fmt.Errorf("node %s: component configuration is required", id))
+		return b
+	}
+
+	configuration, err := configurationMap(component)
+	if err != nil {
+		b.errors = append(b.errors, fmt.Errorf("node %s: %w", id, err))
+	}
+
+	node := newNode(id, name, openapi_client.COMPONENTSNODETYPE_TYPE_COMPONENT, configuration)
+	node.Component = &openapi_client.NodeComponentRef{Name: Ptr(component.ComponentName())}
+	return b.addNode(node, options)
+}
+
+// AddBlueprint adds a node running a blueprint, configured
+// with the values for the configuration fields of the blueprint.
+func (b *CanvasBuilder) AddBlueprint(id, name, blueprintID string, configuration map[string]any, options ...NodeOption) *CanvasBuilder {
+	node := newNode(id, name, openapi_client.COMPONENTSNODETYPE_TYPE_BLUEPRINT, nonNilMap(configuration))
+	node.Blueprint = &openapi_client.NodeBlueprintRef{Id: &blueprintID}
+	return b.addNode(node, options)
+}
+
+// Connect sends the events of the default output channel of source to target.
+func (b *CanvasBuilder) Connect(sourceID, targetID string) *CanvasBuilder {
+	return b.ConnectChannel(sourceID, DefaultChannel, targetID)
+}
+
+// ConnectChannel sends the events of an output channel of source to target.
+func (b *CanvasBuilder) ConnectChannel(sourceID, channel, targetID string) *CanvasBuilder {
+	b.edges = append(b.edges, openapi_client.ComponentsEdge{
+		SourceId: &sourceID,
+		TargetId: &targetID,
+		Channel:  &channel,
+	})
+
+	return b
+}
+
+// Build validates the canvas, and returns it
+// ready to be given to Client.CreateCanvas.
+func (b *CanvasBuilder) Build() (openapi_client.CanvasesCanvas, error) {
+	errs := append([]error{}, b.errors...)
+	if b.name == "" {
+		errs = append(errs, errors.New("canvas name is required"))
+	}
+
+	nodes := map[string]openapi_client.ComponentsNode{}
+	for _, node := range b.nodes {
+		if node.GetId() == "" {
+			errs = append(errs, fmt.Errorf("node %q: id is required", node.GetName()))
+			continue
+		}
+
+		if node.GetName() == "" {
+			errs = append(errs, fmt.Errorf("node %s: name is required", node.GetId()))
+		}
+
+		if _, ok := nodes[node.GetId()]; ok {
+			errs = append(errs, fmt.Errorf("node %s: duplicate node id", node.GetId()))
+		}
+
+		nodes[node.GetId()] = node
+	}
+
+	edges := map[string]bool{}
+	for _, edge := range b.edges {
+		key := edge.GetSourceId() + "/" + edge.GetChannel() + "/" + edge.GetTargetId()
+		if edges[key] {
+			errs = append(errs, fmt.Errorf("edge %s -> %s: duplicate edge", edge.GetSourceId(), edge.GetTargetId()))
+		}
+
+		edges[key] = true
+		if _, ok := nodes[edge.GetSourceId()]; !ok {
+			errs = append(errs, fmt.Errorf("edge %s -> %s: source node not found", edge.GetSourceId(), edge.GetTargetId()))
+		}
+
+		target, ok := nodes[edge.GetTargetId()]
+		if !ok {
+			errs = append(errs, fmt.Errorf("edge %s -> %s: target node not found", edge.GetSourceId(), edge.GetTargetId()))
+			continue
+		}
+
+		if target.GetType() == openapi_client.COMPONENTSNODETYPE_TYPE_TRIGGER {
+			errs = append(errs, fmt.Errorf("edge %s -> %s: triggers can not receive events", edge.GetSourceId(), edge.GetTargetId()))
+		}
+	}
+
+	if len(errs) > 0 {
+		return openapi_client.CanvasesCanvas{}, errors.Join(errs...)
+	}
+
+	canvas := openapi_client.CanvasesCanvas{}
+	canvas.SetMetadata(openapi_client.CanvasesCanvasMetadata{
+		Name:        &b.name,
+		Description: &b.description,
+	})
+
+	canvas.SetSpec(openapi_client.CanvasesCanvasSpec{
+		Nodes: b.layout(),
+		Edges: append([]openapi_client.ComponentsEdge{}, b.edges...),
+	})
+
+	return canvas, nil
+}
+
+func (b *CanvasBuilder) addNode(node openapi_client.ComponentsNode, options []NodeOption) *CanvasBuilder {
+	for _, option := range options {
+		option(&node)
+	}
+
+	b.nodes = append(b.nodes, node)
+	return b
+}
+
+/*
+ * layout places the nodes without a position in columns, with each
+ * node one column to the right of the furthest node sending events to it.
+ * Cycles are not followed, so nodes in them stay in the first column reached.
+ */
+func (b *CanvasBuilder) layout() []openapi_client.ComponentsNode {
+	targets := map[string][]string{}
+	incoming := map[string]bool{}
+	for _, edge := range b.edges {
+		targets[edge.GetSourceId()] = append(targets[edge.GetSourceId()], edge.GetTargetId())
+		incoming[edge.GetTargetId()] = true
+	}
+
+	columns := map[string]int{}
+	var visit func(id string, column int, path map[string]bool)
+	visit = func(id string, column int, path map[string]bool) {
+		if path[id] {
+			return
+		}
+
+		if current, ok := columns[id]; ok && current >= column {
+			return
+		}
+
+		columns[id] = column
+		path[id] = true
+		for _, target := range targets[id] {
+			visit(target, column+1, path)
+		}
+
+		delete(path, id)
+	}
+
+	for _, node := range b.nodes {
+		if !incoming[node.GetId()] {
+			visit(node.GetId(), 0, map[string]bool{})
+		}
+	}
+
+	rows := map[int]int{}
+	nodes := make([]openapi_client.ComponentsNode, 0, len(b.nodes))
+	for _, node := range b.nodes {
+		if node.Position == nil {
+			column := columns[node.GetId()]
+			node.Position = &openapi_client.ComponentsPosition{
+				X: Ptr(int32(column * layoutColumnWidth)),
+				Y: Ptr(int32(rows[column] * layoutRowHeight)),
+			}
+
+			rows[column]++
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
+
+func newNode(id, name string, nodeType openapi_client.ComponentsNodeType, configuration map[string]any) openapi_client.ComponentsNode {
+	return openapi_client.ComponentsNode{
+		Id:            &id,
+		Name:          &name,
+		Type:          &nodeType,
+		Configuration: configuration,
+	}
+}
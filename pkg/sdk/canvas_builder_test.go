@@ -0,0 +1,110 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func Test__CanvasBuilder(t *testing.T) {
+	t.Run("canvas is built with typed configurations", func(t *testing.T) {
+		canvas, err := NewCanvas("deploy").
+			Description("Deploys the API").
+			AddTrigger("start", "Start", StartTrigger{}).
+			AddComponent("call", "Call API", HTTPComponent{
+				Method:  "POST",
+				URL:     "https://example.com",
+				Headers: []HTTPComponentHeadersItem{{Name: "X-Test", Value: "1"}},
+			}).
+			AddComponent("noop", "Noop", RawComponent{Name: "noop"}, Paused()).
+			Connect("start", "call").
+			ConnectChannel("call", "failure", "noop").
+			Build()
+
+		require.NoError(t, err)
+		assert.Equal(t, "deploy", canvas.Metadata.GetName())
+		assert.Equal(t, "Deploys the API", canvas.Metadata.GetDescription())
+
+		nodes := canvas.Spec.GetNodes()
+		require.Len(t, nodes, 3)
+		assert.Equal(t, openapi_client.COMPONENTSNODETYPE_TYPE_TRIGGER, nodes[0].GetType())
+		assert.Equal(t, "start", nodes[0].Trigger.GetName())
+		assert.Equal(t, map[string]any{}, nodes[0].Configuration)
+
+		assert.Equal(t, "http", nodes[1].Component.GetName())
+		assert.Equal(t, map[string]any{
+			"method":  "POST",
+			"url":     "https://example.com",
+			"headers": []any{map[string]any{"name": "X-Test", "value": "1"}},
+		}, nodes[1].Configuration)
+
+		assert.Equal(t, "noop", nodes[2].Component.GetName())
+		assert.True(t, nodes[2].GetPaused())
+
+		edges := canvas.Spec.GetEdges()
+		require.Len(t, edges, 2)
+		assert.Equal(t, DefaultChannel, edges[0].GetChannel())
+		assert.Equal(t, "failure", edges[1].GetChannel())
+	})
+
+	t.Run("nodes are placed in columns following the edges", func(t *testing.T) {
+		canvas, err := NewCanvas("layout").
+			AddTrigger("start", "Start", StartTrigger{}).
+			AddComponent("a", "A", RawComponent{Name: "noop"}).
+			AddComponent("b", "B", RawComponent{Name: "noop"}).
+			AddComponent("c", "C", RawComponent{Name: "noop"}).
+			AddComponent("fixed", "Fixed", RawComponent{Name: "noop"}, At(10, 20)).
+			Connect("start", "a").
+			Connect("start", "b").
+			Connect("a", "c").
+			Connect("b", "c").
+			Build()
+
+		require.NoError(t, err)
+
+		positions := map[string][2]int32{}
+		for _, node := range canvas.Spec.GetNodes() {
+			positions[node.GetId()] = [2]int32{node.Position.GetX(), node.Position.GetY()}
+		}
+
+		assert.Equal(t, map[string][2]int32{
+			"start": {0, 0},
+			"a":     {layoutColumnWidth, 0},
+			"b":     {layoutColumnWidth, layoutRowHeight},
+			"c":     {2 * layoutColumnWidth, 0},
+			"fixed": {10, 20},
+		}, positions)
+	})
+
+	t.Run("invalid canvas -> error", func(t *testing.T) {
+		_, err := NewCanvas("").
+			AddTrigger("start", "Start", StartTrigger{}).
+			AddComponent("start", "Other", RawComponent{Name: "noop"}).
+			AddComponent("call", "", RawComponent{Name: "noop"}).
+			AddComponent("nil", "Nil", nil).
+			Connect("call", "start").
+			Connect("start", "missing").
+			Connect("start", "missing").
+			Build()
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "node nil: component configuration is required")
+		assert.Contains(t, err.Error(), "canvas name is required")
+		assert.Contains(t, err.Error(), "node start: duplicate node id")
+		assert.Contains(t, err.Error(), "node call: name is required")
+		assert.Contains(t, err.Error(), "edge start -> missing: target node not found")
+		assert.Contains(t, err.Error(), "edge start -> missing: duplicate edge")
+	})
+
+	t.Run("trigger receiving events -> error", func(t *testing.T) {
+		_, err := NewCanvas("test").
+			AddTrigger("start", "Start", StartTrigger{}).
+			AddComponent("call", "Call", RawComponent{Name: "noop"}).
+			Connect("call", "start").
+			Build()
+
+		require.EqualError(t, err, "edge call -> start: triggers can not receive events")
+	})
+}
@@ -0,0 +1,119 @@
+/*
+ * Package sdk is a Go client for the SuperPlane API, built on top of the
+ * generated client in pkg/openapi_client. It adds a canvas builder with
+ * typed configuration for every component and trigger, iterators over
+ * paginated lists, and helpers to wait for executions to finish.
+ *
+ * The generated client is still available through Client.API,
+ * for the endpoints the SDK does not cover.
+ */
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type Client struct {
+	api *openapi_client.APIClient
+}
+
+type Option func(*openapi_client.Configuration)
+
+// WithHTTPClient sets the HTTP client used for requests.
+func WithHTTPClient(client *http.Client) Option {
+	return func(config *openapi_client.Configuration) {
+		config.HTTPClient = client
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with requests.
+func WithUserAgent(userAgent string) Option {
+	return func(config *openapi_client.Configuration) {
+		config.UserAgent = userAgent
+	}
+}
+
+// NewClient returns a client for the SuperPlane API at baseURL,
+// authenticated with an API token of a user or service account.
+func NewClient(baseURL, token string, options ...Option) *Client {
+	config := openapi_client.NewConfiguration()
+	config.Servers = openapi_client.ServerConfigurations{{URL: baseURL}}
+	if token != "" {
+		config.DefaultHeader["Authorization"] = "Bearer " + token
+	}
+
+	for _, option := range options {
+		option(config)
+	}
+
+	return &Client{api: openapi_client.NewAPIClient(config)}
+}
+
+// API returns the generated client used by the SDK.
+func (c *Client) API() *openapi_client.APIClient {
+	return c.api
+}
+
+// CreateCanvas creates a canvas, usually one returned by CanvasBuilder.Build.
+func (c *Client) CreateCanvas(ctx context.Context, canvas openapi_client.CanvasesCanvas) (*openapi_client.CanvasesCanvas, error) {
+	request := openapi_client.CanvasesCreateCanvasRequest{}
+	request.SetCanvas(canvas)
+
+	response, httpResponse, err := c.api.CanvasAPI.CanvasesCreateCanvas(ctx).Body(request).Execute()
+	if err != nil {
+		return nil, apiError(httpResponse, err)
+	}
+
+	return response.Canvas, nil
+}
+
+// UpdateCanvas replaces the nodes and edges of an existing canvas.
+func (c *Client) UpdateCanvas(ctx context.Context, id string, canvas openapi_client.CanvasesCanvas) (*openapi_client.CanvasesCanvas, error) {
+	body := openapi_client.CanvasesUpdateCanvasBody{}
+	body.SetCanvas(canvas)
+
+	response, httpResponse, err := c.api.CanvasAPI.CanvasesUpdateCanvas(ctx, id).Body(body).Execute()
+	if err != nil {
+		return nil, apiError(httpResponse, err)
+	}
+
+	return response.Canvas, nil
+}
+
+// APIError is returned when the API responds with an error.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+/*
+ * apiError replaces the errors of the generated client, which only
+ * have the status of the response, with one including the message
+ * sent by the API.
+ */
+func apiError(response *http.Response, err error) error {
+	var openAPIError *openapi_client.GenericOpenAPIError
+	if response == nil || !errors.As(err, &openAPIError) {
+		return err
+	}
+
+	var body struct {
+		Message string `json:"message"`
+	}
+
+	if json.Unmarshal(openAPIError.Body(), &body) != nil || body.Message == "" {
+		return err
+	}
+
+	return &APIError{StatusCode: response.StatusCode, Message: body.Message}
+}
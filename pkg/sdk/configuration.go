@@ -0,0 +1,96 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+/*
+ * ComponentConfiguration is the configuration of a component node.
+ * There is a generated struct implementing it for every component,
+ * e.g. HTTPComponent, and RawComponent can be used for the others.
+ */
+type ComponentConfiguration interface {
+	ComponentName() string
+}
+
+/*
+ * TriggerConfiguration is the configuration of a trigger node.
+ * There is a generated struct implementing it for every trigger,
+ * e.g. WebhookTrigger, and RawTrigger can be used for the others.
+ */
+type TriggerConfiguration interface {
+	TriggerName() string
+}
+
+// RawComponent is a component configured with an untyped map,
+// for components added to SuperPlane after the SDK was generated.
+type RawComponent struct {
+	Name          string
+	Configuration map[string]any
+}
+
+func (c RawComponent) ComponentName() string {
+	return c.Name
+}
+
+// RawTrigger is a trigger configured with an untyped map,
+// for triggers added to SuperPlane after the SDK was generated.
+type RawTrigger struct {
+	Name          string
+	Configuration map[string]any
+}
+
+func (t RawTrigger) TriggerName() string {
+	return t.Name
+}
+
+// Predicate is an item of "any-predicate-list" fields.
+type Predicate struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// SecretKeyRef is the value of "secret-key" fields,
+// pointing to a key of an organization secret.
+type SecretKeyRef struct {
+	Secret string `json:"secret"`
+	Key    string `json:"key"`
+}
+
+// Ptr returns a pointer to v, for the optional
+// number and boolean fields of generated structs.
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// configurationMap converts a typed configuration to
+// the map sent to the API, following its JSON tags.
+func configurationMap(configuration any) (map[string]any, error) {
+	switch c := configuration.(type) {
+	case RawComponent:
+		return nonNilMap(c.Configuration), nil
+	case RawTrigger:
+		return nonNilMap(c.Configuration), nil
+	}
+
+	data, err := json.Marshal(configuration)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding configuration: %w", err)
+	}
+
+	result := map[string]any{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("configuration must be a struct or map: %w", err)
+	}
+
+	return result, nil
+}
+
+func nonNilMap(m map[string]any) map[string]any {
+	if m == nil {
+		return map[string]any{}
+	}
+
+	return m
+}
@@ -0,0 +1,136 @@
+package sdk
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+// Number of items requested per page by the iterators.
+const PageSize = 50
+
+// page is one page of a list paginated by timestamp.
+type page[T any] struct {
+	items         []T
+	hasNextPage   bool
+	lastTimestamp time.Time
+}
+
+/*
+ * paginate iterates over the items of a list paginated by timestamp,
+ * fetching the next page, with items created before the last one,
+ * only when the items of the current one were consumed.
+ * Iteration stops after the first error.
+ */
+func paginate[T any](fetch func(before *time.Time) (*page[T], error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var before *time.Time
+		for {
+			p, err := fetch(before)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range p.items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if !p.hasNextPage || len(p.items) == 0 {
+				return
+			}
+
+			last := p.lastTimestamp
+			before = &last
+		}
+	}
+}
+
+// CanvasEvents iterates over the root events of a canvas, newest first.
+func (c *Client) CanvasEvents(ctx context.Context, canvasID string) iter.Seq2[openapi_client.CanvasesCanvasEventWithExecutions, error] {
+	return paginate(func(before *time.Time) (*page[openapi_client.CanvasesCanvasEventWithExecutions], error) {
+		request := c.api.CanvasEventAPI.CanvasesListCanvasEvents(ctx, canvasID).Limit(PageSize)
+		if before != nil {
+			request = request.Before(*before)
+		}
+
+		response, httpResponse, err := request.Execute()
+		if err != nil {
+			return nil, apiError(httpResponse, err)
+		}
+
+		return &page[openapi_client.CanvasesCanvasEventWithExecutions]{
+			items:         response.GetEvents(),
+			hasNextPage:   response.GetHasNextPage(),
+			lastTimestamp: response.GetLastTimestamp(),
+		}, nil
+	})
+}
+
+// NodeEvents iterates over the events emitted by a node, newest first.
+func (c *Client) NodeEvents(ctx context.Context, canvasID, nodeID string) iter.Seq2[openapi_client.CanvasesCanvasEvent, error] {
+	return paginate(func(before *time.Time) (*page[openapi_client.CanvasesCanvasEvent], error) {
+		request := c.api.CanvasNodeAPI.CanvasesListNodeEvents(ctx, canvasID, nodeID).Limit(PageSize)
+		if before != nil {
+			request = request.Before(*before)
+		}
+
+		response, httpResponse, err := request.Execute()
+		if err != nil {
+			return nil, apiError(httpResponse, err)
+		}
+
+		return &page[openapi_client.CanvasesCanvasEvent]{
+			items:         response.GetEvents(),
+			hasNextPage:   response.GetHasNextPage(),
+			lastTimestamp: response.GetLastTimestamp(),
+		}, nil
+	})
+}
+
+// NodeExecutions iterates over the executions of a node, newest first.
+func (c *Client) NodeExecutions(ctx context.Context, canvasID, nodeID string) iter.Seq2[openapi_client.CanvasesCanvasNodeExecution, error] {
+	return paginate(func(before *time.Time) (*page[openapi_client.CanvasesCanvasNodeExecution], error) {
+		request := c.api.CanvasNodeAPI.CanvasesListNodeExecutions(ctx, canvasID, nodeID).Limit(PageSize)
+		if before != nil {
+			request = request.Before(*before)
+		}
+
+		response, httpResponse, err := request.Execute()
+		if err != nil {
+			return nil, apiError(httpResponse, err)
+		}
+
+		return &page[openapi_client.CanvasesCanvasNodeExecution]{
+			items:         response.GetExecutions(),
+			hasNextPage:   response.GetHasNextPage(),
+			lastTimestamp: response.GetLastTimestamp(),
+		}, nil
+	})
+}
+
+// NodeQueueItems iterates over the items waiting in the queue of a node.
+func (c *Client) NodeQueueItems(ctx context.Context, canvasID, nodeID string) iter.Seq2[openapi_client.CanvasesCanvasNodeQueueItem, error] {
+	return paginate(func(before *time.Time) (*page[openapi_client.CanvasesCanvasNodeQueueItem], error) {
+		request := c.api.CanvasNodeAPI.CanvasesListNodeQueueItems(ctx, canvasID, nodeID).Limit(PageSize)
+		if before != nil {
+			request = request.Before(*before)
+		}
+
+		response, httpResponse, err := request.Execute()
+		if err != nil {
+			return nil, apiError(httpResponse, err)
+		}
+
+		return &page[openapi_client.CanvasesCanvasNodeQueueItem]{
+			items:         response.GetItems(),
+			hasNextPage:   response.GetHasNextPage(),
+			lastTimestamp: response.GetLastTimestamp(),
+		}, nil
+	})
+}
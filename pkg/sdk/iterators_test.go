@@ -0,0 +1,91 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__NodeExecutions(t *testing.T) {
+	t.Run("next pages are fetched with the last timestamp", func(t *testing.T) {
+		befores := []string{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v1/canvases/canvas-1/nodes/node-1/executions", r.URL.Path)
+			before := r.URL.Query().Get("before")
+			befores = append(befores, before)
+
+			if before == "" {
+				writeJSON(w, map[string]any{
+					"executions":    []any{map[string]any{"id": "e1"}, map[string]any{"id": "e2"}},
+					"hasNextPage":   true,
+					"lastTimestamp": "2025-01-01T10:00:00Z",
+				})
+				return
+			}
+
+			writeJSON(w, map[string]any{
+				"executions":  []any{map[string]any{"id": "e3"}},
+				"hasNextPage": false,
+			})
+		}))
+		defer server.Close()
+
+		ids := []string{}
+		for execution, err := range NewClient(server.URL, "token").NodeExecutions(context.Background(), "canvas-1", "node-1") {
+			require.NoError(t, err)
+			ids = append(ids, execution.GetId())
+		}
+
+		assert.Equal(t, []string{"e1", "e2", "e3"}, ids)
+		assert.Equal(t, []string{"", "2025-01-01T10:00:00Z"}, befores)
+	})
+
+	t.Run("pages are not fetched after iteration stops", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			writeJSON(w, map[string]any{
+				"executions":    []any{map[string]any{"id": "e1"}, map[string]any{"id": "e2"}},
+				"hasNextPage":   true,
+				"lastTimestamp": "2025-01-01T10:00:00Z",
+			})
+		}))
+		defer server.Close()
+
+		for _, err := range NewClient(server.URL, "token").NodeExecutions(context.Background(), "canvas-1", "node-1") {
+			require.NoError(t, err)
+			break
+		}
+
+		assert.Equal(t, 1, requests)
+	})
+
+	t.Run("API error -> error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			writeJSON(w, map[string]any{"code": 5, "message": "canvas not found"})
+		}))
+		defer server.Close()
+
+		var errs []error
+		for _, err := range NewClient(server.URL, "token").NodeExecutions(context.Background(), "canvas-1", "node-1") {
+			errs = append(errs, err)
+		}
+
+		require.Len(t, errs, 1)
+		var apiErr *APIError
+		require.ErrorAs(t, errs[0], &apiErr)
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+		assert.Equal(t, "canvas not found", apiErr.Message)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
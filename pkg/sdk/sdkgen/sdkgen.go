@@ -0,0 +1,379 @@
+/*
+ * Package sdkgen generates the typed configuration structs of the SDK
+ * from the configuration schemas of the registered components and triggers.
+ * It is used by scripts/generate_sdk, and kept apart from the SDK,
+ * so programs using the SDK do not depend on the registry.
+ */
+package sdkgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const (
+	KindComponent = "Component"
+	KindTrigger   = "Trigger"
+)
+
+// Definition is a component or trigger whose configuration gets a struct.
+type Definition struct {
+	Kind        string
+	Name        string
+	Label       string
+	Description string
+	Fields      []configuration.Field
+}
+
+// DefinitionsFromRegistry returns the definitions of all components and triggers,
+// including the ones from integrations, sorted by kind and name.
+func DefinitionsFromRegistry(reg *registry.Registry) []Definition {
+	definitions := []Definition{}
+	for _, component := range reg.ListComponents() {
+		definitions = append(definitions, Definition{
+			Kind:        KindComponent,
+			Name:        component.Name(),
+			Label:       component.Label(),
+			Description: component.Description(),
+			Fields:      component.Configuration(),
+		})
+	}
+
+	for _, trigger := range reg.ListTriggers() {
+		definitions = append(definitions, Definition{
+			Kind:        KindTrigger,
+			Name:        trigger.Name(),
+			Label:       trigger.Label(),
+			Description: trigger.Description(),
+			Fields:      trigger.Configuration(),
+		})
+	}
+
+	for _, integration := range reg.ListIntegrations() {
+		for _, component := range integration.Components() {
+			definitions = append(definitions, Definition{
+				Kind:        KindComponent,
+				Name:        component.Name(),
+				Label:       component.Label(),
+				Description: component.Description(),
+				Fields:      component.Configuration(),
+			})
+		}
+
+		for _, trigger := range integration.Triggers() {
+			definitions = append(definitions, Definition{
+				Kind:        KindTrigger,
+				Name:        trigger.Name(),
+				Label:       trigger.Label(),
+				Description: trigger.Description(),
+				Fields:      trigger.Configuration(),
+			})
+		}
+	}
+
+	slices.SortFunc(definitions, func(a, b Definition) int {
+		if a.Kind != b.Kind {
+			return strings.Compare(a.Kind, b.Kind)
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return definitions
+}
+
+/*
+ * Generate returns the Go source with a struct for the configuration
+ * of each definition. Component structs implement sdk.ComponentConfiguration,
+ * and trigger structs implement sdk.TriggerConfiguration, so they can be
+ * given to the canvas builder directly.
+ */
+func Generate(packageName string, definitions []Definition) ([]byte, error) {
+	g := &generator{types: map[string]string{}}
+
+	g.buf.WriteString("// Code generated by scripts/generate_sdk. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&g.buf, "package %s\n", packageName)
+
+	for _, definition := range definitions {
+		if err := g.writeDefinition(definition); err != nil {
+			return nil, err
+		}
+	}
+
+	source, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error formatting generated code: %w", err)
+	}
+
+	return source, nil
+}
+
+type generator struct {
+	buf bytes.Buffer
+
+	// Generated type names, with the component or trigger they belong to.
+	types map[string]string
+}
+
+func (g *generator) writeDefinition(definition Definition) error {
+	typeName := GoName(definition.Name) + definition.Kind
+	comment := fmt.Sprintf("%s is the configuration of the %s %q.", typeName, strings.ToLower(definition.Kind), definition.Name)
+	if definition.Description != "" {
+		comment += "\n\n" + definition.Description
+	}
+
+	if err := g.writeStruct(definition.Name, typeName, comment, definition.Fields); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(&g.buf, "\nfunc (%s) %sName() string {\n\treturn %q\n}\n", typeName, definition.Kind, definition.Name)
+	return nil
+}
+
+func (g *generator) writeStruct(owner, typeName, comment string, fields []configuration.Field) error {
+	if other, ok := g.types[typeName]; ok {
+		return fmt.Errorf("type %s of %s is already used by %s", typeName, owner, other)
+	}
+
+	g.types[typeName] = owner
+
+	//
+	// Nested structs are written after this one,
+	// so the types are in the same order as the fields.
+	//
+	nested := []func() error{}
+	fieldNames := map[string]bool{}
+
+	var body bytes.Buffer
+	for _, field := range fields {
+		fieldName := GoName(field.Name)
+		if fieldNames[fieldName] {
+			return fmt.Errorf("%s: field %s is defined twice", owner, fieldName)
+		}
+
+		fieldNames[fieldName] = true
+		fieldType, write := g.fieldType(owner, typeName+fieldName, field)
+		if write != nil {
+			nested = append(nested, write)
+		}
+
+		tag := field.Name
+		if !isRequired(field) {
+			tag += ",omitempty"
+			if fieldType == "bool" || fieldType == "int" {
+				fieldType = "*" + fieldType
+			}
+		}
+
+		writeComment(&body, "\t", fieldComment(field))
+		fmt.Fprintf(&body, "\t%s %s `json:%q`\n", fieldName, fieldType, tag)
+	}
+
+	g.buf.WriteString("\n")
+	writeComment(&g.buf, "", comment)
+	fmt.Fprintf(&g.buf, "type %s struct {\n%s}\n", typeName, body.String())
+
+	for _, write := range nested {
+		if err := write(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fieldType returns the Go type of a field, and a function writing
+// the nested struct used by it, if the field is an object or list of objects.
+func (g *generator) fieldType(owner, nestedName string, field configuration.Field) (string, func() error) {
+	options := field.TypeOptions
+	if options == nil {
+		options = &configuration.TypeOptions{}
+	}
+
+	switch field.Type {
+	case configuration.FieldTypeBool:
+		return "bool", nil
+
+	case configuration.FieldTypeNumber:
+		return "int", nil
+
+	case configuration.FieldTypeMultiSelect, configuration.FieldTypeDaysOfWeek:
+		return "[]string", nil
+
+	case configuration.FieldTypeIntegrationResource:
+		if options.Resource != nil && options.Resource.Multi {
+			return "[]string", nil
+		}
+
+		return "string", nil
+
+	case configuration.FieldTypeAnyPredicateList:
+		return "[]Predicate", nil
+
+	case configuration.FieldTypeSecretKey:
+		return "*SecretKeyRef", nil
+
+	case configuration.FieldTypeObject:
+		if options.Object == nil || len(options.Object.Schema) == 0 {
+			return "map[string]any", nil
+		}
+
+		return "*" + nestedName, func() error {
+			comment := fmt.Sprintf("%s is the %q field of %s.", nestedName, field.Name, owner)
+			return g.writeStruct(owner, nestedName, comment, options.Object.Schema)
+		}
+
+	case configuration.FieldTypeList:
+		if options.List == nil || options.List.ItemDefinition == nil {
+			return "[]any", nil
+		}
+
+		item := options.List.ItemDefinition
+		if item.Type == configuration.FieldTypeObject && len(item.Schema) > 0 {
+			itemName := nestedName + "Item"
+			return "[]" + itemName, func() error {
+				comment := fmt.Sprintf("%s is an item of the %q field of %s.", itemName, field.Name, owner)
+				return g.writeStruct(owner, itemName, comment, item.Schema)
+			}
+		}
+
+		itemType, _ := g.fieldType(owner, nestedName+"Item", configuration.Field{Type: item.Type})
+		return "[]" + itemType, nil
+
+	case configuration.FieldTypeString,
+		configuration.FieldTypeText,
+		configuration.FieldTypeExpression,
+		configuration.FieldTypeXML,
+		configuration.FieldTypeSelect,
+		configuration.FieldTypeTime,
+		configuration.FieldTypeDate,
+		configuration.FieldTypeDateTime,
+		configuration.FieldTypeTimezone,
+		configuration.FieldTypeTimeRange,
+		configuration.FieldTypeDayInYear,
+		configuration.FieldTypeCron,
+		configuration.FieldTypeUser,
+		configuration.FieldTypeRole,
+		configuration.FieldTypeGroup,
+		configuration.FieldTypeGitRef:
+		return "string", nil
+	}
+
+	return "any", nil
+}
+
+// Fields required only under some conditions, or that
+// can be toggled off, are optional in the generated structs.
+func isRequired(field configuration.Field) bool {
+	return field.Required && !field.Togglable && len(field.RequiredConditions) == 0
+}
+
+func fieldComment(field configuration.Field) string {
+	comment := field.Label
+	if field.Description != "" && field.Description != field.Label {
+		if comment != "" {
+			comment += ": "
+		}
+
+		comment += field.Description
+	}
+
+	values := []string{}
+	if field.TypeOptions != nil && field.TypeOptions.Select != nil {
+		for _, option := range field.TypeOptions.Select.Options {
+			values = append(values, fmt.Sprintf("%q", option.Value))
+		}
+	}
+
+	if field.TypeOptions != nil && field.TypeOptions.MultiSelect != nil {
+		for _, option := range field.TypeOptions.MultiSelect.Options {
+			values = append(values, fmt.Sprintf("%q", option.Value))
+		}
+	}
+
+	if len(values) > 0 {
+		comment += "\n\nOne of " + strings.Join(values, ", ") + "."
+	}
+
+	return comment
+}
+
+func writeComment(buf *bytes.Buffer, indent, comment string) {
+	comment = strings.TrimSpace(comment)
+	if comment == "" {
+		return
+	}
+
+	for _, line := range strings.Split(comment, "\n") {
+		line = strings.TrimRightFunc(line, unicode.IsSpace)
+		if line == "" {
+			fmt.Fprintf(buf, "%s//\n", indent)
+			continue
+		}
+
+		fmt.Fprintf(buf, "%s// %s\n", indent, line)
+	}
+}
+
+var initialisms = map[string]bool{
+	"ACL": true, "API": true, "CPU": true, "CSS": true, "DNS": true, "HTML": true,
+	"HTTP": true, "HTTPS": true, "ID": true, "IP": true, "JSON": true, "PR": true,
+	"RPC": true, "SHA": true, "SMTP": true, "SQL": true, "SSH": true, "TCP": true,
+	"TLS": true, "TTL": true, "UDP": true, "UI": true, "URI": true, "URL": true,
+	"UUID": true, "VM": true, "XML": true,
+}
+
+/*
+ * GoName returns the exported Go name of a component, trigger or field name,
+ * e.g. "github.createIssue" -> "GithubCreateIssue" and "webhookUrl" -> "WebhookURL".
+ */
+func GoName(name string) string {
+	words := []string{}
+	current := []rune{}
+	flush := func() {
+		if len(current) > 0 {
+			words = append(words, string(current))
+			current = []rune{}
+		}
+	}
+
+	runes := []rune(name)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+
+		if i > 0 && unicode.IsUpper(r) && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])) {
+			flush()
+		}
+
+		current = append(current, r)
+	}
+
+	flush()
+
+	var result strings.Builder
+	for _, word := range words {
+		upper := strings.ToUpper(word)
+		if initialisms[upper] {
+			result.WriteString(upper)
+			continue
+		}
+
+		result.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+
+	if result.Len() == 0 || unicode.IsDigit(rune(result.String()[0])) {
+		return "Field" + result.String()
+	}
+
+	return result.String()
+}
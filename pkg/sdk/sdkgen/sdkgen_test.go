@@ -0,0 +1,109 @@
+package sdkgen
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/registry"
+
+	_ "github.com/superplanehq/superplane/pkg/server"
+)
+
+func Test__GoName(t *testing.T) {
+	assert.Equal(t, "HTTP", GoName("http"))
+	assert.Equal(t, "GithubCreateIssue", GoName("github.createIssue"))
+	assert.Equal(t, "WebhookURL", GoName("webhookUrl"))
+	assert.Equal(t, "SecretID", GoName("secret_id"))
+	assert.Equal(t, "ContentType", GoName("content-type"))
+	assert.Equal(t, "Field2fa", GoName("2fa"))
+}
+
+func Test__Generate(t *testing.T) {
+	t.Run("struct is generated for each definition", func(t *testing.T) {
+		source, err := Generate("sdk", []Definition{
+			{
+				Kind:        KindComponent,
+				Name:        "deploy",
+				Description: "Deploys a service",
+				Fields: []configuration.Field{
+					{Name: "service", Label: "Service", Type: configuration.FieldTypeString, Required: true},
+					{Name: "replicas", Label: "Replicas", Type: configuration.FieldTypeNumber},
+					{
+						Name:  "env",
+						Label: "Environment",
+						Type:  configuration.FieldTypeList,
+						TypeOptions: &configuration.TypeOptions{
+							List: &configuration.ListTypeOptions{
+								ItemDefinition: &configuration.ListItemDefinition{
+									Type: configuration.FieldTypeObject,
+									Schema: []configuration.Field{
+										{Name: "name", Label: "Name", Type: configuration.FieldTypeString, Required: true},
+										{Name: "value", Label: "Value", Type: configuration.FieldTypeString, Required: true},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, `// Code generated by scripts/generate_sdk. DO NOT EDIT.
+
+package sdk
+
+// DeployComponent is the configuration of the component "deploy".
+//
+// Deploys a service
+type DeployComponent struct {
+	// Service
+	Service string `+"`json:\"service\"`"+`
+	// Replicas
+	Replicas *int `+"`json:\"replicas,omitempty\"`"+`
+	// Environment
+	Env []DeployComponentEnvItem `+"`json:\"env,omitempty\"`"+`
+}
+
+// DeployComponentEnvItem is an item of the "env" field of deploy.
+type DeployComponentEnvItem struct {
+	// Name
+	Name string `+"`json:\"name\"`"+`
+	// Value
+	Value string `+"`json:\"value\"`"+`
+}
+
+func (DeployComponent) ComponentName() string {
+	return "deploy"
+}
+`, string(source))
+	})
+
+	t.Run("conflicting type names -> error", func(t *testing.T) {
+		_, err := Generate("sdk", []Definition{
+			{Kind: KindComponent, Name: "http"},
+			{Kind: KindComponent, Name: "HTTP"},
+		})
+
+		require.EqualError(t, err, "type HTTPComponent of HTTP is already used by http")
+	})
+}
+
+func Test__GeneratedConfigurationsAreUpToDate(t *testing.T) {
+	reg, err := registry.NewRegistry(crypto.NewNoOpEncryptor(), registry.HTTPOptions{})
+	require.NoError(t, err)
+
+	source, err := Generate("sdk", DefinitionsFromRegistry(reg))
+	require.NoError(t, err)
+
+	current, err := os.ReadFile("../configurations_generated.go")
+	require.NoError(t, err)
+
+	if string(current) != string(source) {
+		t.Fatal("pkg/sdk/configurations_generated.go is out of date, run `make gen.sdk`")
+	}
+}
@@ -0,0 +1,257 @@
+package sdk
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const (
+	DefaultPollInterval = 2 * time.Second
+	DefaultSettle       = 10 * time.Second
+)
+
+type waitOptions struct {
+	pollInterval time.Duration
+	settle       time.Duration
+}
+
+type WaitOption func(*waitOptions)
+
+// PollEvery sets how often the API is polled while waiting.
+func PollEvery(interval time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		o.pollInterval = interval
+	}
+}
+
+/*
+ * SettleFor sets for how long the executions of a run must be finished,
+ * without new ones being created, for the run to be considered finished.
+ * Executions of the next nodes are only created after the previous ones
+ * finish, so a run may look finished for a moment while it is not.
+ */
+func SettleFor(settle time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		o.settle = settle
+	}
+}
+
+func newWaitOptions(options []WaitOption) *waitOptions {
+	o := &waitOptions{pollInterval: DefaultPollInterval, settle: DefaultSettle}
+	for _, option := range options {
+		option(o)
+	}
+
+	return o
+}
+
+// Run is the set of executions started by a root event.
+type Run struct {
+	RootEventID string
+	Executions  []openapi_client.CanvasesCanvasNodeExecution
+}
+
+// Passed returns true if every execution of the run passed.
+func (r *Run) Passed() bool {
+	return len(r.Failed()) == 0
+}
+
+// Failed returns the executions of the run that failed or were cancelled.
+func (r *Run) Failed() []openapi_client.CanvasesCanvasNodeExecution {
+	failed := []openapi_client.CanvasesCanvasNodeExecution{}
+	for _, execution := range r.Executions {
+		if execution.GetResult() != openapi_client.CANVASNODEEXECUTIONRESULT_RESULT_PASSED {
+			failed = append(failed, execution)
+		}
+	}
+
+	return failed
+}
+
+func isFinished(execution openapi_client.CanvasesCanvasNodeExecution) bool {
+	return execution.GetState() == openapi_client.CANVASNODEEXECUTIONSTATE_STATE_FINISHED
+}
+
+/*
+ * WaitForExecution waits until an execution is finished,
+ * returning it. The result of the execution is not checked,
+ * so a failed execution is returned without an error.
+ */
+func (c *Client) WaitForExecution(ctx context.Context, canvasID, executionID string, options ...WaitOption) (*openapi_client.CanvasesCanvasNodeExecution, error) {
+	o := newWaitOptions(options)
+	for {
+		response, httpResponse, err := c.api.CanvasNodeExecutionAPI.CanvasesDescribeExecution(ctx, canvasID, executionID).Execute()
+		if err != nil {
+			return nil, apiError(httpResponse, err)
+		}
+
+		execution := response.GetExecution()
+		if isFinished(execution) {
+			return &execution, nil
+		}
+
+		if err := sleep(ctx, o.pollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+/*
+ * WaitForRun waits until all executions started by a root event are
+ * finished, and nothing changed in the run for the settle duration.
+ * A run with no executions, like one for an event not routed to any
+ * node, is also finished once the settle duration passes.
+ * The result of the executions is not checked, use Run.Passed for that.
+ */
+func (c *Client) WaitForRun(ctx context.Context, canvasID, rootEventID string, options ...WaitOption) (*Run, error) {
+	o := newWaitOptions(options)
+	settler := NewRunSettler(o.settle)
+	for {
+		activity, err := c.RunActivity(ctx, canvasID, rootEventID)
+		if err != nil {
+			return nil, err
+		}
+
+		if settler.Settled(activity) {
+			return &Run{RootEventID: rootEventID, Executions: activity.Executions}, nil
+		}
+
+		if err := sleep(ctx, o.pollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+type RunStatus int
+
+const (
+	RunStatusUnknown RunStatus = iota
+	RunStatusRunning
+	RunStatusPassed
+	RunStatusFailed
+)
+
+// RunActivity is the work of a run at one point in time: its executions,
+// and the items of the run still waiting in the queues of nodes.
+type RunActivity struct {
+	Executions []openapi_client.CanvasesCanvasNodeExecution
+	QueueItems []openapi_client.CanvasesCanvasNodeQueueItem
+}
+
+// RunActivity returns the executions and queue items of the run started by a root event.
+func (c *Client) RunActivity(ctx context.Context, canvasID, rootEventID string) (*RunActivity, error) {
+	executions, httpResponse, err := c.api.CanvasEventAPI.CanvasesListEventExecutions(ctx, canvasID, rootEventID).Execute()
+	if err != nil {
+		return nil, apiError(httpResponse, err)
+	}
+
+	canvas, httpResponse, err := c.api.CanvasAPI.CanvasesDescribeCanvas(ctx, canvasID).Execute()
+	if err != nil {
+		return nil, apiError(httpResponse, err)
+	}
+
+	activity := &RunActivity{Executions: executions.GetExecutions()}
+	spec := canvas.GetCanvas().Spec
+	for _, node := range spec.GetNodes() {
+		if node.GetType() == openapi_client.COMPONENTSNODETYPE_TYPE_TRIGGER {
+			continue
+		}
+
+		for item, err := range c.NodeQueueItems(ctx, canvasID, node.GetId()) {
+			if err != nil {
+				return nil, err
+			}
+
+			if item.RootEvent.GetId() == rootEventID {
+				activity.QueueItems = append(activity.QueueItems, item)
+			}
+		}
+	}
+
+	return activity, nil
+}
+
+// Status returns the status of the run from its executions. The run is
+// still running while it has executions not finished or queue items.
+func (a *RunActivity) Status() RunStatus {
+	if len(a.QueueItems) > 0 || slices.ContainsFunc(a.Executions, notFinished) {
+		return RunStatusRunning
+	}
+
+	for _, execution := range a.Executions {
+		if execution.GetResult() != openapi_client.CANVASNODEEXECUTIONRESULT_RESULT_PASSED {
+			return RunStatusFailed
+		}
+	}
+
+	return RunStatusPassed
+}
+
+// fingerprint returns the IDs and states of the executions and queue items,
+// to tell if anything changed between two snapshots of the activity.
+func (a *RunActivity) fingerprint() []string {
+	fingerprint := []string{}
+	for _, execution := range a.Executions {
+		fingerprint = append(fingerprint, execution.GetId()+"/"+string(execution.GetState()))
+	}
+
+	for _, item := range a.QueueItems {
+		fingerprint = append(fingerprint, item.GetId()+"/queued")
+	}
+
+	slices.Sort(fingerprint)
+	return fingerprint
+}
+
+func notFinished(execution openapi_client.CanvasesCanvasNodeExecution) bool {
+	return !isFinished(execution)
+}
+
+/*
+ * RunSettler tells when a run is finished, from the activity of the run
+ * seen over time. Executions of the next nodes are only created after
+ * the events of the previous ones are routed, so the run must stay
+ * unchanged, with nothing running, for the settle duration.
+ */
+type RunSettler struct {
+	settle    time.Duration
+	previous  []string
+	settledAt time.Time
+}
+
+func NewRunSettler(settle time.Duration) *RunSettler {
+	return &RunSettler{settle: settle}
+}
+
+// Settled records the activity of the run, returning true once the run is finished.
+func (s *RunSettler) Settled(activity *RunActivity) bool {
+	current := activity.fingerprint()
+	changed := !slices.Equal(s.previous, current)
+	s.previous = current
+
+	switch {
+	case activity.Status() == RunStatusRunning:
+		s.settledAt = time.Time{}
+		return false
+	case changed || s.settledAt.IsZero():
+		s.settledAt = time.Now()
+		return false
+	default:
+		return time.Since(s.settledAt) >= s.settle
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
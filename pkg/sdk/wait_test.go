@@ -0,0 +1,167 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func Test__WaitForExecution(t *testing.T) {
+	t.Run("execution is returned once finished", func(t *testing.T) {
+		polls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v1/canvases/canvas-1/executions/e1", r.URL.Path)
+
+			polls++
+			state := "STATE_STARTED"
+			if polls >= 3 {
+				state = "STATE_FINISHED"
+			}
+
+			writeJSON(w, map[string]any{
+				"execution": map[string]any{"id": "e1", "state": state, "result": "RESULT_PASSED"},
+			})
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, "token")
+		execution, err := client.WaitForExecution(context.Background(), "canvas-1", "e1", PollEvery(time.Millisecond))
+		require.NoError(t, err)
+		assert.Equal(t, "e1", execution.GetId())
+		assert.Equal(t, 3, polls)
+	})
+
+	t.Run("execution not found -> error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code": 5, "message": "execution not found"}`))
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, "token")
+		_, err := client.WaitForExecution(context.Background(), "canvas-1", "e1", PollEvery(time.Millisecond))
+		require.EqualError(t, err, "404 Not Found: execution not found")
+	})
+
+	t.Run("context is cancelled -> error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, map[string]any{"execution": map[string]any{"id": "e1", "state": "STATE_STARTED"}})
+		}))
+		defer server.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err := NewClient(server.URL, "token").WaitForExecution(ctx, "canvas-1", "e1", PollEvery(time.Millisecond))
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func Test__WaitForRun(t *testing.T) {
+	polls := 0
+	queued := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/canvases/canvas-1":
+			writeJSON(w, map[string]any{
+				"canvas": map[string]any{
+					"spec": map[string]any{
+						"nodes": []any{
+							map[string]any{"id": "trigger-1", "type": "TYPE_TRIGGER"},
+							map[string]any{"id": "node-1", "type": "TYPE_COMPONENT"},
+						},
+					},
+				},
+			})
+
+		case "/api/v1/canvases/canvas-1/nodes/node-1/queue":
+			items := []any{map[string]any{"id": "other", "rootEvent": map[string]any{"id": "event-2"}}}
+			if queued {
+				items = append(items, map[string]any{"id": "q1", "rootEvent": map[string]any{"id": "event-1"}})
+			}
+
+			writeJSON(w, map[string]any{"items": items})
+
+		case "/api/v1/canvases/canvas-1/events/event-1/executions":
+			polls++
+
+			//
+			// The queue item of the run is only picked up
+			// after the first execution is finished.
+			//
+			executions := []any{map[string]any{"id": "e1", "state": "STATE_FINISHED", "result": "RESULT_PASSED"}}
+			switch {
+			case polls == 1:
+				executions = []any{map[string]any{"id": "e1", "state": "STATE_STARTED"}}
+			case polls > 3:
+				queued = false
+				executions = append(executions, map[string]any{"id": "e2", "state": "STATE_FINISHED", "result": "RESULT_FAILED"})
+			}
+
+			writeJSON(w, map[string]any{"executions": executions})
+
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "token")
+	run, err := client.WaitForRun(context.Background(), "canvas-1", "event-1", PollEvery(time.Millisecond), SettleFor(20*time.Millisecond))
+	require.NoError(t, err)
+
+	assert.Equal(t, "event-1", run.RootEventID)
+	require.Len(t, run.Executions, 2)
+	assert.False(t, run.Passed())
+	require.Len(t, run.Failed(), 1)
+	assert.Equal(t, "e2", run.Failed()[0].GetId())
+}
+
+func Test__WaitForRunWithoutExecutions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/canvases/canvas-1":
+			writeJSON(w, map[string]any{"canvas": map[string]any{"spec": map[string]any{"nodes": []any{}}}})
+		case "/api/v1/canvases/canvas-1/events/event-1/executions":
+			writeJSON(w, map[string]any{"executions": []any{}})
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	client := NewClient(server.URL, "token")
+	run, err := client.WaitForRun(ctx, "canvas-1", "event-1", PollEvery(time.Millisecond), SettleFor(20*time.Millisecond))
+	require.NoError(t, err)
+	assert.Empty(t, run.Executions)
+	assert.True(t, run.Passed())
+}
+
+func Test__RunActivityStatus(t *testing.T) {
+	execution := func(state openapi_client.CanvasNodeExecutionState, result openapi_client.CanvasNodeExecutionResult) openapi_client.CanvasesCanvasNodeExecution {
+		return openapi_client.CanvasesCanvasNodeExecution{State: &state, Result: &result}
+	}
+
+	passed := execution(openapi_client.CANVASNODEEXECUTIONSTATE_STATE_FINISHED, openapi_client.CANVASNODEEXECUTIONRESULT_RESULT_PASSED)
+	failed := execution(openapi_client.CANVASNODEEXECUTIONSTATE_STATE_FINISHED, openapi_client.CANVASNODEEXECUTIONRESULT_RESULT_FAILED)
+	started := execution(openapi_client.CANVASNODEEXECUTIONSTATE_STATE_STARTED, openapi_client.CANVASNODEEXECUTIONRESULT_RESULT_UNKNOWN)
+	queued := []openapi_client.CanvasesCanvasNodeQueueItem{{}}
+
+	assert.Equal(t, RunStatusPassed, (&RunActivity{}).Status())
+	assert.Equal(t, RunStatusPassed, (&RunActivity{Executions: []openapi_client.CanvasesCanvasNodeExecution{passed}}).Status())
+	assert.Equal(t, RunStatusFailed, (&RunActivity{Executions: []openapi_client.CanvasesCanvasNodeExecution{passed, failed}}).Status())
+	assert.Equal(t, RunStatusRunning, (&RunActivity{Executions: []openapi_client.CanvasesCanvasNodeExecution{failed, started}}).Status())
+	assert.Equal(t, RunStatusRunning, (&RunActivity{Executions: []openapi_client.CanvasesCanvasNodeExecution{passed}, QueueItems: queued}).Status())
+}
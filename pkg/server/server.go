@@ -3,17 +3,26 @@ package server
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/http"
 	"os"
+	"os/signal"
 	"strconv"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/soheilhy/cmux"
 	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/config"
 	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
 	grpc "github.com/superplanehq/superplane/pkg/grpc"
+	"github.com/superplanehq/superplane/pkg/health"
 	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/pkg/oidc"
 	"github.com/superplanehq/superplane/pkg/public"
 	registry "github.com/superplanehq/superplane/pkg/registry"
@@ -23,43 +32,27 @@ import (
 	"github.com/superplanehq/superplane/pkg/workers"
 
 	// Import integrations, components and triggers to register them via init()
-	_ "github.com/superplanehq/superplane/pkg/components/approval"
-	_ "github.com/superplanehq/superplane/pkg/components/filter"
-	_ "github.com/superplanehq/superplane/pkg/components/http"
-	_ "github.com/superplanehq/superplane/pkg/components/if"
-	_ "github.com/superplanehq/superplane/pkg/components/merge"
-	_ "github.com/superplanehq/superplane/pkg/components/noop"
-	_ "github.com/superplanehq/superplane/pkg/components/ssh"
-	_ "github.com/superplanehq/superplane/pkg/components/timegate"
-	_ "github.com/superplanehq/superplane/pkg/components/wait"
-	_ "github.com/superplanehq/superplane/pkg/integrations/aws"
-	_ "github.com/superplanehq/superplane/pkg/integrations/claude"
-	_ "github.com/superplanehq/superplane/pkg/integrations/cloudflare"
-	_ "github.com/superplanehq/superplane/pkg/integrations/dash0"
-	_ "github.com/superplanehq/superplane/pkg/integrations/datadog"
-	_ "github.com/superplanehq/superplane/pkg/integrations/daytona"
-	_ "github.com/superplanehq/superplane/pkg/integrations/discord"
-	_ "github.com/superplanehq/superplane/pkg/integrations/dockerhub"
-	_ "github.com/superplanehq/superplane/pkg/integrations/github"
-	_ "github.com/superplanehq/superplane/pkg/integrations/gitlab"
-	_ "github.com/superplanehq/superplane/pkg/integrations/jira"
-	_ "github.com/superplanehq/superplane/pkg/integrations/openai"
-	_ "github.com/superplanehq/superplane/pkg/integrations/pagerduty"
-	_ "github.com/superplanehq/superplane/pkg/integrations/render"
-	_ "github.com/superplanehq/superplane/pkg/integrations/rootly"
-	_ "github.com/superplanehq/superplane/pkg/integrations/semaphore"
-	_ "github.com/superplanehq/superplane/pkg/integrations/sendgrid"
-	_ "github.com/superplanehq/superplane/pkg/integrations/slack"
-	_ "github.com/superplanehq/superplane/pkg/integrations/smtp"
-	_ "github.com/superplanehq/superplane/pkg/triggers/schedule"
-	_ "github.com/superplanehq/superplane/pkg/triggers/start"
-	_ "github.com/superplanehq/superplane/pkg/triggers/webhook"
-	_ "github.com/superplanehq/superplane/pkg/widgets/annotation"
+	_ "github.com/superplanehq/superplane/pkg/registry/builtin"
 )
 
-func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcProvider oidc.Provider, baseURL string, authService authorization.Authorization) {
+type drainableWorker interface {
+	Start(ctx context.Context)
+	Drain(ctx context.Context) error
+	InFlight() int64
+}
+
+// startWorkers starts the workers enabled for this process,
+// stopping them when the context is done, and returns them
+// so the work they have in flight can be drained.
+func startWorkers(ctx context.Context, encryptor crypto.Encryptor, registry *registry.Registry, oidcProvider oidc.Provider, baseURL string, authService authorization.Authorization, checker *health.Checker) []drainableWorker {
 	log.Println("Starting Workers")
 
+	started := []drainableWorker{}
+	start := func(w drainableWorker) {
+		started = append(started, w)
+		go w.Start(ctx)
+	}
+
 	rabbitMQURL, err := config.RabbitMQURL()
 	if err != nil {
 		panic(err)
@@ -69,25 +62,61 @@ func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcP
 		startEmailConsumers(rabbitMQURL, encryptor, baseURL, authService)
 	}
 
+	//
+	// Workers are woken up through Postgres notifications
+	// when there is work for them, instead of polling every second.
+	//
+	var listener *database.Listener
+	if os.Getenv("DISABLE_WORKER_NOTIFICATIONS") != "yes" {
+		listener = database.NewListener(database.DSN())
+	}
+
+	//
+	// Replicas own shards of canvases through advisory locks,
+	// taking over the shards of replicas that are down.
+	//
+	shards := shardLeases()
+	if shards != nil {
+		go shards.Start(ctx)
+	}
+
 	if os.Getenv("START_WORKFLOW_EVENT_ROUTER") == "yes" || os.Getenv("START_EVENT_ROUTER") == "yes" {
 		log.Println("Starting Event Router")
 
 		w := workers.NewEventRouter()
-		go w.Start(context.Background())
+		w.SetHeartbeat(checker.NewHeartbeat("EventRouter", health.WorkerHeartbeatTimeout))
+		configureWorkerPool(w, "EVENT_ROUTER_CONCURRENCY", shards)
+		if listener != nil {
+			w.WakeOn(listener.Subscribe(models.NotificationChannelPendingEvents))
+		}
+
+		start(w)
 	}
 
 	if os.Getenv("START_WORKFLOW_NODE_EXECUTOR") == "yes" || os.Getenv("START_NODE_EXECUTOR") == "yes" {
 		log.Println("Starting Node Executor")
 
-		w := workers.NewNodeExecutor(encryptor, registry, baseURL)
-		go w.Start(context.Background())
+		w := workers.NewNodeExecutor(encryptor, registry, oidcProvider, baseURL)
+		w.SetHeartbeat(checker.NewHeartbeat("NodeExecutor", health.WorkerHeartbeatTimeout))
+		configureWorkerPool(w, "NODE_EXECUTOR_CONCURRENCY", shards)
+		if listener != nil {
+			w.WakeOn(listener.Subscribe(models.NotificationChannelPendingExecutions))
+		}
+
+		start(w)
 	}
 
 	if os.Getenv("START_NODE_REQUEST_WORKER") == "yes" {
 		log.Println("Starting Node Request Worker")
 
-		w := workers.NewNodeRequestWorker(encryptor, registry)
-		go w.Start(context.Background())
+		w := workers.NewNodeRequestWorker(encryptor, registry, oidcProvider)
+		w.SetHeartbeat(checker.NewHeartbeat("NodeRequestWorker", health.WorkerHeartbeatTimeout))
+		configureWorkerPool(w, "NODE_REQUEST_WORKER_CONCURRENCY", shards)
+		if listener != nil {
+			w.WakeOn(listener.Subscribe(models.NotificationChannelPendingRequests))
+		}
+
+		start(w)
 	}
 
 	if os.Getenv("START_APP_INSTALLATION_REQUEST_WORKER") == "yes" || os.Getenv("START_INTEGRATION_REQUEST_WORKER") == "yes" {
@@ -95,14 +124,21 @@ func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcP
 
 		webhooksBaseURL := getWebhookBaseURL(baseURL)
 		w := workers.NewIntegrationRequestWorker(encryptor, registry, oidcProvider, baseURL, webhooksBaseURL)
-		go w.Start(context.Background())
+		w.SetHeartbeat(checker.NewHeartbeat("IntegrationRequestWorker", health.WorkerHeartbeatTimeout))
+		start(w)
 	}
 
 	if os.Getenv("START_WORKFLOW_NODE_QUEUE_WORKER") == "yes" || os.Getenv("START_NODE_QUEUE_WORKER") == "yes" {
 		log.Println("Starting Node Queue Worker")
 
 		w := workers.NewNodeQueueWorker(registry)
-		go w.Start(context.Background())
+		w.SetHeartbeat(checker.NewHeartbeat("NodeQueueWorker", health.WorkerHeartbeatTimeout))
+		configureWorkerPool(w, "NODE_QUEUE_WORKER_CONCURRENCY", shards)
+		if listener != nil {
+			w.WakeOn(listener.Subscribe(models.NotificationChannelReadyNodes))
+		}
+
+		start(w)
 	}
 
 	if os.Getenv("START_WEBHOOK_PROVISIONER") == "yes" {
@@ -110,31 +146,130 @@ func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcP
 
 		webhookBaseURL := getWebhookBaseURL(baseURL)
 		w := workers.NewWebhookProvisioner(webhookBaseURL, encryptor, registry)
-		go w.Start(context.Background())
+		w.SetHeartbeat(checker.NewHeartbeat("WebhookProvisioner", health.WorkerHeartbeatTimeout))
+		start(w)
 	}
 
 	if os.Getenv("START_WEBHOOK_CLEANUP_WORKER") == "yes" {
 		log.Println("Starting Webhook Cleanup Worker")
 
 		w := workers.NewWebhookCleanupWorker(encryptor, registry, baseURL)
-		go w.Start(context.Background())
+		w.SetHeartbeat(checker.NewHeartbeat("WebhookCleanupWorker", health.WorkerHeartbeatTimeout))
+		start(w)
 	}
 
 	if os.Getenv("START_INSTALLATION_CLEANUP_WORKER") == "yes" || os.Getenv("START_INTEGRATION_CLEANUP_WORKER") == "yes" {
 		log.Println("Starting Integration Cleanup Worker")
 
 		w := workers.NewIntegrationCleanupWorker(registry, encryptor, baseURL)
-		go w.Start(context.Background())
+		w.SetHeartbeat(checker.NewHeartbeat("IntegrationCleanupWorker", health.WorkerHeartbeatTimeout))
+		start(w)
 	}
 
 	if os.Getenv("START_WORKFLOW_CLEANUP_WORKER") == "yes" || os.Getenv("START_CANVAS_CLEANUP_WORKER") == "yes" {
 		log.Println("Starting Canvas Cleanup Worker")
 
 		w := workers.NewCanvasCleanupWorker()
-		go w.Start(context.Background())
+		w.SetHeartbeat(checker.NewHeartbeat("CanvasCleanupWorker", health.WorkerHeartbeatTimeout))
+		start(w)
+	}
+
+	if os.Getenv("START_STUCK_WORK_RECONCILER") == "yes" {
+		log.Println("Starting Stuck Work Reconciler")
+
+		w := workers.NewStuckWorkReconciler(stuckWorkThresholds(), registry.ListComponentsWaitingForUsers())
+		w.SetHeartbeat(checker.NewHeartbeat("StuckWorkReconciler", health.WorkerHeartbeatTimeout))
+		start(w)
+	}
+
+	if os.Getenv("START_ORGANIZATION_WEBHOOK_WORKER") == "yes" {
+		log.Println("Starting Organization Webhook Consumer")
+		workers.NewOrganizationWebhookConsumer(rabbitMQURL).Start()
+
+		log.Println("Starting Organization Webhook Sender")
+		w := workers.NewOrganizationWebhookSender(encryptor, registry.HTTPContext())
+		w.SetHeartbeat(checker.NewHeartbeat("OrganizationWebhookSender", health.WorkerHeartbeatTimeout))
+		start(w)
+	}
+
+	if listener != nil {
+		go listener.Start(ctx)
+	}
+
+	return started
+}
+
+// stuckWorkThresholds reads the thresholds used to detect stuck work,
+// using the defaults for the ones not set.
+func stuckWorkThresholds() models.StuckWorkThresholds {
+	thresholds := models.DefaultStuckWorkThresholds
+	for name, threshold := range map[string]*time.Duration{
+		"STUCK_NODE_THRESHOLD":       &thresholds.Node,
+		"STUCK_QUEUE_ITEM_THRESHOLD": &thresholds.QueueItem,
+		"STUCK_EXECUTION_THRESHOLD":  &thresholds.Execution,
+		"STUCK_WEBHOOK_THRESHOLD":    &thresholds.Webhook,
+	} {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+
+		duration, err := time.ParseDuration(value)
+		if err != nil || duration <= 0 {
+			log.Fatalf("%s must be a positive duration, got %q", name, value)
+		}
+
+		*threshold = duration
+	}
+
+	return thresholds
+}
+
+type workerPool interface {
+	SetConcurrency(concurrency int)
+	SetShards(shards *workers.ShardLeases)
+}
+
+// configureWorkerPool sets the worker concurrency from the given variable,
+// and the shards of canvases processed by this replica.
+func configureWorkerPool(w workerPool, concurrencyVar string, shards *workers.ShardLeases) {
+	if value := os.Getenv(concurrencyVar); value != "" {
+		concurrency, err := strconv.Atoi(value)
+		if err != nil || concurrency < 1 {
+			log.Fatalf("%s must be a positive number, got %q", concurrencyVar, value)
+		}
+
+		w.SetConcurrency(concurrency)
+	}
+
+	if shards != nil {
+		w.SetShards(shards)
 	}
 }
 
+// shardLeases returns the leases on the shards of canvases processed by
+// this replica, from WORKER_SHARD_INDEX and WORKER_SHARD_COUNT.
+// Without WORKER_SHARD_COUNT, canvases are not sharded.
+func shardLeases() *workers.ShardLeases {
+	countValue := os.Getenv("WORKER_SHARD_COUNT")
+	if countValue == "" {
+		return nil
+	}
+
+	count, err := strconv.Atoi(countValue)
+	if err != nil || count < 1 {
+		log.Fatalf("WORKER_SHARD_COUNT must be a positive number, got %q", countValue)
+	}
+
+	indexValue := os.Getenv("WORKER_SHARD_INDEX")
+	index, err := strconv.Atoi(indexValue)
+	if err != nil || index < 0 || index >= count {
+		log.Fatalf("WORKER_SHARD_INDEX must be between 0 and %d, got %q", count-1, indexValue)
+	}
+
+	return workers.NewShardLeases(database.DSN(), index, count)
+}
+
 func startEmailConsumers(rabbitMQURL string, encryptor crypto.Encryptor, baseURL string, authService authorization.Authorization) {
 	templateDir := os.Getenv("TEMPLATE_DIR")
 	if templateDir == "" {
@@ -172,12 +307,83 @@ func startEmailConsumersWithService(rabbitMQURL string, emailService services.Em
 	go notificationEmailConsumer.Start()
 }
 
-func startInternalAPI(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encryptor, authService authorization.Authorization, registry *registry.Registry, oidcProvider oidc.Provider) {
+func startInternalAPI(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encryptor, authService authorization.Authorization, registry *registry.Registry, oidcProvider oidc.Provider, lis net.Listener, servers *apiServers) {
 	log.Println("Starting Internal API")
-	grpc.RunServer(baseURL, webhooksBaseURL, basePath, encryptor, authService, registry, oidcProvider, lookupInternalAPIPort())
+	server := grpc.NewServer(baseURL, webhooksBaseURL, basePath, encryptor, authService, registry, oidcProvider, stuckWorkThresholds())
+	servers.add(func(ctx context.Context) {
+		grpc.GracefulStop(ctx, server)
+	})
+
+	log.Infof("Starting GRPC on %s.", lis.Addr())
+	err := server.Serve(lis)
+	if err != nil {
+		panic(err)
+	}
+}
+
+/*
+ * The internal port always serves the Prometheus metrics
+ * and the liveness and readiness endpoints, so they are
+ * available in every process, including the workers.
+ * If the internal API is started, it shares the port:
+ * HTTP/1 requests go to the HTTP handlers, and everything else to gRPC.
+ */
+func startInternalServer(checker *health.Checker, startAPI func(lis net.Listener)) {
+	lis, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", lookupInternalAPIPort()))
+	if err != nil {
+		log.Fatalf("failed to listen on internal port: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", telemetry.MetricsHandler())
+	mux.HandleFunc("/livez", checker.LiveHandler)
+	mux.HandleFunc("/readyz", checker.ReadyHandler)
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+
+	if startAPI == nil {
+		log.Printf("Serving metrics and health checks on %s", lis.Addr())
+		log.Fatal(server.Serve(lis))
+	}
+
+	m := cmux.New(lis)
+	httpListener := m.Match(cmux.HTTP1Fast())
+	grpcListener := m.Match(cmux.Any())
+
+	go func() {
+		log.Printf("Serving metrics and health checks on %s", lis.Addr())
+		if err := server.Serve(httpListener); err != nil && err != cmux.ErrListenerClosed {
+			log.Fatalf("failed to serve metrics and health checks: %v", err)
+		}
+	}()
+
+	go startAPI(grpcListener)
+
+	if err := m.Serve(); err != nil {
+		log.Fatalf("failed to serve internal port: %v", err)
+	}
 }
 
-func startPublicAPI(baseURL, basePath string, encryptor crypto.Encryptor, registry *registry.Registry, jwtSigner *jwt.Signer, oidcProvider oidc.Provider, authService authorization.Authorization) {
+func newHealthChecker() *health.Checker {
+	checker := health.NewChecker()
+	checker.AddCheck("database", health.DatabaseCheck)
+
+	rabbitMQURL, err := config.RabbitMQURL()
+	if err != nil {
+		panic(err)
+	}
+
+	checker.AddCheck("rabbitmq", health.RabbitMQCheck(rabbitMQURL))
+
+	migrationsDir := os.Getenv("MIGRATIONS_DIR")
+	if migrationsDir == "" {
+		migrationsDir = "db/migrations"
+	}
+
+	checker.AddCheck("migrations", health.MigrationsCheck(migrationsDir))
+	return checker
+}
+
+func startPublicAPI(baseURL, basePath string, encryptor crypto.Encryptor, registry *registry.Registry, jwtSigner *jwt.Signer, oidcProvider oidc.Provider, authService authorization.Authorization, servers *apiServers) {
 	log.Println("Starting Public API with integrated Web Server")
 
 	appEnv := os.Getenv("APP_ENV")
@@ -190,6 +396,16 @@ func startPublicAPI(baseURL, basePath string, encryptor crypto.Encryptor, regist
 		log.Panicf("Error creating public API server: %v", err)
 	}
 
+	//
+	// Synchronous webhooks are woken up through Postgres notifications
+	// when canvas events are created, to check for their response.
+	//
+	if os.Getenv("DISABLE_WORKER_NOTIFICATIONS") != "yes" {
+		listener := database.NewListener(database.DSN())
+		server.WakeWebhookResponsesOn(listener.Subscribe(models.NotificationChannelPendingEvents))
+		go listener.Start(context.Background())
+	}
+
 	// Start the EventDistributer worker if enabled
 	if os.Getenv("START_EVENT_DISTRIBUTER") == "yes" {
 		log.Println("Starting Event Distributer Worker")
@@ -224,8 +440,14 @@ func startPublicAPI(baseURL, basePath string, encryptor crypto.Encryptor, regist
 		log.Println("Web server routes not registered (START_WEB_SERVER != yes)")
 	}
 
+	servers.add(func(ctx context.Context) {
+		if err := server.Shutdown(ctx); err != nil {
+			log.Warnf("Error shutting down public API: %v", err)
+		}
+	})
+
 	err = server.Serve("0.0.0.0", lookupPublicAPIPort())
-	if err != nil {
+	if err != nil && err != http.ErrServerClosed {
 		log.Fatal(err)
 	}
 }
@@ -275,23 +497,44 @@ func configureLogging() {
 }
 
 func setupOtelMetrics() {
-	if os.Getenv("OTEL_ENABLED") != "yes" {
+	options := telemetry.MetricsOptions{
+		OTLP:       os.Getenv("OTEL_ENABLED") == "yes",
+		Prometheus: os.Getenv("PROMETHEUS_ENABLED") == "yes",
+	}
+
+	if !options.OTLP && !options.Prometheus {
 		return
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	if err := telemetry.InitMetrics(ctx); err != nil {
+	if err := telemetry.InitMetrics(ctx, options); err != nil {
 		log.Warnf("Failed to initialize OpenTelemetry metrics: %v", err)
 	} else {
 		log.Info("OpenTelemetry metrics initialized")
 	}
 }
 
+func setupOtelTracing() {
+	if os.Getenv("OTEL_TRACING_ENABLED") != "yes" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := telemetry.InitTracing(ctx); err != nil {
+		log.Warnf("Failed to initialize OpenTelemetry tracing: %v", err)
+	} else {
+		log.Info("OpenTelemetry tracing initialized")
+	}
+}
+
 func Start() {
 	configureLogging()
 	setupOtelMetrics()
+	setupOtelTracing()
 
 	telemetry.InitSentry()
 	telemetry.StartBeacon()
@@ -354,20 +597,127 @@ func Start() {
 
 	templates.Setup(registry)
 
+	servers := &apiServers{}
 	if os.Getenv("START_PUBLIC_API") == "yes" {
-		go startPublicAPI(baseURL, basePath, encryptorInstance, registry, jwtSigner, oidcProvider, authService)
+		go startPublicAPI(baseURL, basePath, encryptorInstance, registry, jwtSigner, oidcProvider, authService, servers)
 	}
 
+	var startAPI func(lis net.Listener)
 	if os.Getenv("START_INTERNAL_API") == "yes" {
 		webhooksBaseURL := getWebhookBaseURL(baseURL)
-		go startInternalAPI(baseURL, webhooksBaseURL, basePath, encryptorInstance, authService, registry, oidcProvider)
+		startAPI = func(lis net.Listener) {
+			startInternalAPI(baseURL, webhooksBaseURL, basePath, encryptorInstance, authService, registry, oidcProvider, lis, servers)
+		}
 	}
 
-	startWorkers(encryptorInstance, registry, oidcProvider, baseURL, authService)
+	checker := newHealthChecker()
+	go startInternalServer(checker, startAPI)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
+
+	started := startWorkers(ctx, encryptorInstance, registry, oidcProvider, baseURL, authService, checker)
 
 	log.Println("SuperPlane is UP.")
 
-	select {}
+	<-ctx.Done()
+	drainWorkers(checker, servers, started, shutdownTimeout())
+}
+
+// How long the workers have to finish their work in flight on shutdown, by default.
+// It should be lower than the termination grace period of the pod.
+const DefaultShutdownTimeout = 25 * time.Second
+
+func shutdownTimeout() time.Duration {
+	value := os.Getenv("SHUTDOWN_TIMEOUT")
+	if value == "" {
+		return DefaultShutdownTimeout
+	}
+
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		log.Fatalf("SHUTDOWN_TIMEOUT must be a positive duration, got %q", value)
+	}
+
+	return timeout
+}
+
+// apiServers are the API servers started by this process,
+// stopped along with the workers on shutdown.
+type apiServers struct {
+	mu        sync.Mutex
+	shutdowns []func(ctx context.Context)
+}
+
+func (s *apiServers) add(shutdown func(ctx context.Context)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.shutdowns = append(s.shutdowns, shutdown)
+}
+
+func (s *apiServers) list() []func(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]func(ctx context.Context){}, s.shutdowns...)
+}
+
+/*
+ * drainWorkers stops the workers from taking new work, and waits for
+ * the work they already took to finish. While draining, the process is
+ * reported as not ready, with the number of items still in flight.
+ *
+ * The API servers stop taking requests before the workers are drained,
+ * since requests also create work, and the requests in flight
+ * get the same deadline as the work in flight.
+ *
+ * All the work is done in database transactions, so the work that does
+ * not finish before the timeout is rolled back when the process exits,
+ * and picked up again by another replica.
+ */
+func drainWorkers(checker *health.Checker, servers *apiServers, started []drainableWorker, timeout time.Duration) {
+	inFlight := func() int64 {
+		total := int64(0)
+		for _, w := range started {
+			total += w.InFlight()
+		}
+
+		return total
+	}
+
+	checker.AddCheck("shutdown", func(ctx context.Context) error {
+		return fmt.Errorf("draining, %d items in flight", inFlight())
+	})
+
+	log.Infof("Shutting down, draining %d items in flight", inFlight())
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, shutdown := range servers.list() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			shutdown(ctx)
+		}()
+	}
+
+	for _, w := range started {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = w.Drain(ctx)
+		}()
+	}
+
+	wg.Wait()
+
+	if remaining := inFlight(); remaining > 0 {
+		log.Warnf("Shutdown timeout of %s reached with %d items in flight, their transactions will be rolled back", timeout, remaining)
+		return
+	}
+
+	log.Info("All work drained, SuperPlane is DOWN.")
 }
 
 // getWebhookBaseURL returns the webhook base URL, using the same pattern as SyncContext.
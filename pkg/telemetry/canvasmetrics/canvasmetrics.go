@@ -0,0 +1,173 @@
+package canvasmetrics
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+/*
+ * Metrics for the executions of canvas nodes, labeled by
+ * organization, canvas and component. Nodes are not a label,
+ * to keep the number of series from growing with the canvases;
+ * the per-node numbers are available from the canvas metrics API.
+ *
+ * These live outside of the telemetry package, since they are
+ * taken by the models when executions change state, and
+ * the telemetry package itself depends on the models.
+ */
+
+var (
+	ready atomic.Bool
+
+	executionDurationHistogram metric.Float64Histogram
+	queueWaitHistogram         metric.Float64Histogram
+	approvalWaitHistogram      metric.Float64Histogram
+	executionResultsCounter    metric.Int64Counter
+
+	// Executions take anything from milliseconds to days,
+	// when waiting for approvals or external systems.
+	durationBuckets = []float64{0.1, 0.5, 1, 5, 10, 30, 60, 300, 900, 1800, 3600, 14400, 86400}
+)
+
+type Labels struct {
+	OrganizationID string
+	CanvasID       string
+	Component      string
+}
+
+func (l Labels) attributes(extra ...attribute.KeyValue) metric.MeasurementOption {
+	attrs := []attribute.KeyValue{
+		attribute.String("organization_id", l.OrganizationID),
+		attribute.String("canvas_id", l.CanvasID),
+		attribute.String("component", l.Component),
+	}
+
+	return metric.WithAttributes(append(attrs, extra...)...)
+}
+
+func Init(meter metric.Meter) error {
+	var err error
+
+	executionDurationHistogram, err = meter.Float64Histogram(
+		"canvas.execution.duration.seconds",
+		metric.WithDescription("Duration of node executions, from start to finish"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(durationBuckets...),
+	)
+	if err != nil {
+		return err
+	}
+
+	queueWaitHistogram, err = meter.Float64Histogram(
+		"canvas.execution.queue_wait.seconds",
+		metric.WithDescription("Time node executions wait in the queue before starting"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(durationBuckets...),
+	)
+	if err != nil {
+		return err
+	}
+
+	approvalWaitHistogram, err = meter.Float64Histogram(
+		"canvas.execution.approval_wait.seconds",
+		metric.WithDescription("Time approval executions wait to be approved or rejected"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(durationBuckets...),
+	)
+	if err != nil {
+		return err
+	}
+
+	executionResultsCounter, err = meter.Int64Counter(
+		"canvas.execution.results",
+		metric.WithDescription("Number of finished node executions by result and result reason"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return err
+	}
+
+	ready.Store(true)
+	return nil
+}
+
+func Enabled() bool {
+	return ready.Load()
+}
+
+type pendingKey struct{}
+
+// Pending holds the measurements taken in a transaction,
+// so they are only recorded once the transaction commits.
+type Pending struct {
+	mu           sync.Mutex
+	measurements []func(context.Context)
+}
+
+// WithPending returns a context that collects the measurements taken with it.
+func WithPending(ctx context.Context) (context.Context, *Pending) {
+	pending := &Pending{}
+	return context.WithValue(ctx, pendingKey{}, pending), pending
+}
+
+// Record records the measurements collected.
+func (p *Pending) Record(ctx context.Context) {
+	p.mu.Lock()
+	measurements := p.measurements
+	p.measurements = nil
+	p.mu.Unlock()
+
+	for _, measurement := range measurements {
+		measurement(ctx)
+	}
+}
+
+// measure records a measurement now, or collects it
+// if the context is collecting measurements.
+func measure(ctx context.Context, measurement func(context.Context)) {
+	if !ready.Load() {
+		return
+	}
+
+	if pending, ok := ctx.Value(pendingKey{}).(*Pending); ok {
+		pending.mu.Lock()
+		pending.measurements = append(pending.measurements, measurement)
+		pending.mu.Unlock()
+		return
+	}
+
+	measurement(ctx)
+}
+
+// RecordExecutionFinished records the result of a finished execution,
+// and how long it ran, if it was started.
+func RecordExecutionFinished(ctx context.Context, labels Labels, result, resultReason string, duration *time.Duration) {
+	measure(ctx, func(ctx context.Context) {
+		executionResultsCounter.Add(ctx, 1, labels.attributes(
+			attribute.String("result", result),
+			attribute.String("result_reason", resultReason),
+		))
+
+		if duration != nil {
+			executionDurationHistogram.Record(ctx, duration.Seconds(), labels.attributes(attribute.String("result", result)))
+		}
+	})
+}
+
+// RecordApprovalWait records how long an approval execution waited to be approved or rejected.
+func RecordApprovalWait(ctx context.Context, labels Labels, result string, d time.Duration) {
+	measure(ctx, func(ctx context.Context) {
+		approvalWaitHistogram.Record(ctx, d.Seconds(), labels.attributes(attribute.String("result", result)))
+	})
+}
+
+func RecordQueueWait(ctx context.Context, labels Labels, d time.Duration) {
+	measure(ctx, func(ctx context.Context) {
+		queueWaitHistogram.Record(ctx, d.Seconds(), labels.attributes())
+	})
+}
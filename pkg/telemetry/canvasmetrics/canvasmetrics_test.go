@@ -0,0 +1,48 @@
+package canvasmetrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func Test__Pending(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	require.NoError(t, Init(provider.Meter("test")))
+
+	collect := func() map[string]metricdata.Aggregation {
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(context.Background(), &rm))
+
+		metrics := map[string]metricdata.Aggregation{}
+		for _, scope := range rm.ScopeMetrics {
+			for _, m := range scope.Metrics {
+				metrics[m.Name] = m.Data
+			}
+		}
+
+		return metrics
+	}
+
+	labels := Labels{OrganizationID: "org", CanvasID: "canvas", Component: "noop"}
+	ctx, pending := WithPending(context.Background())
+	duration := time.Second
+	RecordExecutionFinished(ctx, labels, "passed", "ok", &duration)
+
+	assert.NotContains(t, collect(), "canvas.execution.results")
+
+	pending.Record(context.Background())
+	results, ok := collect()["canvas.execution.results"].(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, results.DataPoints, 1)
+	assert.Equal(t, int64(1), results.DataPoints[0].Value)
+
+	_, hasNode := results.DataPoints[0].Attributes.Value("node_id")
+	assert.False(t, hasNode)
+}
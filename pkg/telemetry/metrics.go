@@ -2,19 +2,25 @@ package telemetry
 
 import (
 	"context"
+	"net/http"
 	"sync/atomic"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/superplanehq/superplane/pkg/telemetry/canvasmetrics"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	otelprometheus "go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/metric"
 
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 )
 
 var (
-	meter        = otel.Meter("superplane")
-	metricsReady atomic.Bool
+	meter          = otel.Meter("superplane")
+	metricsReady   atomic.Bool
+	metricsHandler atomic.Value
 
 	queueWorkerTickHistogram       metric.Float64Histogram
 	queueWorkerNodesCountHistogram metric.Int64Histogram
@@ -36,21 +42,42 @@ var (
 	dbLongQueriesCountHistogram metric.Int64Histogram
 )
 
-func InitMetrics(ctx context.Context) error {
-	exporter, err := otlpmetricgrpc.New(ctx)
-	if err != nil {
-		return err
+type MetricsOptions struct {
+	// Push metrics to the OTel collector
+	OTLP bool
+
+	// Expose metrics to be scraped through MetricsHandler()
+	Prometheus bool
+}
+
+func InitMetrics(ctx context.Context, options MetricsOptions) error {
+	var readerOptions []sdkmetric.Option
+	if options.OTLP {
+		exporter, err := otlpmetricgrpc.New(ctx)
+		if err != nil {
+			return err
+		}
+
+		readerOptions = append(readerOptions, sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter)))
 	}
 
-	provider := sdkmetric.NewMeterProvider(
-		sdkmetric.WithReader(
-			sdkmetric.NewPeriodicReader(exporter),
-		),
-	)
+	if options.Prometheus {
+		registry := prometheus.NewRegistry()
+		exporter, err := otelprometheus.New(otelprometheus.WithRegisterer(registry))
+		if err != nil {
+			return err
+		}
+
+		readerOptions = append(readerOptions, sdkmetric.WithReader(exporter))
+		metricsHandler.Store(promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	}
+
+	provider := sdkmetric.NewMeterProvider(readerOptions...)
 
 	otel.SetMeterProvider(provider)
 	meter = provider.Meter("superplane")
 
+	var err error
 	queueWorkerTickHistogram, err = meter.Float64Histogram(
 		"queue_worker.tick.duration.seconds",
 		metric.WithDescription("Duration of each WorkflowNodeQueueWorker tick"),
@@ -168,6 +195,11 @@ func InitMetrics(ctx context.Context) error {
 		return err
 	}
 
+	err = canvasmetrics.Init(meter)
+	if err != nil {
+		return err
+	}
+
 	StartPeriodicMetricsReporter()
 
 	metricsReady.Store(true)
@@ -175,6 +207,16 @@ func InitMetrics(ctx context.Context) error {
 	return nil
 }
 
+// MetricsHandler serves the metrics in the Prometheus format,
+// if they are exposed for scraping.
+func MetricsHandler() http.Handler {
+	if handler := metricsHandler.Load(); handler != nil {
+		return handler.(http.Handler)
+	}
+
+	return http.NotFoundHandler()
+}
+
 func StartPeriodicMetricsReporter() {
 	p := NewPeriodic(context.Background())
 	p.Start()
@@ -0,0 +1,241 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "superplane"
+
+// Attributes recorded on the spans of an event chain.
+const (
+	AttributeCanvasID    = attribute.Key("superplane.canvas.id")
+	AttributeNodeID      = attribute.Key("superplane.node.id")
+	AttributeComponent   = attribute.Key("superplane.component")
+	AttributeEventID     = attribute.Key("superplane.event.id")
+	AttributeQueueItemID = attribute.Key("superplane.queue_item.id")
+	AttributeExecutionID = attribute.Key("superplane.execution.id")
+	AttributeAction      = attribute.Key("superplane.action")
+	AttributeState       = attribute.Key("superplane.execution.state")
+	AttributeResult      = attribute.Key("superplane.execution.result")
+)
+
+var (
+	tracingReady atomic.Bool
+	propagator   = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+)
+
+/*
+ * Every root CanvasEvent starts a trace, and its trace context
+ * is stored on the event. Everything processed for that event later,
+ * by any worker, is recorded as a span under it, so a whole event
+ * chain shows up as a single trace.
+ */
+func InitTracing(ctx context.Context) error {
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return err
+	}
+
+	//
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES take precedence.
+	//
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceName("superplane")),
+		resource.WithFromEnv(),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+	tracingReady.Store(true)
+	return nil
+}
+
+func TracingEnabled() bool {
+	return tracingReady.Load()
+}
+
+func StartSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, opts...)
+}
+
+// EndSpan ends the span, recording the error if there is one.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// RecordSpan records a span for work that already happened,
+// started at the given time and ending now.
+func RecordSpan(ctx context.Context, name string, start time.Time, err error, attrs ...attribute.KeyValue) {
+	if !TracingEnabled() {
+		return
+	}
+
+	_, span := StartSpan(ctx, name, trace.WithTimestamp(start), trace.WithAttributes(attrs...))
+	EndSpan(span, err)
+}
+
+// Traceparent returns the W3C trace context for the span in the context.
+func Traceparent(ctx context.Context) *string {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+
+	traceparent := carrier.Get("traceparent")
+	if traceparent == "" {
+		return nil
+	}
+
+	return &traceparent
+}
+
+// WithTraceparent returns a context with the given W3C trace context as its remote parent.
+func WithTraceparent(ctx context.Context, traceparent *string) context.Context {
+	if traceparent == nil {
+		return ctx
+	}
+
+	carrier := propagation.MapCarrier{"traceparent": *traceparent}
+	return propagation.TraceContext{}.Extract(ctx, carrier)
+}
+
+// RootEventContext returns a context to record spans under the trace of a root event.
+func RootEventContext(ctx context.Context, rootEventID uuid.UUID) context.Context {
+	if !TracingEnabled() {
+		return ctx
+	}
+
+	traceparent, err := models.FindRootEventTraceparent(rootEventID)
+	if err != nil {
+		log.Warnf("Error finding trace context for event %s: %v", rootEventID, err)
+		return ctx
+	}
+
+	return WithTraceparent(ctx, traceparent)
+}
+
+// EventContext returns a context to record spans under the trace
+// of the root event of the given event.
+func EventContext(ctx context.Context, event *models.CanvasEvent) context.Context {
+	if !TracingEnabled() {
+		return ctx
+	}
+
+	if event.ExecutionID == nil {
+		return WithTraceparent(ctx, event.Traceparent)
+	}
+
+	return ExecutionContext(ctx, *event.ExecutionID)
+}
+
+// ExecutionContext returns a context to record spans under the trace
+// of the root event of the given execution.
+func ExecutionContext(ctx context.Context, executionID uuid.UUID) context.Context {
+	if !TracingEnabled() {
+		return ctx
+	}
+
+	traceparent, err := models.FindExecutionTraceparent(executionID)
+	if err != nil {
+		log.Warnf("Error finding trace context for execution %s: %v", executionID, err)
+		return ctx
+	}
+
+	return WithTraceparent(ctx, traceparent)
+}
+
+// StartActionSpan starts the span for an action invoked on an execution,
+// under the trace of the root event of the execution.
+func StartActionSpan(execution *models.CanvasNodeExecution, component, action string) (context.Context, trace.Span) {
+	ctx := RootEventContext(context.Background(), execution.RootEventID)
+	return StartSpan(ctx, "canvas.execution.action", trace.WithAttributes(
+		AttributeCanvasID.String(execution.WorkflowID.String()),
+		AttributeNodeID.String(execution.NodeID),
+		AttributeExecutionID.String(execution.ID.String()),
+		AttributeComponent.String(component),
+		AttributeAction.String(action),
+	))
+}
+
+type HTTPDoer interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// TraceHTTP records every request sent through the doer as a span.
+// Requests without a span in their own context are recorded
+// under the span in the given context.
+func TraceHTTP(ctx context.Context, doer HTTPDoer) HTTPDoer {
+	if !TracingEnabled() {
+		return doer
+	}
+
+	return &tracedHTTP{ctx: ctx, doer: doer}
+}
+
+type tracedHTTP struct {
+	ctx  context.Context
+	doer HTTPDoer
+}
+
+func (h *tracedHTTP) Do(req *http.Request) (*http.Response, error) {
+	parent := req.Context()
+	if !trace.SpanContextFromContext(parent).IsValid() {
+		parent = trace.ContextWithSpan(parent, trace.SpanFromContext(h.ctx))
+	}
+
+	ctx, span := StartSpan(parent, "HTTP "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.ServerAddress(req.URL.Hostname()),
+			semconv.URLPath(req.URL.Path),
+		),
+	)
+
+	req = req.Clone(ctx)
+	propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	res, err := h.doer.Do(req)
+	if err != nil {
+		EndSpan(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(semconv.HTTPResponseStatusCode(res.StatusCode))
+	if res.StatusCode >= 500 {
+		EndSpan(span, fmt.Errorf("request failed with status %d", res.StatusCode))
+		return res, nil
+	}
+
+	span.End()
+	return res, nil
+}
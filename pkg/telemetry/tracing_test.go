@@ -0,0 +1,84 @@
+package telemetry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setupTestTracing(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	tracingReady.Store(true)
+
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+		tracingReady.Store(false)
+	})
+
+	return recorder
+}
+
+func TestTraceparent_RoundTrip(t *testing.T) {
+	setupTestTracing(t)
+
+	assert.Nil(t, Traceparent(context.Background()))
+
+	ctx, span := StartSpan(context.Background(), "root")
+	defer span.End()
+
+	traceparent := Traceparent(ctx)
+	require.NotNil(t, traceparent)
+
+	//
+	// Spans started from the stored trace context are part of the same trace.
+	//
+	_, child := StartSpan(WithTraceparent(context.Background(), traceparent), "child")
+	defer child.End()
+
+	assert.Equal(t, span.SpanContext().TraceID(), child.SpanContext().TraceID())
+	assert.Equal(t, ctx, WithTraceparent(ctx, nil))
+}
+
+func TestTraceHTTP_InjectsTraceContext(t *testing.T) {
+	recorder := setupTestTracing(t)
+
+	received := make(chan http.Header, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.Header.Clone()
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+
+	defer server.Close()
+
+	ctx, parent := StartSpan(context.Background(), "canvas.execution")
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/deploy", nil)
+	require.NoError(t, err)
+
+	res, err := TraceHTTP(ctx, http.DefaultClient).Do(req)
+	require.NoError(t, err)
+	res.Body.Close()
+	parent.End()
+
+	headers := <-received
+	assert.NotEmpty(t, headers.Get("traceparent"))
+	assert.Empty(t, req.Header.Get("traceparent"))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	span := spans[0]
+	assert.Equal(t, "HTTP POST", span.Name())
+	assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+	assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+	assert.Equal(t, "Error", span.Status().Code.String())
+}
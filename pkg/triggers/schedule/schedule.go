@@ -33,6 +33,23 @@ const (
 	WeekDayFriday    = "friday"
 	WeekDaySaturday  = "saturday"
 	WeekDaySunday    = "sunday"
+
+	CatchUpSkip = "skip"
+	CatchUpOnce = "once"
+	CatchUpAll  = "all"
+
+	RunTypeScheduled = "scheduled"
+	RunTypeCatchUp   = "catch_up"
+	RunTypeBackfill  = "backfill"
+
+	// Runs that happen later than this are considered missed
+	MissedRunTolerance = time.Minute
+
+	// Upper bound on the number of events emitted for missed runs and backfills
+	MaxCatchUpRuns  = 100
+	MaxBackfillRuns = 100
+
+	BackfillTimeFormat = "2006-01-02T15:04"
 )
 
 type Schedule struct{}
@@ -55,6 +72,15 @@ type Configuration struct {
 	DayOfMonth      *int     `json:"dayOfMonth"`      // 1-31 for months scheduling
 	CronExpression  *string  `json:"cronExpression"`  // For cron scheduling
 	Timezone        *string  `json:"timezone"`        // Timezone offset (e.g., "0", "-5", "5.5")
+
+	CatchUp         string           `json:"catchUp"`         // What to do with runs missed while the server was down
+	BlackoutDates   []string         `json:"blackoutDates"`   // Days of the year (MM/DD) with no runs, such as holidays
+	BlackoutWindows []BlackoutWindow `json:"blackoutWindows"` // Periods of the year with no runs
+}
+
+type BlackoutWindow struct {
+	Start string `json:"start"` // MM/DD, inclusive
+	End   string `json:"end"`   // MM/DD, inclusive
 }
 
 func (s *Schedule) Name() string {
@@ -98,11 +124,28 @@ Supports both 5-field and 6-field cron expressions:
 - **5-field**: ` + "`minute hour day month dayofweek`" + ` (e.g., ` + "`30 14 * * MON-FRI`" + `)
 - **6-field**: ` + "`second minute hour day month dayofweek`" + ` (e.g., ` + "`0 30 14 * * MON-FRI`" + `)
 
+## Missed Runs
+
+If the server is not running when a run is due, the **Missed Runs** option controls what happens when it comes back:
+- **Run once**: Emit a single event for the most recent missed run (default)
+- **Run all**: Emit one event for every missed run, up to 100
+- **Skip**: Do not emit events for missed runs
+
+## Blackouts
+
+Use **Blackout Dates** to skip runs on specific days of the year, such as holidays, and **Blackout Windows** to skip runs during periods of the year, such as a change freeze from 12/20 to 01/05. Blackouts are evaluated in the schedule timezone.
+
+## Backfill
+
+The **Backfill** action emits one event for each time the schedule would have run between two past dates. Blackouts apply to backfilled runs too.
+
 ## Event Data
 
 Each scheduled execution includes calendar information:
 - **calendar**: Year, month, day, hour, minute, second, week_day
 - **timezone**: Timezone information (for applicable schedule types)
+- **scheduled_at**: The time the run was scheduled for
+- **run_type**: ` + "`scheduled`" + `, ` + "`catch_up`" + ` for missed runs, or ` + "`backfill`" + `
 
 ## Examples
 
@@ -349,6 +392,66 @@ func (s *Schedule) Configuration() []configuration.Field {
 				Cron: &configuration.CronTypeOptions{},
 			},
 		},
+		{
+			Name:        "catchUp",
+			Label:       "Missed Runs",
+			Type:        configuration.FieldTypeSelect,
+			Default:     CatchUpOnce,
+			Description: "What to do with runs missed while SuperPlane was not running",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Run once", Value: CatchUpOnce},
+						{Label: "Run all", Value: CatchUpAll},
+						{Label: "Skip", Value: CatchUpSkip},
+					},
+				},
+			},
+		},
+		{
+			Name:        "blackoutDates",
+			Label:       "Blackout Dates (MM/DD)",
+			Type:        configuration.FieldTypeList,
+			Togglable:   true,
+			Description: "Days of the year with no runs, such as holidays",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Date",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeDayInYear,
+					},
+				},
+			},
+		},
+		{
+			Name:        "blackoutWindows",
+			Label:       "Blackout Windows",
+			Type:        configuration.FieldTypeList,
+			Togglable:   true,
+			Description: "Periods of the year with no runs, such as a change freeze",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Window",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:     "start",
+								Label:    "From (MM/DD)",
+								Type:     configuration.FieldTypeDayInYear,
+								Required: true,
+							},
+							{
+								Name:     "end",
+								Label:    "To (MM/DD)",
+								Type:     configuration.FieldTypeDayInYear,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -359,6 +462,11 @@ func (s *Schedule) Setup(ctx core.TriggerContext) error {
 		return fmt.Errorf("failed to decode configuration: %w", err)
 	}
 
+	err = validateCatchUpAndBlackouts(config)
+	if err != nil {
+		return err
+	}
+
 	var metadata Metadata
 	err = mapstructure.Decode(ctx.Metadata.Get(), &metadata)
 	if err != nil {
@@ -412,6 +520,27 @@ func (s *Schedule) Actions() []core.Action {
 			Name:           "emitEvent",
 			UserAccessible: false,
 		},
+		{
+			Name:           "backfill",
+			Description:    "Emit one event for each time the schedule would have run in a past period",
+			UserAccessible: true,
+			Parameters: []configuration.Field{
+				{
+					Name:        "from",
+					Label:       "From",
+					Type:        configuration.FieldTypeDateTime,
+					Required:    true,
+					Description: "Start of the period, in the schedule timezone",
+				},
+				{
+					Name:        "to",
+					Label:       "To",
+					Type:        configuration.FieldTypeDateTime,
+					Required:    true,
+					Description: "End of the period, in the schedule timezone",
+				},
+			},
+		},
 	}
 }
 
@@ -419,6 +548,8 @@ func (s *Schedule) HandleAction(ctx core.TriggerActionContext) (map[string]any,
 	switch ctx.Name {
 	case "emitEvent":
 		return nil, s.emitEvent(ctx)
+	case "backfill":
+		return s.backfill(ctx)
 	}
 
 	return nil, fmt.Errorf("action %s not supported", ctx.Name)
@@ -431,27 +562,150 @@ func (s *Schedule) emitEvent(ctx core.TriggerActionContext) error {
 		return err
 	}
 
+	var existingMetadata Metadata
+	err = mapstructure.Decode(ctx.Metadata.Get(), &existingMetadata)
+	if err != nil {
+		return fmt.Errorf("failed to parse existing metadata: %w", err)
+	}
+
+	nowUTC := time.Now()
+	runs, runType, err := dueRuns(spec, existingMetadata, nowUTC)
+	if err != nil {
+		return err
+	}
+
+	if runType == RunTypeCatchUp {
+		ctx.Logger.Infof("Emitting %d event(s) for missed runs", len(runs))
+	}
+
+	for _, run := range runs {
+		if isBlackedOut(spec, run) {
+			ctx.Logger.Infof("Run at %s is in a blackout - skipping", run.Format(time.RFC3339))
+			continue
+		}
+
+		err = ctx.Events.Emit("scheduler.tick", buildPayload(spec, run, runType))
+		if err != nil {
+			return err
+		}
+	}
+
+	nextTrigger, err := getNextTrigger(spec, nowUTC, existingMetadata.ReferenceTime)
+	if err != nil {
+		return err
+	}
+
+	err = ctx.Requests.ScheduleActionCall("emitEvent", map[string]any{}, time.Until(*nextTrigger))
+	if err != nil {
+		return err
+	}
+
+	formatted := nextTrigger.Format(time.RFC3339)
+	ctx.Logger.Infof("Next trigger at: %v", formatted)
+
+	return ctx.Metadata.Set(Metadata{
+		NextTrigger:   &formatted,
+		ReferenceTime: existingMetadata.ReferenceTime,
+	})
+}
+
+func (s *Schedule) backfill(ctx core.TriggerActionContext) (map[string]any, error) {
+	spec := Configuration{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata Metadata
+	err = mapstructure.Decode(ctx.Metadata.Get(), &metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metadata: %w", err)
+	}
+
+	from, err := parseBackfillTime(spec, ctx.Parameters, "from")
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := parseBackfillTime(spec, ctx.Parameters, "to")
+	if err != nil {
+		return nil, err
+	}
+
+	if !from.Before(*to) {
+		return nil, fmt.Errorf("from must be before to")
+	}
+
+	if to.After(time.Now()) {
+		return nil, fmt.Errorf("to must be in the past")
+	}
+
+	runs, err := runsBetween(spec, metadata.ReferenceTime, *from, *to, MaxBackfillRuns)
+	if err != nil {
+		return nil, err
+	}
+
+	emitted := 0
+	skipped := 0
+	for _, run := range runs {
+		if isBlackedOut(spec, run) {
+			skipped++
+			continue
+		}
+
+		err = ctx.Events.Emit("scheduler.tick", buildPayload(spec, run, RunTypeBackfill))
+		if err != nil {
+			return nil, err
+		}
+
+		emitted++
+	}
+
+	ctx.Logger.Infof("Backfilled %d run(s) from %s to %s - %d in blackouts", emitted, from.Format(time.RFC3339), to.Format(time.RFC3339), skipped)
+
+	return map[string]any{
+		"emitted": emitted,
+		"skipped": skipped,
+	}, nil
+}
+
+func parseBackfillTime(spec Configuration, parameters map[string]any, name string) (*time.Time, error) {
+	value, ok := parameters[name].(string)
+	if !ok || value == "" {
+		return nil, fmt.Errorf("%s is required", name)
+	}
+
+	t, err := time.ParseInLocation(BackfillTimeFormat, value, parseTimezone(spec.Timezone))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", name, err)
+	}
+
+	return &t, nil
+}
+
+func buildPayload(spec Configuration, run time.Time, runType string) map[string]any {
 	var timezone *time.Location
-	var now time.Time
 
 	// Only use timezone for schedule types that support it
-	if spec.Type == TypeDays || spec.Type == TypeWeeks || spec.Type == TypeMonths || spec.Type == TypeCron {
+	if usesTimezone(spec.Type) {
 		timezone = parseTimezone(spec.Timezone)
-		now = time.Now().In(timezone)
+		run = run.In(timezone)
 	} else {
-		now = time.Now()
+		run = run.In(time.Local)
 	}
 
 	payload := map[string]any{
 		"calendar": map[string]any{
-			"year":     now.Format("2006"),
-			"month":    now.Format("January"),
-			"day":      now.Format("2"),
-			"hour":     now.Format("15"),
-			"minute":   now.Format("04"),
-			"second":   now.Format("05"),
-			"week_day": now.Format("Monday"),
+			"year":     run.Format("2006"),
+			"month":    run.Format("January"),
+			"day":      run.Format("2"),
+			"hour":     run.Format("15"),
+			"minute":   run.Format("04"),
+			"second":   run.Format("05"),
+			"week_day": run.Format("Monday"),
 		},
+		"scheduled_at": run.UTC().Format(time.RFC3339),
+		"run_type":     runType,
 	}
 
 	// Only include timezone for schedule types that support it
@@ -459,36 +713,232 @@ func (s *Schedule) emitEvent(ctx core.TriggerActionContext) error {
 		payload["timezone"] = formatTimezone(timezone)
 	}
 
-	err = ctx.Events.Emit("scheduler.tick", payload)
+	return payload
+}
 
-	if err != nil {
-		return err
+func usesTimezone(scheduleType string) bool {
+	return scheduleType == TypeDays || scheduleType == TypeWeeks || scheduleType == TypeMonths || scheduleType == TypeCron
+}
+
+// dueRuns returns the runs that should be emitted now.
+// If the run was due a while ago, the runs missed since then
+// are handled according to the catch-up policy.
+func dueRuns(spec Configuration, metadata Metadata, now time.Time) ([]time.Time, string, error) {
+	if metadata.NextTrigger == nil {
+		return []time.Time{now}, RunTypeScheduled, nil
 	}
 
-	var existingMetadata Metadata
-	err = mapstructure.Decode(ctx.Metadata.Get(), &existingMetadata)
+	scheduled, err := time.Parse(time.RFC3339, *metadata.NextTrigger)
 	if err != nil {
-		return fmt.Errorf("failed to parse existing metadata: %w", err)
+		return nil, "", fmt.Errorf("error parsing next trigger: %v", err)
 	}
 
-	nowUTC := time.Now()
-	nextTrigger, err := getNextTrigger(spec, nowUTC, existingMetadata.ReferenceTime)
-	if err != nil {
-		return err
+	if now.Sub(scheduled) <= MissedRunTolerance {
+		return []time.Time{scheduled}, RunTypeScheduled, nil
 	}
 
-	err = ctx.Requests.ScheduleActionCall("emitEvent", map[string]any{}, time.Until(*nextTrigger))
-	if err != nil {
-		return err
+	switch spec.CatchUp {
+	case CatchUpSkip:
+		return []time.Time{}, RunTypeCatchUp, nil
+
+	case CatchUpAll:
+		missed, err := lastRunsBetween(spec, metadata.ReferenceTime, scheduled, now, MaxCatchUpRuns)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return missed, RunTypeCatchUp, nil
+
+	default:
+		missed, err := lastRunsBetween(spec, metadata.ReferenceTime, scheduled, now, 1)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(missed) == 0 {
+			return []time.Time{scheduled}, RunTypeCatchUp, nil
+		}
+
+		return missed, RunTypeCatchUp, nil
 	}
+}
 
-	formatted := nextTrigger.Format(time.RFC3339)
-	ctx.Logger.Infof("Next trigger at: %v", formatted)
+// runsBetween returns the times the schedule runs at between from and to, inclusive.
+// More than limit runs is an error.
+func runsBetween(spec Configuration, referenceTime *string, from, to time.Time, limit int) ([]time.Time, error) {
+	runs := []time.Time{}
+	err := walkRuns(spec, referenceTime, from, to, func(run time.Time) error {
+		if len(runs) == limit {
+			return fmt.Errorf("period includes more than %d runs", limit)
+		}
 
-	return ctx.Metadata.Set(Metadata{
-		NextTrigger:   &formatted,
-		ReferenceTime: existingMetadata.ReferenceTime,
+		runs = append(runs, run)
+		return nil
 	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return runs, nil
+}
+
+// lastRunsBetween returns the last limit times the schedule runs at
+// between from and to, inclusive. Instead of stepping through every slot
+// since from, it looks back from to over a window that doubles
+// until it includes limit runs or reaches from.
+func lastRunsBetween(spec Configuration, referenceTime *string, from, to time.Time, limit int) ([]time.Time, error) {
+	window := to.Sub(stepBack(spec, to))
+
+	for {
+		start := to.Add(-window)
+		if window >= to.Sub(from) {
+			start = from
+		}
+
+		runs := []time.Time{}
+		err := walkRuns(spec, referenceTime, start, to, func(run time.Time) error {
+			runs = append(runs, run)
+			if len(runs) > limit {
+				runs = runs[1:]
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(runs) >= limit || start.Equal(from) {
+			return runs, nil
+		}
+
+		window *= 2
+	}
+}
+
+// walkRuns calls fn for each time the schedule runs at between from and to, inclusive.
+func walkRuns(spec Configuration, referenceTime *string, from, to time.Time, fn func(time.Time) error) error {
+	cursor := stepBack(spec, from)
+
+	for {
+		next, err := getNextTrigger(spec, cursor, referenceTime)
+		if err != nil {
+			return err
+		}
+
+		if next.After(to) {
+			return nil
+		}
+
+		//
+		// Protect against schedules that do not move forward.
+		//
+		if !next.After(cursor) {
+			return fmt.Errorf("schedule did not advance after %s", cursor.Format(time.RFC3339))
+		}
+
+		if !next.Before(from) {
+			err = fn(*next)
+			if err != nil {
+				return err
+			}
+		}
+
+		cursor = *next
+	}
+}
+
+// stepBack returns a time from which the next trigger
+// is the first run at or after t.
+func stepBack(spec Configuration, t time.Time) time.Time {
+	switch spec.Type {
+	case TypeHours:
+		if spec.HoursInterval != nil {
+			return t.Add(-time.Duration(*spec.HoursInterval) * time.Hour)
+		}
+	case TypeDays:
+		if spec.DaysInterval != nil {
+			return t.AddDate(0, 0, -*spec.DaysInterval)
+		}
+	case TypeWeeks:
+		if spec.WeeksInterval != nil {
+			return t.AddDate(0, 0, -7**spec.WeeksInterval)
+		}
+	case TypeMonths:
+		if spec.MonthsInterval != nil {
+			return t.AddDate(0, -*spec.MonthsInterval, 0)
+		}
+	}
+
+	return t.Add(-time.Second)
+}
+
+func isBlackedOut(spec Configuration, run time.Time) bool {
+	run = run.In(parseTimezone(spec.Timezone))
+	day := int(run.Month())*100 + run.Day()
+
+	for _, date := range spec.BlackoutDates {
+		month, dayOfMonth, err := configuration.ParseDayInYear(date)
+		if err == nil && month*100+dayOfMonth == day {
+			return true
+		}
+	}
+
+	for _, window := range spec.BlackoutWindows {
+		startMonth, startDay, err := configuration.ParseDayInYear(window.Start)
+		if err != nil {
+			continue
+		}
+
+		endMonth, endDay, err := configuration.ParseDayInYear(window.End)
+		if err != nil {
+			continue
+		}
+
+		start := startMonth*100 + startDay
+		end := endMonth*100 + endDay
+
+		//
+		// Windows ending before they start wrap around the end of the year.
+		//
+		if start <= end && day >= start && day <= end {
+			return true
+		}
+
+		if start > end && (day >= start || day <= end) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateCatchUpAndBlackouts(config Configuration) error {
+	switch config.CatchUp {
+	case "", CatchUpSkip, CatchUpOnce, CatchUpAll:
+	default:
+		return fmt.Errorf("invalid catchUp: %s", config.CatchUp)
+	}
+
+	for _, date := range config.BlackoutDates {
+		if _, _, err := configuration.ParseDayInYear(date); err != nil {
+			return fmt.Errorf("blackoutDates error: %w", err)
+		}
+	}
+
+	for _, window := range config.BlackoutWindows {
+		if _, _, err := configuration.ParseDayInYear(window.Start); err != nil {
+			return fmt.Errorf("blackoutWindows error: %w", err)
+		}
+
+		if _, _, err := configuration.ParseDayInYear(window.End); err != nil {
+			return fmt.Errorf("blackoutWindows error: %w", err)
+		}
+	}
+
+	return nil
 }
 
 func getNextTrigger(config Configuration, now time.Time, referenceTime *string) (*time.Time, error) {
@@ -553,3 +553,263 @@ func TestEmitEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestEmitEventCatchUp(t *testing.T) {
+	config := func(catchUp string) Configuration {
+		return Configuration{
+			Type:          TypeHours,
+			HoursInterval: intPtr(1),
+			Minute:        intPtr(0),
+			CatchUp:       catchUp,
+		}
+	}
+
+	// Due 3h and some minutes ago, so 4 runs were missed.
+	now := time.Now().UTC()
+	missedSince := now.Truncate(time.Hour).Add(-3 * time.Hour).Format(time.RFC3339)
+
+	tests := []struct {
+		name           string
+		config         Configuration
+		nextTrigger    string
+		expectedEvents int
+		expectedType   string
+	}{
+		{
+			name:           "run on time",
+			config:         config(CatchUpAll),
+			nextTrigger:    now.Add(-5 * time.Second).Format(time.RFC3339),
+			expectedEvents: 1,
+			expectedType:   RunTypeScheduled,
+		},
+		{
+			name:           "missed runs are emitted once by default",
+			config:         config(""),
+			nextTrigger:    missedSince,
+			expectedEvents: 1,
+			expectedType:   RunTypeCatchUp,
+		},
+		{
+			name:           "all missed runs are emitted",
+			config:         config(CatchUpAll),
+			nextTrigger:    missedSince,
+			expectedEvents: 4,
+			expectedType:   RunTypeCatchUp,
+		},
+		{
+			name:           "missed runs are skipped",
+			config:         config(CatchUpSkip),
+			nextTrigger:    missedSince,
+			expectedEvents: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := &Schedule{}
+			eventCtx := &contexts.EventContext{}
+			metadataCtx := &contexts.MetadataContext{
+				Metadata: Metadata{NextTrigger: &tt.nextTrigger},
+			}
+
+			err := schedule.emitEvent(core.TriggerActionContext{
+				Name:          "emitEvent",
+				Configuration: tt.config,
+				Logger:        log.NewEntry(log.StandardLogger()),
+				Events:        eventCtx,
+				Metadata:      metadataCtx,
+				Requests:      &contexts.RequestContext{},
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if eventCtx.Count() != tt.expectedEvents {
+				t.Fatalf("expected %d events, got %d", tt.expectedEvents, eventCtx.Count())
+			}
+
+			for _, payload := range eventCtx.Payloads {
+				data := payload.Data.(map[string]any)
+				if data["run_type"] != tt.expectedType {
+					t.Errorf("expected run_type %s, got %v", tt.expectedType, data["run_type"])
+				}
+			}
+
+			metadata := metadataCtx.Metadata.(Metadata)
+			if metadata.NextTrigger == nil {
+				t.Fatalf("expected next trigger to be set")
+			}
+
+			next, _ := time.Parse(time.RFC3339, *metadata.NextTrigger)
+			if !next.After(now) {
+				t.Errorf("expected next trigger in the future, got %v", next)
+			}
+		})
+	}
+}
+
+func TestLastRunsBetween(t *testing.T) {
+	config := Configuration{
+		Type:            TypeMinutes,
+		MinutesInterval: intPtr(1),
+	}
+
+	reference := "2020-01-01T00:00:00Z"
+	to := mustParseTime("2025-03-10T14:30:30Z")
+
+	t.Run("years of missed slots keep only the last runs", func(t *testing.T) {
+		runs, err := lastRunsBetween(config, &reference, to.AddDate(-5, 0, 0), to, MaxCatchUpRuns)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(runs) != MaxCatchUpRuns {
+			t.Fatalf("expected %d runs, got %d", MaxCatchUpRuns, len(runs))
+		}
+
+		if !runs[len(runs)-1].Equal(mustParseTime("2025-03-10T14:30:00Z")) {
+			t.Errorf("expected last run at 14:30, got %v", runs[len(runs)-1])
+		}
+
+		if !runs[0].Equal(mustParseTime("2025-03-10T12:51:00Z")) {
+			t.Errorf("expected first run at 12:51, got %v", runs[0])
+		}
+	})
+
+	t.Run("fewer slots than the limit", func(t *testing.T) {
+		runs, err := lastRunsBetween(config, &reference, to.Add(-10*time.Minute), to, MaxCatchUpRuns)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(runs) != 10 {
+			t.Fatalf("expected 10 runs, got %d", len(runs))
+		}
+	})
+}
+
+func TestIsBlackedOut(t *testing.T) {
+	config := Configuration{
+		Type:          TypeDays,
+		DaysInterval:  intPtr(1),
+		Hour:          intPtr(9),
+		Minute:        intPtr(0),
+		Timezone:      stringPtr("-5"),
+		BlackoutDates: []string{"07/04"},
+		BlackoutWindows: []BlackoutWindow{
+			{Start: "12/20", End: "01/05"},
+			{Start: "08/01", End: "08/15"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		run      time.Time
+		expected bool
+	}{
+		{name: "regular day", run: mustParseTime("2025-03-10T14:00:00Z"), expected: false},
+		{name: "blackout date", run: mustParseTime("2025-07-04T14:00:00Z"), expected: true},
+		{name: "blackout date in schedule timezone", run: mustParseTime("2025-07-05T02:00:00Z"), expected: true},
+		{name: "day after blackout date", run: mustParseTime("2025-07-05T14:00:00Z"), expected: false},
+		{name: "window", run: mustParseTime("2025-08-10T14:00:00Z"), expected: true},
+		{name: "window end is inclusive", run: mustParseTime("2025-08-15T14:00:00Z"), expected: true},
+		{name: "after window", run: mustParseTime("2025-08-16T14:00:00Z"), expected: false},
+		{name: "window wrapping the year - december", run: mustParseTime("2025-12-24T14:00:00Z"), expected: true},
+		{name: "window wrapping the year - january", run: mustParseTime("2026-01-02T14:00:00Z"), expected: true},
+		{name: "after window wrapping the year", run: mustParseTime("2026-01-06T14:00:00Z"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if isBlackedOut(config, tt.run) != tt.expected {
+				t.Errorf("expected %v for %v", tt.expected, tt.run)
+			}
+		})
+	}
+}
+
+func TestBackfill(t *testing.T) {
+	config := Configuration{
+		Type:          TypeDays,
+		DaysInterval:  intPtr(1),
+		Hour:          intPtr(9),
+		Minute:        intPtr(0),
+		Timezone:      stringPtr("0"),
+		BlackoutDates: []string{"01/03"},
+	}
+
+	t.Run("emits one event per run in the period", func(t *testing.T) {
+		schedule := &Schedule{}
+		eventCtx := &contexts.EventContext{}
+
+		result, err := schedule.HandleAction(core.TriggerActionContext{
+			Name:          "backfill",
+			Configuration: config,
+			Parameters:    map[string]any{"from": "2025-01-01T09:00", "to": "2025-01-05T08:00"},
+			Logger:        log.NewEntry(log.StandardLogger()),
+			Events:        eventCtx,
+			Metadata:      &contexts.MetadataContext{},
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// 01/01, 01/02 and 01/04 - 01/03 is a blackout date.
+		if eventCtx.Count() != 3 {
+			t.Fatalf("expected 3 events, got %d", eventCtx.Count())
+		}
+
+		if result["emitted"] != 3 || result["skipped"] != 1 {
+			t.Errorf("unexpected result: %v", result)
+		}
+
+		data := eventCtx.Payloads[0].Data.(map[string]any)
+		if data["run_type"] != RunTypeBackfill {
+			t.Errorf("expected run_type backfill, got %v", data["run_type"])
+		}
+
+		if data["scheduled_at"] != "2025-01-01T09:00:00Z" {
+			t.Errorf("expected first run at 2025-01-01T09:00:00Z, got %v", data["scheduled_at"])
+		}
+	})
+
+	t.Run("period with too many runs is rejected", func(t *testing.T) {
+		schedule := &Schedule{}
+		eventCtx := &contexts.EventContext{}
+
+		_, err := schedule.HandleAction(core.TriggerActionContext{
+			Name:          "backfill",
+			Configuration: config,
+			Parameters:    map[string]any{"from": "2020-01-01T00:00", "to": "2025-01-01T00:00"},
+			Logger:        log.NewEntry(log.StandardLogger()),
+			Events:        eventCtx,
+			Metadata:      &contexts.MetadataContext{},
+		})
+
+		if err == nil {
+			t.Fatalf("expected error")
+		}
+
+		if eventCtx.Count() != 0 {
+			t.Errorf("expected no events, got %d", eventCtx.Count())
+		}
+	})
+
+	t.Run("from must be before to", func(t *testing.T) {
+		schedule := &Schedule{}
+		_, err := schedule.HandleAction(core.TriggerActionContext{
+			Name:          "backfill",
+			Configuration: config,
+			Parameters:    map[string]any{"from": "2025-01-05T00:00", "to": "2025-01-01T00:00"},
+			Logger:        log.NewEntry(log.StandardLogger()),
+			Events:        &contexts.EventContext{},
+			Metadata:      &contexts.MetadataContext{},
+		})
+
+		if err == nil {
+			t.Fatalf("expected error")
+		}
+	})
+}
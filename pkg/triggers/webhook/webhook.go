@@ -3,10 +3,16 @@ package webhook
 import (
 	"encoding/json"
 	"fmt"
+	"mime"
 	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
+	"github.com/santhosh-tekuri/jsonschema/v6"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/crypto"
@@ -15,6 +21,14 @@ import (
 
 const MaxEventSize = 64 * 1024
 
+const (
+	ModeAsync = "async"
+	ModeSync  = "sync"
+
+	DefaultSyncTimeout = 10
+	MaxSyncTimeout     = 25
+)
+
 func init() {
 	registry.RegisterTrigger("webhook", &Webhook{})
 }
@@ -27,7 +41,37 @@ type Metadata struct {
 }
 
 type Configuration struct {
-	Authentication string `json:"authentication"`
+	Authentication string         `json:"authentication" mapstructure:"authentication"`
+	Methods        []string       `json:"methods,omitempty" mapstructure:"methods"`
+	HeaderFilters  []HeaderFilter `json:"headerFilters,omitempty" mapstructure:"headerFilters"`
+	AllowedIPs     []string       `json:"allowedIPs,omitempty" mapstructure:"allowedIPs"`
+	Schema         string         `json:"schema,omitempty" mapstructure:"schema"`
+	Extract        []Extraction   `json:"extract,omitempty" mapstructure:"extract"`
+	Response       *Response      `json:"response,omitempty" mapstructure:"response"`
+	Mode           string         `json:"mode,omitempty" mapstructure:"mode"`
+	Timeout        int            `json:"timeout,omitempty" mapstructure:"timeout"`
+}
+
+type HeaderFilter struct {
+	Name  string `json:"name" mapstructure:"name"`
+	Value string `json:"value" mapstructure:"value"`
+}
+
+const (
+	ExtractionSourceHeader = "header"
+	ExtractionSourceQuery  = "query"
+)
+
+type Extraction struct {
+	Source string `json:"source" mapstructure:"source"`
+	Name   string `json:"name" mapstructure:"name"`
+	As     string `json:"as,omitempty" mapstructure:"as"`
+}
+
+type Response struct {
+	Status      int    `json:"status" mapstructure:"status"`
+	ContentType string `json:"contentType,omitempty" mapstructure:"contentType"`
+	Body        string `json:"body,omitempty" mapstructure:"body"`
 }
 
 func (w *Webhook) Name() string {
@@ -65,11 +109,32 @@ func (w *Webhook) Documentation() string {
 - **Bearer Token**: Require a Bearer token in the ` + "`Authorization`" + ` header
 - **None (unsafe)**: No authentication (not recommended for production)
 
+## Request Validation
+
+- **Allowed methods**: Requests using other HTTP methods are rejected with a 405
+- **Content type**: JSON, form and text bodies are accepted. Requests with other content types are rejected with a 415
+- **Allowed IPs**: Only accept requests from these IP addresses or CIDR ranges. Other requests are rejected with a 403
+- **Header filters**: Only emit events for requests with matching header values. Other requests are acknowledged, but ignored
+- **JSON Schema**: Validate the request body against a JSON Schema. Invalid requests are rejected with a 400, and the validation errors are returned to the caller
+
 ## Request Data
 
 The webhook payload includes:
-- **body**: Parsed request body (JSON if possible, otherwise raw data)
+- **body**: Parsed request body. JSON and form bodies are parsed into objects, text and XML bodies are kept as strings
 - **headers**: All HTTP headers from the request
+- **method**: The HTTP method used
+- **query**: The query parameters of the request
+- **params**: Header and query parameter values extracted with the **Extract** option, keyed by their configured names
+
+## Response
+
+By default, accepted requests receive an empty 200 response. Use the **Response** option to return a custom status code and body.
+
+## Synchronous Mode
+
+In **Synchronous** mode, the webhook holds the request open until a **Respond to Webhook** component in the execution chain started by the request emits. Its status code, headers and body are returned to the caller.
+
+If no response is emitted before the configured timeout, the caller receives a 202 with the ID of the event and a URL to check the status of its executions.
 
 ## Security
 
@@ -109,6 +174,219 @@ func (w *Webhook) Configuration() []configuration.Field {
 				},
 			},
 		},
+		{
+			Name:        "methods",
+			Label:       "Allowed Methods",
+			Type:        configuration.FieldTypeMultiSelect,
+			Required:    false,
+			Togglable:   true,
+			Description: "Reject requests using other HTTP methods",
+			Default:     []string{http.MethodPost},
+			TypeOptions: &configuration.TypeOptions{
+				MultiSelect: &configuration.MultiSelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "GET", Value: http.MethodGet},
+						{Label: "POST", Value: http.MethodPost},
+						{Label: "PUT", Value: http.MethodPut},
+						{Label: "PATCH", Value: http.MethodPatch},
+						{Label: "DELETE", Value: http.MethodDelete},
+					},
+				},
+			},
+		},
+		{
+			Name:        "allowedIPs",
+			Label:       "Allowed IPs",
+			Type:        configuration.FieldTypeList,
+			Required:    false,
+			Togglable:   true,
+			Description: "Only accept requests from these IP addresses or CIDR ranges",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "IP or CIDR",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeString,
+					},
+				},
+			},
+		},
+		{
+			Name:        "headerFilters",
+			Label:       "Header Filters",
+			Type:        configuration.FieldTypeList,
+			Required:    false,
+			Togglable:   true,
+			Description: "Only emit events for requests with all these header values",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Header",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:               "name",
+								Type:               configuration.FieldTypeString,
+								Label:              "Header Name",
+								Required:           true,
+								Placeholder:        "X-GitHub-Event",
+								DisallowExpression: true,
+							},
+							{
+								Name:               "value",
+								Type:               configuration.FieldTypeString,
+								Label:              "Header Value",
+								Required:           true,
+								Placeholder:        "push",
+								DisallowExpression: true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "schema",
+			Label:       "JSON Schema",
+			Type:        configuration.FieldTypeText,
+			Required:    false,
+			Togglable:   true,
+			Description: "Reject requests whose body does not match this JSON Schema",
+			Placeholder: "{\"type\": \"object\", \"required\": [\"ref\"]}",
+		},
+		{
+			Name:        "extract",
+			Label:       "Extract",
+			Type:        configuration.FieldTypeList,
+			Required:    false,
+			Togglable:   true,
+			Description: "Header and query parameter values to include in the params of the emitted event",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Value",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:     "source",
+								Type:     configuration.FieldTypeSelect,
+								Label:    "Source",
+								Required: true,
+								Default:  ExtractionSourceQuery,
+								TypeOptions: &configuration.TypeOptions{
+									Select: &configuration.SelectTypeOptions{
+										Options: []configuration.FieldOption{
+											{Label: "Query Parameter", Value: ExtractionSourceQuery},
+											{Label: "Header", Value: ExtractionSourceHeader},
+										},
+									},
+								},
+							},
+							{
+								Name:               "name",
+								Type:               configuration.FieldTypeString,
+								Label:              "Name",
+								Required:           true,
+								Placeholder:        "environment",
+								DisallowExpression: true,
+							},
+							{
+								Name:               "as",
+								Type:               configuration.FieldTypeString,
+								Label:              "Store As",
+								Required:           false,
+								Description:        "Key used in the emitted params. Defaults to the name",
+								DisallowExpression: true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "response",
+			Label:       "Response",
+			Type:        configuration.FieldTypeObject,
+			Required:    false,
+			Togglable:   true,
+			Description: "Custom response returned for accepted requests",
+			TypeOptions: &configuration.TypeOptions{
+				Object: &configuration.ObjectTypeOptions{
+					Schema: []configuration.Field{
+						{
+							Name:     "status",
+							Type:     configuration.FieldTypeNumber,
+							Label:    "Status Code",
+							Required: true,
+							Default:  http.StatusOK,
+							TypeOptions: &configuration.TypeOptions{
+								Number: &configuration.NumberTypeOptions{
+									Min: func() *int { min := 200; return &min }(),
+									Max: func() *int { max := 299; return &max }(),
+								},
+							},
+						},
+						{
+							Name:     "contentType",
+							Type:     configuration.FieldTypeSelect,
+							Label:    "Content Type",
+							Required: false,
+							Default:  "application/json",
+							TypeOptions: &configuration.TypeOptions{
+								Select: &configuration.SelectTypeOptions{
+									Options: []configuration.FieldOption{
+										{Label: "JSON", Value: "application/json"},
+										{Label: "Plain Text", Value: "text/plain"},
+									},
+								},
+							},
+						},
+						{
+							Name:               "body",
+							Type:               configuration.FieldTypeText,
+							Label:              "Body",
+							Required:           false,
+							DisallowExpression: true,
+						},
+					},
+				},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "mode", Values: []string{ModeAsync}},
+			},
+		},
+		{
+			Name:        "mode",
+			Label:       "Mode",
+			Type:        configuration.FieldTypeSelect,
+			Required:    false,
+			Default:     ModeAsync,
+			Description: "Whether to respond right away, or wait for a Respond to Webhook component to emit",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Asynchronous", Value: ModeAsync},
+						{Label: "Synchronous", Value: ModeSync},
+					},
+				},
+			},
+		},
+		{
+			Name:        "timeout",
+			Label:       "Timeout (seconds)",
+			Type:        configuration.FieldTypeNumber,
+			Required:    false,
+			Default:     DefaultSyncTimeout,
+			Description: "How long to wait for a response before returning a 202 to the caller",
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "mode", Values: []string{ModeSync}},
+			},
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+					Max: func() *int { max := MaxSyncTimeout; return &max }(),
+				},
+			},
+		},
 	}
 }
 
@@ -125,6 +403,10 @@ func (w *Webhook) Setup(ctx core.TriggerContext) error {
 		return fmt.Errorf("failed to decode configuration: %w", err)
 	}
 
+	if err := validateConfiguration(config); err != nil {
+		return err
+	}
+
 	if metadata.URL != "" && metadata.Authentication == config.Authentication {
 
 		return nil
@@ -213,6 +495,20 @@ func (w *Webhook) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
 		return http.StatusInternalServerError, fmt.Errorf("failed to parse configuration: %w", err)
 	}
 
+	var config Configuration
+	err = mapstructure.Decode(ctx.Configuration, &config)
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("failed to parse configuration: %w", err)
+	}
+
+	if len(config.AllowedIPs) > 0 && !isIPAllowed(ctx.RemoteIP, config.AllowedIPs) {
+		return http.StatusForbidden, fmt.Errorf("requests from %s are not allowed", ctx.RemoteIP)
+	}
+
+	if len(config.Methods) > 0 && ctx.Method != "" && !slices.Contains(config.Methods, ctx.Method) {
+		return http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", ctx.Method)
+	}
+
 	secret, err := ctx.Webhook.GetSecret()
 	if err != nil {
 		return http.StatusInternalServerError, fmt.Errorf("error authenticating request")
@@ -244,13 +540,35 @@ func (w *Webhook) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
 			return http.StatusUnauthorized, fmt.Errorf("invalid Bearer token")
 		}
 
+		//
+		// The token is masked on a copy of the headers, so the headers
+		// recorded for the delivery still authenticate its replays.
+		//
+		ctx.Headers = ctx.Headers.Clone()
 		ctx.Headers.Set("Authorization", "Bearer ********")
 	}
 
-	var parsedData any
-	err = json.Unmarshal(ctx.Body, &parsedData)
+	//
+	// Requests that do not match the header filters are
+	// acknowledged, so the sender does not retry them, but ignored.
+	//
+	if !matchesHeaderFilters(ctx.Headers, config.HeaderFilters) {
+		if ctx.Logger != nil {
+			ctx.Logger.Infof("Request does not match header filters - ignoring")
+		}
+
+		return http.StatusOK, nil
+	}
+
+	parsedData, code, err := parseBody(ctx.Headers, ctx.Body)
 	if err != nil {
-		return http.StatusBadRequest, fmt.Errorf("error parsing request body: %v", err)
+		return code, err
+	}
+
+	if config.Schema != "" {
+		if err := validateBody(config.Schema, parsedData); err != nil {
+			return http.StatusBadRequest, err
+		}
 	}
 
 	output := map[string]any{
@@ -258,12 +576,272 @@ func (w *Webhook) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
 		"headers": ctx.Headers,
 	}
 
+	if ctx.Method != "" {
+		output["method"] = ctx.Method
+	}
+
+	if len(ctx.Query) > 0 {
+		output["query"] = ctx.Query
+	}
+
+	if len(config.Extract) > 0 {
+		output["params"] = extractParams(ctx, config.Extract)
+	}
+
 	err = ctx.Events.Emit("webhook", output)
 	if err != nil {
 		return http.StatusInternalServerError, fmt.Errorf("error emitting event: %v", err)
 	}
 
-	return http.StatusOK, nil
+	if config.Mode == ModeSync {
+		if ctx.Response != nil {
+			ctx.Response.WaitTimeout = syncTimeout(config)
+		}
+
+		return http.StatusOK, nil
+	}
+
+	if config.Response == nil || config.Response.Status == 0 {
+		return http.StatusOK, nil
+	}
+
+	if ctx.Response != nil {
+		ctx.Response.StatusCode = config.Response.Status
+		ctx.Response.ContentType = config.Response.ContentType
+		ctx.Response.Body = []byte(config.Response.Body)
+	}
+
+	return config.Response.Status, nil
+}
+
+func validateConfiguration(config Configuration) error {
+	for _, allowed := range config.AllowedIPs {
+		if _, err := parsePrefix(allowed); err != nil {
+			return fmt.Errorf("invalid allowed IP %s: %w", allowed, err)
+		}
+	}
+
+	for _, filter := range config.HeaderFilters {
+		if filter.Name == "" {
+			return fmt.Errorf("header filter name is required")
+		}
+	}
+
+	for _, extraction := range config.Extract {
+		if extraction.Source != ExtractionSourceHeader && extraction.Source != ExtractionSourceQuery {
+			return fmt.Errorf("invalid extraction source: %s", extraction.Source)
+		}
+
+		if extraction.Name == "" {
+			return fmt.Errorf("extraction name is required")
+		}
+	}
+
+	if config.Schema != "" {
+		if _, err := compileSchema(config.Schema); err != nil {
+			return fmt.Errorf("invalid JSON schema: %w", err)
+		}
+	}
+
+	if config.Response != nil && config.Response.Status != 0 {
+		if config.Response.Status < 200 || config.Response.Status > 299 {
+			return fmt.Errorf("response status must be between 200 and 299")
+		}
+
+		if config.Response.ContentType == "application/json" && config.Response.Body != "" && !json.Valid([]byte(config.Response.Body)) {
+			return fmt.Errorf("response body is not valid JSON")
+		}
+	}
+
+	switch config.Mode {
+	case "", ModeAsync:
+	case ModeSync:
+		if config.Timeout < 0 || config.Timeout > MaxSyncTimeout {
+			return fmt.Errorf("timeout must be between 1 and %d seconds", MaxSyncTimeout)
+		}
+	default:
+		return fmt.Errorf("invalid mode: %s", config.Mode)
+	}
+
+	return nil
+}
+
+func syncTimeout(config Configuration) time.Duration {
+	if config.Timeout <= 0 {
+		return DefaultSyncTimeout * time.Second
+	}
+
+	return time.Duration(min(config.Timeout, MaxSyncTimeout)) * time.Second
+}
+
+func parsePrefix(value string) (netip.Prefix, error) {
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, "/") {
+		return netip.ParsePrefix(value)
+	}
+
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+func isIPAllowed(remoteIP string, allowedIPs []string) bool {
+	addr, err := netip.ParseAddr(remoteIP)
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+	for _, allowed := range allowedIPs {
+		prefix, err := parsePrefix(allowed)
+		if err != nil {
+			continue
+		}
+
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesHeaderFilters(headers http.Header, filters []HeaderFilter) bool {
+	for _, filter := range filters {
+		if !slices.Contains(headers.Values(filter.Name), filter.Value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func compileSchema(schema string) (*jsonschema.Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(strings.NewReader(schema))
+	if err != nil {
+		return nil, err
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource("schema.json", doc); err != nil {
+		return nil, err
+	}
+
+	return compiler.Compile("schema.json")
+}
+
+/*
+ * parseBody parses the request body following its content type.
+ * JSON and form bodies are parsed, text and XML bodies are kept as strings,
+ * and bodies without a content type are parsed as JSON.
+ */
+func parseBody(headers http.Header, body []byte) (any, int, error) {
+	if len(body) == 0 {
+		return nil, http.StatusOK, nil
+	}
+
+	mediaType := "application/json"
+	if contentType := headers.Get("Content-Type"); contentType != "" {
+		parsed, _, err := mime.ParseMediaType(contentType)
+		if err != nil {
+			return nil, http.StatusUnsupportedMediaType, fmt.Errorf("invalid content type: %s", contentType)
+		}
+
+		mediaType = parsed
+	}
+
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		var data any
+		if err := json.Unmarshal(body, &data); err != nil {
+			return nil, http.StatusBadRequest, fmt.Errorf("error parsing request body: %v", err)
+		}
+
+		return data, http.StatusOK, nil
+
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return nil, http.StatusBadRequest, fmt.Errorf("error parsing request body: %v", err)
+		}
+
+		return formData(values), http.StatusOK, nil
+
+	case strings.HasPrefix(mediaType, "text/") || mediaType == "application/xml":
+		return string(body), http.StatusOK, nil
+
+	default:
+		return nil, http.StatusUnsupportedMediaType, fmt.Errorf("unsupported content type: %s", mediaType)
+	}
+}
+
+// formData returns the values of a form, with
+// fields sent only once as strings instead of lists.
+func formData(values url.Values) map[string]any {
+	data := map[string]any{}
+	for name, value := range values {
+		if len(value) == 1 {
+			data[name] = value[0]
+			continue
+		}
+
+		data[name] = value
+	}
+
+	return data
+}
+
+func validateBody(schema string, body any) error {
+	compiled, err := compileSchema(schema)
+	if err != nil {
+		return fmt.Errorf("invalid JSON schema: %v", err)
+	}
+
+	//
+	// The parsed body is encoded again, so form bodies can be
+	// validated too, and numbers are decoded the way the schema expects.
+	//
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("error encoding request body: %v", err)
+	}
+
+	instance, err := jsonschema.UnmarshalJSON(strings.NewReader(string(data)))
+	if err != nil {
+		return fmt.Errorf("error parsing request body: %v", err)
+	}
+
+	if err := compiled.Validate(instance); err != nil {
+		return fmt.Errorf("request body does not match schema: %v", err)
+	}
+
+	return nil
+}
+
+func extractParams(ctx core.WebhookRequestContext, extractions []Extraction) map[string]any {
+	params := map[string]any{}
+	for _, extraction := range extractions {
+		key := extraction.As
+		if key == "" {
+			key = extraction.Name
+		}
+
+		switch extraction.Source {
+		case ExtractionSourceHeader:
+			if ctx.Headers != nil && ctx.Headers.Get(extraction.Name) != "" {
+				params[key] = ctx.Headers.Get(extraction.Name)
+			}
+		case ExtractionSourceQuery:
+			if ctx.Query != nil && ctx.Query.Has(extraction.Name) {
+				params[key] = ctx.Query.Get(extraction.Name)
+			}
+		}
+	}
+
+	return params
 }
 
 func (w *Webhook) Cleanup(ctx core.TriggerContext) error {
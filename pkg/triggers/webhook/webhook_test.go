@@ -5,7 +5,9 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"net/http"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/core"
@@ -241,9 +243,249 @@ func Test__Webhook__HandleWebhook(t *testing.T) {
 		headers, ok := data["headers"].(http.Header)
 		require.True(t, ok)
 		require.Equal(t, "Bearer ********", headers.Get("Authorization"))
+		require.Equal(t, "Bearer secret", ctx.Headers.Get("Authorization"))
 	})
 }
 
+func Test__Webhook__HandleWebhook__Validation(t *testing.T) {
+	t.Run("rejects requests from IPs not allowed", func(t *testing.T) {
+		webhook := &Webhook{}
+		ctx, eventCtx := webhookRequestContext([]byte(`{"ok":true}`), "none", "secret")
+		ctx.Configuration.(map[string]any)["allowedIPs"] = []any{"10.0.0.0/8", "192.168.1.10"}
+		ctx.RemoteIP = "172.16.0.1"
+
+		status, err := webhook.HandleWebhook(ctx)
+		require.Equal(t, http.StatusForbidden, status)
+		require.Error(t, err)
+		require.Equal(t, 0, eventCtx.Count())
+	})
+
+	t.Run("accepts requests from allowed IPs", func(t *testing.T) {
+		webhook := &Webhook{}
+		ctx, eventCtx := webhookRequestContext([]byte(`{"ok":true}`), "none", "secret")
+		ctx.Configuration.(map[string]any)["allowedIPs"] = []any{"10.0.0.0/8", "192.168.1.10"}
+		ctx.RemoteIP = "192.168.1.10"
+
+		status, err := webhook.HandleWebhook(ctx)
+		require.Equal(t, http.StatusOK, status)
+		require.NoError(t, err)
+		require.Equal(t, 1, eventCtx.Count())
+	})
+
+	t.Run("rejects methods not allowed", func(t *testing.T) {
+		webhook := &Webhook{}
+		ctx, eventCtx := webhookRequestContext([]byte(`{"ok":true}`), "none", "secret")
+		ctx.Configuration.(map[string]any)["methods"] = []any{"POST"}
+		ctx.Method = http.MethodPut
+
+		status, err := webhook.HandleWebhook(ctx)
+		require.Equal(t, http.StatusMethodNotAllowed, status)
+		require.Error(t, err)
+		require.Equal(t, 0, eventCtx.Count())
+	})
+
+	t.Run("accepts allowed methods without body", func(t *testing.T) {
+		webhook := &Webhook{}
+		ctx, eventCtx := webhookRequestContext(nil, "none", "secret")
+		ctx.Configuration.(map[string]any)["methods"] = []any{"GET", "DELETE"}
+		ctx.Method = http.MethodDelete
+
+		status, err := webhook.HandleWebhook(ctx)
+		require.Equal(t, http.StatusOK, status)
+		require.NoError(t, err)
+		require.Equal(t, 1, eventCtx.Count())
+		require.Nil(t, eventCtx.Payloads[0].Data.(map[string]any)["body"])
+	})
+
+	t.Run("parses form bodies", func(t *testing.T) {
+		webhook := &Webhook{}
+		ctx, eventCtx := webhookRequestContext([]byte("name=api&tag=a&tag=b"), "none", "secret")
+		ctx.Headers.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		status, err := webhook.HandleWebhook(ctx)
+		require.Equal(t, http.StatusOK, status)
+		require.NoError(t, err)
+		require.Equal(t, 1, eventCtx.Count())
+		require.Equal(t, map[string]any{
+			"name": "api",
+			"tag":  []string{"a", "b"},
+		}, eventCtx.Payloads[0].Data.(map[string]any)["body"])
+	})
+
+	t.Run("keeps text bodies as strings", func(t *testing.T) {
+		webhook := &Webhook{}
+		ctx, eventCtx := webhookRequestContext([]byte("<build status=\"ok\"/>"), "none", "secret")
+		ctx.Headers.Set("Content-Type", "application/xml; charset=utf-8")
+
+		status, err := webhook.HandleWebhook(ctx)
+		require.Equal(t, http.StatusOK, status)
+		require.NoError(t, err)
+		require.Equal(t, "<build status=\"ok\"/>", eventCtx.Payloads[0].Data.(map[string]any)["body"])
+	})
+
+	t.Run("rejects unsupported content types", func(t *testing.T) {
+		webhook := &Webhook{}
+		ctx, eventCtx := webhookRequestContext([]byte("data"), "none", "secret")
+		ctx.Headers.Set("Content-Type", "application/octet-stream")
+
+		status, err := webhook.HandleWebhook(ctx)
+		require.Equal(t, http.StatusUnsupportedMediaType, status)
+		require.ErrorContains(t, err, "unsupported content type")
+		require.Equal(t, 0, eventCtx.Count())
+	})
+
+	t.Run("validates form bodies against schema", func(t *testing.T) {
+		webhook := &Webhook{}
+		ctx, eventCtx := webhookRequestContext([]byte("name=api"), "none", "secret")
+		ctx.Headers.Set("Content-Type", "application/x-www-form-urlencoded")
+		ctx.Configuration.(map[string]any)["schema"] = `{"type":"object","required":["version"]}`
+
+		status, err := webhook.HandleWebhook(ctx)
+		require.Equal(t, http.StatusBadRequest, status)
+		require.ErrorContains(t, err, "does not match schema")
+		require.Equal(t, 0, eventCtx.Count())
+	})
+
+	t.Run("ignores requests not matching header filters", func(t *testing.T) {
+		webhook := &Webhook{}
+		ctx, eventCtx := webhookRequestContext([]byte(`{"ok":true}`), "none", "secret")
+		ctx.Configuration.(map[string]any)["headerFilters"] = []any{
+			map[string]any{"name": "X-Event", "value": "push"},
+		}
+		ctx.Headers.Set("X-Event", "pull_request")
+
+		status, err := webhook.HandleWebhook(ctx)
+		require.Equal(t, http.StatusOK, status)
+		require.NoError(t, err)
+		require.Equal(t, 0, eventCtx.Count())
+	})
+
+	t.Run("rejects body not matching schema", func(t *testing.T) {
+		webhook := &Webhook{}
+		ctx, eventCtx := webhookRequestContext([]byte(`{"ref":1}`), "none", "secret")
+		ctx.Configuration.(map[string]any)["schema"] = `{"type": "object", "required": ["ref"], "properties": {"ref": {"type": "string"}}}`
+
+		status, err := webhook.HandleWebhook(ctx)
+		require.Equal(t, http.StatusBadRequest, status)
+		require.ErrorContains(t, err, "does not match schema")
+		require.Equal(t, 0, eventCtx.Count())
+	})
+
+	t.Run("accepts body matching schema and extracts params", func(t *testing.T) {
+		webhook := &Webhook{}
+		ctx, eventCtx := webhookRequestContext([]byte(`{"ref":"main"}`), "none", "secret")
+		ctx.Configuration.(map[string]any)["schema"] = `{"type": "object", "required": ["ref"]}`
+		ctx.Configuration.(map[string]any)["extract"] = []any{
+			map[string]any{"source": "query", "name": "env"},
+			map[string]any{"source": "header", "name": "X-Request-Id", "as": "requestId"},
+		}
+		ctx.Method = http.MethodPost
+		ctx.Query = url.Values{"env": []string{"production"}}
+		ctx.Headers.Set("X-Request-Id", "123")
+
+		status, err := webhook.HandleWebhook(ctx)
+		require.Equal(t, http.StatusOK, status)
+		require.NoError(t, err)
+		require.Equal(t, 1, eventCtx.Count())
+
+		data := eventCtx.Payloads[0].Data.(map[string]any)
+		require.Equal(t, http.MethodPost, data["method"])
+		require.Equal(t, map[string]any{"env": "production", "requestId": "123"}, data["params"])
+	})
+
+	t.Run("returns custom response", func(t *testing.T) {
+		webhook := &Webhook{}
+		ctx, _ := webhookRequestContext([]byte(`{"ok":true}`), "none", "secret")
+		ctx.Configuration.(map[string]any)["response"] = map[string]any{
+			"status":      202,
+			"contentType": "application/json",
+			"body":        `{"accepted":true}`,
+		}
+
+		status, err := webhook.HandleWebhook(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusAccepted, status)
+		require.Equal(t, http.StatusAccepted, ctx.Response.StatusCode)
+		require.Equal(t, "application/json", ctx.Response.ContentType)
+		require.Equal(t, `{"accepted":true}`, string(ctx.Response.Body))
+	})
+
+	t.Run("sync mode waits for response", func(t *testing.T) {
+		webhook := &Webhook{}
+		ctx, eventCtx := webhookRequestContext([]byte(`{"ok":true}`), "none", "secret")
+		ctx.Configuration.(map[string]any)["mode"] = ModeSync
+		ctx.Configuration.(map[string]any)["timeout"] = 5
+
+		status, err := webhook.HandleWebhook(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, status)
+		require.Equal(t, 1, eventCtx.Count())
+		require.Equal(t, 5*time.Second, ctx.Response.WaitTimeout)
+	})
+
+	t.Run("sync mode uses default timeout", func(t *testing.T) {
+		webhook := &Webhook{}
+		ctx, _ := webhookRequestContext([]byte(`{"ok":true}`), "none", "secret")
+		ctx.Configuration.(map[string]any)["mode"] = ModeSync
+
+		_, err := webhook.HandleWebhook(ctx)
+		require.NoError(t, err)
+		require.Equal(t, DefaultSyncTimeout*time.Second, ctx.Response.WaitTimeout)
+	})
+}
+
+func Test__Webhook__Setup__ValidatesConfiguration(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Configuration
+		err    string
+	}{
+		{
+			name:   "invalid IP",
+			config: Configuration{Authentication: "none", AllowedIPs: []string{"not-an-ip"}},
+			err:    "invalid allowed IP",
+		},
+		{
+			name:   "invalid schema",
+			config: Configuration{Authentication: "none", Schema: `{"type": 1}`},
+			err:    "invalid JSON schema",
+		},
+		{
+			name:   "invalid extraction source",
+			config: Configuration{Authentication: "none", Extract: []Extraction{{Source: "body", Name: "x"}}},
+			err:    "invalid extraction source",
+		},
+		{
+			name:   "invalid response status",
+			config: Configuration{Authentication: "none", Response: &Response{Status: 500}},
+			err:    "response status must be between 200 and 299",
+		},
+		{
+			name:   "invalid mode",
+			config: Configuration{Authentication: "none", Mode: "later"},
+			err:    "invalid mode",
+		},
+		{
+			name:   "sync timeout too long",
+			config: Configuration{Authentication: "none", Mode: ModeSync, Timeout: 60},
+			err:    "timeout must be between 1 and 25 seconds",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhook := &Webhook{}
+			err := webhook.Setup(core.TriggerContext{
+				Configuration: tt.config,
+				Metadata:      &contexts.MetadataContext{Metadata: Metadata{}},
+				Webhook:       &contexts.WebhookContext{},
+			})
+
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
 func webhookRequestContext(body []byte, authentication string, secret string) (core.WebhookRequestContext, *contexts.EventContext) {
 	eventCtx := &contexts.EventContext{}
 	webhookCtx := &contexts.WebhookContext{Secret: secret}
@@ -254,6 +496,7 @@ func webhookRequestContext(body []byte, authentication string, secret string) (c
 		Configuration: map[string]any{"authentication": authentication},
 		Webhook:       webhookCtx,
 		Events:        eventCtx,
+		Response:      &core.WebhookResponse{},
 	}, eventCtx
 }
 
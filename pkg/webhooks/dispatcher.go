@@ -0,0 +1,337 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/logging"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
+)
+
+/*
+ * Request is an inbound webhook request.
+ */
+type Request struct {
+	Body     []byte
+	Headers  http.Header
+	Method   string
+	Query    url.Values
+	RemoteIP string
+}
+
+/*
+ * Result is the outcome of dispatching a request
+ * to the nodes using a webhook.
+ */
+type Result struct {
+	StatusCode int
+	Err        error
+	Response   *core.WebhookResponse
+
+	//
+	// Events emitted by the nodes while handling the request.
+	//
+	Events []models.CanvasEvent
+
+	//
+	// The recorded delivery.
+	// Nil if recording the delivery failed.
+	//
+	Delivery *models.WebhookDelivery
+}
+
+/*
+ * Dispatcher routes webhook requests to the nodes using the webhook,
+ * and records every request as a webhook delivery.
+ */
+type Dispatcher struct {
+	encryptor      crypto.Encryptor
+	registry       *registry.Registry
+	baseURL        string
+	webhookBaseURL string
+}
+
+func NewDispatcher(encryptor crypto.Encryptor, registry *registry.Registry, baseURL, webhookBaseURL string) *Dispatcher {
+	return &Dispatcher{
+		encryptor:      encryptor,
+		registry:       registry,
+		baseURL:        baseURL,
+		webhookBaseURL: webhookBaseURL,
+	}
+}
+
+func (d *Dispatcher) Dispatch(ctx context.Context, webhook *models.Webhook, nodes []models.CanvasNode, request Request, replayOf *uuid.UUID) *Result {
+	result := &Result{
+		StatusCode: http.StatusOK,
+		Response:   &core.WebhookResponse{},
+	}
+
+	deliveryNodes := []models.WebhookDeliveryNode{}
+	for _, node := range nodes {
+		//
+		// Each node receives its own copy of the headers,
+		// so changes made by one are not seen by the others,
+		// or recorded in the delivery.
+		//
+		nodeRequest := request
+		nodeRequest.Headers = request.Headers.Clone()
+
+		code, events, err := d.executeNode(ctx, nodeRequest, result.Response, node)
+		result.Events = append(result.Events, events...)
+
+		deliveryNode := models.WebhookDeliveryNode{
+			CanvasID:   node.WorkflowID.String(),
+			NodeID:     node.NodeID,
+			StatusCode: code,
+		}
+
+		if err != nil {
+			deliveryNode.Error = err.Error()
+			deliveryNodes = append(deliveryNodes, deliveryNode)
+			result.StatusCode = code
+			result.Err = err
+			break
+		}
+
+		deliveryNodes = append(deliveryNodes, deliveryNode)
+	}
+
+	if result.Err == nil && result.Response.StatusCode != 0 {
+		result.StatusCode = result.Response.StatusCode
+	}
+
+	delivery, err := d.recordDelivery(ctx, webhook, request, replayOf, result, deliveryNodes)
+	if err != nil {
+		log.Errorf("error recording delivery for webhook %s: %v", webhook.ID, err)
+	}
+
+	result.Delivery = delivery
+	return result
+}
+
+// DecodeRequest rebuilds the request stored in a delivery.
+func (d *Dispatcher) DecodeRequest(ctx context.Context, delivery *models.WebhookDelivery) (*Request, error) {
+	headers, err := DecryptHeaders(ctx, d.encryptor, delivery)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := url.ParseQuery(delivery.Query)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing query: %w", err)
+	}
+
+	return &Request{
+		Body:     delivery.Body,
+		Headers:  headers,
+		Method:   delivery.Method,
+		Query:    query,
+		RemoteIP: delivery.RemoteIP,
+	}, nil
+}
+
+// DecryptHeaders returns the request headers stored in a delivery.
+func DecryptHeaders(ctx context.Context, encryptor crypto.Encryptor, delivery *models.WebhookDelivery) (http.Header, error) {
+	data, err := encryptor.Decrypt(ctx, delivery.Headers, []byte(delivery.WebhookID.String()))
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting headers: %w", err)
+	}
+
+	headers := http.Header{}
+	err = json.Unmarshal(data, &headers)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling headers: %w", err)
+	}
+
+	return headers, nil
+}
+
+func (d *Dispatcher) recordDelivery(ctx context.Context, webhook *models.Webhook, request Request, replayOf *uuid.UUID, result *Result, nodes []models.WebhookDeliveryNode) (*models.WebhookDelivery, error) {
+	//
+	// Headers may include credentials used to authenticate
+	// the request, so they are stored encrypted.
+	//
+	headers, err := json.Marshal(request.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedHeaders, err := d.encryptor.Encrypt(ctx, headers, []byte(webhook.ID.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	body := request.Body
+	truncated := false
+	if len(body) > models.MaxWebhookDeliveryBodySize {
+		body = body[:models.MaxWebhookDeliveryBodySize]
+		truncated = true
+	}
+
+	eventIDs := []string{}
+	for _, event := range result.Events {
+		eventIDs = append(eventIDs, event.ID.String())
+	}
+
+	now := time.Now()
+	delivery := &models.WebhookDelivery{
+		WebhookID:     webhook.ID,
+		ReplayOf:      replayOf,
+		Method:        request.Method,
+		RemoteIP:      request.RemoteIP,
+		Query:         request.Query.Encode(),
+		Headers:       encryptedHeaders,
+		Body:          body,
+		BodyTruncated: truncated,
+		StatusCode:    result.StatusCode,
+		Nodes:         nodes,
+		EventIDs:      eventIDs,
+		CreatedAt:     &now,
+	}
+
+	if result.Err != nil {
+		errorMessage := result.Err.Error()
+		delivery.Error = &errorMessage
+	}
+
+	err = models.CreateWebhookDeliveryInTransaction(database.Conn(), delivery)
+	if err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+func (d *Dispatcher) executeNode(ctx context.Context, request Request, response *core.WebhookResponse, node models.CanvasNode) (int, []models.CanvasEvent, error) {
+	if node.Type == models.NodeTypeTrigger {
+		return d.executeTriggerNode(ctx, request, response, node)
+	}
+
+	return d.executeComponentNode(ctx, request, response, node)
+}
+
+func (d *Dispatcher) executeTriggerNode(ctx context.Context, request Request, response *core.WebhookResponse, node models.CanvasNode) (int, []models.CanvasEvent, error) {
+	ref := node.Ref.Data()
+	trigger, err := d.registry.GetTrigger(ref.Trigger.Name)
+	if err != nil {
+		return http.StatusInternalServerError, nil, fmt.Errorf("trigger not found: %w", err)
+	}
+
+	logger := logging.ForNode(node)
+	tx := database.Conn()
+	var integrationCtx core.IntegrationContext
+	if node.AppInstallationID != nil {
+		integration, integrationErr := models.FindUnscopedIntegrationInTransaction(tx, *node.AppInstallationID)
+		if integrationErr != nil {
+			return http.StatusInternalServerError, nil, integrationErr
+		}
+
+		logger = logging.WithIntegration(logger, *integration)
+		integrationCtx = contexts.NewIntegrationContext(tx, &node, integration, d.encryptor, d.registry)
+	}
+
+	events := contexts.NewEventContext(tx, &node).WithContext(ctx)
+	code, err := trigger.HandleWebhook(core.WebhookRequestContext{
+		Body:          request.Body,
+		Headers:       request.Headers,
+		Method:        request.Method,
+		Query:         request.Query,
+		RemoteIP:      request.RemoteIP,
+		Response:      response,
+		WorkflowID:    node.WorkflowID.String(),
+		NodeID:        node.NodeID,
+		Configuration: node.Configuration.Data(),
+		Metadata:      contexts.NewNodeMetadataContext(tx, &node),
+		Logger:        logger,
+		HTTP:          d.registry.HTTPContext(),
+		Webhook:       contexts.NewNodeWebhookContext(ctx, tx, d.encryptor, &node, d.webhookBaseURL),
+		Events:        events,
+		Integration:   integrationCtx,
+	})
+
+	return code, events.EmittedEvents(), err
+}
+
+func (d *Dispatcher) executeComponentNode(ctx context.Context, request Request, response *core.WebhookResponse, node models.CanvasNode) (int, []models.CanvasEvent, error) {
+	ref := node.Ref.Data()
+	component, err := d.registry.GetComponent(ref.Component.Name)
+	if err != nil {
+		return http.StatusInternalServerError, nil, fmt.Errorf("component not found: %w", err)
+	}
+
+	logger := logging.ForNode(node)
+	tx := database.Conn()
+	var integrationCtx core.IntegrationContext
+	if node.AppInstallationID != nil {
+		integration, integrationErr := models.FindUnscopedIntegrationInTransaction(tx, *node.AppInstallationID)
+		if integrationErr != nil {
+			return http.StatusInternalServerError, nil, integrationErr
+		}
+
+		logger = logging.WithIntegration(logger, *integration)
+		integrationCtx = contexts.NewIntegrationContext(tx, &node, integration, d.encryptor, d.registry)
+	}
+
+	var executionLogs []*logging.ExecutionLogs
+	defer func() {
+		for _, logs := range executionLogs {
+			logs.Flush()
+		}
+	}()
+
+	events := contexts.NewEventContext(tx, &node).WithContext(ctx)
+	code, err := component.HandleWebhook(core.WebhookRequestContext{
+		Body:          request.Body,
+		Headers:       request.Headers,
+		Method:        request.Method,
+		Query:         request.Query,
+		RemoteIP:      request.RemoteIP,
+		Response:      response,
+		WorkflowID:    node.WorkflowID.String(),
+		NodeID:        node.NodeID,
+		Configuration: node.Configuration.Data(),
+		Metadata:      contexts.NewNodeMetadataContext(tx, &node),
+		Logger:        logger,
+		HTTP:          d.registry.HTTPContext(),
+		Webhook:       contexts.NewNodeWebhookContext(ctx, tx, d.encryptor, &node, d.webhookBaseURL),
+		Events:        events,
+		Integration:   integrationCtx,
+		FindExecutionByKV: func(key string, value string) (*core.ExecutionContext, error) {
+			execution, err := models.FirstNodeExecutionByKVInTransaction(tx, node.WorkflowID, node.NodeID, key, value)
+			if err != nil {
+				return nil, err
+			}
+
+			executionLogger, logs := logging.WithExecutionLogs(logging.ForExecution(execution, nil), execution)
+			executionLogs = append(executionLogs, logs)
+
+			return &core.ExecutionContext{
+				ID:             execution.ID,
+				WorkflowID:     execution.WorkflowID.String(),
+				NodeID:         execution.NodeID,
+				BaseURL:        d.baseURL,
+				Configuration:  execution.Configuration.Data(),
+				HTTP:           d.registry.HTTPContext(),
+				Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
+				NodeMetadata:   contexts.NewNodeMetadataContext(tx, &node),
+				ExecutionState: contexts.NewExecutionStateContext(tx, execution),
+				Requests:       contexts.NewExecutionRequestContext(tx, execution),
+				Logger:         executionLogger,
+				Notifications:  contexts.NewNotificationContext(tx, uuid.Nil, execution.WorkflowID),
+			}, nil
+		},
+	})
+
+	return code, events.EmittedEvents(), err
+}
@@ -16,6 +16,8 @@ import (
 )
 
 type CanvasCleanupWorker struct {
+	monitored
+
 	semaphore           *semaphore.Weighted
 	logger              *log.Entry
 	maxResourcesPerTick int
@@ -38,6 +40,7 @@ func (w *CanvasCleanupWorker) Start(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
+			w.heartbeat.Beat()
 			tickStart := time.Now()
 			canvases, err := models.ListDeletedCanvases()
 			if err != nil {
@@ -53,13 +56,18 @@ func (w *CanvasCleanupWorker) Start(ctx context.Context) {
 					continue
 				}
 
-				go func(canvas models.Canvas) {
+				started := w.tracker.Go(func() {
 					defer w.semaphore.Release(1)
 
 					if err := w.LockAndProcessCanvas(canvas); err != nil {
 						w.logger.Errorf("Error processing canvas %s: %v", canvas.ID, err)
 					}
-				}(canvas)
+				})
+
+				if !started {
+					w.semaphore.Release(1)
+					break
+				}
 			}
 
 			telemetry.RecordWorkflowCleanupWorkerTickDuration(context.Background(), time.Since(tickStart))
@@ -153,6 +161,7 @@ func (w *CanvasCleanupWorker) deleteNodeResourcesBatched(tx *gorm.DB, workflowID
 	}{
 		{&models.CanvasNodeRequest{}, "canvas_node_requests"},
 		{&models.CanvasNodeExecutionKV{}, "canvas_node_execution_kvs"},
+		{&models.CanvasNodeExecutionLog{}, "canvas_node_execution_logs"},
 		{&models.CanvasNodeExecution{}, "canvas_node_executions"},
 		{&models.CanvasNodeQueueItem{}, "canvas_node_queue_items"},
 		{&models.CanvasEvent{}, "canvas_events"},
@@ -1,24 +1,36 @@
 package contexts
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+	"go.opentelemetry.io/otel/trace"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
 type EventContext struct {
+	ctx            context.Context
 	tx             *gorm.DB
 	node           *models.CanvasNode
 	maxPayloadSize int
+	emitted        []models.CanvasEvent
 }
 
 func NewEventContext(tx *gorm.DB, node *models.CanvasNode) *EventContext {
-	return &EventContext{tx: tx, node: node, maxPayloadSize: DefaultMaxPayloadSize}
+	return &EventContext{ctx: context.Background(), tx: tx, node: node, maxPayloadSize: DefaultMaxPayloadSize}
+}
+
+// WithContext sets the context the traces of the emitted events continue from,
+// e.g. the one of the incoming webhook request.
+func (s *EventContext) WithContext(ctx context.Context) *EventContext {
+	s.ctx = ctx
+	return s
 }
 
 func (s *EventContext) Emit(payloadType string, payload any) error {
@@ -57,7 +69,37 @@ func (s *EventContext) Emit(payloadType string, payload any) error {
 		event.CustomName = customName
 	}
 
-	return s.tx.Create(&event).Error
+	//
+	// Every emitted event is a root event, so it starts a new trace.
+	//
+	var span trace.Span
+	if telemetry.TracingEnabled() {
+		var spanCtx context.Context
+		spanCtx, span = telemetry.StartSpan(s.ctx, "canvas.event", trace.WithAttributes(
+			telemetry.AttributeCanvasID.String(s.node.WorkflowID.String()),
+			telemetry.AttributeNodeID.String(s.node.NodeID),
+		))
+
+		event.Traceparent = telemetry.Traceparent(spanCtx)
+	}
+
+	err = s.tx.Create(&event).Error
+	if span != nil {
+		span.SetAttributes(telemetry.AttributeEventID.String(event.ID.String()))
+		telemetry.EndSpan(span, err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	s.emitted = append(s.emitted, event)
+	return nil
+}
+
+// EmittedEvents returns the events created through this context.
+func (s *EventContext) EmittedEvents() []models.CanvasEvent {
+	return s.emitted
 }
 
 func (s *EventContext) resolveCustomName(payload any) (*string, error) {
@@ -0,0 +1,63 @@
+package contexts
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/oidc"
+)
+
+// OIDCContext issues OIDC tokens scoped to a single node execution.
+type OIDCContext struct {
+	provider       oidc.Provider
+	organizationID uuid.UUID
+	execution      *models.CanvasNodeExecution
+}
+
+// NewOIDCContext returns an OIDCContext that signs tokens identifying
+// the given execution, using the given provider.
+func NewOIDCContext(provider oidc.Provider, organizationID uuid.UUID, execution *models.CanvasNodeExecution) *OIDCContext {
+	return &OIDCContext{
+		provider:       provider,
+		organizationID: organizationID,
+		execution:      execution,
+	}
+}
+
+// Token implements core.OIDCContext.
+func (c *OIDCContext) Token(audience string, duration time.Duration) (string, error) {
+	if c.provider == nil {
+		return "", fmt.Errorf("OIDC provider not available")
+	}
+
+	audience = strings.TrimSpace(audience)
+	if audience == "" {
+		return "", fmt.Errorf("audience is required")
+	}
+
+	if duration <= 0 {
+		duration = oidc.DefaultExecutionTokenDuration
+	}
+
+	if duration > oidc.MaxExecutionTokenDuration {
+		return "", fmt.Errorf("token duration cannot be longer than %s", oidc.MaxExecutionTokenDuration)
+	}
+
+	subject := oidc.ExecutionSubject(
+		c.organizationID.String(),
+		c.execution.WorkflowID.String(),
+		c.execution.NodeID,
+		c.execution.ID.String(),
+	)
+
+	return c.provider.Sign(subject, duration, audience, map[string]any{
+		oidc.ClaimOrganizationID: c.organizationID.String(),
+		oidc.ClaimCanvasID:       c.execution.WorkflowID.String(),
+		oidc.ClaimNodeID:         c.execution.NodeID,
+		oidc.ClaimExecutionID:    c.execution.ID.String(),
+		oidc.ClaimRootEventID:    c.execution.RootEventID.String(),
+	})
+}
@@ -0,0 +1,76 @@
+package contexts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/oidc"
+)
+
+type recordingOIDCProvider struct {
+	subject  string
+	duration time.Duration
+	audience string
+	claims   map[string]any
+}
+
+func (p *recordingOIDCProvider) Sign(subject string, duration time.Duration, audience string, additionalClaims map[string]any) (string, error) {
+	p.subject = subject
+	p.duration = duration
+	p.audience = audience
+	p.claims = additionalClaims
+	return "token", nil
+}
+
+func (p *recordingOIDCProvider) PublicJWKs() []oidc.PublicJWK {
+	return nil
+}
+
+func Test__OIDCContext__Token(t *testing.T) {
+	organizationID := uuid.New()
+	execution := &models.CanvasNodeExecution{
+		ID:          uuid.New(),
+		WorkflowID:  uuid.New(),
+		NodeID:      "deploy",
+		RootEventID: uuid.New(),
+	}
+
+	t.Run("token identifies the execution", func(t *testing.T) {
+		provider := &recordingOIDCProvider{}
+		ctx := NewOIDCContext(provider, organizationID, execution)
+
+		token, err := ctx.Token("https://vault.example.com", 0)
+		require.NoError(t, err)
+		assert.Equal(t, "token", token)
+		assert.Equal(t, "https://vault.example.com", provider.audience)
+		assert.Equal(t, oidc.DefaultExecutionTokenDuration, provider.duration)
+		assert.Equal(t, oidc.ExecutionSubject(organizationID.String(), execution.WorkflowID.String(), "deploy", execution.ID.String()), provider.subject)
+		assert.Equal(t, organizationID.String(), provider.claims[oidc.ClaimOrganizationID])
+		assert.Equal(t, execution.WorkflowID.String(), provider.claims[oidc.ClaimCanvasID])
+		assert.Equal(t, "deploy", provider.claims[oidc.ClaimNodeID])
+		assert.Equal(t, execution.ID.String(), provider.claims[oidc.ClaimExecutionID])
+		assert.Equal(t, execution.RootEventID.String(), provider.claims[oidc.ClaimRootEventID])
+	})
+
+	t.Run("audience is required", func(t *testing.T) {
+		ctx := NewOIDCContext(&recordingOIDCProvider{}, organizationID, execution)
+		_, err := ctx.Token(" ", time.Minute)
+		assert.ErrorContains(t, err, "audience is required")
+	})
+
+	t.Run("duration is capped", func(t *testing.T) {
+		ctx := NewOIDCContext(&recordingOIDCProvider{}, organizationID, execution)
+		_, err := ctx.Token("aud", 2*time.Hour)
+		assert.ErrorContains(t, err, "cannot be longer than")
+	})
+
+	t.Run("no provider", func(t *testing.T) {
+		ctx := NewOIDCContext(nil, organizationID, execution)
+		_, err := ctx.Token("aud", time.Minute)
+		assert.Error(t, err)
+	})
+}
@@ -95,6 +95,7 @@ func BuildProcessQueueContext(httpCtx core.HTTPContext, tx *gorm.DB, node *model
 			PreviousExecutionID: event.ExecutionID,
 			State:               models.CanvasNodeExecutionStatePending,
 			Configuration:       datatypes.NewJSONType(config),
+			QueuedAt:            queueItem.CreatedAt,
 			CreatedAt:           &now,
 			UpdatedAt:           &now,
 		}
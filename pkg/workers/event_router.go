@@ -5,72 +5,65 @@ import (
 	"fmt"
 	"time"
 
-	"golang.org/x/sync/semaphore"
 	"gorm.io/gorm"
 
+	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
-	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/pkg/telemetry"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type EventRouter struct {
-	semaphore *semaphore.Weighted
-	logger    *log.Entry
+	poller
+	*pool
+
+	logger *log.Entry
 }
 
 func NewEventRouter() *EventRouter {
 	return &EventRouter{
-		semaphore: semaphore.NewWeighted(25),
-		logger:    log.WithFields(log.Fields{"worker": "EventRouter"}),
+		poller: newPoller(),
+		pool:   newPool(),
+		logger: log.WithFields(log.Fields{"worker": "EventRouter"}),
 	}
 }
 
 func (w *EventRouter) Start(ctx context.Context) {
-	ticker := time.NewTicker(1 * time.Second)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			tickStart := time.Now()
+	w.run(ctx, w.tick)
+}
 
-			events, err := models.ListPendingCanvasEvents()
-			if err != nil {
-				w.logger.Errorf("Error finding canvas nodes ready to be processed: %v", err)
-			}
+func (w *EventRouter) tick() {
+	tickStart := time.Now()
 
-			telemetry.RecordEventWorkerEventsCount(context.Background(), len(events))
+	events, err := models.ListPendingCanvasEvents()
+	if err != nil {
+		w.logger.Errorf("Error finding canvas nodes ready to be processed: %v", err)
+	}
 
-			for _, event := range events {
-				logger := logging.ForEvent(w.logger, event)
-				if err := w.semaphore.Acquire(context.Background(), 1); err != nil {
-					w.logger.Errorf("Error acquiring semaphore: %v", err)
-					continue
-				}
+	telemetry.RecordEventWorkerEventsCount(context.Background(), len(events))
 
-				go func(event models.CanvasEvent) {
-					defer w.semaphore.Release(1)
+	dispatch(w.pool, &w.tracker, w.logger, events, canvasOfEvent, func(event models.CanvasEvent) {
+		logger := logging.ForEvent(w.logger, event)
+		if err := w.LockAndProcessEvent(logger, event); err != nil {
+			w.logger.Errorf("Error processing event %s: %v", event.ID, err)
+		}
+	})
 
-					if err := w.LockAndProcessEvent(logger, event); err != nil {
-						w.logger.Errorf("Error processing event %s: %v", event.ID, err)
-					}
-				}(event)
-			}
+	telemetry.RecordEventWorkerTickDuration(context.Background(), time.Since(tickStart))
+}
 
-			telemetry.RecordEventWorkerTickDuration(context.Background(), time.Since(tickStart))
-		}
-	}
+func canvasOfEvent(item models.CanvasEvent) uuid.UUID {
+	return item.WorkflowID
 }
 
 func (w *EventRouter) LockAndProcessEvent(logger *log.Entry, event models.CanvasEvent) error {
+	start := time.Now()
 	var createdQueueItems []models.CanvasNodeQueueItem
 	var execution *models.CanvasNodeExecution
-	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+	err := models.TransactionWithMetrics(func(tx *gorm.DB) error {
 		e, err := models.LockCanvasEvent(tx, event.ID)
 		if err != nil {
 			logger.Info("Event already being processed - skipping")
@@ -85,6 +78,17 @@ func (w *EventRouter) LockAndProcessEvent(logger *log.Entry, event models.Canvas
 		return nil
 	})
 
+	telemetry.RecordSpan(
+		telemetry.EventContext(context.Background(), &event),
+		"canvas.event.route",
+		start,
+		err,
+		telemetry.AttributeCanvasID.String(event.WorkflowID.String()),
+		telemetry.AttributeNodeID.String(event.NodeID),
+		telemetry.AttributeEventID.String(event.ID.String()),
+		attribute.Int("superplane.queue_items.count", len(createdQueueItems)),
+	)
+
 	if err != nil {
 		return err
 	}
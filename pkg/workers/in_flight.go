@@ -0,0 +1,67 @@
+package workers
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+/*
+ * inFlight tracks the work a worker is processing in the background,
+ * so the worker can stop taking new work and wait for the work
+ * it already took when the process is shutting down.
+ */
+type inFlight struct {
+	mu     sync.Mutex
+	wg     sync.WaitGroup
+	count  atomic.Int64
+	closed bool
+}
+
+// Go runs fn in the background, unless the worker is draining.
+// It returns false if fn was not started.
+func (f *inFlight) Go(fn func()) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return false
+	}
+
+	f.wg.Add(1)
+	f.count.Add(1)
+
+	go func() {
+		defer f.wg.Done()
+		defer f.count.Add(-1)
+
+		fn()
+	}()
+
+	return true
+}
+
+func (f *inFlight) Count() int64 {
+	return f.count.Load()
+}
+
+// Drain stops new work from being started, and waits
+// for the work in flight to finish, or for the context to be done.
+func (f *inFlight) Drain(ctx context.Context) error {
+	f.mu.Lock()
+	f.closed = true
+	f.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		f.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
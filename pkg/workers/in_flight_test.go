@@ -0,0 +1,59 @@
+package workers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__InFlight(t *testing.T) {
+	t.Run("drain waits for the work in flight", func(t *testing.T) {
+		tracker := &inFlight{}
+		release := make(chan struct{})
+		finished := make(chan struct{})
+
+		require.True(t, tracker.Go(func() {
+			<-release
+			close(finished)
+		}))
+
+		assert.Equal(t, int64(1), tracker.Count())
+
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			close(release)
+		}()
+
+		require.NoError(t, tracker.Drain(context.Background()))
+		assert.Equal(t, int64(0), tracker.Count())
+
+		select {
+		case <-finished:
+		default:
+			require.Fail(t, "expected work to finish before drain returns")
+		}
+	})
+
+	t.Run("no new work is started after draining", func(t *testing.T) {
+		tracker := &inFlight{}
+		require.NoError(t, tracker.Drain(context.Background()))
+		assert.False(t, tracker.Go(func() { require.Fail(t, "should not run") }))
+	})
+
+	t.Run("drain returns when the context is done", func(t *testing.T) {
+		tracker := &inFlight{}
+		release := make(chan struct{})
+		defer close(release)
+
+		require.True(t, tracker.Go(func() { <-release }))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		assert.ErrorIs(t, tracker.Drain(ctx), context.DeadlineExceeded)
+		assert.Equal(t, int64(1), tracker.Count())
+	})
+}
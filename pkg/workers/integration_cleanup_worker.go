@@ -18,6 +18,8 @@ import (
 )
 
 type IntegrationCleanupWorker struct {
+	monitored
+
 	semaphore *semaphore.Weighted
 	registry  *registry.Registry
 	encryptor crypto.Encryptor
@@ -42,6 +44,7 @@ func (w *IntegrationCleanupWorker) Start(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
+			w.heartbeat.Beat()
 			integrations, err := models.ListDeletedIntegrations()
 			if err != nil {
 				w.log("Error finding deleted integrations: %v", err)
@@ -53,13 +56,18 @@ func (w *IntegrationCleanupWorker) Start(ctx context.Context) {
 					continue
 				}
 
-				go func(integration models.Integration) {
+				started := w.tracker.Go(func() {
 					defer w.semaphore.Release(1)
 
 					if err := w.LockAndProcessIntegration(integration); err != nil {
 						w.log("Error processing integration %s: %v", integration.ID, err)
 					}
-				}(integration)
+				})
+
+				if !started {
+					w.semaphore.Release(1)
+					break
+				}
 			}
 		}
 	}
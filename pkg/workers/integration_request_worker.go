@@ -20,6 +20,8 @@ import (
 )
 
 type IntegrationRequestWorker struct {
+	monitored
+
 	semaphore       *semaphore.Weighted
 	registry        *registry.Registry
 	encryptor       crypto.Encryptor
@@ -48,6 +50,7 @@ func (w *IntegrationRequestWorker) Start(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
+			w.heartbeat.Beat()
 			requests, err := models.ListIntegrationRequests()
 			if err != nil {
 				w.log("Error finding app installation requests: %v", err)
@@ -59,13 +62,18 @@ func (w *IntegrationRequestWorker) Start(ctx context.Context) {
 					continue
 				}
 
-				go func(request models.IntegrationRequest) {
+				started := w.tracker.Go(func() {
 					defer w.semaphore.Release(1)
 
 					if err := w.LockAndProcessRequest(request); err != nil {
 						w.log("Error processing request %s: %v", request.ID, err)
 					}
-				}(request)
+				})
+
+				if !started {
+					w.semaphore.Release(1)
+					break
+				}
 			}
 		}
 	}
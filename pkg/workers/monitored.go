@@ -0,0 +1,33 @@
+package workers
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/health"
+)
+
+/*
+ * monitored workers beat a heartbeat on every tick,
+ * so the process is not ready if one of them stops ticking.
+ * They also track the work they process in the background,
+ * so it can be drained when the process shuts down.
+ */
+type monitored struct {
+	heartbeat *health.Heartbeat
+	tracker   inFlight
+}
+
+func (m *monitored) SetHeartbeat(heartbeat *health.Heartbeat) {
+	m.heartbeat = heartbeat
+}
+
+// Drain stops the worker from taking new work,
+// and waits for the work it already took to finish.
+func (m *monitored) Drain(ctx context.Context) error {
+	return m.tracker.Drain(ctx)
+}
+
+// InFlight returns how many items the worker is processing.
+func (m *monitored) InFlight() int64 {
+	return m.tracker.Count()
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"time"
 
-	"golang.org/x/sync/semaphore"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
@@ -15,87 +14,104 @@ import (
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/crypto"
-	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/oidc"
 	"github.com/superplanehq/superplane/pkg/registry"
 	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var ErrRecordLocked = errors.New("record locked")
 
 type NodeExecutor struct {
-	encryptor crypto.Encryptor
-	registry  *registry.Registry
-	baseURL   string
-	semaphore *semaphore.Weighted
-	logger    *logrus.Entry
+	poller
+	*pool
+
+	encryptor    crypto.Encryptor
+	registry     *registry.Registry
+	oidcProvider oidc.Provider
+	baseURL      string
+	logger       *logrus.Entry
 }
 
-func NewNodeExecutor(encryptor crypto.Encryptor, registry *registry.Registry, baseURL string) *NodeExecutor {
+func NewNodeExecutor(encryptor crypto.Encryptor, registry *registry.Registry, oidcProvider oidc.Provider, baseURL string) *NodeExecutor {
 	return &NodeExecutor{
-		encryptor: encryptor,
-		registry:  registry,
-		baseURL:   baseURL,
-		semaphore: semaphore.NewWeighted(25),
-		logger:    logrus.WithFields(logrus.Fields{"worker": "NodeExecutor"}),
+		poller:       newPoller(),
+		pool:         newPool(),
+		encryptor:    encryptor,
+		registry:     registry,
+		oidcProvider: oidcProvider,
+		baseURL:      baseURL,
+		logger:       logrus.WithFields(logrus.Fields{"worker": "NodeExecutor"}),
 	}
 }
 
 func (w *NodeExecutor) Start(ctx context.Context) {
-	ticker := time.NewTicker(1 * time.Second)
-	defer ticker.Stop()
+	w.run(ctx, w.tick)
+}
 
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			tickStart := time.Now()
+func (w *NodeExecutor) tick() {
+	tickStart := time.Now()
 
-			executions, err := models.ListPendingNodeExecutions()
-			if err != nil {
-				w.logger.Errorf("Error finding workflow nodes ready to be processed: %v", err)
-			}
+	executions, err := models.ListPendingNodeExecutions()
+	if err != nil {
+		w.logger.Errorf("Error finding workflow nodes ready to be processed: %v", err)
+	}
 
-			telemetry.RecordExecutorWorkerNodesCount(context.Background(), len(executions))
+	telemetry.RecordExecutorWorkerNodesCount(context.Background(), len(executions))
 
-			for _, execution := range executions {
-				if err := w.semaphore.Acquire(context.Background(), 1); err != nil {
-					w.logger.Errorf("Error acquiring semaphore: %v", err)
-					continue
-				}
+	dispatch(w.pool, &w.tracker, w.logger, executions, canvasOfExecution, func(execution models.CanvasNodeExecution) {
+		messages.NewCanvasExecutionMessage(execution.WorkflowID.String(), execution.ID.String(), execution.NodeID).Publish()
 
-				messages.NewCanvasExecutionMessage(execution.WorkflowID.String(), execution.ID.String(), execution.NodeID).Publish()
+		processed, err := w.lockAndProcessNodeExecution(execution.ID)
+		if err == nil {
+			publishExecutionTransitions(processed)
+			return
+		}
 
-				go func(execution models.CanvasNodeExecution) {
-					defer w.semaphore.Release(1)
+		if err == ErrRecordLocked {
+			return
+		}
 
-					err := w.LockAndProcessNodeExecution(execution.ID)
-					if err == nil {
-						messages.NewCanvasExecutionMessage(execution.WorkflowID.String(), execution.ID.String(), execution.NodeID).Publish()
-						return
-					}
+		w.logger.Errorf("Error processing node execution - node=%s, execution=%s: %v", execution.NodeID, execution.ID, err)
+	})
 
-					if err == ErrRecordLocked {
-						return
-					}
+	telemetry.RecordExecutorWorkerTickDuration(context.Background(), time.Since(tickStart))
+}
 
-					w.logger.Errorf("Error processing node execution - node=%s, execution=%s: %v", execution.NodeID, execution.ID, err)
-				}(execution)
-			}
+func canvasOfExecution(item models.CanvasNodeExecution) uuid.UUID {
+	return item.WorkflowID
+}
 
-			telemetry.RecordExecutorWorkerTickDuration(context.Background(), time.Since(tickStart))
-		}
+// publishExecutionTransitions publishes the states a processed execution moved to.
+// An execution can start and finish in the same transaction,
+// so the start is published on its own, for consumers not to miss it.
+func publishExecutionTransitions(execution *models.CanvasNodeExecution) {
+	if execution.StartedAt != nil {
+		messages.NewCanvasExecutionMessage(execution.WorkflowID.String(), execution.ID.String(), execution.NodeID).
+			WithState(models.CanvasNodeExecutionStateStarted).
+			Publish()
+	}
+
+	if execution.State != models.CanvasNodeExecutionStateStarted {
+		messages.NewCanvasExecutionMessage(execution.WorkflowID.String(), execution.ID.String(), execution.NodeID).
+			WithState(execution.State).
+			Publish()
 	}
 }
 
 func (w *NodeExecutor) LockAndProcessNodeExecution(id uuid.UUID) error {
-	return database.Conn().Transaction(func(tx *gorm.DB) error {
-		var execution models.CanvasNodeExecution
+	_, err := w.lockAndProcessNodeExecution(id)
+	return err
+}
 
+func (w *NodeExecutor) lockAndProcessNodeExecution(id uuid.UUID) (*models.CanvasNodeExecution, error) {
+	var execution models.CanvasNodeExecution
+	err := models.TransactionWithMetrics(func(tx *gorm.DB) error {
 		//
 		// Try to lock the execution record for update.
 		// If we can't, it means another worker is already processing it.
@@ -129,6 +145,12 @@ func (w *NodeExecutor) LockAndProcessNodeExecution(id uuid.UUID) error {
 
 		return w.processNodeExecution(tx, &execution)
 	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &execution, nil
 }
 
 func (w *NodeExecutor) processNodeExecution(tx *gorm.DB, execution *models.CanvasNodeExecution) error {
@@ -137,14 +159,19 @@ func (w *NodeExecutor) processNodeExecution(tx *gorm.DB, execution *models.Canva
 		return err
 	}
 
+	workflow, err := models.FindCanvasWithoutOrgScopeInTransaction(tx, node.WorkflowID)
+	if err != nil {
+		return fmt.Errorf("failed to find workflow: %v", err)
+	}
+
 	if node.Type == models.NodeTypeBlueprint {
-		return w.executeBlueprintNode(tx, execution, node)
+		return w.executeBlueprintNode(tx, workflow, execution, node)
 	}
 
-	return w.executeComponentNode(tx, execution, node)
+	return w.executeComponentNode(tx, workflow, execution, node)
 }
 
-func (w *NodeExecutor) executeBlueprintNode(tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode) error {
+func (w *NodeExecutor) executeBlueprintNode(tx *gorm.DB, workflow *models.Canvas, execution *models.CanvasNodeExecution, node *models.CanvasNode) error {
 	ref := node.Ref.Data()
 	blueprint, err := models.FindUnscopedBlueprintInTransaction(tx, ref.Blueprint.ID)
 	if err != nil {
@@ -209,7 +236,7 @@ func (w *NodeExecutor) executeBlueprintNode(tx *gorm.DB, execution *models.Canva
 		return fmt.Errorf("failed to create child execution: %w", err)
 	}
 
-	err = execution.StartInTransaction(tx)
+	err = execution.StartInTransaction(tx, workflow, node)
 
 	return err
 }
@@ -239,14 +266,24 @@ func (w *NodeExecutor) configurationFieldsForBlueprintNode(tx *gorm.DB, node mod
 	}
 }
 
-func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode) error {
+func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, workflow *models.Canvas, execution *models.CanvasNodeExecution, node *models.CanvasNode) (err error) {
+	spanCtx, span := w.startExecutionSpan(execution, node)
+	defer func() {
+		span.SetAttributes(
+			telemetry.AttributeState.String(execution.State),
+			telemetry.AttributeResult.String(execution.Result),
+		)
+
+		telemetry.EndSpan(span, err)
+	}()
+
 	logger := logging.WithExecution(
 		logging.WithNode(w.logger, *node),
 		execution,
 		nil,
 	)
 
-	err := execution.StartInTransaction(tx)
+	err = execution.StartInTransaction(tx, workflow, node)
 	if err != nil {
 		logger.Errorf("failed to start execution: %v", err)
 		return fmt.Errorf("failed to start execution: %w", err)
@@ -267,12 +304,6 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 
 	input := inputEvent.Data.Data()
 
-	workflow, err := models.FindCanvasWithoutOrgScopeInTransaction(tx, node.WorkflowID)
-	if err != nil {
-		logger.Errorf("failed to find workflow: %v", err)
-		return fmt.Errorf("failed to find workflow: %v", err)
-	}
-
 	ctx := core.ExecutionContext{
 		ID:             execution.ID,
 		WorkflowID:     execution.WorkflowID.String(),
@@ -282,7 +313,7 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 		BaseURL:        w.baseURL,
 		Configuration:  execution.Configuration.Data(),
 		Data:           input,
-		HTTP:           w.registry.HTTPContext(),
+		HTTP:           telemetry.TraceHTTP(spanCtx, w.registry.HTTPContext()),
 		Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
 		NodeMetadata:   contexts.NewNodeMetadataContext(tx, node),
 		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
@@ -290,6 +321,7 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
 		Notifications:  contexts.NewNotificationContext(tx, workflow.OrganizationID, execution.WorkflowID),
 		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
+		OIDC:           contexts.NewOIDCContext(w.oidcProvider, workflow.OrganizationID, execution),
 	}
 	ctx.ExpressionEnv = func(expression string) (map[string]any, error) {
 		builder := contexts.NewNodeConfigurationBuilder(tx, execution.WorkflowID).
@@ -318,9 +350,13 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 		ctx.Integration = contexts.NewIntegrationContext(tx, node, instance, w.encryptor, w.registry)
 	}
 
+	logger, executionLogs := logging.WithExecutionLogs(logger, execution)
+	defer executionLogs.Flush()
+
 	ctx.Logger = logger
 	if err := component.Execute(ctx); err != nil {
 		logger.Errorf("failed to execute component: %v", err)
+		span.RecordError(err)
 		err = execution.FailInTransaction(tx, models.CanvasNodeExecutionResultReasonError, err.Error())
 		return err
 	}
@@ -329,3 +365,19 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 
 	return tx.Save(execution).Error
 }
+
+func (w *NodeExecutor) startExecutionSpan(execution *models.CanvasNodeExecution, node *models.CanvasNode) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		telemetry.AttributeCanvasID.String(execution.WorkflowID.String()),
+		telemetry.AttributeNodeID.String(execution.NodeID),
+		telemetry.AttributeExecutionID.String(execution.ID.String()),
+		telemetry.AttributeEventID.String(execution.EventID.String()),
+	}
+
+	if ref := node.Ref.Data(); ref.Component != nil {
+		attrs = append(attrs, telemetry.AttributeComponent.String(ref.Component.Name))
+	}
+
+	ctx := telemetry.RootEventContext(context.Background(), execution.RootEventID)
+	return telemetry.StartSpan(ctx, "canvas.execution", trace.WithAttributes(attrs...))
+}
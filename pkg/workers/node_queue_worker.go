@@ -6,78 +6,70 @@ import (
 	"fmt"
 	"time"
 
-	"golang.org/x/sync/semaphore"
 	"gorm.io/gorm"
 
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
-	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/pkg/registry"
 	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type NodeQueueWorker struct {
-	registry  *registry.Registry
-	semaphore *semaphore.Weighted
-	logger    *log.Entry
+	poller
+	*pool
+
+	registry *registry.Registry
+	logger   *log.Entry
 }
 
 func NewNodeQueueWorker(registry *registry.Registry) *NodeQueueWorker {
 	return &NodeQueueWorker{
-		registry:  registry,
-		semaphore: semaphore.NewWeighted(25),
-		logger:    log.WithFields(log.Fields{"worker": "NodeQueueWorker"}),
+		poller:   newPoller(),
+		pool:     newPool(),
+		registry: registry,
+		logger:   log.WithFields(log.Fields{"worker": "NodeQueueWorker"}),
 	}
 }
 
 func (w *NodeQueueWorker) Start(ctx context.Context) {
-	ticker := time.NewTicker(1 * time.Second)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			tickStart := time.Now()
-			nodes, err := models.ListCanvasNodesReady()
-			if err != nil {
-				w.logger.Errorf("Error finding canvas nodes ready to be processed: %v", err)
-			}
+	w.run(ctx, w.tick)
+}
 
-			telemetry.RecordQueueWorkerNodesCount(context.Background(), len(nodes))
+func (w *NodeQueueWorker) tick() {
+	tickStart := time.Now()
+	nodes, err := models.ListCanvasNodesReady()
+	if err != nil {
+		w.logger.Errorf("Error finding canvas nodes ready to be processed: %v", err)
+	}
 
-			for _, node := range nodes {
-				logger := logging.WithNode(w.logger, node)
-				if err := w.semaphore.Acquire(context.Background(), 1); err != nil {
-					logger.Errorf("Error acquiring semaphore: %v", err)
-					continue
-				}
+	telemetry.RecordQueueWorkerNodesCount(context.Background(), len(nodes))
 
-				go func(node models.CanvasNode) {
-					defer w.semaphore.Release(1)
+	dispatch(w.pool, &w.tracker, w.logger, nodes, canvasOfNode, func(node models.CanvasNode) {
+		logger := logging.WithNode(w.logger, node)
+		if err := w.LockAndProcessNode(logger, node); err != nil {
+			logger.Errorf("Error processing: %v", err)
+		}
+	})
 
-					if err := w.LockAndProcessNode(logger, node); err != nil {
-						logger.Errorf("Error processing: %v", err)
-					}
-				}(node)
-			}
+	telemetry.RecordQueueWorkerTickDuration(context.Background(), time.Since(tickStart))
+}
 
-			telemetry.RecordQueueWorkerTickDuration(context.Background(), time.Since(tickStart))
-		}
-	}
+func canvasOfNode(item models.CanvasNode) uuid.UUID {
+	return item.WorkflowID
 }
 
 func (w *NodeQueueWorker) LockAndProcessNode(logger *log.Entry, node models.CanvasNode) error {
+	start := time.Now()
 	var executionIDs []*uuid.UUID
 	var queueItem *models.CanvasNodeQueueItem
-	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+	err := models.TransactionWithMetrics(func(tx *gorm.DB) error {
 		n, err := models.LockCanvasNode(tx, node.WorkflowID, node.NodeID)
 		if err != nil {
 			logger.Info("Node already being processed - skipping")
@@ -88,6 +80,10 @@ func (w *NodeQueueWorker) LockAndProcessNode(logger *log.Entry, node models.Canv
 		return err
 	})
 
+	if queueItem != nil {
+		w.recordQueueItemSpan(start, node, queueItem, executionIDs, err)
+	}
+
 	if err == nil {
 		if len(executionIDs) > 0 {
 			for _, executionID := range executionIDs {
@@ -115,6 +111,33 @@ func (w *NodeQueueWorker) LockAndProcessNode(logger *log.Entry, node models.Canv
 	return err
 }
 
+func (w *NodeQueueWorker) recordQueueItemSpan(start time.Time, node models.CanvasNode, queueItem *models.CanvasNodeQueueItem, executionIDs []*uuid.UUID, err error) {
+	if !telemetry.TracingEnabled() {
+		return
+	}
+
+	attrs := []attribute.KeyValue{
+		telemetry.AttributeCanvasID.String(node.WorkflowID.String()),
+		telemetry.AttributeNodeID.String(node.NodeID),
+		telemetry.AttributeQueueItemID.String(queueItem.ID.String()),
+		telemetry.AttributeEventID.String(queueItem.EventID.String()),
+	}
+
+	if ref := node.Ref.Data(); ref.Component != nil {
+		attrs = append(attrs, telemetry.AttributeComponent.String(ref.Component.Name))
+	}
+
+	for _, executionID := range executionIDs {
+		if executionID != nil {
+			attrs = append(attrs, telemetry.AttributeExecutionID.String(executionID.String()))
+			break
+		}
+	}
+
+	ctx := telemetry.RootEventContext(context.Background(), queueItem.RootEventID)
+	telemetry.RecordSpan(ctx, "canvas.queue_item.process", start, err, attrs...)
+}
+
 func (w *NodeQueueWorker) processNode(tx *gorm.DB, logger *log.Entry, node *models.CanvasNode) ([]*uuid.UUID, *models.CanvasNodeQueueItem, error) {
 	queueItem, err := node.FirstQueueItem(tx)
 	if err != nil {
@@ -256,6 +279,8 @@ func (w *NodeQueueWorker) handleNodeConfigurationError(tx *gorm.DB, logger *log.
 		Result:              models.CanvasNodeExecutionResultFailed,
 		ResultReason:        models.CanvasNodeExecutionResultReasonError,
 		ResultMessage:       configErr.Err.Error(),
+		QueuedAt:            configErr.QueueItem.CreatedAt,
+		FinishedAt:          &now,
 		CreatedAt:           &now,
 		UpdatedAt:           &now,
 	}
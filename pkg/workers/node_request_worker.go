@@ -9,78 +9,91 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	"golang.org/x/sync/semaphore"
 	"gorm.io/gorm"
 
+	"github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/crypto"
-	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/oidc"
 	"github.com/superplanehq/superplane/pkg/registry"
 	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
 )
 
 type NodeRequestWorker struct {
-	semaphore *semaphore.Weighted
-	registry  *registry.Registry
-	encryptor crypto.Encryptor
+	poller
+	*pool
+
+	registry     *registry.Registry
+	encryptor    crypto.Encryptor
+	oidcProvider oidc.Provider
+	logger       *logrus.Entry
 }
 
-func NewNodeRequestWorker(encryptor crypto.Encryptor, registry *registry.Registry) *NodeRequestWorker {
-	return &NodeRequestWorker{
-		encryptor: encryptor,
-		registry:  registry,
-		semaphore: semaphore.NewWeighted(25),
-	}
+func NewNodeRequestWorker(encryptor crypto.Encryptor, registry *registry.Registry, oidcProvider oidc.Provider) *NodeRequestWorker {
+	w := &NodeRequestWorker{
+		poller:       newPoller(),
+		pool:         newPool(),
+		logger:       logrus.WithFields(logrus.Fields{"worker": "NodeRequestWorker"}),
+		encryptor:    encryptor,
+		registry:     registry,
+		oidcProvider: oidcProvider,
+	}
+
+	//
+	// Requests scheduled for the future do not send notifications
+	// when they become due, so we wake up on time for the next one.
+	//
+	w.nextDue = w.nextRequestDue
+	return w
 }
 
 func (w *NodeRequestWorker) Start(ctx context.Context) {
-	ticker := time.NewTicker(1 * time.Second)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			tickStart := time.Now()
-
-			requests, err := models.ListNodeRequests()
-			if err != nil {
-				w.log("Error finding workflow nodes ready to be processed: %v", err)
-			}
-
-			telemetry.RecordNodeRequestWorkerRequestsCount(context.Background(), len(requests))
+	w.run(ctx, w.tick)
+}
 
-			for _, request := range requests {
-				if err := w.semaphore.Acquire(context.Background(), 1); err != nil {
-					w.log("Error acquiring semaphore: %v", err)
-					continue
-				}
+func (w *NodeRequestWorker) tick() {
+	tickStart := time.Now()
 
-				go func(request models.CanvasNodeRequest) {
-					defer w.semaphore.Release(1)
+	requests, err := models.ListNodeRequests()
+	if err != nil {
+		w.log("Error finding workflow nodes ready to be processed: %v", err)
+	}
 
-					if err := w.LockAndProcessRequest(request); err != nil {
-						w.log("Error processing request %s: %v", request.ID, err)
-					}
+	telemetry.RecordNodeRequestWorkerRequestsCount(context.Background(), len(requests))
 
-					if request.ExecutionID != nil {
-						messages.NewCanvasExecutionMessage(request.WorkflowID.String(), request.ExecutionID.String(), request.NodeID).Publish()
-					}
-				}(request)
-			}
+	dispatch(w.pool, &w.tracker, w.logger, requests, canvasOfRequest, func(request models.CanvasNodeRequest) {
+		if err := w.LockAndProcessRequest(request); err != nil {
+			w.log("Error processing request %s: %v", request.ID, err)
+		}
 
-			telemetry.RecordNodeRequestWorkerTickDuration(context.Background(), time.Since(tickStart))
+		if request.ExecutionID != nil {
+			messages.NewCanvasExecutionMessage(request.WorkflowID.String(), request.ExecutionID.String(), request.NodeID).Publish()
 		}
+	})
+
+	telemetry.RecordNodeRequestWorkerTickDuration(context.Background(), time.Since(tickStart))
+}
+
+func canvasOfRequest(item models.CanvasNodeRequest) uuid.UUID {
+	return item.WorkflowID
+}
+
+func (w *NodeRequestWorker) nextRequestDue() *time.Time {
+	runAt, err := models.NextNodeRequestRunAt()
+	if err != nil {
+		w.log("Error finding next scheduled request: %v", err)
+		return nil
 	}
+
+	return runAt
 }
 
 func (w *NodeRequestWorker) LockAndProcessRequest(request models.CanvasNodeRequest) error {
-	return database.Conn().Transaction(func(tx *gorm.DB) error {
+	return models.TransactionWithMetrics(func(tx *gorm.DB) error {
 		r, err := models.LockNodeRequest(tx, request.ID)
 		if err != nil {
 			w.log("Request %s already being processed - skipping", request.ID)
@@ -203,16 +216,21 @@ func (w *NodeRequestWorker) invokeParentNodeComponentAction(tx *gorm.DB, request
 		return fmt.Errorf("action '%s' not found for component '%s'", actionName, component.Name())
 	}
 
+	workflow, err := models.FindCanvasWithoutOrgScopeInTransaction(tx, execution.WorkflowID)
+	if err != nil {
+		return fmt.Errorf("canvas not found: %w", err)
+	}
+
 	logger := logging.ForExecution(execution, nil)
 	actionCtx := core.ActionContext{
 		Name:           actionName,
 		Configuration:  node.Configuration.Data(),
 		Parameters:     spec.InvokeAction.Parameters,
-		HTTP:           w.registry.HTTPContext(),
 		Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
 		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
 		Notifications:  contexts.NewNotificationContext(tx, uuid.Nil, node.WorkflowID),
+		OIDC:           contexts.NewOIDCContext(w.oidcProvider, workflow.OrganizationID, execution),
 	}
 
 	if node.AppInstallationID != nil {
@@ -225,8 +243,11 @@ func (w *NodeRequestWorker) invokeParentNodeComponentAction(tx *gorm.DB, request
 		actionCtx.Integration = contexts.NewIntegrationContext(tx, node, instance, w.encryptor, w.registry)
 	}
 
-	actionCtx.Logger = logger
-	err = component.HandleAction(actionCtx)
+	actionLogger, executionLogs := logging.WithExecutionLogs(logger, execution)
+	defer executionLogs.Flush()
+
+	actionCtx.Logger = actionLogger
+	err = w.handleAction(component, execution, actionCtx)
 	if err != nil {
 		return fmt.Errorf("action execution failed: %w", err)
 	}
@@ -277,19 +298,27 @@ func (w *NodeRequestWorker) invokeChildNodeComponentAction(tx *gorm.DB, request
 		return fmt.Errorf("action '%s' not found for component '%s'", actionName, component.Name())
 	}
 
+	workflow, err := models.FindCanvasWithoutOrgScopeInTransaction(tx, execution.WorkflowID)
+	if err != nil {
+		return fmt.Errorf("canvas not found: %w", err)
+	}
+
+	actionLogger, executionLogs := logging.WithExecutionLogs(logging.ForExecution(execution, parentExecution), execution)
+	defer executionLogs.Flush()
+
 	actionCtx := core.ActionContext{
 		Name:           actionName,
 		Configuration:  childNode.Configuration,
 		Parameters:     spec.InvokeAction.Parameters,
-		Logger:         logging.ForExecution(execution, parentExecution),
-		HTTP:           w.registry.HTTPContext(),
+		Logger:         actionLogger,
 		Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
 		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
 		Notifications:  contexts.NewNotificationContext(tx, uuid.Nil, execution.WorkflowID),
+		OIDC:           contexts.NewOIDCContext(w.oidcProvider, workflow.OrganizationID, execution),
 	}
 
-	err = component.HandleAction(actionCtx)
+	err = w.handleAction(component, execution, actionCtx)
 	if err != nil {
 		return fmt.Errorf("action execution failed: %w", err)
 	}
@@ -302,6 +331,15 @@ func (w *NodeRequestWorker) invokeChildNodeComponentAction(tx *gorm.DB, request
 	return request.Complete(tx)
 }
 
+func (w *NodeRequestWorker) handleAction(component core.Component, execution *models.CanvasNodeExecution, actionCtx core.ActionContext) error {
+	spanCtx, span := telemetry.StartActionSpan(execution, component.Name(), actionCtx.Name)
+	actionCtx.HTTP = telemetry.TraceHTTP(spanCtx, w.registry.HTTPContext())
+
+	err := component.HandleAction(actionCtx)
+	telemetry.EndSpan(span, err)
+	return err
+}
+
 func (w *NodeRequestWorker) log(format string, v ...any) {
 	log.Printf("[NodeRequestWorker] "+format, v...)
 }
@@ -0,0 +1,274 @@
+package workers
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/renderedtext/go-tackle"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/logging"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+const OrganizationWebhookConnectionName = "superplane"
+
+/*
+ * OrganizationWebhookConsumer listens to the execution and canvas messages
+ * published to the workflow exchange, and creates a delivery for every
+ * organization webhook subscription interested in them.
+ * The deliveries are sent by the OrganizationWebhookSender.
+ */
+type OrganizationWebhookConsumer struct {
+	RabbitMQURL string
+	logger      *log.Entry
+}
+
+func NewOrganizationWebhookConsumer(rabbitMQURL string) *OrganizationWebhookConsumer {
+	return &OrganizationWebhookConsumer{
+		RabbitMQURL: rabbitMQURL,
+		logger:      log.WithFields(log.Fields{"consumer": "organization_webhooks"}),
+	}
+}
+
+func (c *OrganizationWebhookConsumer) Start() {
+	go c.consume(messages.WorkflowExecutionRoutingKey, c.ConsumeExecution)
+	go c.consume(messages.CanvasChangedRoutingKey, c.ConsumeCanvasChanged)
+}
+
+func (c *OrganizationWebhookConsumer) consume(routingKey string, handler func(tackle.Delivery) error) {
+	options := tackle.Options{
+		URL:            c.RabbitMQURL,
+		ConnectionName: OrganizationWebhookConnectionName,
+		Service:        "superplane." + messages.WorkflowExchange + "." + routingKey + ".organization-webhooks",
+		RemoteExchange: messages.WorkflowExchange,
+		RoutingKey:     routingKey,
+	}
+
+	for {
+		c.logger.Infof("Connecting to RabbitMQ queue for %s events", routingKey)
+
+		consumer := tackle.NewConsumer()
+		consumer.SetLogger(logging.NewTackleLogger(c.logger))
+
+		err := consumer.Start(&options, handler)
+		if err != nil {
+			c.logger.Errorf("Error consuming messages from %s: %v", routingKey, err)
+			time.Sleep(5 * time.Second)
+			continue
+		}
+
+		c.logger.Warnf("Connection to RabbitMQ closed for %s, reconnecting...", routingKey)
+		time.Sleep(5 * time.Second)
+	}
+}
+
+func (c *OrganizationWebhookConsumer) ConsumeExecution(delivery tackle.Delivery) error {
+	data := &pb.CanvasNodeExecutionMessage{}
+	err := proto.Unmarshal(delivery.Body(), data)
+	if err != nil {
+		c.logger.Errorf("Error unmarshaling execution message: %v", err)
+		return nil
+	}
+
+	canvasID, err := uuid.Parse(data.CanvasId)
+	if err != nil {
+		c.logger.Errorf("Invalid canvas ID %s: %v", data.CanvasId, err)
+		return nil
+	}
+
+	executionID, err := uuid.Parse(data.Id)
+	if err != nil {
+		c.logger.Errorf("Invalid execution ID %s: %v", data.Id, err)
+		return nil
+	}
+
+	return EnqueueExecutionWebhooks(canvasID, executionID, data.State)
+}
+
+func (c *OrganizationWebhookConsumer) ConsumeCanvasChanged(delivery tackle.Delivery) error {
+	data := &pb.CanvasChangedMessage{}
+	err := proto.Unmarshal(delivery.Body(), data)
+	if err != nil {
+		c.logger.Errorf("Error unmarshaling canvas changed message: %v", err)
+		return nil
+	}
+
+	return EnqueueCanvasWebhooks(data)
+}
+
+// EnqueueExecutionWebhooks creates the deliveries for the state an execution moved to.
+// Messages published without a state use the current state of the execution.
+// Executions are published several times in the same state, so deliveries
+// are deduplicated by execution and event type.
+func EnqueueExecutionWebhooks(canvasID, executionID uuid.UUID, state string) error {
+	execution, err := models.FindNodeExecution(canvasID, executionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	canvas, err := models.FindUnscopedCanvas(canvasID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	node, err := models.FindCanvasNode(database.Conn(), canvasID, execution.NodeID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	if state == "" {
+		state = execution.State
+	}
+
+	eventTypes := executionEventTypes(state, execution, node)
+	if len(eventTypes) == 0 {
+		return nil
+	}
+
+	data := executionWebhookData(canvas, node, execution, state)
+	for _, eventType := range eventTypes {
+		dedupeKey := fmt.Sprintf("execution/%s/%s", execution.ID, eventType)
+		err := enqueueOrganizationWebhooks(canvas.OrganizationID, eventType, dedupeKey, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func executionEventTypes(state string, execution *models.CanvasNodeExecution, node *models.CanvasNode) []string {
+	switch state {
+	case models.CanvasNodeExecutionStateStarted:
+		eventTypes := []string{models.OrganizationWebhookEventExecutionStarted}
+		if node != nil && node.Ref.Data().Component != nil && node.Ref.Data().Component.Name == models.ApprovalComponentName {
+			eventTypes = append(eventTypes, models.OrganizationWebhookEventExecutionApprovalRequested)
+		}
+
+		return eventTypes
+
+	case models.CanvasNodeExecutionStateFinished:
+		if execution.Result == models.CanvasNodeExecutionResultFailed {
+			return []string{models.OrganizationWebhookEventExecutionFailed}
+		}
+
+		return []string{models.OrganizationWebhookEventExecutionFinished}
+	}
+
+	return nil
+}
+
+func executionWebhookData(canvas *models.Canvas, node *models.CanvasNode, execution *models.CanvasNodeExecution, state string) map[string]any {
+	data := map[string]any{
+		"canvasId":      canvas.ID.String(),
+		"canvasName":    canvas.Name,
+		"nodeId":        execution.NodeID,
+		"executionId":   execution.ID.String(),
+		"rootEventId":   execution.RootEventID.String(),
+		"state":         state,
+		"result":        "",
+		"resultReason":  "",
+		"resultMessage": "",
+		"createdAt":     execution.CreatedAt.Format(time.RFC3339),
+		"updatedAt":     execution.UpdatedAt.Format(time.RFC3339),
+	}
+
+	//
+	// The execution may have finished after the state being delivered,
+	// so its result is only included when delivering the finish.
+	//
+	if state == models.CanvasNodeExecutionStateFinished {
+		data["result"] = execution.Result
+		data["resultReason"] = execution.ResultReason
+		data["resultMessage"] = execution.ResultMessage
+	}
+
+	if node != nil {
+		data["nodeName"] = node.Name
+	}
+
+	if execution.ParentExecutionID != nil {
+		data["parentExecutionId"] = execution.ParentExecutionID.String()
+	}
+
+	return data
+}
+
+// EnqueueCanvasWebhooks creates the deliveries for a canvas change.
+func EnqueueCanvasWebhooks(message *pb.CanvasChangedMessage) error {
+	var eventType string
+	switch message.Action {
+	case pb.CanvasChangedMessage_ACTION_CREATED:
+		eventType = models.OrganizationWebhookEventCanvasCreated
+	case pb.CanvasChangedMessage_ACTION_UPDATED:
+		eventType = models.OrganizationWebhookEventCanvasUpdated
+	case pb.CanvasChangedMessage_ACTION_DELETED:
+		eventType = models.OrganizationWebhookEventCanvasDeleted
+	default:
+		return nil
+	}
+
+	orgID, err := uuid.Parse(message.OrganizationId)
+	if err != nil {
+		return nil
+	}
+
+	canvasID, err := uuid.Parse(message.CanvasId)
+	if err != nil {
+		return nil
+	}
+
+	data := map[string]any{
+		"canvasId": canvasID.String(),
+	}
+
+	canvas, err := models.FindUnscopedCanvas(canvasID)
+	if err == nil {
+		data["canvasName"] = canvas.Name
+	}
+
+	dedupeKey := fmt.Sprintf("canvas/%s/%s/%d", canvasID, eventType, message.Timestamp.AsTime().UnixNano())
+	return enqueueOrganizationWebhooks(orgID, eventType, dedupeKey, data)
+}
+
+func enqueueOrganizationWebhooks(orgID uuid.UUID, eventType, dedupeKey string, data map[string]any) error {
+	subscriptions, err := models.ListEnabledOrganizationWebhookSubscriptions(orgID)
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]any{
+		"type":           eventType,
+		"organizationId": orgID.String(),
+		"timestamp":      time.Now().UTC().Format(time.RFC3339),
+		"data":           data,
+	}
+
+	for _, subscription := range subscriptions {
+		if !subscription.Wants(eventType) {
+			continue
+		}
+
+		err := models.EnqueueOrganizationWebhookDelivery(subscription.ID, eventType, dedupeKey, payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
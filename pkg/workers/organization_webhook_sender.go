@@ -0,0 +1,214 @@
+package workers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"golang.org/x/sync/semaphore"
+	"gorm.io/gorm"
+)
+
+const (
+	// How many deliveries are sent at the same time
+	OrganizationWebhookSenderConcurrency = 10
+
+	// How long we wait for the webhook to respond
+	OrganizationWebhookTimeout = 10 * time.Second
+
+	// Deliveries being sent are not picked up again for this long,
+	// so the delivery of a sender that goes away is retried after it.
+	OrganizationWebhookClaimDuration = time.Minute
+
+	// Failed deliveries are retried with exponential backoff,
+	// starting at the base delay, up to the max attempts.
+	OrganizationWebhookRetryBaseDelay = 30 * time.Second
+	OrganizationWebhookRetryMaxDelay  = time.Hour
+	OrganizationWebhookMaxAttempts    = 8
+
+	// Only part of the response body is recorded in the delivery error
+	maxOrganizationWebhookErrorBodySize = 1024
+)
+
+/*
+ * OrganizationWebhookSender sends the pending organization webhook deliveries.
+ *
+ * Every request is signed with the subscription secret: the X-Superplane-Signature
+ * header is "sha256=" followed by the hex HMAC-SHA256 of the
+ * X-Superplane-Timestamp header value, a ".", and the request body.
+ */
+type OrganizationWebhookSender struct {
+	poller
+
+	encryptor crypto.Encryptor
+	http      core.HTTPContext
+	semaphore *semaphore.Weighted
+	logger    *logrus.Entry
+}
+
+func NewOrganizationWebhookSender(encryptor crypto.Encryptor, http core.HTTPContext) *OrganizationWebhookSender {
+	return &OrganizationWebhookSender{
+		poller:    newPoller(),
+		encryptor: encryptor,
+		http:      http,
+		semaphore: semaphore.NewWeighted(OrganizationWebhookSenderConcurrency),
+		logger:    logrus.WithFields(logrus.Fields{"worker": "OrganizationWebhookSender"}),
+	}
+}
+
+func (w *OrganizationWebhookSender) Start(ctx context.Context) {
+	w.run(ctx, w.tick)
+}
+
+func (w *OrganizationWebhookSender) tick() {
+	deliveries, err := models.ListPendingOrganizationWebhookDeliveries(OrganizationWebhookSenderConcurrency * 10)
+	if err != nil {
+		w.logger.Errorf("Error finding pending organization webhook deliveries: %v", err)
+		return
+	}
+
+	for _, delivery := range deliveries {
+		if !w.semaphore.TryAcquire(1) {
+			return
+		}
+
+		started := w.tracker.Go(func() {
+			defer w.semaphore.Release(1)
+
+			if err := w.ClaimAndSend(delivery); err != nil {
+				w.logger.Errorf("Error sending organization webhook delivery %s: %v", delivery.ID, err)
+			}
+		})
+
+		if !started {
+			w.semaphore.Release(1)
+			return
+		}
+	}
+}
+
+// ClaimAndSend claims the delivery and sends it.
+// No transaction is kept open while waiting for the webhook to respond:
+// the delivery is claimed first, and the result is recorded after.
+func (w *OrganizationWebhookSender) ClaimAndSend(delivery models.OrganizationWebhookDelivery) error {
+	d, err := models.ClaimOrganizationWebhookDelivery(delivery.ID, time.Now().Add(OrganizationWebhookClaimDuration))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			w.logger.Infof("Delivery %s already being sent - skipping", delivery.ID)
+			return nil
+		}
+
+		return err
+	}
+
+	return w.send(d)
+}
+
+func (w *OrganizationWebhookSender) send(delivery *models.OrganizationWebhookDelivery) error {
+	subscription, err := models.FindUnscopedOrganizationWebhookSubscriptionInTransaction(database.Conn(), delivery.SubscriptionID)
+	if err != nil {
+		return err
+	}
+
+	if !subscription.Enabled {
+		return recordFailedDelivery(delivery, nil, "subscription is disabled", nil)
+	}
+
+	secret, err := w.encryptor.Decrypt(context.Background(), subscription.Secret, []byte(subscription.ID.String()))
+	if err != nil {
+		return fmt.Errorf("error decrypting secret: %v", err)
+	}
+
+	body, err := json.Marshal(delivery.Payload.Data())
+	if err != nil {
+		return recordFailedDelivery(delivery, nil, fmt.Sprintf("error encoding payload: %v", err), nil)
+	}
+
+	statusCode, err := w.post(subscription.URL, delivery, secret, body)
+	if err == nil {
+		w.logger.Infof("Delivery %s sent to subscription %s", delivery.ID, subscription.ID)
+		return database.Conn().Transaction(func(tx *gorm.DB) error {
+			return delivery.Delivered(tx, statusCode)
+		})
+	}
+
+	var code *int
+	if statusCode != 0 {
+		code = &statusCode
+	}
+
+	nextAttemptAt := NextOrganizationWebhookAttempt(delivery.Attempts+1, time.Now())
+	w.logger.Warnf("Delivery %s to subscription %s failed (attempt %d): %v", delivery.ID, subscription.ID, delivery.Attempts+1, err)
+	return recordFailedDelivery(delivery, code, err.Error(), nextAttemptAt)
+}
+
+func recordFailedDelivery(delivery *models.OrganizationWebhookDelivery, statusCode *int, message string, nextAttemptAt *time.Time) error {
+	return database.Conn().Transaction(func(tx *gorm.DB) error {
+		return delivery.Failed(tx, statusCode, message, nextAttemptAt)
+	})
+}
+
+func (w *OrganizationWebhookSender) post(url string, delivery *models.OrganizationWebhookDelivery, secret []byte, body []byte) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), OrganizationWebhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Superplane-Webhooks")
+	req.Header.Set("X-Superplane-Event", delivery.EventType)
+	req.Header.Set("X-Superplane-Delivery", delivery.ID.String())
+	req.Header.Set("X-Superplane-Timestamp", timestamp)
+	req.Header.Set("X-Superplane-Signature", SignOrganizationWebhook(secret, timestamp, body))
+
+	res, err := w.http.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		responseBody, _ := io.ReadAll(io.LimitReader(res.Body, maxOrganizationWebhookErrorBodySize))
+		return res.StatusCode, fmt.Errorf("webhook responded with %d: %s", res.StatusCode, string(responseBody))
+	}
+
+	return res.StatusCode, nil
+}
+
+// SignOrganizationWebhook returns the X-Superplane-Signature header value for a request.
+func SignOrganizationWebhook(secret []byte, timestamp string, body []byte) string {
+	data := append([]byte(timestamp+"."), body...)
+	return "sha256=" + crypto.ComputeSignature(secret, data)
+}
+
+// NextOrganizationWebhookAttempt returns when a delivery that failed
+// the given number of attempts should be retried, or nil if it should not.
+func NextOrganizationWebhookAttempt(attempts int, now time.Time) *time.Time {
+	if attempts >= OrganizationWebhookMaxAttempts {
+		return nil
+	}
+
+	delay := OrganizationWebhookRetryBaseDelay << (attempts - 1)
+	if delay > OrganizationWebhookRetryMaxDelay {
+		delay = OrganizationWebhookRetryMaxDelay
+	}
+
+	next := now.Add(delay)
+	return &next
+}
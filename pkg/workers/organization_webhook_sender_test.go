@@ -0,0 +1,247 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/organizations"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func Test__OrganizationWebhookSender(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "trigger-1",
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: "approval-1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "approval"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: "trigger-1", TargetID: "approval-1", Channel: "default"},
+		},
+	)
+
+	type received struct {
+		headers http.Header
+		body    []byte
+	}
+
+	requests := make(chan received, 10)
+	statusCode := atomic.Int32{}
+	statusCode.Store(http.StatusOK)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		requests <- received{headers: req.Header.Clone(), body: body}
+		w.WriteHeader(int(statusCode.Load()))
+	}))
+
+	defer server.Close()
+
+	response, err := organizations.CreateWebhookSubscription(
+		context.Background(),
+		r.Encryptor,
+		r.Organization.ID.String(),
+		server.URL,
+		[]string{models.OrganizationWebhookEventExecutionStarted, models.OrganizationWebhookEventExecutionApprovalRequested},
+	)
+
+	require.NoError(t, err)
+	secret := response.Secret
+	subscription, err := models.FindUnscopedOrganizationWebhookSubscriptionInTransaction(database.Conn(), uuid.MustParse(response.Subscription.Id))
+	require.NoError(t, err)
+
+	sender := NewOrganizationWebhookSender(r.Encryptor, http.DefaultClient)
+
+	t.Run("started approval execution -> signed deliveries for both event types", func(t *testing.T) {
+		event := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "approval-1", event.ID, event.ID, nil)
+		require.NoError(t, database.Conn().Model(execution).Update("state", models.CanvasNodeExecutionStateStarted).Error)
+
+		//
+		// Enqueueing the same state twice does not create duplicates.
+		//
+		require.NoError(t, EnqueueExecutionWebhooks(canvas.ID, execution.ID, ""))
+		require.NoError(t, EnqueueExecutionWebhooks(canvas.ID, execution.ID, ""))
+
+		deliveries, err := models.ListOrganizationWebhookDeliveries(subscription.ID, 10, nil)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 2)
+
+		for _, delivery := range deliveries {
+			require.NoError(t, sender.ClaimAndSend(delivery))
+		}
+
+		for range deliveries {
+			request := <-requests
+			timestamp := request.headers.Get("X-Superplane-Timestamp")
+			assert.Equal(t, SignOrganizationWebhook([]byte(secret), timestamp, request.body), request.headers.Get("X-Superplane-Signature"))
+			assert.NotEmpty(t, request.headers.Get("X-Superplane-Delivery"))
+
+			payload := map[string]any{}
+			require.NoError(t, json.Unmarshal(request.body, &payload))
+			assert.Equal(t, request.headers.Get("X-Superplane-Event"), payload["type"])
+			data := payload["data"].(map[string]any)
+			assert.Equal(t, execution.ID.String(), data["executionId"])
+		}
+
+		deliveries, err = models.ListOrganizationWebhookDeliveries(subscription.ID, 10, nil)
+		require.NoError(t, err)
+		for _, delivery := range deliveries {
+			assert.Equal(t, models.OrganizationWebhookDeliveryStateDelivered, delivery.State)
+			assert.Equal(t, 1, delivery.Attempts)
+		}
+	})
+
+	t.Run("execution finished before its start is consumed -> start is still delivered", func(t *testing.T) {
+		event := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "approval-1", event.ID, event.ID, nil)
+		require.NoError(t, database.Conn().Model(execution).Updates(map[string]any{
+			"state":  models.CanvasNodeExecutionStateFinished,
+			"result": models.CanvasNodeExecutionResultPassed,
+		}).Error)
+
+		require.NoError(t, EnqueueExecutionWebhooks(canvas.ID, execution.ID, models.CanvasNodeExecutionStateStarted))
+
+		deliveries, err := models.ListPendingOrganizationWebhookDeliveries(10)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 2)
+
+		eventTypes := []string{}
+		for _, delivery := range deliveries {
+			eventTypes = append(eventTypes, delivery.EventType)
+			data := delivery.Payload.Data()["data"].(map[string]any)
+			assert.Equal(t, execution.ID.String(), data["executionId"])
+			assert.Equal(t, models.CanvasNodeExecutionStateStarted, data["state"])
+			assert.Empty(t, data["result"])
+
+			require.NoError(t, sender.ClaimAndSend(delivery))
+			<-requests
+		}
+
+		assert.ElementsMatch(t, []string{
+			models.OrganizationWebhookEventExecutionStarted,
+			models.OrganizationWebhookEventExecutionApprovalRequested,
+		}, eventTypes)
+	})
+
+	t.Run("delivery being sent is not claimed again", func(t *testing.T) {
+		event := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "approval-1", event.ID, event.ID, nil)
+		require.NoError(t, database.Conn().Model(execution).Update("state", models.CanvasNodeExecutionStateStarted).Error)
+		require.NoError(t, EnqueueExecutionWebhooks(canvas.ID, execution.ID, ""))
+
+		deliveries, err := models.ListPendingOrganizationWebhookDeliveries(10)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 2)
+
+		claimedUntil := time.Now().Add(OrganizationWebhookClaimDuration)
+		claimed, err := models.ClaimOrganizationWebhookDelivery(deliveries[0].ID, claimedUntil)
+		require.NoError(t, err)
+		assert.Equal(t, deliveries[0].ID, claimed.ID)
+
+		_, err = models.ClaimOrganizationWebhookDelivery(deliveries[0].ID, claimedUntil)
+		require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+		//
+		// The sender skips it too.
+		//
+		require.NoError(t, sender.ClaimAndSend(deliveries[0]))
+		delivery, err := models.FindOrganizationWebhookDelivery(deliveries[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, 0, delivery.Attempts)
+
+		require.NoError(t, sender.ClaimAndSend(deliveries[1]))
+		<-requests
+	})
+
+	t.Run("event types not subscribed to -> no delivery", func(t *testing.T) {
+		event := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "approval-1", event.ID, event.ID, nil)
+		require.NoError(t, database.Conn().Model(execution).Updates(map[string]any{
+			"state":  models.CanvasNodeExecutionStateFinished,
+			"result": models.CanvasNodeExecutionResultPassed,
+		}).Error)
+
+		count, err := models.CountOrganizationWebhookDeliveries(subscription.ID)
+		require.NoError(t, err)
+		require.NoError(t, EnqueueExecutionWebhooks(canvas.ID, execution.ID, ""))
+
+		newCount, err := models.CountOrganizationWebhookDeliveries(subscription.ID)
+		require.NoError(t, err)
+		assert.Equal(t, count, newCount)
+	})
+
+	t.Run("webhook fails -> delivery is retried later", func(t *testing.T) {
+		statusCode.Store(http.StatusInternalServerError)
+		defer statusCode.Store(http.StatusOK)
+
+		event := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "approval-1", event.ID, event.ID, nil)
+		require.NoError(t, database.Conn().Model(execution).Update("state", models.CanvasNodeExecutionStateStarted).Error)
+		require.NoError(t, EnqueueExecutionWebhooks(canvas.ID, execution.ID, ""))
+
+		deliveries, err := models.ListPendingOrganizationWebhookDeliveries(10)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 2)
+
+		require.NoError(t, sender.ClaimAndSend(deliveries[0]))
+		<-requests
+
+		delivery, err := models.FindOrganizationWebhookDelivery(deliveries[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.OrganizationWebhookDeliveryStatePending, delivery.State)
+		assert.Equal(t, 1, delivery.Attempts)
+		require.NotNil(t, delivery.LastStatusCode)
+		assert.Equal(t, http.StatusInternalServerError, *delivery.LastStatusCode)
+		require.NotNil(t, delivery.NextAttemptAt)
+		assert.True(t, delivery.NextAttemptAt.After(time.Now()))
+	})
+}
+
+func Test__NextOrganizationWebhookAttempt(t *testing.T) {
+	now := time.Now()
+
+	next := NextOrganizationWebhookAttempt(1, now)
+	require.NotNil(t, next)
+	assert.Equal(t, now.Add(OrganizationWebhookRetryBaseDelay), *next)
+
+	next = NextOrganizationWebhookAttempt(3, now)
+	require.NotNil(t, next)
+	assert.Equal(t, now.Add(4*OrganizationWebhookRetryBaseDelay), *next)
+
+	next = NextOrganizationWebhookAttempt(OrganizationWebhookMaxAttempts-1, now)
+	require.NotNil(t, next)
+	assert.Equal(t, now.Add(64*OrganizationWebhookRetryBaseDelay), *next)
+
+	assert.Nil(t, NextOrganizationWebhookAttempt(OrganizationWebhookMaxAttempts, now))
+}
+
+func Test__SignOrganizationWebhook(t *testing.T) {
+	// printf '%s' '1700000000.{"type":"canvas.created"}' | openssl dgst -sha256 -hmac "secret"
+	signature := SignOrganizationWebhook([]byte("secret"), "1700000000", []byte(`{"type":"canvas.created"}`))
+	assert.Equal(t, "sha256=be844881b1dcc7e45bc9d4baa3cff9f0cfa0881d6a75f8cb147ff43635a1299e", signature)
+}
@@ -0,0 +1,74 @@
+package workers
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// How often workers poll for work when not woken up by notifications
+	DefaultPollInterval = time.Second
+
+	// How often workers woken up by notifications poll for work,
+	// in case a notification is lost
+	FallbackPollInterval = 30 * time.Second
+)
+
+/*
+ * poller runs a worker tick on an interval,
+ * and right away whenever the worker is woken up.
+ */
+type poller struct {
+	monitored
+
+	interval time.Duration
+	wakeups  <-chan struct{}
+
+	//
+	// Optional. Returns when the next piece of work
+	// is due, if earlier than the poll interval.
+	//
+	nextDue func() *time.Time
+}
+
+func newPoller() poller {
+	return poller{interval: DefaultPollInterval}
+}
+
+// WakeOn makes the worker tick whenever the channel receives a value,
+// and only poll on the slower fallback interval otherwise.
+func (p *poller) WakeOn(wakeups <-chan struct{}) {
+	p.wakeups = wakeups
+	p.interval = FallbackPollInterval
+}
+
+func (p *poller) run(ctx context.Context, tick func()) {
+	timer := time.NewTimer(p.interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		case <-p.wakeups:
+		}
+
+		tick()
+		p.heartbeat.Beat()
+		timer.Reset(p.wait())
+	}
+}
+
+func (p *poller) wait() time.Duration {
+	if p.nextDue == nil {
+		return p.interval
+	}
+
+	next := p.nextDue()
+	if next == nil {
+		return p.interval
+	}
+
+	return max(0, min(time.Until(*next), p.interval))
+}
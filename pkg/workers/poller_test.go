@@ -0,0 +1,64 @@
+package workers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__Poller(t *testing.T) {
+	t.Run("ticks on the poll interval", func(t *testing.T) {
+		p := poller{interval: 10 * time.Millisecond}
+		ticks := make(chan struct{}, 10)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go p.run(ctx, func() { ticks <- struct{}{} })
+
+		for range 3 {
+			select {
+			case <-ticks:
+			case <-time.After(time.Second):
+				require.Fail(t, "expected tick")
+			}
+		}
+	})
+
+	t.Run("ticks right away when woken up", func(t *testing.T) {
+		wakeups := make(chan struct{}, 1)
+		p := newPoller()
+		p.WakeOn(wakeups)
+		assert.Equal(t, FallbackPollInterval, p.interval)
+
+		ticks := make(chan struct{}, 10)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go p.run(ctx, func() { ticks <- struct{}{} })
+
+		wakeups <- struct{}{}
+		select {
+		case <-ticks:
+		case <-time.After(time.Second):
+			require.Fail(t, "expected tick after wakeup")
+		}
+	})
+
+	t.Run("waits until the next piece of work is due", func(t *testing.T) {
+		p := newPoller()
+		p.interval = FallbackPollInterval
+
+		due := time.Now().Add(5 * time.Second)
+		p.nextDue = func() *time.Time { return &due }
+		assert.InDelta(t, 5*time.Second, p.wait(), float64(100*time.Millisecond))
+
+		p.nextDue = func() *time.Time { return nil }
+		assert.Equal(t, FallbackPollInterval, p.wait())
+
+		past := time.Now().Add(-time.Second)
+		p.nextDue = func() *time.Time { return &past }
+		assert.Equal(t, time.Duration(0), p.wait())
+	})
+}
@@ -0,0 +1,203 @@
+package workers
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	"golang.org/x/sync/semaphore"
+)
+
+const (
+	// How many items each worker processes at the same time, by default
+	DefaultConcurrency = 25
+
+	// Share of a worker's concurrency a single organization can use
+	// while other organizations have work waiting
+	MaxOrganizationShare = 0.5
+)
+
+/*
+ * pool limits how many items a worker processes at the same time,
+ * only takes the items for canvases in the worker's shard,
+ * and shares the available slots fairly between organizations.
+ */
+type pool struct {
+	concurrency int
+	semaphore   *semaphore.Weighted
+
+	//
+	// Canvases are spread across shards by consistent hashing on the canvas ID.
+	// Each replica only processes the canvases in the shards it owns.
+	//
+	shards *ShardLeases
+
+	mu       sync.Mutex
+	inFlight map[uuid.UUID]int
+}
+
+func newPool() *pool {
+	return &pool{
+		concurrency: DefaultConcurrency,
+		semaphore:   semaphore.NewWeighted(DefaultConcurrency),
+		inFlight:    map[uuid.UUID]int{},
+	}
+}
+
+// SetConcurrency sets how many items the worker processes at the same time.
+func (p *pool) SetConcurrency(concurrency int) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	p.concurrency = concurrency
+	p.semaphore = semaphore.NewWeighted(int64(concurrency))
+}
+
+// SetShards makes the worker only process canvases in the shards owned by the replica.
+func (p *pool) SetShards(shards *ShardLeases) {
+	p.shards = shards
+}
+
+// Owns returns true if the canvas belongs to a shard owned by the replica.
+func (p *pool) Owns(canvasID uuid.UUID) bool {
+	if p.shards == nil || p.shards.Shards() <= 1 {
+		return true
+	}
+
+	return p.shards.Owns(shardFor(canvasID, p.shards.Shards()))
+}
+
+// dispatch processes the items in the worker's shard in the background,
+// alternating between organizations, and waiting for a free slot when
+// the worker is at its concurrency limit. Items for organizations already
+// over their share are left for the next tick, and so are all
+// the items once the worker starts draining.
+func dispatch[T any](p *pool, tracker *inFlight, logger *log.Entry, items []T, canvasOf func(T) uuid.UUID, process func(T)) {
+	owned := []T{}
+	canvasIDs := []uuid.UUID{}
+	for _, item := range items {
+		if p.Owns(canvasOf(item)) {
+			owned = append(owned, item)
+			canvasIDs = append(canvasIDs, canvasOf(item))
+		}
+	}
+
+	if len(owned) == 0 {
+		return
+	}
+
+	organizations, err := models.FindCanvasOrganizationIDs(canvasIDs)
+	if err != nil {
+		logger.Errorf("Error finding organizations for canvases: %v", err)
+		organizations = map[uuid.UUID]uuid.UUID{}
+	}
+
+	organizationOf := func(item T) uuid.UUID {
+		return organizations[canvasOf(item)]
+	}
+
+	ordered, organizationCount := interleave(owned, organizationOf)
+	limit := p.concurrency
+	if organizationCount > 1 {
+		limit = max(1, int(float64(p.concurrency)*MaxOrganizationShare))
+	}
+
+	for _, item := range ordered {
+		organizationID := organizationOf(item)
+		if !p.reserve(organizationID, limit) {
+			continue
+		}
+
+		if err := p.semaphore.Acquire(context.Background(), 1); err != nil {
+			logger.Errorf("Error acquiring semaphore: %v", err)
+			p.release(organizationID)
+			continue
+		}
+
+		started := tracker.Go(func() {
+			defer p.release(organizationID)
+			defer p.semaphore.Release(1)
+
+			process(item)
+		})
+
+		if !started {
+			p.semaphore.Release(1)
+			p.release(organizationID)
+			return
+		}
+	}
+}
+
+func (p *pool) reserve(organizationID uuid.UUID, limit int) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.inFlight[organizationID] >= limit {
+		return false
+	}
+
+	p.inFlight[organizationID]++
+	return true
+}
+
+func (p *pool) release(organizationID uuid.UUID) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.inFlight[organizationID]--
+	if p.inFlight[organizationID] <= 0 {
+		delete(p.inFlight, organizationID)
+	}
+}
+
+// interleave orders the items round-robin between groups,
+// keeping the original order inside each group.
+// It also returns the number of groups.
+func interleave[T any](items []T, groupOf func(T) uuid.UUID) ([]T, int) {
+	groups := [][]T{}
+	indexes := map[uuid.UUID]int{}
+	for _, item := range items {
+		key := groupOf(item)
+		i, ok := indexes[key]
+		if !ok {
+			i = len(groups)
+			indexes[key] = i
+			groups = append(groups, []T{})
+		}
+
+		groups[i] = append(groups[i], item)
+	}
+
+	ordered := make([]T, 0, len(items))
+	for round := 0; len(ordered) < len(items); round++ {
+		for _, group := range groups {
+			if round < len(group) {
+				ordered = append(ordered, group[round])
+			}
+		}
+	}
+
+	return ordered, len(groups)
+}
+
+// shardFor uses jump consistent hashing, so changing the number
+// of shards only moves the canvases that must move.
+func shardFor(canvasID uuid.UUID, shards int) int {
+	h := fnv.New64a()
+	h.Write(canvasID[:])
+	key := h.Sum64()
+
+	var b, j int64 = -1, 0
+	for j < int64(shards) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+
+	return int(b)
+}
@@ -0,0 +1,78 @@
+package workers
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__Pool(t *testing.T) {
+	t.Run("every canvas is owned by exactly one shard", func(t *testing.T) {
+		shards := []*pool{newPool(), newPool(), newPool()}
+		for i, p := range shards {
+			leases := NewShardLeases("", i, len(shards))
+			leases.setOwned(i, true)
+			p.SetShards(leases)
+		}
+
+		counts := make([]int, len(shards))
+		for range 3000 {
+			canvasID := uuid.New()
+			owners := 0
+			for i, p := range shards {
+				if p.Owns(canvasID) {
+					owners++
+					counts[i]++
+				}
+			}
+
+			require.Equal(t, 1, owners)
+		}
+
+		for _, count := range counts {
+			assert.InDelta(t, 1000, count, 200)
+		}
+	})
+
+	t.Run("adding a shard only moves canvases to the new shard", func(t *testing.T) {
+		for range 1000 {
+			canvasID := uuid.New()
+			before := shardFor(canvasID, 4)
+			after := shardFor(canvasID, 5)
+			if before != after {
+				assert.Equal(t, 4, after)
+			}
+		}
+	})
+
+	t.Run("without sharding, every canvas is owned", func(t *testing.T) {
+		p := newPool()
+		assert.True(t, p.Owns(uuid.New()))
+	})
+
+	t.Run("items are interleaved between organizations", func(t *testing.T) {
+		orgA := uuid.New()
+		orgB := uuid.New()
+
+		items := []uuid.UUID{orgA, orgA, orgA, orgB, orgA, orgB}
+		ordered, groups := interleave(items, func(item uuid.UUID) uuid.UUID { return item })
+
+		assert.Equal(t, 2, groups)
+		assert.Equal(t, []uuid.UUID{orgA, orgB, orgA, orgB, orgA, orgA}, ordered)
+	})
+
+	t.Run("organizations cannot go over the limit", func(t *testing.T) {
+		p := newPool()
+		org := uuid.New()
+
+		assert.True(t, p.reserve(org, 2))
+		assert.True(t, p.reserve(org, 2))
+		assert.False(t, p.reserve(org, 2))
+		assert.True(t, p.reserve(uuid.New(), 2))
+
+		p.release(org)
+		assert.True(t, p.reserve(org, 2))
+	})
+}
@@ -0,0 +1,237 @@
+package workers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// How often shards are claimed, taken over and given back
+	ShardLeaseRefreshInterval = 10 * time.Second
+
+	ShardLeaseMinReconnectDelay = 500 * time.Millisecond
+	ShardLeaseMaxReconnectDelay = 30 * time.Second
+
+	// Namespaces of the advisory locks used for shards.
+	// The second key of each lock is the shard index.
+	shardPresenceLockNamespace  = 0x5350_0001
+	shardOwnershipLockNamespace = 0x5350_0002
+)
+
+// advisoryLocker takes and releases session-level advisory locks.
+type advisoryLocker interface {
+	TryLock(ctx context.Context, namespace, key int) (bool, error)
+	Unlock(ctx context.Context, namespace, key int) error
+}
+
+/*
+ * ShardLeases tracks the shards of canvases processed by this replica.
+ *
+ * Owning a shard means holding its ownership advisory lock,
+ * on a dedicated connection, so the shards of a replica that goes away
+ * are released as soon as its connection is closed.
+ *
+ * Each replica also holds the presence lock of its own shard, telling
+ * the others it is up. Shards without a replica present are taken over
+ * by the other replicas, and given back when the replica returns.
+ */
+type ShardLeases struct {
+	dsn    string
+	shard  int
+	shards int
+	logger *log.Entry
+
+	mu    sync.RWMutex
+	owned map[int]bool
+
+	presenceHeld bool
+}
+
+func NewShardLeases(dsn string, shard, shards int) *ShardLeases {
+	return &ShardLeases{
+		dsn:    dsn,
+		shard:  shard,
+		shards: shards,
+		owned:  map[int]bool{},
+		logger: log.WithFields(log.Fields{"worker": "ShardLeases", "shard": shard}),
+	}
+}
+
+// Shards returns the number of shards canvases are spread across.
+func (l *ShardLeases) Shards() int {
+	return l.shards
+}
+
+// Owns returns true if this replica currently processes the shard.
+func (l *ShardLeases) Owns(shard int) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.owned[shard]
+}
+
+func (l *ShardLeases) Start(ctx context.Context) {
+	delay := ShardLeaseMinReconnectDelay
+
+	for {
+		err := l.hold(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		l.logger.Errorf("Error holding shard leases - reconnecting in %v: %v", delay, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		delay = min(delay*2, ShardLeaseMaxReconnectDelay)
+	}
+}
+
+func (l *ShardLeases) hold(ctx context.Context) error {
+	conn, err := pgx.Connect(ctx, l.dsn)
+	if err != nil {
+		return err
+	}
+
+	//
+	// Locks are released with the connection,
+	// so nothing is owned after it is closed.
+	//
+	defer conn.Close(context.Background())
+	defer l.release()
+
+	locker := &pgxLocker{conn: conn}
+	for {
+		if err := l.refresh(ctx, locker); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(ShardLeaseRefreshInterval):
+		}
+	}
+}
+
+func (l *ShardLeases) refresh(ctx context.Context, locker advisoryLocker) error {
+	if !l.presenceHeld {
+		held, err := locker.TryLock(ctx, shardPresenceLockNamespace, l.shard)
+		if err != nil {
+			return err
+		}
+
+		if !held {
+			l.logger.Warnf("Shard %d is held by another replica", l.shard)
+		}
+
+		l.presenceHeld = held
+	}
+
+	for shard := range l.shards {
+		owned, err := l.refreshShard(ctx, locker, shard)
+		if err != nil {
+			return err
+		}
+
+		l.setOwned(shard, owned)
+	}
+
+	return nil
+}
+
+func (l *ShardLeases) refreshShard(ctx context.Context, locker advisoryLocker, shard int) (bool, error) {
+	owned := l.Owns(shard)
+
+	//
+	// The replica's own shard is only claimed while its presence is held,
+	// so two replicas configured with the same shard do not both claim it.
+	// Claiming it fails while the replica that took it over gives it back.
+	//
+	if shard == l.shard {
+		if owned || !l.presenceHeld {
+			return owned, nil
+		}
+
+		return locker.TryLock(ctx, shardOwnershipLockNamespace, shard)
+	}
+
+	present, err := l.isPresent(ctx, locker, shard)
+	if err != nil {
+		return owned, err
+	}
+
+	switch {
+	case owned && present:
+		l.logger.Infof("Replica of shard %d is back - giving the shard back", shard)
+		return false, locker.Unlock(ctx, shardOwnershipLockNamespace, shard)
+
+	case !owned && !present:
+		taken, err := locker.TryLock(ctx, shardOwnershipLockNamespace, shard)
+		if taken {
+			l.logger.Infof("Replica of shard %d is down - taking the shard over", shard)
+		}
+
+		return taken, err
+
+	default:
+		return owned, nil
+	}
+}
+
+// isPresent returns true if the replica of the shard holds its presence lock.
+func (l *ShardLeases) isPresent(ctx context.Context, locker advisoryLocker, shard int) (bool, error) {
+	locked, err := locker.TryLock(ctx, shardPresenceLockNamespace, shard)
+	if err != nil {
+		return false, err
+	}
+
+	if !locked {
+		return true, nil
+	}
+
+	return false, locker.Unlock(ctx, shardPresenceLockNamespace, shard)
+}
+
+func (l *ShardLeases) setOwned(shard int, owned bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if owned {
+		l.owned[shard] = true
+		return
+	}
+
+	delete(l.owned, shard)
+}
+
+func (l *ShardLeases) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.owned = map[int]bool{}
+	l.presenceHeld = false
+}
+
+type pgxLocker struct {
+	conn *pgx.Conn
+}
+
+func (p *pgxLocker) TryLock(ctx context.Context, namespace, key int) (bool, error) {
+	var locked bool
+	err := p.conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1::int, $2::int)", namespace, key).Scan(&locked)
+	return locked, err
+}
+
+func (p *pgxLocker) Unlock(ctx context.Context, namespace, key int) error {
+	_, err := p.conn.Exec(ctx, "SELECT pg_advisory_unlock($1::int, $2::int)", namespace, key)
+	return err
+}
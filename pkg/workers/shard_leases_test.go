@@ -0,0 +1,137 @@
+package workers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__ShardLeases(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("each replica owns its own shard", func(t *testing.T) {
+		locks := newFakeLocks()
+		replicas := []*ShardLeases{NewShardLeases("", 0, 2), NewShardLeases("", 1, 2)}
+		refreshAll(t, locks, replicas)
+
+		assert.True(t, replicas[0].Owns(0))
+		assert.False(t, replicas[0].Owns(1))
+		assert.True(t, replicas[1].Owns(1))
+		assert.False(t, replicas[1].Owns(0))
+	})
+
+	t.Run("shard of a replica that is down is taken over and given back", func(t *testing.T) {
+		locks := newFakeLocks()
+		replica0 := NewShardLeases("", 0, 2)
+		replica1 := NewShardLeases("", 1, 2)
+		refreshAll(t, locks, []*ShardLeases{replica0, replica1})
+
+		//
+		// Replica 1 goes away, releasing its locks.
+		//
+		locks.closeSession(replica1)
+		replica1.release()
+
+		require.NoError(t, replica0.refresh(ctx, locks.session(replica0)))
+		assert.True(t, replica0.Owns(0))
+		assert.True(t, replica0.Owns(1))
+
+		//
+		// Replica 1 comes back. It can only claim its shard
+		// after replica 0 sees it is back and gives the shard back.
+		//
+		require.NoError(t, replica1.refresh(ctx, locks.session(replica1)))
+		assert.False(t, replica1.Owns(1))
+
+		require.NoError(t, replica0.refresh(ctx, locks.session(replica0)))
+		assert.False(t, replica0.Owns(1))
+
+		require.NoError(t, replica1.refresh(ctx, locks.session(replica1)))
+		assert.True(t, replica1.Owns(1))
+	})
+
+	t.Run("replicas configured with the same shard do not both own it", func(t *testing.T) {
+		locks := newFakeLocks()
+		replicas := []*ShardLeases{NewShardLeases("", 0, 2), NewShardLeases("", 0, 2)}
+		refreshAll(t, locks, replicas)
+
+		assert.True(t, replicas[0].Owns(0))
+		assert.False(t, replicas[1].Owns(0))
+	})
+
+	t.Run("shards without a replica are owned by exactly one replica", func(t *testing.T) {
+		locks := newFakeLocks()
+		replicas := []*ShardLeases{NewShardLeases("", 0, 3), NewShardLeases("", 1, 3)}
+		refreshAll(t, locks, replicas)
+
+		owners := 0
+		for _, replica := range replicas {
+			if replica.Owns(2) {
+				owners++
+			}
+		}
+
+		assert.Equal(t, 1, owners)
+	})
+}
+
+func refreshAll(t *testing.T, locks *fakeLocks, replicas []*ShardLeases) {
+	for range 2 {
+		for _, replica := range replicas {
+			require.NoError(t, replica.refresh(context.Background(), locks.session(replica)))
+		}
+	}
+}
+
+type lockKey struct {
+	namespace int
+	key       int
+}
+
+// fakeLocks holds advisory locks for multiple sessions,
+// each replica using its own session.
+type fakeLocks struct {
+	holders map[lockKey]*ShardLeases
+}
+
+func newFakeLocks() *fakeLocks {
+	return &fakeLocks{holders: map[lockKey]*ShardLeases{}}
+}
+
+func (f *fakeLocks) session(owner *ShardLeases) advisoryLocker {
+	return &fakeSession{locks: f, owner: owner}
+}
+
+func (f *fakeLocks) closeSession(owner *ShardLeases) {
+	for key, holder := range f.holders {
+		if holder == owner {
+			delete(f.holders, key)
+		}
+	}
+}
+
+type fakeSession struct {
+	locks *fakeLocks
+	owner *ShardLeases
+}
+
+func (s *fakeSession) TryLock(_ context.Context, namespace, key int) (bool, error) {
+	k := lockKey{namespace: namespace, key: key}
+	if holder, ok := s.locks.holders[k]; ok && holder != s.owner {
+		return false, nil
+	}
+
+	s.locks.holders[k] = s.owner
+	return true, nil
+}
+
+func (s *fakeSession) Unlock(_ context.Context, namespace, key int) error {
+	k := lockKey{namespace: namespace, key: key}
+	if s.locks.holders[k] == s.owner {
+		delete(s.locks.holders, k)
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/gorm"
+)
+
+const StuckWorkReconcilerInterval = time.Minute
+
+/*
+ * StuckWorkReconciler finds work that has not moved for longer
+ * than the thresholds. Nodes left in processing are moved back to ready,
+ * and the queue worker is woken up again for queue items not being picked up.
+ * Anything else is not safe to repair automatically, so the state reason
+ * of the node is updated to explain what is stuck.
+ */
+type StuckWorkReconciler struct {
+	monitored
+
+	thresholds        models.StuckWorkThresholds
+	waitingComponents []string
+	logger            *log.Entry
+}
+
+func NewStuckWorkReconciler(thresholds models.StuckWorkThresholds, waitingComponents []string) *StuckWorkReconciler {
+	return &StuckWorkReconciler{
+		thresholds:        thresholds,
+		waitingComponents: waitingComponents,
+		logger:            log.WithFields(log.Fields{"worker": "StuckWorkReconciler"}),
+	}
+}
+
+func (w *StuckWorkReconciler) Start(ctx context.Context) {
+	ticker := time.NewTicker(StuckWorkReconcilerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.heartbeat.Beat()
+			if err := w.Tick(time.Now()); err != nil {
+				w.logger.Errorf("Error reconciling stuck work: %v", err)
+			}
+		}
+	}
+}
+
+func (w *StuckWorkReconciler) Tick(now time.Time) error {
+	stuck, err := models.FindStuckWork(nil, w.thresholds, w.waitingComponents, now)
+	if err != nil {
+		return err
+	}
+
+	reasons := map[nodeKey]string{}
+	for _, work := range stuck {
+		repaired, err := w.repair(work)
+		if err != nil {
+			w.logger.Errorf("Error repairing %s for node %s in canvas %s: %v", work.Kind, work.NodeID, work.WorkflowID, err)
+		}
+
+		if repaired {
+			w.logger.Infof("Repaired %s for node %s in canvas %s", work.Kind, work.NodeID, work.WorkflowID)
+			continue
+		}
+
+		key := nodeKey{canvasID: work.WorkflowID.String(), nodeID: work.NodeID}
+		if _, ok := reasons[key]; !ok {
+			reasons[key] = work.Reason(now)
+		}
+	}
+
+	return w.updateStateReasons(reasons)
+}
+
+type nodeKey struct {
+	canvasID string
+	nodeID   string
+}
+
+func (w *StuckWorkReconciler) repair(work models.StuckWork) (bool, error) {
+	switch work.Kind {
+	case models.StuckWorkKindNodeProcessing:
+		return w.resumeNode(work)
+
+	case models.StuckWorkKindQueueItems:
+		return true, models.NotifyWorkers(models.NotificationChannelReadyNodes)
+
+	default:
+		return false, nil
+	}
+}
+
+// resumeNode moves the node back to the state it would be in
+// if it was not processing anything.
+func (w *StuckWorkReconciler) resumeNode(work models.StuckWork) (bool, error) {
+	repaired := false
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		node, err := models.LockCanvasNodeForUpdate(tx, work.WorkflowID, work.NodeID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+
+			return err
+		}
+
+		//
+		// The node may have been updated since it was found.
+		//
+		if node.State != models.CanvasNodeStateProcessing {
+			repaired = true
+			return nil
+		}
+
+		state, err := models.ResumeStateForNodeInTransaction(tx, node.WorkflowID, node.NodeID)
+		if err != nil {
+			return err
+		}
+
+		if state == models.CanvasNodeStateProcessing {
+			return nil
+		}
+
+		repaired = true
+		return node.UpdateState(tx, state)
+	})
+
+	return repaired, err
+}
+
+// updateStateReasons sets the reasons for the nodes with stuck work,
+// and clears the ones set before for nodes that are not stuck anymore.
+func (w *StuckWorkReconciler) updateStateReasons(reasons map[nodeKey]string) error {
+	nodes, err := models.ListCanvasNodesWithStateReason()
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		key := nodeKey{canvasID: node.WorkflowID.String(), nodeID: node.NodeID}
+		if _, ok := reasons[key]; ok {
+			continue
+		}
+
+		if err := node.UpdateStateReason(database.Conn(), nil); err != nil {
+			return err
+		}
+	}
+
+	for key, reason := range reasons {
+		err := database.Conn().
+			Model(&models.CanvasNode{}).
+			Where("workflow_id = ?", key.canvasID).
+			Where("node_id = ?", key.nodeID).
+			Where("state <> ?", models.CanvasNodeStateError).
+			UpdateColumn("state_reason", reason).
+			Error
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__StuckWorkReconciler(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	waitingComponents := r.Registry.ListComponentsWaitingForUsers()
+	require.Contains(t, waitingComponents, models.ApprovalComponentName)
+
+	worker := NewStuckWorkReconciler(models.DefaultStuckWorkThresholds, waitingComponents)
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+			{
+				NodeID: "node-2",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+			{
+				NodeID: "approval-1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: models.ApprovalComponentName}}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	t.Run("nothing is stuck -> nothing is found", func(t *testing.T) {
+		stuck, err := models.FindStuckWork(&r.Organization.ID, models.DefaultStuckWorkThresholds, waitingComponents, time.Now())
+		require.NoError(t, err)
+		assert.Empty(t, stuck)
+		require.NoError(t, worker.Tick(time.Now()))
+	})
+
+	t.Run("processing node without executions -> moved back to ready", func(t *testing.T) {
+		node, err := models.FindCanvasNode(database.Conn(), canvas.ID, "node-1")
+		require.NoError(t, err)
+		require.NoError(t, node.UpdateState(database.Conn(), models.CanvasNodeStateProcessing))
+
+		now := time.Now().Add(time.Hour)
+		stuck, err := models.FindStuckWork(&r.Organization.ID, models.DefaultStuckWorkThresholds, waitingComponents, now)
+		require.NoError(t, err)
+		require.Len(t, stuck, 1)
+		assert.Equal(t, models.StuckWorkKindNodeProcessing, stuck[0].Kind)
+		assert.Equal(t, "node-1", stuck[0].NodeID)
+
+		require.NoError(t, worker.Tick(now))
+		node, err = models.FindCanvasNode(database.Conn(), canvas.ID, "node-1")
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeStateReady, node.State)
+		assert.Nil(t, node.StateReason)
+	})
+
+	t.Run("stuck work in other organizations is not listed", func(t *testing.T) {
+		node, err := models.FindCanvasNode(database.Conn(), canvas.ID, "node-1")
+		require.NoError(t, err)
+		require.NoError(t, node.UpdateState(database.Conn(), models.CanvasNodeStateProcessing))
+
+		otherOrg := uuid.New()
+		stuck, err := models.FindStuckWork(&otherOrg, models.DefaultStuckWorkThresholds, waitingComponents, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Empty(t, stuck)
+
+		require.NoError(t, node.UpdateState(database.Conn(), models.CanvasNodeStateReady))
+	})
+
+	t.Run("started execution with nothing scheduled -> marked, and cleared once finished", func(t *testing.T) {
+		event := support.EmitCanvasEventForNode(t, canvas.ID, "node-2", "default", nil)
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-2", event.ID, event.ID, nil)
+		require.NoError(t, database.Conn().Model(execution).Update("state", models.CanvasNodeExecutionStateStarted).Error)
+
+		now := time.Now().Add(48 * time.Hour)
+		stuck, err := models.FindStuckWork(&r.Organization.ID, models.DefaultStuckWorkThresholds, waitingComponents, now)
+		require.NoError(t, err)
+		require.Len(t, stuck, 1)
+		assert.Equal(t, models.StuckWorkKindExecutionStarted, stuck[0].Kind)
+		require.NotNil(t, stuck[0].ResourceID)
+		assert.Equal(t, execution.ID, *stuck[0].ResourceID)
+
+		require.NoError(t, worker.Tick(now))
+		node, err := models.FindCanvasNode(database.Conn(), canvas.ID, "node-2")
+		require.NoError(t, err)
+		require.NotNil(t, node.StateReason)
+		assert.Contains(t, *node.StateReason, execution.ID.String())
+
+		require.NoError(t, database.Conn().Model(execution).Update("state", models.CanvasNodeExecutionStateFinished).Error)
+		require.NoError(t, worker.Tick(now))
+		node, err = models.FindCanvasNode(database.Conn(), canvas.ID, "node-2")
+		require.NoError(t, err)
+		assert.Nil(t, node.StateReason)
+	})
+
+	t.Run("started executions waiting for users or external events -> not found", func(t *testing.T) {
+		event := support.EmitCanvasEventForNode(t, canvas.ID, "approval-1", "default", nil)
+		approval := support.CreateCanvasNodeExecution(t, canvas.ID, "approval-1", event.ID, event.ID, nil)
+		require.NoError(t, database.Conn().Model(approval).Update("state", models.CanvasNodeExecutionStateStarted).Error)
+
+		event = support.EmitCanvasEventForNode(t, canvas.ID, "node-2", "default", nil)
+		waiting := support.CreateCanvasNodeExecution(t, canvas.ID, "node-2", event.ID, event.ID, nil)
+		require.NoError(t, database.Conn().Model(waiting).Update("state", models.CanvasNodeExecutionStateStarted).Error)
+		require.NoError(t, models.CreateNodeExecutionKVInTransaction(database.Conn(), canvas.ID, "node-2", waiting.ID, "workflow", "123"))
+
+		stuck, err := models.FindStuckWork(&r.Organization.ID, models.DefaultStuckWorkThresholds, waitingComponents, time.Now().Add(48*time.Hour))
+		require.NoError(t, err)
+		assert.Empty(t, stuck)
+
+		require.NoError(t, database.Conn().Model(approval).Update("state", models.CanvasNodeExecutionStateFinished).Error)
+		require.NoError(t, database.Conn().Model(waiting).Update("state", models.CanvasNodeExecutionStateFinished).Error)
+	})
+
+	t.Run("old queue items on ready node -> found", func(t *testing.T) {
+		event := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+		support.CreateQueueItem(t, canvas.ID, "node-1", event.ID, event.ID)
+
+		stuck, err := models.FindStuckWork(&r.Organization.ID, models.DefaultStuckWorkThresholds, waitingComponents, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		require.Len(t, stuck, 1)
+		assert.Equal(t, models.StuckWorkKindQueueItems, stuck[0].Kind)
+		assert.Equal(t, int64(1), stuck[0].Count)
+	})
+}
@@ -18,6 +18,8 @@ import (
 )
 
 type WebhookCleanupWorker struct {
+	monitored
+
 	semaphore *semaphore.Weighted
 	registry  *registry.Registry
 	encryptor crypto.Encryptor
@@ -42,6 +44,7 @@ func (w *WebhookCleanupWorker) Start(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
+			w.heartbeat.Beat()
 			webhooks, err := models.ListDeletedWebhooks()
 			if err != nil {
 				w.log("Error finding workflow nodes ready to be processed: %v", err)
@@ -53,13 +56,18 @@ func (w *WebhookCleanupWorker) Start(ctx context.Context) {
 					continue
 				}
 
-				go func(webhook models.Webhook) {
+				started := w.tracker.Go(func() {
 					defer w.semaphore.Release(1)
 
 					if err := w.LockAndProcessWebhook(webhook); err != nil {
 						w.log("Error processing webhook %s: %v", webhook.ID, err)
 					}
-				}(webhook)
+				})
+
+				if !started {
+					w.semaphore.Release(1)
+					break
+				}
 			}
 		}
 	}
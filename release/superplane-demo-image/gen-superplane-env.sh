@@ -49,6 +49,8 @@ START_INTEGRATION_REQUEST_WORKER="${START_INTEGRATION_REQUEST_WORKER:-yes}"
 START_WEBHOOK_PROVISIONER="${START_WEBHOOK_PROVISIONER:-yes}"
 START_WEBHOOK_CLEANUP_WORKER="${START_WEBHOOK_CLEANUP_WORKER:-yes}"
 START_CANVAS_CLEANUP_WORKER="${START_CANVAS_CLEANUP_WORKER:-yes}"
+START_ORGANIZATION_WEBHOOK_WORKER="${START_ORGANIZATION_WEBHOOK_WORKER:-yes}"
+START_STUCK_WORK_RECONCILER="${START_STUCK_WORK_RECONCILER:-yes}"
 NO_ENCRYPTION="${NO_ENCRYPTION:-yes}"
 SUPERPLANE_BEACON_ENABLED="${SUPERPLANE_BEACON_ENABLED:-yes}"
 SUPERPLANE_INSTALLATION_TYPE="${SUPERPLANE_INSTALLATION_TYPE:-demo}"
@@ -138,6 +140,8 @@ export START_INTEGRATION_REQUEST_WORKER="${START_INTEGRATION_REQUEST_WORKER}"
 export START_WEBHOOK_PROVISIONER="${START_WEBHOOK_PROVISIONER}"
 export START_WEBHOOK_CLEANUP_WORKER="${START_WEBHOOK_CLEANUP_WORKER}"
 export START_CANVAS_CLEANUP_WORKER="${START_CANVAS_CLEANUP_WORKER}"
+export START_ORGANIZATION_WEBHOOK_WORKER="${START_ORGANIZATION_WEBHOOK_WORKER}"
+export START_STUCK_WORK_RECONCILER="${START_STUCK_WORK_RECONCILER}"
 export ENCRYPTION_KEY="${ENCRYPTION_KEY}"
 export JWT_SECRET="${JWT_SECRET}"
 export OIDC_KEYS_PATH="${OIDC_KEYS_PATH}"
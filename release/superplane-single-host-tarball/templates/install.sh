@@ -127,6 +127,8 @@ START_WEBHOOK_PROVISIONER=yes
 START_WEBHOOK_CLEANUP_WORKER=yes
 START_INTEGRATION_CLEANUP_WORKER=yes
 START_CANVAS_CLEANUP_WORKER=yes
+START_ORGANIZATION_WEBHOOK_WORKER=yes
+START_STUCK_WORK_RECONCILER=yes
 
 SENTRY_DSN=
 SENTRY_ENVIRONMENT=single-host
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/sdk/sdkgen"
+
+	// Import server to auto-register all integrations, components, and triggers via init()
+	_ "github.com/superplanehq/superplane/pkg/server"
+)
+
+const output = "pkg/sdk/configurations_generated.go"
+
+func main() {
+	reg, err := registry.NewRegistry(crypto.NewNoOpEncryptor(), registry.HTTPOptions{})
+	if err != nil {
+		exitWithError(err)
+	}
+
+	source, err := sdkgen.Generate("sdk", sdkgen.DefinitionsFromRegistry(reg))
+	if err != nil {
+		exitWithError(err)
+	}
+
+	if err := os.WriteFile(output, source, 0o644); err != nil {
+		exitWithError(err)
+	}
+}
+
+func exitWithError(err error) {
+	fmt.Fprintf(os.Stderr, "error: %v\n", err)
+	os.Exit(1)
+}
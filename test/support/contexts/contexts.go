@@ -314,3 +314,14 @@ func (c *HTTPContext) Do(request *http.Request) (*http.Response, error) {
 	c.Responses = c.Responses[1:]
 	return response, nil
 }
+
+type OIDCContext struct {
+	Audiences []string
+	Durations []time.Duration
+}
+
+func (c *OIDCContext) Token(audience string, duration time.Duration) (string, error) {
+	c.Audiences = append(c.Audiences, audience)
+	c.Durations = append(c.Durations, duration)
+	return "oidc-token", nil
+}
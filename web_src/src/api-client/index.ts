@@ -11,7 +11,10 @@ export {
   canvasesDeleteCanvas,
   canvasesDeleteNodeQueueItem,
   canvasesDescribeCanvas,
+  canvasesDescribeExecution,
   canvasesEmitNodeEvent,
+  canvasesGetCanvasMetrics,
+  canvasesGetExecutionLogs,
   canvasesInvokeNodeExecutionAction,
   canvasesInvokeNodeTriggerAction,
   canvasesListCanvases,
@@ -21,9 +24,12 @@ export {
   canvasesListNodeEvents,
   canvasesListNodeExecutions,
   canvasesListNodeQueueItems,
+  canvasesListWebhookDeliveries,
+  canvasesReplayWebhookDelivery,
   canvasesResolveExecutionErrors,
   canvasesUpdateCanvas,
   canvasesUpdateNodePause,
+  canvasesWatchCanvas,
   componentsDescribeComponent,
   componentsListComponentActions,
   componentsListComponents,
@@ -42,20 +48,28 @@ export {
   organizationsAcceptInviteLink,
   organizationsCreateIntegration,
   organizationsCreateInvitation,
+  organizationsCreateWebhookSubscription,
   organizationsDeleteIntegration,
   organizationsDeleteOrganization,
+  organizationsDeleteWebhookSubscription,
   organizationsDescribeIntegration,
   organizationsDescribeOrganization,
+  organizationsExportOrganization,
   organizationsGetInviteLink,
+  organizationsImportOrganization,
   organizationsListIntegrationResources,
   organizationsListIntegrations,
   organizationsListInvitations,
+  organizationsListStuckWork,
+  organizationsListWebhookSubscriptionDeliveries,
+  organizationsListWebhookSubscriptions,
   organizationsRemoveInvitation,
   organizationsRemoveUser,
   organizationsResetInviteLink,
   organizationsUpdateIntegration,
   organizationsUpdateInviteLink,
   organizationsUpdateOrganization,
+  organizationsUpdateWebhookSubscription,
   rolesAssignRole,
   rolesCreateRole,
   rolesDeleteRole,
@@ -154,6 +168,13 @@ export type {
   CanvasesDescribeCanvasResponse,
   CanvasesDescribeCanvasResponse2,
   CanvasesDescribeCanvasResponses,
+  CanvasesDescribeExecutionData,
+  CanvasesDescribeExecutionError,
+  CanvasesDescribeExecutionErrors,
+  CanvasesDescribeExecutionResponse,
+  CanvasesDescribeExecutionResponse2,
+  CanvasesDescribeExecutionResponses,
+  CanvasesDurationStats,
   CanvasesEmitNodeEventBody,
   CanvasesEmitNodeEventData,
   CanvasesEmitNodeEventError,
@@ -161,6 +182,20 @@ export type {
   CanvasesEmitNodeEventResponse,
   CanvasesEmitNodeEventResponse2,
   CanvasesEmitNodeEventResponses,
+  CanvasesExecutionLogLine,
+  CanvasesExecutionMetrics,
+  CanvasesGetCanvasMetricsData,
+  CanvasesGetCanvasMetricsError,
+  CanvasesGetCanvasMetricsErrors,
+  CanvasesGetCanvasMetricsResponse,
+  CanvasesGetCanvasMetricsResponse2,
+  CanvasesGetCanvasMetricsResponses,
+  CanvasesGetExecutionLogsData,
+  CanvasesGetExecutionLogsError,
+  CanvasesGetExecutionLogsErrors,
+  CanvasesGetExecutionLogsResponse,
+  CanvasesGetExecutionLogsResponse2,
+  CanvasesGetExecutionLogsResponses,
   CanvasesInvokeNodeExecutionActionBody,
   CanvasesInvokeNodeExecutionActionData,
   CanvasesInvokeNodeExecutionActionError,
@@ -218,6 +253,19 @@ export type {
   CanvasesListNodeQueueItemsResponse,
   CanvasesListNodeQueueItemsResponse2,
   CanvasesListNodeQueueItemsResponses,
+  CanvasesListWebhookDeliveriesData,
+  CanvasesListWebhookDeliveriesError,
+  CanvasesListWebhookDeliveriesErrors,
+  CanvasesListWebhookDeliveriesResponse,
+  CanvasesListWebhookDeliveriesResponse2,
+  CanvasesListWebhookDeliveriesResponses,
+  CanvasesReplayWebhookDeliveryBody,
+  CanvasesReplayWebhookDeliveryData,
+  CanvasesReplayWebhookDeliveryError,
+  CanvasesReplayWebhookDeliveryErrors,
+  CanvasesReplayWebhookDeliveryResponse,
+  CanvasesReplayWebhookDeliveryResponse2,
+  CanvasesReplayWebhookDeliveryResponses,
   CanvasesResolveExecutionErrorsBody,
   CanvasesResolveExecutionErrorsData,
   CanvasesResolveExecutionErrorsError,
@@ -239,6 +287,14 @@ export type {
   CanvasesUpdateNodePauseResponse,
   CanvasesUpdateNodePauseResponse2,
   CanvasesUpdateNodePauseResponses,
+  CanvasesWatchCanvasData,
+  CanvasesWatchCanvasError,
+  CanvasesWatchCanvasErrors,
+  CanvasesWatchCanvasResponse,
+  CanvasesWatchCanvasResponse2,
+  CanvasesWatchCanvasResponses,
+  CanvasesWatchCanvasResponseType,
+  CanvasesWebhookDelivery,
   CanvasNodeExecutionResult,
   CanvasNodeExecutionResultReason,
   CanvasNodeExecutionState,
@@ -290,6 +346,8 @@ export type {
   ConfigurationTypeOptions,
   ConfigurationValidationRule,
   ConfigurationVisibilityCondition,
+  ExecutionLogLineLevel,
+  ExecutionMetricsResultCount,
   GooglerpcStatus,
   GroupsAddUserToGroupBody,
   GroupsAddUserToGroupData,
@@ -389,6 +447,13 @@ export type {
   OrganizationsCreateInvitationResponse,
   OrganizationsCreateInvitationResponse2,
   OrganizationsCreateInvitationResponses,
+  OrganizationsCreateWebhookSubscriptionBody,
+  OrganizationsCreateWebhookSubscriptionData,
+  OrganizationsCreateWebhookSubscriptionError,
+  OrganizationsCreateWebhookSubscriptionErrors,
+  OrganizationsCreateWebhookSubscriptionResponse,
+  OrganizationsCreateWebhookSubscriptionResponse2,
+  OrganizationsCreateWebhookSubscriptionResponses,
   OrganizationsDeleteIntegrationData,
   OrganizationsDeleteIntegrationError,
   OrganizationsDeleteIntegrationErrors,
@@ -401,6 +466,12 @@ export type {
   OrganizationsDeleteOrganizationResponse,
   OrganizationsDeleteOrganizationResponse2,
   OrganizationsDeleteOrganizationResponses,
+  OrganizationsDeleteWebhookSubscriptionData,
+  OrganizationsDeleteWebhookSubscriptionError,
+  OrganizationsDeleteWebhookSubscriptionErrors,
+  OrganizationsDeleteWebhookSubscriptionResponse,
+  OrganizationsDeleteWebhookSubscriptionResponse2,
+  OrganizationsDeleteWebhookSubscriptionResponses,
   OrganizationsDescribeIntegrationData,
   OrganizationsDescribeIntegrationError,
   OrganizationsDescribeIntegrationErrors,
@@ -413,12 +484,28 @@ export type {
   OrganizationsDescribeOrganizationResponse,
   OrganizationsDescribeOrganizationResponse2,
   OrganizationsDescribeOrganizationResponses,
+  OrganizationsExportOrganizationBody,
+  OrganizationsExportOrganizationData,
+  OrganizationsExportOrganizationError,
+  OrganizationsExportOrganizationErrors,
+  OrganizationsExportOrganizationResponse,
+  OrganizationsExportOrganizationResponse2,
+  OrganizationsExportOrganizationResponses,
   OrganizationsGetInviteLinkData,
   OrganizationsGetInviteLinkError,
   OrganizationsGetInviteLinkErrors,
   OrganizationsGetInviteLinkResponse,
   OrganizationsGetInviteLinkResponse2,
   OrganizationsGetInviteLinkResponses,
+  OrganizationsImportedResource,
+  OrganizationsImportedResourceKind,
+  OrganizationsImportOrganizationBody,
+  OrganizationsImportOrganizationData,
+  OrganizationsImportOrganizationError,
+  OrganizationsImportOrganizationErrors,
+  OrganizationsImportOrganizationResponse,
+  OrganizationsImportOrganizationResponse2,
+  OrganizationsImportOrganizationResponses,
   OrganizationsIntegration,
   OrganizationsIntegrationMetadata,
   OrganizationsIntegrationResourceRef,
@@ -443,6 +530,24 @@ export type {
   OrganizationsListInvitationsResponse,
   OrganizationsListInvitationsResponse2,
   OrganizationsListInvitationsResponses,
+  OrganizationsListStuckWorkData,
+  OrganizationsListStuckWorkError,
+  OrganizationsListStuckWorkErrors,
+  OrganizationsListStuckWorkResponse,
+  OrganizationsListStuckWorkResponse2,
+  OrganizationsListStuckWorkResponses,
+  OrganizationsListWebhookSubscriptionDeliveriesData,
+  OrganizationsListWebhookSubscriptionDeliveriesError,
+  OrganizationsListWebhookSubscriptionDeliveriesErrors,
+  OrganizationsListWebhookSubscriptionDeliveriesResponse,
+  OrganizationsListWebhookSubscriptionDeliveriesResponse2,
+  OrganizationsListWebhookSubscriptionDeliveriesResponses,
+  OrganizationsListWebhookSubscriptionsData,
+  OrganizationsListWebhookSubscriptionsError,
+  OrganizationsListWebhookSubscriptionsErrors,
+  OrganizationsListWebhookSubscriptionsResponse,
+  OrganizationsListWebhookSubscriptionsResponse2,
+  OrganizationsListWebhookSubscriptionsResponses,
   OrganizationsOrganization,
   OrganizationsOrganizationMetadata,
   OrganizationsRemoveInvitationData,
@@ -463,6 +568,9 @@ export type {
   OrganizationsResetInviteLinkResponse,
   OrganizationsResetInviteLinkResponse2,
   OrganizationsResetInviteLinkResponses,
+  OrganizationsSecretValues,
+  OrganizationsStuckWork,
+  OrganizationsStuckWorkKind,
   OrganizationsUpdateIntegrationBody,
   OrganizationsUpdateIntegrationData,
   OrganizationsUpdateIntegrationError,
@@ -484,6 +592,15 @@ export type {
   OrganizationsUpdateOrganizationResponse,
   OrganizationsUpdateOrganizationResponse2,
   OrganizationsUpdateOrganizationResponses,
+  OrganizationsUpdateWebhookSubscriptionBody,
+  OrganizationsUpdateWebhookSubscriptionData,
+  OrganizationsUpdateWebhookSubscriptionError,
+  OrganizationsUpdateWebhookSubscriptionErrors,
+  OrganizationsUpdateWebhookSubscriptionResponse,
+  OrganizationsUpdateWebhookSubscriptionResponse2,
+  OrganizationsUpdateWebhookSubscriptionResponses,
+  OrganizationsWebhookSubscription,
+  OrganizationsWebhookSubscriptionDelivery,
   ProtobufAny,
   ProtobufNullValue,
   RolesAssignRoleBody,
@@ -629,6 +746,7 @@ export type {
   UsersUserRoleAssignment,
   UsersUserSpec,
   UsersUserStatus,
+  WebhookDeliveryRoutedNode,
   WidgetsDescribeWidgetData,
   WidgetsDescribeWidgetError,
   WidgetsDescribeWidgetErrors,
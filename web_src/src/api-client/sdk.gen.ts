@@ -33,9 +33,18 @@ import type {
   CanvasesDescribeCanvasData,
   CanvasesDescribeCanvasErrors,
   CanvasesDescribeCanvasResponses,
+  CanvasesDescribeExecutionData,
+  CanvasesDescribeExecutionErrors,
+  CanvasesDescribeExecutionResponses,
   CanvasesEmitNodeEventData,
   CanvasesEmitNodeEventErrors,
   CanvasesEmitNodeEventResponses,
+  CanvasesGetCanvasMetricsData,
+  CanvasesGetCanvasMetricsErrors,
+  CanvasesGetCanvasMetricsResponses,
+  CanvasesGetExecutionLogsData,
+  CanvasesGetExecutionLogsErrors,
+  CanvasesGetExecutionLogsResponses,
   CanvasesInvokeNodeExecutionActionData,
   CanvasesInvokeNodeExecutionActionErrors,
   CanvasesInvokeNodeExecutionActionResponses,
@@ -63,6 +72,12 @@ import type {
   CanvasesListNodeQueueItemsData,
   CanvasesListNodeQueueItemsErrors,
   CanvasesListNodeQueueItemsResponses,
+  CanvasesListWebhookDeliveriesData,
+  CanvasesListWebhookDeliveriesErrors,
+  CanvasesListWebhookDeliveriesResponses,
+  CanvasesReplayWebhookDeliveryData,
+  CanvasesReplayWebhookDeliveryErrors,
+  CanvasesReplayWebhookDeliveryResponses,
   CanvasesResolveExecutionErrorsData,
   CanvasesResolveExecutionErrorsErrors,
   CanvasesResolveExecutionErrorsResponses,
@@ -72,6 +87,9 @@ import type {
   CanvasesUpdateNodePauseData,
   CanvasesUpdateNodePauseErrors,
   CanvasesUpdateNodePauseResponses,
+  CanvasesWatchCanvasData,
+  CanvasesWatchCanvasErrors,
+  CanvasesWatchCanvasResponses,
   ComponentsDescribeComponentData,
   ComponentsDescribeComponentErrors,
   ComponentsDescribeComponentResponses,
@@ -123,21 +141,33 @@ import type {
   OrganizationsCreateInvitationData,
   OrganizationsCreateInvitationErrors,
   OrganizationsCreateInvitationResponses,
+  OrganizationsCreateWebhookSubscriptionData,
+  OrganizationsCreateWebhookSubscriptionErrors,
+  OrganizationsCreateWebhookSubscriptionResponses,
   OrganizationsDeleteIntegrationData,
   OrganizationsDeleteIntegrationErrors,
   OrganizationsDeleteIntegrationResponses,
   OrganizationsDeleteOrganizationData,
   OrganizationsDeleteOrganizationErrors,
   OrganizationsDeleteOrganizationResponses,
+  OrganizationsDeleteWebhookSubscriptionData,
+  OrganizationsDeleteWebhookSubscriptionErrors,
+  OrganizationsDeleteWebhookSubscriptionResponses,
   OrganizationsDescribeIntegrationData,
   OrganizationsDescribeIntegrationErrors,
   OrganizationsDescribeIntegrationResponses,
   OrganizationsDescribeOrganizationData,
   OrganizationsDescribeOrganizationErrors,
   OrganizationsDescribeOrganizationResponses,
+  OrganizationsExportOrganizationData,
+  OrganizationsExportOrganizationErrors,
+  OrganizationsExportOrganizationResponses,
   OrganizationsGetInviteLinkData,
   OrganizationsGetInviteLinkErrors,
   OrganizationsGetInviteLinkResponses,
+  OrganizationsImportOrganizationData,
+  OrganizationsImportOrganizationErrors,
+  OrganizationsImportOrganizationResponses,
   OrganizationsListIntegrationResourcesData,
   OrganizationsListIntegrationResourcesErrors,
   OrganizationsListIntegrationResourcesResponses,
@@ -147,6 +177,15 @@ import type {
   OrganizationsListInvitationsData,
   OrganizationsListInvitationsErrors,
   OrganizationsListInvitationsResponses,
+  OrganizationsListStuckWorkData,
+  OrganizationsListStuckWorkErrors,
+  OrganizationsListStuckWorkResponses,
+  OrganizationsListWebhookSubscriptionDeliveriesData,
+  OrganizationsListWebhookSubscriptionDeliveriesErrors,
+  OrganizationsListWebhookSubscriptionDeliveriesResponses,
+  OrganizationsListWebhookSubscriptionsData,
+  OrganizationsListWebhookSubscriptionsErrors,
+  OrganizationsListWebhookSubscriptionsResponses,
   OrganizationsRemoveInvitationData,
   OrganizationsRemoveInvitationErrors,
   OrganizationsRemoveInvitationResponses,
@@ -165,6 +204,9 @@ import type {
   OrganizationsUpdateOrganizationData,
   OrganizationsUpdateOrganizationErrors,
   OrganizationsUpdateOrganizationResponses,
+  OrganizationsUpdateWebhookSubscriptionData,
+  OrganizationsUpdateWebhookSubscriptionErrors,
+  OrganizationsUpdateWebhookSubscriptionResponses,
   RolesAssignRoleData,
   RolesAssignRoleErrors,
   RolesAssignRoleResponses,
@@ -395,6 +437,19 @@ export const canvasesResolveExecutionErrors = <ThrowOnError extends boolean = tr
     },
   });
 
+/**
+ * Describe an execution
+ *
+ * Returns the details of a canvas node execution
+ */
+export const canvasesDescribeExecution = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesDescribeExecutionData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesDescribeExecutionResponses, CanvasesDescribeExecutionErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/executions/{executionId}",
+    ...options,
+  });
+
 /**
  * Invoke execution action
  *
@@ -454,6 +509,32 @@ export const canvasesListChildExecutions = <ThrowOnError extends boolean = true>
     },
   });
 
+/**
+ * Get execution logs
+ *
+ * Streams the logs of an execution as newline-delimited JSON, optionally following new lines until the execution finishes.
+ */
+export const canvasesGetExecutionLogs = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesGetExecutionLogsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesGetExecutionLogsResponses, CanvasesGetExecutionLogsErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/executions/{executionId}/logs",
+    ...options,
+  });
+
+/**
+ * Get canvas metrics
+ *
+ * Returns execution counts, durations and wait times for a canvas and its nodes over a time window
+ */
+export const canvasesGetCanvasMetrics = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesGetCanvasMetricsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesGetCanvasMetricsResponses, CanvasesGetCanvasMetricsErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/metrics",
+    ...options,
+  });
+
 /**
  * List node events
  *
@@ -541,6 +622,41 @@ export const canvasesDeleteNodeQueueItem = <ThrowOnError extends boolean = true>
     ThrowOnError
   >({ url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/queue/{itemId}", ...options });
 
+/**
+ * List webhook deliveries
+ *
+ * Returns the most recent requests received by the webhook of a canvas node
+ */
+export const canvasesListWebhookDeliveries = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesListWebhookDeliveriesData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesListWebhookDeliveriesResponses,
+    CanvasesListWebhookDeliveriesErrors,
+    ThrowOnError
+  >({ url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/webhook-deliveries", ...options });
+
+/**
+ * Replay webhook delivery
+ *
+ * Handles a previously received webhook request again with the current node configuration
+ */
+export const canvasesReplayWebhookDelivery = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesReplayWebhookDeliveryData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    CanvasesReplayWebhookDeliveryResponses,
+    CanvasesReplayWebhookDeliveryErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/webhook-deliveries/{deliveryId}/replay",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * Invoke trigger action
  *
@@ -562,6 +678,19 @@ export const canvasesInvokeNodeTriggerAction = <ThrowOnError extends boolean = t
     },
   });
 
+/**
+ * Watch canvas
+ *
+ * Streams canvas activity as newline-delimited JSON. Resume from a previous message using its cursor.
+ */
+export const canvasesWatchCanvas = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesWatchCanvasData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesWatchCanvasResponses, CanvasesWatchCanvasErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/watch",
+    ...options,
+  });
+
 /**
  * Delete canvas
  *
@@ -862,6 +991,48 @@ export const organizationsUpdateOrganization = <ThrowOnError extends boolean = t
     },
   });
 
+/**
+ * Export an organization
+ *
+ * Exports the canvases, blueprints, roles, groups, integrations and secrets of an organization to a portable archive
+ */
+export const organizationsExportOrganization = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsExportOrganizationData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    OrganizationsExportOrganizationResponses,
+    OrganizationsExportOrganizationErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/organizations/{id}/export",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Import an organization
+ *
+ * Recreates the resources of an organization archive in this organization
+ */
+export const organizationsImportOrganization = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsImportOrganizationData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    OrganizationsImportOrganizationResponses,
+    OrganizationsImportOrganizationErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/organizations/{id}/import",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * List integrations in an organization
  *
@@ -1057,6 +1228,19 @@ export const organizationsResetInviteLink = <ThrowOnError extends boolean = true
     ThrowOnError
   >({ url: "/api/v1/organizations/{id}/invite-link/reset", ...options });
 
+/**
+ * List stuck work
+ *
+ * Lists nodes, queue items, executions and webhooks that have not progressed for longer than expected
+ */
+export const organizationsListStuckWork = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsListStuckWorkData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<OrganizationsListStuckWorkResponses, OrganizationsListStuckWorkErrors, ThrowOnError>({
+    url: "/api/v1/organizations/{id}/stuck-work",
+    ...options,
+  });
+
 /**
  * Remove a user from an organization
  *
@@ -1070,6 +1254,90 @@ export const organizationsRemoveUser = <ThrowOnError extends boolean = true>(
     ...options,
   });
 
+/**
+ * List webhook subscriptions
+ *
+ * Lists the webhooks that receive activity from the organization
+ */
+export const organizationsListWebhookSubscriptions = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsListWebhookSubscriptionsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    OrganizationsListWebhookSubscriptionsResponses,
+    OrganizationsListWebhookSubscriptionsErrors,
+    ThrowOnError
+  >({ url: "/api/v1/organizations/{id}/webhook-subscriptions", ...options });
+
+/**
+ * Create webhook subscription
+ *
+ * Creates a webhook that receives activity from the organization. The signing secret is only returned here.
+ */
+export const organizationsCreateWebhookSubscription = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsCreateWebhookSubscriptionData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    OrganizationsCreateWebhookSubscriptionResponses,
+    OrganizationsCreateWebhookSubscriptionErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/organizations/{id}/webhook-subscriptions",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Delete webhook subscription
+ *
+ * Deletes a webhook subscription and its delivery log
+ */
+export const organizationsDeleteWebhookSubscription = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsDeleteWebhookSubscriptionData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    OrganizationsDeleteWebhookSubscriptionResponses,
+    OrganizationsDeleteWebhookSubscriptionErrors,
+    ThrowOnError
+  >({ url: "/api/v1/organizations/{id}/webhook-subscriptions/{subscriptionId}", ...options });
+
+/**
+ * Update webhook subscription
+ *
+ * Updates the URL, event types or state of a webhook subscription
+ */
+export const organizationsUpdateWebhookSubscription = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsUpdateWebhookSubscriptionData, ThrowOnError>,
+) =>
+  (options.client ?? client).patch<
+    OrganizationsUpdateWebhookSubscriptionResponses,
+    OrganizationsUpdateWebhookSubscriptionErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/organizations/{id}/webhook-subscriptions/{subscriptionId}",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * List webhook subscription deliveries
+ *
+ * Lists the most recent deliveries for a webhook subscription
+ */
+export const organizationsListWebhookSubscriptionDeliveries = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsListWebhookSubscriptionDeliveriesData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    OrganizationsListWebhookSubscriptionDeliveriesResponses,
+    OrganizationsListWebhookSubscriptionDeliveriesErrors,
+    ThrowOnError
+  >({ url: "/api/v1/organizations/{id}/webhook-subscriptions/{subscriptionId}/deliveries", ...options });
+
 /**
  * List roles
  *
@@ -192,6 +192,18 @@ export type CanvasesDescribeCanvasResponse = {
   canvas?: CanvasesCanvas;
 };
 
+export type CanvasesDescribeExecutionResponse = {
+  execution?: CanvasesCanvasNodeExecution;
+};
+
+export type CanvasesDurationStats = {
+  count?: number;
+  averageSeconds?: number;
+  p50Seconds?: number;
+  p95Seconds?: number;
+  maxSeconds?: number;
+};
+
 export type CanvasesEmitNodeEventBody = {
   channel?: string;
   data?: {
@@ -203,6 +215,37 @@ export type CanvasesEmitNodeEventResponse = {
   eventId?: string;
 };
 
+export type CanvasesExecutionLogLine = {
+  sequence?: string;
+  level?: ExecutionLogLineLevel;
+  message?: string;
+  fields?: {
+    [key: string]: string;
+  };
+  timestamp?: string;
+};
+
+export type CanvasesExecutionMetrics = {
+  nodeId?: string;
+  component?: string;
+  executions?: number;
+  results?: Array<ExecutionMetricsResultCount>;
+  duration?: CanvasesDurationStats;
+  queueWait?: CanvasesDurationStats;
+  approvalWait?: CanvasesDurationStats;
+};
+
+export type CanvasesGetCanvasMetricsResponse = {
+  startTime?: string;
+  endTime?: string;
+  canvas?: CanvasesExecutionMetrics;
+  nodes?: Array<CanvasesExecutionMetrics>;
+};
+
+export type CanvasesGetExecutionLogsResponse = {
+  lines?: Array<CanvasesExecutionLogLine>;
+};
+
 export type CanvasesInvokeNodeExecutionActionBody = {
   parameters?: {
     [key: string]: unknown;
@@ -269,6 +312,21 @@ export type CanvasesListNodeQueueItemsResponse = {
   lastTimestamp?: string;
 };
 
+export type CanvasesListWebhookDeliveriesResponse = {
+  deliveries?: Array<CanvasesWebhookDelivery>;
+  totalCount?: number;
+  hasNextPage?: boolean;
+  lastTimestamp?: string;
+};
+
+export type CanvasesReplayWebhookDeliveryBody = {
+  [key: string]: unknown;
+};
+
+export type CanvasesReplayWebhookDeliveryResponse = {
+  delivery?: CanvasesWebhookDelivery;
+};
+
 export type CanvasesResolveExecutionErrorsBody = {
   executionIds?: Array<string>;
 };
@@ -293,6 +351,39 @@ export type CanvasesUpdateNodePauseResponse = {
   node?: ComponentsNode;
 };
 
+export type CanvasesWatchCanvasResponse = {
+  type?: CanvasesWatchCanvasResponseType;
+  cursor?: string;
+  timestamp?: string;
+  event?: CanvasesCanvasEvent;
+  execution?: CanvasesCanvasNodeExecution;
+  queueItem?: CanvasesCanvasNodeQueueItem;
+};
+
+export type CanvasesWatchCanvasResponseType =
+  | "TYPE_UNKNOWN"
+  | "TYPE_EVENT_CREATED"
+  | "TYPE_EXECUTION_STATE_CHANGED"
+  | "TYPE_QUEUE_ITEM_CREATED";
+
+export type CanvasesWebhookDelivery = {
+  id?: string;
+  replayOf?: string;
+  method?: string;
+  remoteIp?: string;
+  query?: string;
+  headers?: {
+    [key: string]: string;
+  };
+  body?: string;
+  bodyTruncated?: boolean;
+  statusCode?: number;
+  error?: string;
+  nodes?: Array<WebhookDeliveryRoutedNode>;
+  eventIds?: Array<string>;
+  createdAt?: string;
+};
+
 export type ComponentsComponent = {
   name?: string;
   label?: string;
@@ -493,6 +584,14 @@ export type ConfigurationVisibilityCondition = {
   values?: Array<string>;
 };
 
+export type ExecutionLogLineLevel = "LEVEL_UNKNOWN" | "LEVEL_INFO" | "LEVEL_WARNING" | "LEVEL_ERROR";
+
+export type ExecutionMetricsResultCount = {
+  result?: CanvasNodeExecutionResult;
+  resultReason?: CanvasNodeExecutionResultReason;
+  count?: number;
+};
+
 export type GroupsAddUserToGroupBody = {
   domainType?: AuthorizationDomainType;
   domainId?: string;
@@ -643,6 +742,19 @@ export type OrganizationsCreateInvitationResponse = {
   invitation?: OrganizationsInvitation;
 };
 
+export type OrganizationsCreateWebhookSubscriptionBody = {
+  url?: string;
+  eventTypes?: Array<string>;
+};
+
+export type OrganizationsCreateWebhookSubscriptionResponse = {
+  subscription?: OrganizationsWebhookSubscription;
+  /**
+   * Secret used to sign the requests sent to the webhook.
+   */
+  secret?: string;
+};
+
 export type OrganizationsDeleteIntegrationResponse = {
   [key: string]: unknown;
 };
@@ -651,6 +763,10 @@ export type OrganizationsDeleteOrganizationResponse = {
   [key: string]: unknown;
 };
 
+export type OrganizationsDeleteWebhookSubscriptionResponse = {
+  [key: string]: unknown;
+};
+
 export type OrganizationsDescribeIntegrationResponse = {
   integration?: OrganizationsIntegration;
 };
@@ -659,10 +775,68 @@ export type OrganizationsDescribeOrganizationResponse = {
   organization?: OrganizationsOrganization;
 };
 
+export type OrganizationsExportOrganizationBody = {
+  /**
+   * Secret values are only exported if requested, by users allowed
+   * to read secrets, and are encrypted to this PEM-encoded RSA public key.
+   */
+  includeSecretValues?: boolean;
+  publicKey?: string;
+};
+
+export type OrganizationsExportOrganizationResponse = {
+  /**
+   * JSON document with the exported resources.
+   */
+  archive?: string;
+};
+
 export type OrganizationsGetInviteLinkResponse = {
   inviteLink?: OrganizationsInviteLink;
 };
 
+export type OrganizationsImportOrganizationBody = {
+  archive?: string;
+  /**
+   * Values of the secrets, by secret name, decrypted by the client
+   * from the secret values of the archive. Secrets without values
+   * are created with their keys, but empty values.
+   */
+  secretValues?: {
+    [key: string]: OrganizationsSecretValues;
+  };
+};
+
+export type OrganizationsImportOrganizationResponse = {
+  resources?: Array<OrganizationsImportedResource>;
+  warnings?: Array<string>;
+};
+
+export type OrganizationsImportedResource = {
+  kind?: OrganizationsImportedResourceKind;
+  name?: string;
+  /**
+   * IDs in the archive and in this organization.
+   * Empty for resources identified only by name.
+   */
+  archiveId?: string;
+  id?: string;
+  /**
+   * Resources with the same name already present
+   * are not changed, and the existing one is used.
+   */
+  skipped?: boolean;
+};
+
+export type OrganizationsImportedResourceKind =
+  | "KIND_UNKNOWN"
+  | "KIND_ROLE"
+  | "KIND_GROUP"
+  | "KIND_INTEGRATION"
+  | "KIND_SECRET"
+  | "KIND_BLUEPRINT"
+  | "KIND_CANVAS";
+
 export type OrganizationsIntegration = {
   metadata?: OrganizationsIntegrationMetadata;
   spec?: OrganizationsIntegrationSpec;
@@ -724,6 +898,21 @@ export type OrganizationsListInvitationsResponse = {
   invitations?: Array<OrganizationsInvitation>;
 };
 
+export type OrganizationsListStuckWorkResponse = {
+  items?: Array<OrganizationsStuckWork>;
+};
+
+export type OrganizationsListWebhookSubscriptionDeliveriesResponse = {
+  deliveries?: Array<OrganizationsWebhookSubscriptionDelivery>;
+  totalCount?: number;
+  hasNextPage?: boolean;
+  lastTimestamp?: string;
+};
+
+export type OrganizationsListWebhookSubscriptionsResponse = {
+  subscriptions?: Array<OrganizationsWebhookSubscription>;
+};
+
 export type OrganizationsOrganization = {
   metadata?: OrganizationsOrganizationMetadata;
 };
@@ -748,6 +937,36 @@ export type OrganizationsResetInviteLinkResponse = {
   inviteLink?: OrganizationsInviteLink;
 };
 
+export type OrganizationsSecretValues = {
+  data?: {
+    [key: string]: string;
+  };
+};
+
+export type OrganizationsStuckWork = {
+  kind?: OrganizationsStuckWorkKind;
+  canvasId?: string;
+  nodeId?: string;
+  /**
+   * ID of the stuck execution or webhook.
+   * Empty for the other kinds.
+   */
+  resourceId?: string;
+  /**
+   * Number of queue items waiting, for KIND_QUEUE_ITEMS.
+   */
+  count?: string;
+  since?: string;
+  reason?: string;
+};
+
+export type OrganizationsStuckWorkKind =
+  | "KIND_UNKNOWN"
+  | "KIND_NODE_PROCESSING"
+  | "KIND_QUEUE_ITEMS"
+  | "KIND_EXECUTION_STARTED"
+  | "KIND_WEBHOOK_PENDING";
+
 export type OrganizationsUpdateIntegrationBody = {
   configuration?: {
     [key: string]: unknown;
@@ -775,6 +994,48 @@ export type OrganizationsUpdateOrganizationResponse = {
   organization?: OrganizationsOrganization;
 };
 
+export type OrganizationsUpdateWebhookSubscriptionBody = {
+  url?: string;
+  eventTypes?: Array<string>;
+  enabled?: boolean;
+  /**
+   * Set to replace the event types, including with an empty list.
+   */
+  updateEventTypes?: boolean;
+};
+
+export type OrganizationsUpdateWebhookSubscriptionResponse = {
+  subscription?: OrganizationsWebhookSubscription;
+};
+
+export type OrganizationsWebhookSubscription = {
+  id?: string;
+  url?: string;
+  /**
+   * Types of events sent to the webhook.
+   * If empty, all event types are sent.
+   */
+  eventTypes?: Array<string>;
+  enabled?: boolean;
+  createdAt?: string;
+  updatedAt?: string;
+};
+
+export type OrganizationsWebhookSubscriptionDelivery = {
+  id?: string;
+  eventType?: string;
+  state?: string;
+  attempts?: number;
+  lastStatusCode?: number;
+  lastError?: string;
+  payload?: {
+    [key: string]: unknown;
+  };
+  nextAttemptAt?: string;
+  deliveredAt?: string;
+  createdAt?: string;
+};
+
 export type RolesAssignRoleBody = {
   domainType?: AuthorizationDomainType;
   domainId?: string;
@@ -1040,6 +1301,13 @@ export type UsersUserStatus = {
   roleAssignments?: Array<UsersUserRoleAssignment>;
 };
 
+export type WebhookDeliveryRoutedNode = {
+  canvasId?: string;
+  nodeId?: string;
+  statusCode?: number;
+  error?: string;
+};
+
 export type WidgetsDescribeWidgetResponse = {
   widget?: WidgetsWidget;
 };
@@ -1357,6 +1625,35 @@ export type CanvasesResolveExecutionErrorsResponses = {
 export type CanvasesResolveExecutionErrorsResponse2 =
   CanvasesResolveExecutionErrorsResponses[keyof CanvasesResolveExecutionErrorsResponses];
 
+export type CanvasesDescribeExecutionData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    executionId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/executions/{executionId}";
+};
+
+export type CanvasesDescribeExecutionErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesDescribeExecutionError = CanvasesDescribeExecutionErrors[keyof CanvasesDescribeExecutionErrors];
+
+export type CanvasesDescribeExecutionResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesDescribeExecutionResponse;
+};
+
+export type CanvasesDescribeExecutionResponse2 =
+  CanvasesDescribeExecutionResponses[keyof CanvasesDescribeExecutionResponses];
+
 export type CanvasesInvokeNodeExecutionActionData = {
   body: CanvasesInvokeNodeExecutionActionBody;
   path: {
@@ -1446,6 +1743,87 @@ export type CanvasesListChildExecutionsResponses = {
 export type CanvasesListChildExecutionsResponse2 =
   CanvasesListChildExecutionsResponses[keyof CanvasesListChildExecutionsResponses];
 
+export type CanvasesGetExecutionLogsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    executionId: string;
+  };
+  query?: {
+    /**
+     * Keep streaming new lines until the execution finishes.
+     */
+    follow?: boolean;
+    /**
+     * Only lines after this sequence number are streamed.
+     * Used to resume the stream after a disconnect.
+     */
+    afterSequence?: string;
+  };
+  url: "/api/v1/canvases/{canvasId}/executions/{executionId}/logs";
+};
+
+export type CanvasesGetExecutionLogsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesGetExecutionLogsError = CanvasesGetExecutionLogsErrors[keyof CanvasesGetExecutionLogsErrors];
+
+export type CanvasesGetExecutionLogsResponses = {
+  /**
+   * A successful response.(streaming responses)
+   */
+  200: {
+    result?: CanvasesGetExecutionLogsResponse;
+    error?: GooglerpcStatus;
+  };
+};
+
+export type CanvasesGetExecutionLogsResponse2 =
+  CanvasesGetExecutionLogsResponses[keyof CanvasesGetExecutionLogsResponses];
+
+export type CanvasesGetCanvasMetricsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+  };
+  query?: {
+    /**
+     * Only executions finished in this window are included.
+     * Defaults to the last 24 hours.
+     */
+    startTime?: string;
+    endTime?: string;
+    /**
+     * Only include these nodes.
+     */
+    nodeIds?: Array<string>;
+  };
+  url: "/api/v1/canvases/{canvasId}/metrics";
+};
+
+export type CanvasesGetCanvasMetricsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesGetCanvasMetricsError = CanvasesGetCanvasMetricsErrors[keyof CanvasesGetCanvasMetricsErrors];
+
+export type CanvasesGetCanvasMetricsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesGetCanvasMetricsResponse;
+};
+
+export type CanvasesGetCanvasMetricsResponse2 =
+  CanvasesGetCanvasMetricsResponses[keyof CanvasesGetCanvasMetricsResponses];
+
 export type CanvasesListNodeEventsData = {
   body?: never;
   path: {
@@ -1630,6 +2008,70 @@ export type CanvasesDeleteNodeQueueItemResponses = {
 export type CanvasesDeleteNodeQueueItemResponse2 =
   CanvasesDeleteNodeQueueItemResponses[keyof CanvasesDeleteNodeQueueItemResponses];
 
+export type CanvasesListWebhookDeliveriesData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    nodeId: string;
+  };
+  query?: {
+    limit?: number;
+    before?: string;
+  };
+  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/webhook-deliveries";
+};
+
+export type CanvasesListWebhookDeliveriesErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListWebhookDeliveriesError =
+  CanvasesListWebhookDeliveriesErrors[keyof CanvasesListWebhookDeliveriesErrors];
+
+export type CanvasesListWebhookDeliveriesResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListWebhookDeliveriesResponse;
+};
+
+export type CanvasesListWebhookDeliveriesResponse2 =
+  CanvasesListWebhookDeliveriesResponses[keyof CanvasesListWebhookDeliveriesResponses];
+
+export type CanvasesReplayWebhookDeliveryData = {
+  body: CanvasesReplayWebhookDeliveryBody;
+  path: {
+    canvasId: string;
+    nodeId: string;
+    deliveryId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/webhook-deliveries/{deliveryId}/replay";
+};
+
+export type CanvasesReplayWebhookDeliveryErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesReplayWebhookDeliveryError =
+  CanvasesReplayWebhookDeliveryErrors[keyof CanvasesReplayWebhookDeliveryErrors];
+
+export type CanvasesReplayWebhookDeliveryResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesReplayWebhookDeliveryResponse;
+};
+
+export type CanvasesReplayWebhookDeliveryResponse2 =
+  CanvasesReplayWebhookDeliveryResponses[keyof CanvasesReplayWebhookDeliveryResponses];
+
 export type CanvasesInvokeNodeTriggerActionData = {
   body: CanvasesInvokeNodeTriggerActionBody;
   path: {
@@ -1661,6 +2103,50 @@ export type CanvasesInvokeNodeTriggerActionResponses = {
 export type CanvasesInvokeNodeTriggerActionResponse2 =
   CanvasesInvokeNodeTriggerActionResponses[keyof CanvasesInvokeNodeTriggerActionResponses];
 
+export type CanvasesWatchCanvasData = {
+  body?: never;
+  path: {
+    canvasId: string;
+  };
+  query?: {
+    /**
+     * Only stream activity for these nodes.
+     */
+    nodeIds?: Array<string>;
+    /**
+     * Only stream these types of messages.
+     */
+    types?: Array<"TYPE_UNKNOWN" | "TYPE_EVENT_CREATED" | "TYPE_EXECUTION_STATE_CHANGED" | "TYPE_QUEUE_ITEM_CREATED">;
+    /**
+     * Cursor from a previously received message.
+     * If empty, only activity from now on is streamed.
+     */
+    cursor?: string;
+  };
+  url: "/api/v1/canvases/{canvasId}/watch";
+};
+
+export type CanvasesWatchCanvasErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesWatchCanvasError = CanvasesWatchCanvasErrors[keyof CanvasesWatchCanvasErrors];
+
+export type CanvasesWatchCanvasResponses = {
+  /**
+   * A successful response.(streaming responses)
+   */
+  200: {
+    result?: CanvasesWatchCanvasResponse;
+    error?: GooglerpcStatus;
+  };
+};
+
+export type CanvasesWatchCanvasResponse2 = CanvasesWatchCanvasResponses[keyof CanvasesWatchCanvasResponses];
+
 export type CanvasesDeleteCanvasData = {
   body?: never;
   path: {
@@ -2252,6 +2738,64 @@ export type OrganizationsUpdateOrganizationResponses = {
 export type OrganizationsUpdateOrganizationResponse2 =
   OrganizationsUpdateOrganizationResponses[keyof OrganizationsUpdateOrganizationResponses];
 
+export type OrganizationsExportOrganizationData = {
+  body: OrganizationsExportOrganizationBody;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/export";
+};
+
+export type OrganizationsExportOrganizationErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsExportOrganizationError =
+  OrganizationsExportOrganizationErrors[keyof OrganizationsExportOrganizationErrors];
+
+export type OrganizationsExportOrganizationResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsExportOrganizationResponse;
+};
+
+export type OrganizationsExportOrganizationResponse2 =
+  OrganizationsExportOrganizationResponses[keyof OrganizationsExportOrganizationResponses];
+
+export type OrganizationsImportOrganizationData = {
+  body: OrganizationsImportOrganizationBody;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/import";
+};
+
+export type OrganizationsImportOrganizationErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsImportOrganizationError =
+  OrganizationsImportOrganizationErrors[keyof OrganizationsImportOrganizationErrors];
+
+export type OrganizationsImportOrganizationResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsImportOrganizationResponse;
+};
+
+export type OrganizationsImportOrganizationResponse2 =
+  OrganizationsImportOrganizationResponses[keyof OrganizationsImportOrganizationResponses];
+
 export type OrganizationsListIntegrationsData = {
   body?: never;
   path: {
@@ -2606,6 +3150,34 @@ export type OrganizationsResetInviteLinkResponses = {
 export type OrganizationsResetInviteLinkResponse2 =
   OrganizationsResetInviteLinkResponses[keyof OrganizationsResetInviteLinkResponses];
 
+export type OrganizationsListStuckWorkData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/stuck-work";
+};
+
+export type OrganizationsListStuckWorkErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsListStuckWorkError = OrganizationsListStuckWorkErrors[keyof OrganizationsListStuckWorkErrors];
+
+export type OrganizationsListStuckWorkResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsListStuckWorkResponse;
+};
+
+export type OrganizationsListStuckWorkResponse2 =
+  OrganizationsListStuckWorkResponses[keyof OrganizationsListStuckWorkResponses];
+
 export type OrganizationsRemoveUserData = {
   body?: never;
   path: {
@@ -2634,6 +3206,157 @@ export type OrganizationsRemoveUserResponses = {
 
 export type OrganizationsRemoveUserResponse2 = OrganizationsRemoveUserResponses[keyof OrganizationsRemoveUserResponses];
 
+export type OrganizationsListWebhookSubscriptionsData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/webhook-subscriptions";
+};
+
+export type OrganizationsListWebhookSubscriptionsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsListWebhookSubscriptionsError =
+  OrganizationsListWebhookSubscriptionsErrors[keyof OrganizationsListWebhookSubscriptionsErrors];
+
+export type OrganizationsListWebhookSubscriptionsResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsListWebhookSubscriptionsResponse;
+};
+
+export type OrganizationsListWebhookSubscriptionsResponse2 =
+  OrganizationsListWebhookSubscriptionsResponses[keyof OrganizationsListWebhookSubscriptionsResponses];
+
+export type OrganizationsCreateWebhookSubscriptionData = {
+  body: OrganizationsCreateWebhookSubscriptionBody;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/webhook-subscriptions";
+};
+
+export type OrganizationsCreateWebhookSubscriptionErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsCreateWebhookSubscriptionError =
+  OrganizationsCreateWebhookSubscriptionErrors[keyof OrganizationsCreateWebhookSubscriptionErrors];
+
+export type OrganizationsCreateWebhookSubscriptionResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsCreateWebhookSubscriptionResponse;
+};
+
+export type OrganizationsCreateWebhookSubscriptionResponse2 =
+  OrganizationsCreateWebhookSubscriptionResponses[keyof OrganizationsCreateWebhookSubscriptionResponses];
+
+export type OrganizationsDeleteWebhookSubscriptionData = {
+  body?: never;
+  path: {
+    id: string;
+    subscriptionId: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/webhook-subscriptions/{subscriptionId}";
+};
+
+export type OrganizationsDeleteWebhookSubscriptionErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsDeleteWebhookSubscriptionError =
+  OrganizationsDeleteWebhookSubscriptionErrors[keyof OrganizationsDeleteWebhookSubscriptionErrors];
+
+export type OrganizationsDeleteWebhookSubscriptionResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsDeleteWebhookSubscriptionResponse;
+};
+
+export type OrganizationsDeleteWebhookSubscriptionResponse2 =
+  OrganizationsDeleteWebhookSubscriptionResponses[keyof OrganizationsDeleteWebhookSubscriptionResponses];
+
+export type OrganizationsUpdateWebhookSubscriptionData = {
+  body: OrganizationsUpdateWebhookSubscriptionBody;
+  path: {
+    id: string;
+    subscriptionId: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/webhook-subscriptions/{subscriptionId}";
+};
+
+export type OrganizationsUpdateWebhookSubscriptionErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsUpdateWebhookSubscriptionError =
+  OrganizationsUpdateWebhookSubscriptionErrors[keyof OrganizationsUpdateWebhookSubscriptionErrors];
+
+export type OrganizationsUpdateWebhookSubscriptionResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsUpdateWebhookSubscriptionResponse;
+};
+
+export type OrganizationsUpdateWebhookSubscriptionResponse2 =
+  OrganizationsUpdateWebhookSubscriptionResponses[keyof OrganizationsUpdateWebhookSubscriptionResponses];
+
+export type OrganizationsListWebhookSubscriptionDeliveriesData = {
+  body?: never;
+  path: {
+    id: string;
+    subscriptionId: string;
+  };
+  query?: {
+    limit?: number;
+    before?: string;
+  };
+  url: "/api/v1/organizations/{id}/webhook-subscriptions/{subscriptionId}/deliveries";
+};
+
+export type OrganizationsListWebhookSubscriptionDeliveriesErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsListWebhookSubscriptionDeliveriesError =
+  OrganizationsListWebhookSubscriptionDeliveriesErrors[keyof OrganizationsListWebhookSubscriptionDeliveriesErrors];
+
+export type OrganizationsListWebhookSubscriptionDeliveriesResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsListWebhookSubscriptionDeliveriesResponse;
+};
+
+export type OrganizationsListWebhookSubscriptionDeliveriesResponse2 =
+  OrganizationsListWebhookSubscriptionDeliveriesResponses[keyof OrganizationsListWebhookSubscriptionDeliveriesResponses];
+
 export type RolesListRolesData = {
   body?: never;
   path?: never;